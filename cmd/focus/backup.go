@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/backup"
+	"github.com/urfave/cli/v3"
+)
+
+func newBackupCommand() *cli.Command {
+	return &cli.Command{ //nolint:exhaustruct
+		Name:  "backup",
+		Usage: "dump tasks, their times and settings as json",
+		Flags: append(newDatabaseFlags(),
+			&cli.StringSliceFlag{ //nolint:exhaustruct
+				Name:  "user",
+				Usage: "username to dump (default: all users)",
+			},
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:  "output",
+				Usage: "file to write (default: stdout)",
+			},
+		),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			log.Println("backup")
+
+			cfg, err := loadDatabaseConfig(c)
+			if err != nil {
+				return err
+			}
+
+			return runBackup(ctx, cfg, c.StringSlice("user"), c.String("output"))
+		},
+	}
+}
+
+func newRestoreCommand() *cli.Command {
+	return &cli.Command{ //nolint:exhaustruct
+		Name:  "restore",
+		Usage: "replace users' data with a json dump",
+		Flags: append(newDatabaseFlags(),
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:  "input",
+				Usage: "file to read (default: stdin)",
+			},
+		),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			log.Println("restore")
+
+			cfg, err := loadDatabaseConfig(c)
+			if err != nil {
+				return err
+			}
+
+			return runRestore(ctx, cfg, c.String("input"))
+		},
+	}
+}
+
+func newBackupService(cfg *databaseConfig) (*backup.Service, error) {
+	repo, err := openRepository(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	return backup.NewService(repo, repo, repo, repo, repo, repo, repo), nil
+}
+
+func runBackup(ctx context.Context, cfg *databaseConfig, usernames []string, output string) error {
+	service, err := newBackupService(cfg)
+	if err != nil {
+		return err
+	}
+
+	out, err := service.Backup(ctx, &backup.BackupInput{
+		Usernames: usernames,
+		Now:       time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to backup: %w", err)
+	}
+
+	var writer io.Writer = os.Stdout
+
+	if output != "" {
+		file, err := os.Create(output) //nolint:gosec
+		if err != nil {
+			return fmt.Errorf("failed to create output: %w", err)
+		}
+		defer file.Close() //nolint:errcheck
+
+		writer = file
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	err = encoder.Encode(out.Dump)
+	if err != nil {
+		return fmt.Errorf("failed to encode dump: %w", err)
+	}
+
+	return nil
+}
+
+func runRestore(ctx context.Context, cfg *databaseConfig, input string) error {
+	var reader io.Reader = os.Stdin
+
+	if input != "" {
+		file, err := os.Open(input) //nolint:gosec
+		if err != nil {
+			return fmt.Errorf("failed to open input: %w", err)
+		}
+		defer file.Close() //nolint:errcheck
+
+		reader = file
+	}
+
+	var dump backup.Dump
+
+	err := json.NewDecoder(reader).Decode(&dump)
+	if err != nil {
+		return fmt.Errorf("failed to decode dump: %w", err)
+	}
+
+	service, err := newBackupService(cfg)
+	if err != nil {
+		return err
+	}
+
+	err = service.Restore(ctx, &backup.RestoreInput{
+		Dump: &dump,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/neatflowcv/focus/internal/pkg/repository/gorm"
+	"github.com/urfave/cli/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// flag 이름은 설정 파일의 키이기도 하다.
+const (
+	configFlag            = "config"
+	backendFlag           = "backend"
+	dbFlag                = "db"
+	dbMaxOpenConnsFlag    = "db-max-open-conns"
+	dbMaxIdleConnsFlag    = "db-max-idle-conns"
+	dbConnMaxLifetimeFlag = "db-conn-max-lifetime"
+	dbConnMaxIdleTimeFlag = "db-conn-max-idle-time"
+	logLevelFlag          = "log-level"
+	addrFlag              = "addr"
+	adminAddrFlag         = "admin-addr"
+	readTimeoutFlag       = "read-timeout"
+	writeTimeoutFlag      = "write-timeout"
+
+	envPrefix = "FOCUS_"
+
+	postgresBackend = "postgres"
+	sqliteBackend   = "sqlite"
+	sqliteScheme    = "sqlite://"
+
+	defaultAddr        = ":8080"
+	defaultReadTimeout = 30 * time.Second
+)
+
+var (
+	errUnknownBackend      = errors.New("unknown backend")
+	errUnknownConfigFormat = errors.New("unknown config format")
+	errUnknownConfigKey    = errors.New("unknown config key")
+	errNegative            = errors.New("must not be negative")
+	errMissingSQLitePath   = errors.New("sqlite backend needs a file path in --db")
+	errBackendMismatch     = errors.New("--db is a sqlite path but the backend is postgres")
+	errTooManyIdleConns    = errors.New("--db-max-idle-conns is larger than --db-max-open-conns")
+)
+
+// databaseConfig는 저장소를 여는 명령이 받는 설정이다.
+type databaseConfig struct {
+	Backend string
+	DSN     string // PostgreSQL의 DSN이나 SQLite 파일의 경로
+	Pool    gorm.Pool
+}
+
+// serverConfig는 run 명령이 띄우는 HTTP 서버의 설정이다.
+type serverConfig struct {
+	Addr         string
+	AdminAddr    string // 비어 있으면 관리용 listener를 띄우지 않는다
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+// newDatabaseFlags는 저장소를 여는 명령에 붙이는 flag이다.
+func newDatabaseFlags() []cli.Flag {
+	return databaseFlags(&configFile{path: "", loaded: "", values: nil})
+}
+
+// newServerFlags는 newDatabaseFlags에 서버 설정을 더한다.
+func newServerFlags() []cli.Flag {
+	file := &configFile{path: "", loaded: "", values: nil}
+
+	return append(databaseFlags(file),
+		&cli.StringFlag{ //nolint:exhaustruct
+			Name:      addrFlag,
+			Usage:     "address to listen on",
+			Value:     defaultAddr,
+			Sources:   sources(file, addrFlag),
+			Validator: validateAddr,
+		},
+		&cli.StringFlag{ //nolint:exhaustruct
+			Name:    adminAddrFlag,
+			Usage:   "address to serve /debug/vars on, kept off the API address (default: disabled)",
+			Sources: sources(file, adminAddrFlag),
+			Validator: func(addr string) error {
+				if addr == "" {
+					return nil
+				}
+
+				return validateAddr(addr)
+			},
+		},
+		&cli.DurationFlag{ //nolint:exhaustruct
+			Name:      readTimeoutFlag,
+			Usage:     "maximum duration for reading a request, 0 for no limit",
+			Value:     defaultReadTimeout,
+			Sources:   sources(file, readTimeoutFlag),
+			Validator: nonNegative[time.Duration],
+		},
+		&cli.DurationFlag{ //nolint:exhaustruct
+			Name:      writeTimeoutFlag,
+			Usage:     "maximum duration for writing a response, 0 for no limit (streams end when it passes)",
+			Sources:   sources(file, writeTimeoutFlag),
+			Validator: nonNegative[time.Duration],
+		},
+	)
+}
+
+func databaseFlags(file *configFile) []cli.Flag { //nolint:funlen
+	return []cli.Flag{
+		// 다른 flag가 파일을 읽기 전에 경로가 정해지도록 맨 앞에 둔다.
+		&cli.StringFlag{ //nolint:exhaustruct
+			Name:        configFlag,
+			Usage:       "YAML or TOML file with the same keys as the flags",
+			Sources:     cli.EnvVars(envName(configFlag)),
+			Destination: &file.path,
+			Validator:   file.validate,
+		},
+		&cli.StringFlag{ //nolint:exhaustruct
+			Name:      backendFlag,
+			Usage:     "postgres or sqlite (default: sqlite if --db starts with sqlite://, otherwise postgres)",
+			Sources:   sources(file, backendFlag),
+			Validator: validateBackend,
+		},
+		&cli.StringFlag{ //nolint:exhaustruct
+			Name:    dbFlag,
+			Usage:   "PostgreSQL DSN, or the path of a SQLite file (default: local PostgreSQL)",
+			Sources: sources(file, dbFlag),
+		},
+		&cli.IntFlag{ //nolint:exhaustruct
+			Name:      dbMaxOpenConnsFlag,
+			Usage:     "maximum number of open connections, 0 for no limit",
+			Sources:   sources(file, dbMaxOpenConnsFlag),
+			Validator: nonNegative[int],
+		},
+		&cli.IntFlag{ //nolint:exhaustruct
+			Name:      dbMaxIdleConnsFlag,
+			Usage:     "maximum number of idle connections, 0 for the driver default",
+			Sources:   sources(file, dbMaxIdleConnsFlag),
+			Validator: nonNegative[int],
+		},
+		&cli.DurationFlag{ //nolint:exhaustruct
+			Name:      dbConnMaxLifetimeFlag,
+			Usage:     "maximum time a connection may be reused, 0 for no limit",
+			Sources:   sources(file, dbConnMaxLifetimeFlag),
+			Validator: nonNegative[time.Duration],
+		},
+		&cli.DurationFlag{ //nolint:exhaustruct
+			Name:      dbConnMaxIdleTimeFlag,
+			Usage:     "maximum time a connection may be idle, 0 for no limit",
+			Sources:   sources(file, dbConnMaxIdleTimeFlag),
+			Validator: nonNegative[time.Duration],
+		},
+		&cli.StringFlag{ //nolint:exhaustruct
+			Name:      logLevelFlag,
+			Usage:     "debug, info, warn or error",
+			Value:     "info",
+			Sources:   sources(file, logLevelFlag),
+			Validator: validateLogLevel,
+		},
+	}
+}
+
+// loadDatabaseConfig는 flag 사이의 관계를 검사하고 로그 수준을 맞춘다.
+func loadDatabaseConfig(c *cli.Command) (*databaseConfig, error) {
+	var level slog.Level
+
+	err := level.UnmarshalText([]byte(c.String(logLevelFlag)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", logLevelFlag, err)
+	}
+
+	slog.SetLogLoggerLevel(level)
+
+	backend := c.String(backendFlag)
+	dsn, isSQLite := strings.CutPrefix(c.String(dbFlag), sqliteScheme)
+
+	switch {
+	case backend == "" && isSQLite:
+		backend = sqliteBackend
+	case backend == "":
+		backend = postgresBackend
+	case backend == postgresBackend && isSQLite:
+		return nil, errBackendMismatch
+	}
+
+	if backend == sqliteBackend && dsn == "" {
+		return nil, errMissingSQLitePath
+	}
+
+	pool := gorm.Pool{
+		MaxOpenConns:    c.Int(dbMaxOpenConnsFlag),
+		MaxIdleConns:    c.Int(dbMaxIdleConnsFlag),
+		ConnMaxLifetime: c.Duration(dbConnMaxLifetimeFlag),
+		ConnMaxIdleTime: c.Duration(dbConnMaxIdleTimeFlag),
+	}
+	if pool.MaxOpenConns > 0 && pool.MaxIdleConns > pool.MaxOpenConns {
+		return nil, errTooManyIdleConns
+	}
+
+	return &databaseConfig{
+		Backend: backend,
+		DSN:     dsn,
+		Pool:    pool,
+	}, nil
+}
+
+func loadServerConfig(c *cli.Command) *serverConfig {
+	return &serverConfig{
+		Addr:         c.String(addrFlag),
+		AdminAddr:    c.String(adminAddrFlag),
+		ReadTimeout:  c.Duration(readTimeoutFlag),
+		WriteTimeout: c.Duration(writeTimeoutFlag),
+	}
+}
+
+// sources는 환경 변수를 설정 파일보다 먼저 본다.
+func sources(file *configFile, name string) cli.ValueSourceChain {
+	return cli.NewValueSourceChain(append(cli.EnvVars(envName(name)).Chain, file.source(name))...)
+}
+
+func envName(name string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+// configKeys는 설정 파일에 쓸 수 있는 키이다.
+func configKeys() []string {
+	return []string{
+		backendFlag,
+		dbFlag,
+		dbMaxOpenConnsFlag,
+		dbMaxIdleConnsFlag,
+		dbConnMaxLifetimeFlag,
+		dbConnMaxIdleTimeFlag,
+		logLevelFlag,
+		addrFlag,
+		adminAddrFlag,
+		readTimeoutFlag,
+		writeTimeoutFlag,
+	}
+}
+
+func nonNegative[T int | time.Duration](value T) error {
+	if value < 0 {
+		return fmt.Errorf("%w: %v", errNegative, value)
+	}
+
+	return nil
+}
+
+func validateBackend(backend string) error {
+	switch backend {
+	case "", postgresBackend, sqliteBackend:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", errUnknownBackend, backend)
+	}
+}
+
+func validateAddr(addr string) error {
+	_, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+
+	return nil
+}
+
+func validateLogLevel(text string) error {
+	var level slog.Level
+
+	err := level.UnmarshalText([]byte(text))
+	if err != nil {
+		return fmt.Errorf("invalid log level: %w", err)
+	}
+
+	return nil
+}
+
+// configFile은 --config로 받은 파일이며 처음 한 번 읽는다.
+type configFile struct {
+	path   string
+	loaded string // values를 읽어 온 경로
+	values map[string]any
+}
+
+func (f *configFile) source(key string) cli.ValueSource {
+	return &configValue{file: f, key: key}
+}
+
+// validate는 --config가 정해질 때 불려서 읽을 수 없는 파일과 모르는 키를 시작할 때 알린다.
+func (f *configFile) validate(path string) error {
+	values, err := f.load(path)
+	if err != nil {
+		return err
+	}
+
+	for key := range values {
+		if !slices.Contains(configKeys(), key) {
+			return fmt.Errorf("%w: %s", errUnknownConfigKey, key)
+		}
+	}
+
+	return nil
+}
+
+func (f *configFile) load(path string) (map[string]any, error) {
+	if path == "" || path == f.loaded {
+		return f.values, nil
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	values := map[string]any{}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &values)
+	case ".toml":
+		err = toml.Unmarshal(data, &values)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownConfigFormat, path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	f.loaded = path
+	f.values = values
+
+	return values, nil
+}
+
+// configValue는 설정 파일의 키 하나를 flag의 값으로 준다.
+type configValue struct {
+	file *configFile
+	key  string
+}
+
+func (v *configValue) Lookup() (string, bool) {
+	// 파일의 에러는 --config의 validate가 이미 알렸다.
+	values, err := v.file.load(v.file.path)
+	if err != nil {
+		return "", false
+	}
+
+	value, ok := values[v.key]
+	if !ok {
+		return "", false
+	}
+
+	return fmt.Sprint(value), true
+}
+
+func (v *configValue) String() string {
+	return fmt.Sprintf("key %q in the config file", v.key)
+}
+
+func (v *configValue) GoString() string {
+	return fmt.Sprintf("&configValue{key:%q}", v.key)
+}
@@ -1,31 +1,31 @@
 package main
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/neatflowcv/focus/gen/task"
+	"github.com/neatflowcv/focus/internal/app/analytics"
+	"github.com/neatflowcv/focus/internal/app/autostop"
+	"github.com/neatflowcv/focus/internal/app/burndown"
 	"github.com/neatflowcv/focus/internal/app/extra"
 	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/projection"
+	"github.com/neatflowcv/focus/internal/app/stream"
 	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/app/webhook"
 )
 
-func makeCreateTaskOutput(
-	in *task.CreateTaskInput,
-	out *flow.CreateTaskOutput,
-	extraOut *extra.ListExtrasOutput,
-	traceOut *trace.ListTracesOutput,
-) *task.Createtaskoutput {
-	return &task.Createtaskoutput{
-		ID:            out.ID,
-		ParentID:      in.ParentID,
-		Title:         in.Title,
-		CreatedAt:     out.CreatedAt.Unix(),
-		EstimatedTime: pointer(int64(traceOut.Traces[0].Estimated.Seconds())),
-		ActualTime:    pointer(int64(traceOut.Traces[0].Actual.Seconds())),
-		StartedAt:     startedAt(traceOut.Traces[0].StartedAt),
-		Status:        &extraOut.Extras[0].Status,
-		IsLeaf:        &extraOut.Extras[0].Leaf,
-	}
+// makeCreateTaskOutput은 이벤트가 만들 처음 상태로 응답한다.
+func makeCreateTaskOutput(in *task.CreateTaskInput, out *flow.CreateTaskOutput) *task.Createtaskoutput {
+	return makeTaskOutput(
+		out.ID,
+		in.ParentID,
+		in.Title,
+		out.CreatedAt,
+		extra.InitialExtra(out.ID),
+		trace.InitialTrace(out.ID),
+	)
 }
 
 func pointer[T any](v T) *T {
@@ -33,24 +33,20 @@ func pointer[T any](v T) *T {
 }
 
 func makeCreatetaskoutputCollection(
-	in *task.ListPayload,
 	flowOut *flow.ListTasksOutput,
 	extraOut *extra.ListExtrasOutput,
 	traceOut *trace.ListTracesOutput,
 ) task.CreatetaskoutputCollection {
 	var ret task.CreatetaskoutputCollection
-	for idx, item := range flowOut.Tasks {
-		ret = append(ret, &task.Createtaskoutput{
-			ID:            item.ID,
-			ParentID:      in.ParentID,
-			Title:         item.Title,
-			CreatedAt:     item.CreatedAt.Unix(),
-			EstimatedTime: pointer(int64(traceOut.Traces[idx].Estimated.Seconds())),
-			ActualTime:    pointer(int64(traceOut.Traces[idx].Actual.Seconds())),
-			StartedAt:     startedAt(traceOut.Traces[idx].StartedAt),
-			Status:        &extraOut.Extras[idx].Status,
-			IsLeaf:        &extraOut.Extras[idx].Leaf,
-		})
+	for _, item := range flowOut.Tasks {
+		ret = append(ret, makeTaskOutput(
+			item.ID,
+			nonEmpty(item.ParentID),
+			item.Title,
+			item.CreatedAt,
+			findExtra(extraOut, item.ID),
+			findTrace(traceOut, item.ID),
+		))
 	}
 
 	return ret
@@ -62,23 +58,344 @@ func makeUpdateTaskOutput(
 	extraOut *extra.ListExtrasOutput,
 	traceOut *trace.ListTracesOutput,
 ) *task.Createtaskoutput {
-	return &task.Createtaskoutput{
-		ID:            in.TaskID,
-		ParentID:      in.ParentID,
-		Title:         in.Title,
-		CreatedAt:     flowOut.Task.CreatedAt.Unix(),
-		EstimatedTime: pointer(int64(traceOut.Traces[0].Estimated.Seconds())),
-		ActualTime:    pointer(int64(traceOut.Traces[0].Actual.Seconds())),
-		StartedAt:     startedAt(traceOut.Traces[0].StartedAt),
-		Status:        &extraOut.Extras[0].Status,
-		IsLeaf:        &extraOut.Extras[0].Leaf,
+	return makeTaskOutput(
+		in.TaskID,
+		in.ParentID,
+		in.Title,
+		flowOut.Task.CreatedAt,
+		findExtra(extraOut, in.TaskID),
+		findTrace(traceOut, in.TaskID),
+	)
+}
+
+// makeTaskOutput은 extra나 trace가 아직 없으면 해당 필드를 비운다.
+func makeTaskOutput(
+	id string,
+	parentID *string,
+	title string,
+	createdAt time.Time,
+	extraItem *extra.Extra,
+	traceItem *trace.Trace,
+) *task.Createtaskoutput {
+	ret := &task.Createtaskoutput{ //nolint:exhaustruct
+		ID:        id,
+		ParentID:  parentID,
+		Title:     title,
+		CreatedAt: createdAt.Unix(),
+	}
+
+	if traceItem != nil {
+		ret.EstimatedTime = pointer(int64(traceItem.Estimated.Seconds()))
+		ret.ActualTime = pointer(int64(traceItem.Actual.Seconds()))
+		ret.StartedAt = timestamp(traceItem.StartedAt)
+		ret.DueAt = timestamp(traceItem.DueAt)
+	}
+
+	if extraItem != nil {
+		ret.Status = &extraItem.Status
+		ret.IsLeaf = &extraItem.Leaf
+		ret.Rollup = &extraItem.Rollup
+		ret.FirstStartedAt = timestamp(extraItem.FirstStartedAt)
+		ret.CompletedAt = timestamp(extraItem.CompletedAt)
+		ret.Reopens = &extraItem.Reopens
+	}
+
+	return ret
+}
+
+func findExtra(out *extra.ListExtrasOutput, id string) *extra.Extra {
+	for _, item := range out.Extras {
+		if item.ID == id {
+			return item
+		}
+	}
+
+	return nil
+}
+
+func findTrace(out *trace.ListTracesOutput, id string) *trace.Trace {
+	for _, item := range out.Traces {
+		if item.ID == id {
+			return item
+		}
 	}
+
+	return nil
 }
 
-func startedAt(t time.Time) *int64 {
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func timestamp(t time.Time) *int64 {
 	if t.IsZero() {
 		return nil
 	}
 
 	return pointer(t.Unix())
 }
+
+func valueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// parseDate는 YYYY-MM-DD를 location의 자정으로 바꾼다.
+func parseDate(s *string, location *time.Location) (time.Time, error) {
+	if s == nil {
+		return time.Time{}, nil
+	}
+
+	date, err := time.ParseInLocation(time.DateOnly, *s, location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse date: %w", err)
+	}
+
+	return date, nil
+}
+
+func makeEstimatesOutput(out *analytics.EstimatesOutput) *task.EstimatesOutput {
+	return &task.EstimatesOutput{
+		Summary:   makeEstimateStats(out.Summary),
+		Timeline:  makeEstimateGroups(out.Timeline),
+		ByDepth:   makeEstimateGroups(out.ByDepth),
+		ByTag:     makeEstimateGroups(out.ByTag),
+		BySubtree: makeEstimateGroups(out.BySubtree),
+	}
+}
+
+func makeEstimateGroups(groups []*analytics.Group) []*task.EstimateGroup {
+	ret := make([]*task.EstimateGroup, 0, len(groups))
+	for _, group := range groups {
+		ret = append(ret, &task.EstimateGroup{
+			Key:   group.Key,
+			Stats: makeEstimateStats(group.Stats),
+		})
+	}
+
+	return ret
+}
+
+func makeEstimateStats(stats analytics.Stats) *task.EstimateStats {
+	return &task.EstimateStats{
+		Count:         stats.Count,
+		MedianRatio:   stats.MedianRatio,
+		MeanAccuracy:  stats.MeanAccuracy,
+		MedianOverrun: int64(stats.MedianOverrun.Seconds()),
+	}
+}
+
+func makeLifecycleOutput(out *analytics.LifecycleOutput) *task.LifecycleOutput {
+	tasks := make([]*task.LifecycleTask, 0, len(out.Tasks))
+	for _, item := range out.Tasks {
+		var cycleTime *int64
+		if !item.FirstStartedAt.IsZero() {
+			cycleTime = pointer(int64(item.CycleTime.Seconds()))
+		}
+
+		tasks = append(tasks, &task.LifecycleTask{
+			ID:             item.ID,
+			Title:          item.Title,
+			IsLeaf:         item.Leaf,
+			CreatedAt:      item.CreatedAt.Unix(),
+			FirstStartedAt: timestamp(item.FirstStartedAt),
+			CompletedAt:    item.CompletedAt.Unix(),
+			Reopens:        item.Reopens,
+			CycleTime:      cycleTime,
+			LeadTime:       int64(item.LeadTime.Seconds()),
+		})
+	}
+
+	groups := make([]*task.FlowGroup, 0, len(out.BySubtree))
+	for _, group := range out.BySubtree {
+		groups = append(groups, &task.FlowGroup{
+			Key:   group.Key,
+			Stats: makeFlowStats(group.Stats),
+		})
+	}
+
+	return &task.LifecycleOutput{
+		Tasks:     tasks,
+		Summary:   makeFlowStats(out.Summary),
+		BySubtree: groups,
+	}
+}
+
+func makeFlowStats(stats analytics.FlowStats) *task.FlowStats {
+	return &task.FlowStats{
+		Count:           stats.Count,
+		Started:         stats.Started,
+		Reopens:         stats.Reopens,
+		MedianCycleTime: int64(stats.MedianCycleTime.Seconds()),
+		MeanCycleTime:   int64(stats.MeanCycleTime.Seconds()),
+		MedianLeadTime:  int64(stats.MedianLeadTime.Seconds()),
+		MeanLeadTime:    int64(stats.MeanLeadTime.Seconds()),
+	}
+}
+
+func makeSessionOutputs(out *trace.ListSessionsOutput) []*task.SessionOutput {
+	ret := make([]*task.SessionOutput, 0, len(out.Sessions))
+	for _, session := range out.Sessions {
+		ret = append(ret, makeSessionOutput(session))
+	}
+
+	return ret
+}
+
+func makeSessionOutput(session *trace.Session) *task.SessionOutput {
+	return &task.SessionOutput{
+		ID:        session.ID,
+		TaskID:    session.TraceID,
+		StartedAt: session.StartedAt.Unix(),
+		EndedAt:   session.EndedAt.Unix(),
+		Auto:      session.Auto,
+		Note:      nonEmpty(session.Note),
+	}
+}
+
+func makeBurndownOutput(out *burndown.SeriesOutput) *task.BurndownOutput {
+	points := make([]*task.BurndownPoint, 0, len(out.Points))
+	for _, point := range out.Points {
+		points = append(points, &task.BurndownPoint{
+			Date:          point.Date.Format(time.DateOnly),
+			RemainingTime: int64(point.Remaining.Seconds()),
+			CompletedTime: int64(point.Completed.Seconds()),
+			ActualTime:    int64(point.Actual.Seconds()),
+			Open:          point.Open,
+			Done:          point.Done,
+		})
+	}
+
+	return &task.BurndownOutput{
+		Timezone: out.Timezone,
+		Points:   points,
+	}
+}
+
+func makeWorkflow(workflow *extra.Workflow) *task.Workflow {
+	statuses := make([]*task.WorkflowStatus, 0, len(workflow.Statuses))
+	for _, status := range workflow.Statuses {
+		statuses = append(statuses, &task.WorkflowStatus{
+			Name:     status.Name,
+			Category: status.Category,
+		})
+	}
+
+	transitions := make([]*task.WorkflowTransition, 0, len(workflow.Transitions))
+	for _, transition := range workflow.Transitions {
+		transitions = append(transitions, &task.WorkflowTransition{
+			From: transition.From,
+			To:   transition.To,
+		})
+	}
+
+	return &task.Workflow{
+		Statuses:    statuses,
+		Transitions: transitions,
+	}
+}
+
+func makeAutoStopPolicy(policy autostop.Policy) *task.AutoStopPolicy {
+	return &task.AutoStopPolicy{
+		Threshold: int64(policy.Threshold.Seconds()),
+		Cap:       int64(policy.Cap.Seconds()),
+		Midnight:  policy.Midnight,
+		Timezone:  policy.Timezone,
+	}
+}
+
+func makeRebuildProjectionsOutput(dryRun bool, out *projection.RebuildOutput) *task.RebuildProjectionsOutput {
+	changes := make([]*task.ProjectionChange, 0, len(out.Changes))
+	for _, change := range out.Changes {
+		changes = append(changes, &task.ProjectionChange{
+			Projection: change.Projection,
+			ID:         change.ID,
+			Kind:       change.Kind,
+			From:       change.From,
+			To:         change.To,
+		})
+	}
+
+	return &task.RebuildProjectionsOutput{
+		DryRun:  dryRun,
+		Extras:  makeProjectionCounts(out.Extras),
+		Traces:  makeProjectionCounts(out.Traces),
+		Changes: changes,
+	}
+}
+
+func makeProjectionCounts(counts projection.Counts) *task.RecomputeOutput {
+	return &task.RecomputeOutput{
+		Created: counts.Created,
+		Updated: counts.Updated,
+		Deleted: counts.Deleted,
+	}
+}
+
+func makeStreamEvent(event *stream.Event) *task.StreamEvent {
+	ret := &task.StreamEvent{
+		ID:         event.ID,
+		Kind:       event.Kind,
+		TaskID:     optional(event.TaskID),
+		ParentID:   optional(event.ParentID),
+		NextID:     optional(event.NextID),
+		Status:     optional(event.Status),
+		ActualTime: nil,
+		StartedAt:  timestamp(event.StartedAt),
+		At:         event.At.Unix(),
+	}
+
+	if event.Kind == stream.KindTimerTick {
+		ret.ActualTime = pointer(int64(event.Actual.Seconds()))
+	}
+
+	return ret
+}
+
+// optional은 빈 문자열을 보내지 않도록 nil로 바꾼다.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func makeWebhookOutput(item *webhook.Webhook) *task.WebhookOutput {
+	return &task.WebhookOutput{
+		ID:        item.ID,
+		URL:       item.URL,
+		Events:    item.Events,
+		Secret:    optional(item.Secret),
+		CreatedAt: item.CreatedAt.Unix(),
+	}
+}
+
+func makeWebhookDelivery(item *webhook.Delivery) *task.WebhookDelivery {
+	ret := &task.WebhookDelivery{
+		ID:             item.ID,
+		WebhookID:      item.WebhookID,
+		Event:          item.Event,
+		Payload:        item.Payload,
+		Status:         item.Status,
+		Attempts:       item.Attempts,
+		NextAttemptAt:  timestamp(item.NextAttemptAt),
+		LastStatusCode: nil,
+		LastError:      optional(item.LastError),
+		CreatedAt:      item.CreatedAt.Unix(),
+		DeliveredAt:    timestamp(item.DeliveredAt),
+	}
+
+	if item.LastStatusCode != 0 {
+		ret.LastStatusCode = pointer(item.LastStatusCode)
+	}
+
+	return ret
+}
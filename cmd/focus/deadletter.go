@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/deadletter"
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/urfave/cli/v3"
+)
+
+func newDeadLettersCommand() *cli.Command {
+	return &cli.Command{ //nolint:exhaustruct
+		Name:  "dead-letters",
+		Usage: "list or replay events that every retry failed to deliver",
+		Commands: []*cli.Command{
+			{
+				Name:  "list",
+				Usage: "list dead letters, oldest first",
+				Flags: newDatabaseFlags(),
+				Action: func(ctx context.Context, c *cli.Command) error {
+					log.Println("dead letters list")
+
+					cfg, err := loadDatabaseConfig(c)
+					if err != nil {
+						return err
+					}
+
+					return runDeadLettersList(ctx, cfg)
+				},
+			},
+			{
+				Name:  "replay",
+				Usage: "deliver a dead letter again to the subscriber that failed",
+				Flags: append(newDatabaseFlags(),
+					&cli.Uint64Flag{ //nolint:exhaustruct
+						Name:     "id",
+						Usage:    "dead letter to replay",
+						Required: true,
+					},
+				),
+				Action: func(ctx context.Context, c *cli.Command) error {
+					log.Println("dead letters replay")
+
+					cfg, err := loadDatabaseConfig(c)
+					if err != nil {
+						return err
+					}
+
+					return runDeadLettersReplay(ctx, cfg, c.Uint64("id"))
+				},
+			},
+		},
+	}
+}
+
+func runDeadLettersList(ctx context.Context, cfg *databaseConfig) error {
+	repo, err := openRepository(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	out, err := deadletter.NewService(repo, eventbus.NewBus()).ListDeadLetters(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list dead letters: %w", err)
+	}
+
+	for _, letter := range out.DeadLetters {
+		log.Printf("%d %s subscriber %d after %d attempts at %s: %s: %s",
+			letter.ID, letter.Topic, letter.Subscriber, letter.Attempts,
+			letter.FailedAt.Format(time.RFC3339), letter.Error, letter.Payload)
+	}
+
+	log.Printf("%d dead letters", len(out.DeadLetters))
+
+	return nil
+}
+
+func runDeadLettersReplay(ctx context.Context, cfg *databaseConfig, id uint64) error {
+	repo, err := openRepository(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	// dead letter의 순번이 맞도록 run과 같은 순서로 등록한다.
+	options := eventbus.SyncOptions()
+	options.DeadLetters = deadletter.NewStore(repo)
+	bus := eventbus.NewBusWithOptions(options)
+	_ = newServices(repo, bus)
+
+	err = deadletter.NewService(repo, bus).Replay(ctx, &deadletter.ReplayInput{ID: id})
+	if err != nil {
+		return fmt.Errorf("failed to replay: %w", err)
+	}
+
+	log.Printf("replayed dead letter %d", id)
+
+	return nil
+}
@@ -3,32 +3,73 @@ package main
 import (
 	"context"
 	"errors"
+	"io"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/neatflowcv/focus/gen/task"
+	"github.com/neatflowcv/focus/internal/app/analytics"
+	"github.com/neatflowcv/focus/internal/app/autostop"
+	"github.com/neatflowcv/focus/internal/app/burndown"
+	"github.com/neatflowcv/focus/internal/app/calendar"
 	"github.com/neatflowcv/focus/internal/app/extra"
 	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/projection"
+	"github.com/neatflowcv/focus/internal/app/stream"
+	"github.com/neatflowcv/focus/internal/app/timesheet"
 	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/app/webhook"
 	"github.com/neatflowcv/key-stone/pkg/vault"
 )
 
 var _ task.Service = (*Handler)(nil)
 
+const calendarTokenDuration = 365 * 24 * time.Hour
+
 type Handler struct {
-	flowService  *flow.Service
-	extraService *extra.Service
-	traceService *trace.Service
-	vault        *vault.Vault
+	flowService       *flow.Service
+	extraService      *extra.Service
+	traceService      *trace.Service
+	calendarService   *calendar.Service
+	sheetService      *timesheet.Service
+	analyticsService  *analytics.Service
+	autostopService   *autostop.Service
+	burndownService   *burndown.Service
+	projectionService *projection.Service
+	streamService     *stream.Service
+	webhookService    *webhook.Service
+	vault             *vault.Vault
+	calendarVault     *vault.Vault // 캘린더 토큰으로 다른 API를 호출할 수 없도록 issuer를 분리한다
 }
 
-func NewHandler(flowService *flow.Service, extraService *extra.Service, traceService *trace.Service) *Handler {
+func NewHandler(
+	flowService *flow.Service,
+	extraService *extra.Service,
+	traceService *trace.Service,
+	calendarService *calendar.Service,
+	sheetService *timesheet.Service,
+	analyticsService *analytics.Service,
+	autostopService *autostop.Service,
+	burndownService *burndown.Service,
+	projectionService *projection.Service,
+	streamService *stream.Service,
+	webhookService *webhook.Service,
+) *Handler {
 	return &Handler{
-		flowService:  flowService,
-		extraService: extraService,
-		traceService: traceService,
-		vault:        vault.NewVault("key-stone", []byte("asdf")),
+		flowService:       flowService,
+		extraService:      extraService,
+		traceService:      traceService,
+		calendarService:   calendarService,
+		sheetService:      sheetService,
+		analyticsService:  analyticsService,
+		autostopService:   autostopService,
+		burndownService:   burndownService,
+		projectionService: projectionService,
+		streamService:     streamService,
+		webhookService:    webhookService,
+		vault:             vault.NewVault("key-stone", []byte("asdf")),
+		calendarVault:     vault.NewVault("focus-calendar", []byte("asdf")),
 	}
 }
 
@@ -76,21 +117,7 @@ func (h *Handler) Create(ctx context.Context, input *task.CreateTaskInput) (*tas
 		return nil, task.MakeInternalServerError(err)
 	}
 
-	extraOut, err := h.extraService.ListExtras(ctx, &extra.ListExtrasInput{
-		IDs: []string{flowOut.ID},
-	})
-	if err != nil {
-		return nil, task.MakeInternalServerError(err)
-	}
-
-	traceOut, err := h.traceService.ListTraces(ctx, &trace.ListTracesInput{
-		IDs: []string{flowOut.ID},
-	})
-	if err != nil {
-		return nil, task.MakeInternalServerError(err)
-	}
-
-	return makeCreateTaskOutput(input, flowOut, extraOut, traceOut), nil
+	return makeCreateTaskOutput(input, flowOut), nil
 }
 
 func (h *Handler) List(ctx context.Context, input *task.ListPayload) (task.CreatetaskoutputCollection, error) {
@@ -108,8 +135,9 @@ func (h *Handler) List(ctx context.Context, input *task.ListPayload) (task.Creat
 	}
 
 	flowOut, err := h.flowService.ListTasks(ctx, &flow.ListTasksInput{
-		Username: username,
-		ParentID: parentID,
+		Username:  username,
+		ParentID:  parentID,
+		Recursive: input.Recursive != nil && *input.Recursive,
 	})
 	if err != nil {
 		return nil, task.MakeInternalServerError(err)
@@ -121,27 +149,29 @@ func (h *Handler) List(ctx context.Context, input *task.ListPayload) (task.Creat
 	}
 
 	extraOut, err := h.extraService.ListExtras(ctx, &extra.ListExtrasInput{
-		IDs: ids,
+		Username: username,
+		IDs:      ids,
 	})
 	if err != nil {
 		return nil, task.MakeInternalServerError(err)
 	}
 
 	traceOut, err := h.traceService.ListTraces(ctx, &trace.ListTracesInput{
-		IDs: ids,
+		Username: username,
+		IDs:      ids,
 	})
 	if err != nil {
 		return nil, task.MakeInternalServerError(err)
 	}
 
-	return makeCreatetaskoutputCollection(input, flowOut, extraOut, traceOut), nil
+	return makeCreatetaskoutputCollection(flowOut, extraOut, traceOut), nil
 }
 
 func (h *Handler) Delete(ctx context.Context, input *task.TaskDeleteInput) error {
 	log.Println("call delete task")
 	defer log.Println("end delete task")
 
-	username, _, err := h.authUser(input.Authorization)
+	username, now, err := h.authUser(input.Authorization)
 	if err != nil {
 		return err
 	}
@@ -149,6 +179,7 @@ func (h *Handler) Delete(ctx context.Context, input *task.TaskDeleteInput) error
 	err = h.flowService.DeleteTask(ctx, &flow.DeleteTaskInput{
 		Username: username,
 		TaskID:   input.TaskID,
+		Now:      now,
 	})
 	if err != nil {
 		if errors.Is(err, flow.ErrTaskNotFound) {
@@ -189,18 +220,57 @@ func (h *Handler) Update( //nolint:funlen
 		ParentID: parentID,
 		NextID:   nextID,
 		Title:    input.Title,
+		Now:      now,
 	})
 	if err != nil {
 		return nil, task.MakeInternalServerError(err)
 	}
 
 	err = h.extraService.UpdateStatus(ctx, &extra.UpdateStatusInput{
-		ID:     input.TaskID,
-		Status: input.Status,
-		Now:    now,
+		Username: username,
+		ID:       input.TaskID,
+		Status:   input.Status,
+		Now:      now,
+		Force:    false,
 	})
 	if err != nil {
-		return nil, task.MakeInternalServerError(err)
+		switch {
+		case errors.Is(err, extra.ErrInvalidStatus):
+			return nil, task.MakeBadRequest(err)
+		case errors.Is(err, extra.ErrTransitionNotAllowed), errors.Is(err, extra.ErrStatusDerived):
+			return nil, task.MakeConflict(err)
+		default:
+			return nil, task.MakeInternalServerError(err)
+		}
+	}
+
+	if input.Rollup != nil {
+		err = h.extraService.SetRollup(ctx, &extra.SetRollupInput{
+			Username: username,
+			ID:       input.TaskID,
+			Rollup:   *input.Rollup,
+			Now:      now,
+		})
+		if err != nil {
+			return nil, task.MakeInternalServerError(err)
+		}
+	}
+
+	if input.DueAt != nil {
+		dueAt := time.Time{}
+		if *input.DueAt != 0 {
+			dueAt = time.Unix(*input.DueAt, 0)
+		}
+
+		err = h.traceService.SetDueAt(ctx, &trace.SetDueAtInput{
+			Username: username,
+			ID:       input.TaskID,
+			DueAt:    dueAt,
+			Now:      now,
+		})
+		if err != nil {
+			return nil, task.MakeInternalServerError(err)
+		}
 	}
 
 	flowOut, err := h.flowService.GetTask(ctx, &flow.GetTaskInput{
@@ -212,14 +282,16 @@ func (h *Handler) Update( //nolint:funlen
 	}
 
 	extraOut, err := h.extraService.ListExtras(ctx, &extra.ListExtrasInput{
-		IDs: []string{input.TaskID},
+		Username: username,
+		IDs:      []string{input.TaskID},
 	})
 	if err != nil {
 		return nil, task.MakeInternalServerError(err)
 	}
 
 	traceOut, err := h.traceService.ListTraces(ctx, &trace.ListTracesInput{
-		IDs: []string{input.TaskID},
+		Username: username,
+		IDs:      []string{input.TaskID},
 	})
 	if err != nil {
 		return nil, task.MakeInternalServerError(err)
@@ -228,6 +300,793 @@ func (h *Handler) Update( //nolint:funlen
 	return makeUpdateTaskOutput(input, flowOut, extraOut, traceOut), nil
 }
 
+func (h *Handler) CalendarToken(
+	ctx context.Context,
+	input *task.CalendarTokenPayload,
+) (*task.CalendarTokenOutput, error) {
+	log.Println("call calendar token")
+	defer log.Println("end calendar token")
+
+	username, now, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.CalendarTokenOutput{
+		Token:     h.calendarVault.Encrypt(username, now, calendarTokenDuration),
+		ExpiresAt: now.Add(calendarTokenDuration).Unix(),
+	}, nil
+}
+
+func (h *Handler) Calendar(
+	ctx context.Context,
+	input *task.CalendarPayload,
+) (*task.CalendarResult, io.ReadCloser, error) {
+	log.Println("call calendar")
+	defer log.Println("end calendar")
+
+	now := time.Now()
+
+	username, err := h.calendarVault.Decrypt(input.Token, now)
+	if err != nil {
+		return nil, nil, task.MakeUnauthorized(err)
+	}
+
+	out, err := h.calendarService.Feed(ctx, &calendar.FeedInput{
+		Username:   username,
+		DueAsEvent: input.DueAsEvent != nil && *input.DueAsEvent,
+		Now:        now,
+	})
+	if err != nil {
+		return nil, nil, task.MakeInternalServerError(err)
+	}
+
+	return &task.CalendarResult{
+		ContentType: calendar.ContentType,
+	}, io.NopCloser(strings.NewReader(out.Calendar)), nil
+}
+
+func (h *Handler) Timesheet( //nolint:funlen
+	ctx context.Context,
+	input *task.TimesheetPayload,
+) (*task.TimesheetResult, io.ReadCloser, error) {
+	log.Println("call timesheet")
+	defer log.Println("end timesheet")
+
+	username, now, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	location, err := time.LoadLocation(input.Timezone)
+	if err != nil {
+		return nil, nil, task.MakeBadRequest(err)
+	}
+
+	from, err := parseDate(input.From, location)
+	if err != nil {
+		return nil, nil, task.MakeBadRequest(err)
+	}
+
+	to, err := parseDate(input.To, location)
+	if err != nil {
+		return nil, nil, task.MakeBadRequest(err)
+	}
+
+	if !to.IsZero() {
+		// to는 해당 날짜를 포함한다
+		to = to.AddDate(0, 0, 1)
+	}
+
+	out, err := h.sheetService.Export(ctx, &timesheet.ExportInput{
+		Username: username,
+		From:     from,
+		To:       to,
+		RootID:   valueOf(input.ParentID),
+		Tag:      valueOf(input.Tag),
+		Round:    time.Duration(input.Round) * time.Minute,
+		Location: location,
+		Now:      now,
+	})
+	if err != nil {
+		if errors.Is(err, timesheet.ErrTaskNotFound) {
+			return nil, nil, task.MakeTaskNotFound(err)
+		}
+
+		return nil, nil, task.MakeInternalServerError(err)
+	}
+
+	contentType := timesheet.CSVContentType
+	encode := timesheet.EncodeCSV
+
+	if input.Format == "json" {
+		contentType = timesheet.JSONContentType
+		encode = timesheet.EncodeJSON
+	}
+
+	body, err := encode(out.Rows)
+	if err != nil {
+		return nil, nil, task.MakeInternalServerError(err)
+	}
+
+	return &task.TimesheetResult{
+		ContentType: contentType,
+	}, io.NopCloser(strings.NewReader(body)), nil
+}
+
+func (h *Handler) Estimates(ctx context.Context, input *task.EstimatesPayload) (*task.EstimatesOutput, error) {
+	log.Println("call estimates")
+	defer log.Println("end estimates")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.analyticsService.Estimates(ctx, &analytics.EstimatesInput{
+		Username: username,
+		RootID:   valueOf(input.ParentID),
+		Period:   input.Period,
+	})
+	if err != nil {
+		if errors.Is(err, analytics.ErrTaskNotFound) {
+			return nil, task.MakeTaskNotFound(err)
+		}
+
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	return makeEstimatesOutput(out), nil
+}
+
+func (h *Handler) Lifecycle(ctx context.Context, input *task.LifecyclePayload) (*task.LifecycleOutput, error) {
+	log.Println("call lifecycle")
+	defer log.Println("end lifecycle")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := time.LoadLocation(input.Timezone)
+	if err != nil {
+		return nil, task.MakeBadRequest(err)
+	}
+
+	from, err := parseDate(input.From, location)
+	if err != nil {
+		return nil, task.MakeBadRequest(err)
+	}
+
+	to, err := parseDate(input.To, location)
+	if err != nil {
+		return nil, task.MakeBadRequest(err)
+	}
+
+	if !to.IsZero() {
+		// to는 해당 날짜를 포함한다
+		to = to.AddDate(0, 0, 1)
+	}
+
+	out, err := h.analyticsService.Lifecycle(ctx, &analytics.LifecycleInput{
+		Username: username,
+		RootID:   valueOf(input.ParentID),
+		From:     from,
+		To:       to,
+	})
+	if err != nil {
+		if errors.Is(err, analytics.ErrTaskNotFound) {
+			return nil, task.MakeTaskNotFound(err)
+		}
+
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	return makeLifecycleOutput(out), nil
+}
+
+func (h *Handler) Sessions(ctx context.Context, input *task.SessionsPayload) ([]*task.SessionOutput, error) {
+	log.Println("call sessions")
+	defer log.Println("end sessions")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+
+	if input.TaskID != nil {
+		_, err := h.flowService.GetTask(ctx, &flow.GetTaskInput{
+			Username: username,
+			TaskID:   *input.TaskID,
+		})
+		if err != nil {
+			if errors.Is(err, flow.ErrTaskNotFound) {
+				return nil, task.MakeTaskNotFound(err)
+			}
+
+			return nil, task.MakeInternalServerError(err)
+		}
+
+		ids = append(ids, *input.TaskID)
+	} else {
+		flowOut, err := h.flowService.ListTasks(ctx, &flow.ListTasksInput{
+			Username:  username,
+			ParentID:  "",
+			Recursive: true,
+		})
+		if err != nil {
+			return nil, task.MakeInternalServerError(err)
+		}
+
+		for _, item := range flowOut.Tasks {
+			ids = append(ids, item.ID)
+		}
+	}
+
+	out, err := h.traceService.ListSessions(ctx, &trace.ListSessionsInput{
+		Username: username,
+		IDs:      ids,
+	})
+	if err != nil {
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	return makeSessionOutputs(out), nil
+}
+
+func (h *Handler) AddSession(ctx context.Context, input *task.AddSessionPayload) (*task.SessionOutput, error) {
+	log.Println("call add session")
+	defer log.Println("end add session")
+
+	username, now, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = h.flowService.GetTask(ctx, &flow.GetTaskInput{
+		Username: username,
+		TaskID:   input.TaskID,
+	})
+	if err != nil {
+		if errors.Is(err, flow.ErrTaskNotFound) {
+			return nil, task.MakeTaskNotFound(err)
+		}
+
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	out, err := h.traceService.AddSession(ctx, &trace.AddSessionInput{
+		Username:  username,
+		TraceID:   input.TaskID,
+		StartedAt: time.Unix(input.StartedAt, 0),
+		EndedAt:   time.Unix(input.EndedAt, 0),
+		Note:      valueOf(input.Note),
+		Now:       now,
+	})
+	if err != nil {
+		return nil, makeSessionError(err)
+	}
+
+	return makeSessionOutput(out.Session), nil
+}
+
+func (h *Handler) UpdateSession(ctx context.Context, input *task.UpdateSessionPayload) (*task.SessionOutput, error) {
+	log.Println("call update session")
+	defer log.Println("end update session")
+
+	username, now, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.checkSessionOwner(ctx, username, input.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.traceService.UpdateSession(ctx, &trace.UpdateSessionInput{
+		Username:  username,
+		ID:        input.SessionID,
+		StartedAt: time.Unix(input.StartedAt, 0),
+		EndedAt:   time.Unix(input.EndedAt, 0),
+		Note:      valueOf(input.Note),
+		Now:       now,
+	})
+	if err != nil {
+		return nil, makeSessionError(err)
+	}
+
+	return makeSessionOutput(out.Session), nil
+}
+
+func (h *Handler) DeleteSession(ctx context.Context, input *task.DeleteSessionPayload) error {
+	log.Println("call delete session")
+	defer log.Println("end delete session")
+
+	username, now, err := h.authUser(input.Authorization)
+	if err != nil {
+		return err
+	}
+
+	err = h.checkSessionOwner(ctx, username, input.SessionID)
+	if err != nil {
+		return err
+	}
+
+	err = h.traceService.DeleteSession(ctx, &trace.DeleteSessionInput{
+		Username: username,
+		ID:       input.SessionID,
+		Now:      now,
+	})
+	if err != nil {
+		return makeSessionError(err)
+	}
+
+	return nil
+}
+
+// checkSessionOwner는 세션이 사용자의 작업에 속하는지 확인한다.
+func (h *Handler) checkSessionOwner(ctx context.Context, username, sessionID string) error {
+	_, err := h.traceService.GetSession(ctx, &trace.GetSessionInput{
+		Username: username,
+		ID:       sessionID,
+	})
+	if err != nil {
+		if errors.Is(err, trace.ErrSessionNotFound) {
+			return task.MakeSessionNotFound(err)
+		}
+
+		return task.MakeInternalServerError(err)
+	}
+
+	return nil
+}
+
+func makeSessionError(err error) error {
+	switch {
+	case errors.Is(err, trace.ErrSessionNotFound):
+		return task.MakeSessionNotFound(err)
+	case errors.Is(err, trace.ErrInvalidSession):
+		return task.MakeBadRequest(err)
+	case errors.Is(err, trace.ErrSessionOverlaps), errors.Is(err, trace.ErrOverlapsRunning):
+		return task.MakeConflict(err)
+	default:
+		return task.MakeInternalServerError(err)
+	}
+}
+
+func (h *Handler) TrackProject(ctx context.Context, input *task.TrackProjectPayload) error {
+	log.Println("call track project")
+	defer log.Println("end track project")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return err
+	}
+
+	err = h.burndownService.Track(ctx, &burndown.TrackInput{
+		Username: username,
+		RootID:   input.TaskID,
+		Timezone: input.Timezone,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, burndown.ErrTaskNotFound):
+			return task.MakeTaskNotFound(err)
+		case errors.Is(err, burndown.ErrInvalidTimezone):
+			return task.MakeBadRequest(err)
+		default:
+			return task.MakeInternalServerError(err)
+		}
+	}
+
+	return nil
+}
+
+func (h *Handler) UntrackProject(ctx context.Context, input *task.UntrackProjectPayload) error {
+	log.Println("call untrack project")
+	defer log.Println("end untrack project")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return err
+	}
+
+	err = h.burndownService.Untrack(ctx, &burndown.UntrackInput{
+		Username: username,
+		RootID:   input.TaskID,
+	})
+	if err != nil {
+		if errors.Is(err, burndown.ErrNotTracked) {
+			return task.MakeProjectNotFound(err)
+		}
+
+		return task.MakeInternalServerError(err)
+	}
+
+	return nil
+}
+
+func (h *Handler) Burndown(ctx context.Context, input *task.BurndownPayload) (*task.BurndownOutput, error) {
+	log.Println("call burndown")
+	defer log.Println("end burndown")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.burndownService.Series(ctx, &burndown.SeriesInput{
+		Username: username,
+		RootID:   input.TaskID,
+	})
+	if err != nil {
+		if errors.Is(err, burndown.ErrNotTracked) || errors.Is(err, burndown.ErrTaskNotFound) {
+			return nil, task.MakeProjectNotFound(err)
+		}
+
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	return makeBurndownOutput(out), nil
+}
+
+func (h *Handler) Recompute(ctx context.Context, input *task.RecomputePayload) (*task.RecomputeOutput, error) {
+	log.Println("call recompute")
+	defer log.Println("end recompute")
+
+	username, now, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	flowOut, err := h.flowService.ListTasks(ctx, &flow.ListTasksInput{
+		Username:  username,
+		ParentID:  "",
+		Recursive: true,
+	})
+	if err != nil {
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	var nodes []*extra.Node
+	for _, item := range flowOut.Tasks {
+		nodes = append(nodes, &extra.Node{
+			ID:       item.ID,
+			ParentID: item.ParentID,
+		})
+	}
+
+	out, err := h.extraService.Recompute(ctx, &extra.RecomputeInput{
+		Username: username,
+		Nodes:    nodes,
+		DryRun:   false,
+		Now:      now,
+	})
+	if err != nil {
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	return &task.RecomputeOutput{
+		Created: out.Created,
+		Updated: out.Updated,
+		Deleted: out.Deleted,
+	}, nil
+}
+
+// Stream은 연결이 끊길 때까지 사용자의 이벤트를 보낸다.
+func (h *Handler) Stream(ctx context.Context, input *task.StreamPayload, sender task.StreamServerStream) error {
+	log.Println("call stream")
+	defer log.Println("end stream")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return err
+	}
+
+	out, err := h.streamService.Subscribe(ctx, &stream.SubscribeInput{
+		Username:    username,
+		LastEventID: valueOf(input.LastEventID),
+	})
+	if err != nil {
+		return task.MakeInternalServerError(err)
+	}
+	defer out.Subscription.Close()
+
+	for _, event := range out.Missed {
+		err := sender.SendWithContext(ctx, makeStreamEvent(event))
+		if err != nil {
+			return nil //nolint:nilerr // 연결이 끊겼다
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-out.Subscription.Events():
+			if !ok {
+				return nil
+			}
+
+			err := sender.SendWithContext(ctx, makeStreamEvent(event))
+			if err != nil {
+				return nil //nolint:nilerr // 연결이 끊겼다
+			}
+		}
+	}
+}
+
+func (h *Handler) RebuildProjections(
+	ctx context.Context,
+	input *task.RebuildProjectionsPayload,
+) (*task.RebuildProjectionsOutput, error) {
+	log.Println("call rebuild projections")
+	defer log.Println("end rebuild projections")
+
+	username, now, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.projectionService.Rebuild(ctx, &projection.RebuildInput{
+		Username: username,
+		DryRun:   input.DryRun,
+		Now:      now,
+	})
+	if err != nil {
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	return makeRebuildProjectionsOutput(input.DryRun, out), nil
+}
+
+func (h *Handler) GetWorkflow(ctx context.Context, input *task.GetWorkflowPayload) (*task.Workflow, error) {
+	log.Println("call get workflow")
+	defer log.Println("end get workflow")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.extraService.GetWorkflow(ctx, &extra.GetWorkflowInput{
+		Username: username,
+	})
+	if err != nil {
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	return makeWorkflow(&out.Workflow), nil
+}
+
+func (h *Handler) SetWorkflow(ctx context.Context, input *task.SetWorkflowPayload) (*task.Workflow, error) {
+	log.Println("call set workflow")
+	defer log.Println("end set workflow")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	var workflow extra.Workflow
+
+	for _, status := range input.Statuses {
+		workflow.Statuses = append(workflow.Statuses, &extra.Status{
+			Name:     status.Name,
+			Category: status.Category,
+		})
+	}
+
+	for _, transition := range input.Transitions {
+		workflow.Transitions = append(workflow.Transitions, &extra.Transition{
+			From: transition.From,
+			To:   transition.To,
+		})
+	}
+
+	out, err := h.extraService.SetWorkflow(ctx, &extra.SetWorkflowInput{
+		Username: username,
+		Workflow: workflow,
+	})
+	if err != nil {
+		if errors.Is(err, extra.ErrInvalidWorkflow) {
+			return nil, task.MakeBadRequest(err)
+		}
+
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	return makeWorkflow(&out.Workflow), nil
+}
+
+func (h *Handler) GetAutoStop(ctx context.Context, input *task.GetAutoStopPayload) (*task.AutoStopPolicy, error) {
+	log.Println("call get auto stop")
+	defer log.Println("end get auto stop")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.autostopService.GetPolicy(ctx, &autostop.GetPolicyInput{
+		Username: username,
+	})
+	if err != nil {
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	return makeAutoStopPolicy(out.Policy), nil
+}
+
+func (h *Handler) SetAutoStop(ctx context.Context, input *task.SetAutoStopPayload) (*task.AutoStopPolicy, error) {
+	log.Println("call set auto stop")
+	defer log.Println("end set auto stop")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := autostop.Policy{
+		Threshold: time.Duration(input.Threshold) * time.Second,
+		Cap:       time.Duration(input.Cap) * time.Second,
+		Midnight:  input.Midnight,
+		Timezone:  input.Timezone,
+	}
+
+	err = h.autostopService.SetPolicy(ctx, &autostop.SetPolicyInput{
+		Username: username,
+		Policy:   policy,
+	})
+	if err != nil {
+		if errors.Is(err, autostop.ErrInvalidPolicy) || errors.Is(err, autostop.ErrInvalidTimezone) {
+			return nil, task.MakeBadRequest(err)
+		}
+
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	return makeAutoStopPolicy(policy), nil
+}
+
+func (h *Handler) CreateWebhook(ctx context.Context, input *task.CreateWebhookPayload) (*task.WebhookOutput, error) {
+	log.Println("call create webhook")
+	defer log.Println("end create webhook")
+
+	username, now, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.webhookService.CreateWebhook(ctx, &webhook.CreateWebhookInput{
+		Username: username,
+		URL:      input.URL,
+		Events:   input.Events,
+		Now:      now,
+	})
+	if err != nil {
+		if errors.Is(err, webhook.ErrInvalidURL) || errors.Is(err, webhook.ErrInvalidEvent) {
+			return nil, task.MakeBadRequest(err)
+		}
+
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	return makeWebhookOutput(out.Webhook), nil
+}
+
+func (h *Handler) ListWebhooks(ctx context.Context, input *task.ListWebhooksPayload) ([]*task.WebhookOutput, error) {
+	log.Println("call list webhooks")
+	defer log.Println("end list webhooks")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.webhookService.ListWebhooks(ctx, &webhook.ListWebhooksInput{
+		Username: username,
+	})
+	if err != nil {
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	ret := make([]*task.WebhookOutput, 0, len(out.Webhooks))
+	for _, item := range out.Webhooks {
+		ret = append(ret, makeWebhookOutput(item))
+	}
+
+	return ret, nil
+}
+
+func (h *Handler) DeleteWebhook(ctx context.Context, input *task.DeleteWebhookPayload) error {
+	log.Println("call delete webhook")
+	defer log.Println("end delete webhook")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return err
+	}
+
+	err = h.webhookService.DeleteWebhook(ctx, &webhook.DeleteWebhookInput{
+		Username: username,
+		ID:       input.WebhookID,
+	})
+	if err != nil {
+		if errors.Is(err, webhook.ErrWebhookNotFound) {
+			return task.MakeWebhookNotFound(err)
+		}
+
+		return task.MakeInternalServerError(err)
+	}
+
+	return nil
+}
+
+func (h *Handler) ListWebhookDeliveries(
+	ctx context.Context,
+	input *task.ListWebhookDeliveriesPayload,
+) ([]*task.WebhookDelivery, error) {
+	log.Println("call list webhook deliveries")
+	defer log.Println("end list webhook deliveries")
+
+	username, _, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.webhookService.ListDeliveries(ctx, &webhook.ListDeliveriesInput{
+		Username:  username,
+		WebhookID: input.WebhookID,
+	})
+	if err != nil {
+		if errors.Is(err, webhook.ErrWebhookNotFound) {
+			return nil, task.MakeWebhookNotFound(err)
+		}
+
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	ret := make([]*task.WebhookDelivery, 0, len(out.Deliveries))
+	for _, item := range out.Deliveries {
+		ret = append(ret, makeWebhookDelivery(item))
+	}
+
+	return ret, nil
+}
+
+func (h *Handler) RedeliverWebhook(
+	ctx context.Context,
+	input *task.RedeliverWebhookPayload,
+) (*task.WebhookDelivery, error) {
+	log.Println("call redeliver webhook")
+	defer log.Println("end redeliver webhook")
+
+	username, now, err := h.authUser(input.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.webhookService.Redeliver(ctx, &webhook.RedeliverInput{
+		Username:   username,
+		WebhookID:  input.WebhookID,
+		DeliveryID: input.DeliveryID,
+		Now:        now,
+	})
+	if err != nil {
+		if errors.Is(err, webhook.ErrDeliveryNotFound) {
+			return nil, task.MakeDeliveryNotFound(err)
+		}
+
+		return nil, task.MakeInternalServerError(err)
+	}
+
+	return makeWebhookDelivery(out.Delivery), nil
+}
+
 func (h *Handler) authUser(authorization string) (string, time.Time, error) {
 	now := time.Now()
 	token := strings.TrimPrefix(authorization, "Bearer ")
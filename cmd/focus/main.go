@@ -2,11 +2,20 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
+	"expvar"
 	"fmt"
 	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"runtime/debug"
+	"sync"
+	"syscall"
+	"time"
 
 	_ "goa.design/goa/v3/codegen"
 	_ "goa.design/goa/v3/codegen/generator"
@@ -14,15 +23,40 @@ import (
 
 	taskserver "github.com/neatflowcv/focus/gen/http/task/server"
 	"github.com/neatflowcv/focus/gen/task"
+	"github.com/neatflowcv/focus/internal/app/analytics"
+	"github.com/neatflowcv/focus/internal/app/autostop"
+	"github.com/neatflowcv/focus/internal/app/burndown"
+	"github.com/neatflowcv/focus/internal/app/calendar"
+	"github.com/neatflowcv/focus/internal/app/deadletter"
 	"github.com/neatflowcv/focus/internal/app/extra"
 	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/outbox"
+	"github.com/neatflowcv/focus/internal/app/projection"
+	"github.com/neatflowcv/focus/internal/app/stream"
+	"github.com/neatflowcv/focus/internal/app/timesheet"
 	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/app/webhook"
+	"github.com/neatflowcv/focus/internal/pkg/clock/system"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
 	"github.com/neatflowcv/focus/internal/pkg/eventbus"
 	"github.com/neatflowcv/focus/internal/pkg/idmaker/ulid"
 	"github.com/neatflowcv/focus/internal/pkg/repository/gorm"
 	"github.com/urfave/cli/v3"
 )
 
+const (
+	autoStopInterval = time.Minute
+	burndownInterval = time.Hour
+	busCloseTimeout  = 10 * time.Second
+	outboxInterval   = 5 * time.Second
+	shutdownTimeout  = 10 * time.Second
+	streamInterval   = 5 * time.Second
+	webhookInterval  = 5 * time.Second
+	webhookTimeout   = 10 * time.Second
+
+	requestIDHeader = "X-Request-ID"
+)
+
 func version() string {
 	info, ok := debug.ReadBuildInfo()
 	if !ok {
@@ -39,13 +73,27 @@ func main() {
 		Name: "focus",
 		Commands: []*cli.Command{
 			{
-				Name: "run",
+				Name:  "run",
+				Usage: "serve the API and run the background jobs",
+				Flags: newServerFlags(),
 				Action: func(ctx context.Context, c *cli.Command) error {
 					log.Println("running")
 
-					return run()
+					cfg, err := loadDatabaseConfig(c)
+					if err != nil {
+						return err
+					}
+
+					return run(ctx, cfg, loadServerConfig(c))
 				},
 			},
+			newBackupCommand(),
+			newRestoreCommand(),
+			newTodotxtCommand(),
+			newReconcileCommand(),
+			newRebuildCommand(),
+			newMigrateCommand(),
+			newDeadLettersCommand(),
 		},
 	}
 
@@ -55,113 +103,563 @@ func main() {
 	}
 }
 
-func run() error { //nolint:cyclop,funlen
-	repo, err := gorm.NewRepository()
+// run은 SIGINT나 SIGTERM을 받으면 요청과 백그라운드 작업을 마치고 bus를 닫는다.
+func run(ctx context.Context, cfg *databaseConfig, serverCfg *serverConfig) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	repo, err := openRepository(cfg)
 	if err != nil {
 		return fmt.Errorf("failed to create repository: %w", err)
 	}
 
-	bus := eventbus.NewBus()
+	bus := newBus(deadletter.NewStore(repo))
+	defer closeBus(bus)
+
+	services := newServices(repo, bus)
+	flowService := services.flow
+	extraService := services.extra
+	traceService := services.trace
+
+	calendarService := calendar.NewService(flowService, extraService, traceService)
+	sheetService := timesheet.NewService(flowService, extraService, traceService)
+	analyticsService := analytics.NewService(flowService, extraService, traceService)
+	autostopService := autostop.NewService(system.NewClock(), repo, flowService, extraService, traceService)
+	burndownService := burndown.NewService(system.NewClock(), repo, flowService, extraService, traceService)
+	projectionService := projection.NewService(flowService, extraService, traceService)
+
+	var jobs sync.WaitGroup
+
+	jobs.Go(func() { runOutbox(ctx, services.outbox) })
+	jobs.Go(func() { runAutoStop(ctx, autostopService) })
+	jobs.Go(func() { runBurndown(ctx, burndownService) })
+	jobs.Go(func() { runStreamTick(ctx, services.stream) })
+	jobs.Go(func() { runWebhooks(ctx, services.webhook) })
+
+	server := newServer(
+		serverCfg,
+		flowService,
+		extraService,
+		traceService,
+		calendarService,
+		sheetService,
+		analyticsService,
+		autostopService,
+		burndownService,
+		projectionService,
+		services.stream,
+		services.webhook,
+	)
+
+	if serverCfg.AdminAddr != "" {
+		jobs.Go(func() { runAdmin(ctx, serverCfg.AdminAddr) })
+	}
+
+	err = serve(ctx, server)
+
+	// server가 먼저 멈췄어도 작업을 끝내고, 작업이 남긴 이벤트는 deferred closeBus가 처리한다.
+	stop()
+	jobs.Wait()
+
+	return err
+}
+
+// serve는 ctx가 끝나면 처리 중인 요청을 shutdownTimeout까지 기다렸다가 server를 닫는다.
+func serve(ctx context.Context, server *http.Server) error {
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return fmt.Errorf("failed to listen and serve: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	err := server.Shutdown(shutdownCtx)
+	if err != nil {
+		// 끝나지 않는 stream 같은 연결은 끊는다.
+		_ = server.Close()
+
+		return fmt.Errorf("failed to shut down: %w", err)
+	}
+
+	return nil
+}
+
+// runAdmin은 인증이 없는 /debug/vars를 API와 다른 주소에서 내보낸다.
+func runAdmin(ctx context.Context, addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("GET /debug/vars", expvar.Handler())
+
+	server := &http.Server{ //nolint:exhaustruct
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: defaultReadTimeout,
+	}
+
+	err := serve(ctx, server)
+	if err != nil {
+		log.Printf("failed to serve admin: %v", err)
+	}
+}
+
+// runOutbox는 ctx가 끝날 때까지 commit된 이벤트를 알림을 받거나 주기적으로 전달한다.
+func runOutbox(ctx context.Context, outboxService *outbox.Service) {
+	signal := outboxService.Listen()
+
+	ticker := time.NewTicker(outboxInterval)
+	defer ticker.Stop()
+
+	for {
+		_, err := outboxService.Dispatch(ctx)
+		if err != nil {
+			log.Printf("failed to dispatch outbox: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-signal:
+		}
+	}
+}
+
+// runAutoStop은 ctx가 끝날 때까지 주기적으로 잊힌 trace를 멈춘다.
+func runAutoStop(ctx context.Context, autostopService *autostop.Service) {
+	ticker := time.NewTicker(autoStopInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			out, err := autostopService.Run(ctx)
+			if err != nil {
+				log.Printf("failed to auto stop: %v", err)
+			}
 
-	flowService := flow.NewService(bus, ulid.NewIDMaker(), repo)
-	extraService := extra.NewService(bus, repo)
-	traceService := trace.NewService(repo)
+			if out == nil {
+				continue
+			}
+
+			for _, item := range out.Stopped {
+				log.Printf("auto stopped task %s of %s at %s", item.TaskID, item.Username, item.StoppedAt)
+			}
+		}
+	}
+}
 
-	server := newServer(flowService, extraService, traceService)
+// runBurndown은 ctx가 끝날 때까지 주기적으로 추적 중인 프로젝트의 오늘 snapshot을 갱신한다.
+func runBurndown(ctx context.Context, burndownService *burndown.Service) {
+	ticker := time.NewTicker(burndownInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := burndownService.Run(ctx)
+			if err != nil {
+				log.Printf("failed to record burndown: %v", err)
+			}
+		}
+	}
+}
+
+// runStreamTick은 ctx가 끝날 때까지 주기적으로 구독 중인 사용자에게 재고 있는 시간을 보낸다.
+func runStreamTick(ctx context.Context, streamService *stream.Service) {
+	ticker := time.NewTicker(streamInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := streamService.Tick(ctx)
+			if err != nil {
+				log.Printf("failed to send timer ticks: %v", err)
+			}
+		}
+	}
+}
+
+// runWebhooks는 ctx가 끝날 때까지 주기적으로 보낼 때가 된 webhook을 보낸다.
+func runWebhooks(ctx context.Context, webhookService *webhook.Service) {
+	ticker := time.NewTicker(webhookInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := webhookService.Dispatch(ctx)
+			if err != nil {
+				log.Printf("failed to dispatch webhooks: %v", err)
+			}
+		}
+	}
+}
 
-	bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) {
+// newBus는 비동기 bus를 만들고 처리 과정을 남기는 interceptor를 붙인다.
+func newBus(deadLetters eventbus.DeadLetterStore) *eventbus.Bus {
+	metrics := eventbus.NewMetrics()
+	expvar.Publish("eventbus", metrics)
+
+	options := eventbus.AsyncOptions(deadLetters)
+	options.Interceptors = []eventbus.Interceptor{
+		eventbus.Tracing(),
+		eventbus.Logging(slog.Default()),
+		metrics.Interceptor(),
+		eventbus.Recover(),
+	}
+
+	return eventbus.NewBusWithOptions(options)
+}
+
+// closeBus는 queue에 남은 이벤트를 처리할 시간을 준다.
+func closeBus(bus *eventbus.Bus) {
+	ctx, cancel := context.WithTimeout(context.Background(), busCloseTimeout)
+	defer cancel()
+
+	err := bus.Close(ctx)
+	if err != nil {
+		log.Printf("failed to close event bus: %v", err)
+	}
+}
+
+type services struct {
+	outbox  *outbox.Service
+	flow    *flow.Service
+	extra   *extra.Service
+	trace   *trace.Service
+	stream  *stream.Service
+	webhook *webhook.Service
+}
+
+// newServices는 run과 dead letter 재처리가 같은 순서로 subscriber를 등록하게 한다.
+func newServices(repo *gorm.Repository, bus *eventbus.Bus) *services {
+	outboxService := outbox.NewService(system.NewClock(), repo, bus)
+	flowService := flow.NewService(outboxService, ulid.NewIDMaker(), repo)
+	extraService := extra.NewService(bus, repo, repo)
+	traceService := trace.NewService(bus, ulid.NewIDMaker(), repo)
+
+	subscribe(bus, extraService, traceService)
+
+	streamService := stream.NewService(system.NewClock(), flowService, traceService)
+	subscribeStream(bus, streamService)
+
+	webhookService := webhook.NewService(
+		system.NewClock(),
+		ulid.NewIDMaker(),
+		repo,
+		webhook.NewClient(webhookTimeout),
+		net.DefaultResolver,
+	)
+	subscribeWebhook(bus, webhookService)
+
+	return &services{
+		outbox:  outboxService,
+		flow:    flowService,
+		extra:   extraService,
+		trace:   traceService,
+		stream:  streamService,
+		webhook: webhookService,
+	}
+}
+
+func subscribe(bus *eventbus.Bus, extraService *extra.Service, traceService *trace.Service) { //nolint:funlen
+	bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
 		err := extraService.CreateExtra(ctx, &extra.CreateExtraInput{
+			Username: event.Username,
 			ID:       event.TaskID,
 			ParentID: event.ParentID,
+			Now:      event.Now,
 		})
 		if err != nil {
-			log.Printf("failed to create extra: %v", err)
+			return fmt.Errorf("failed to create extra: %w", err)
 		}
+
+		return nil
 	})
-	bus.TaskDeleted.Subscribe(func(ctx context.Context, event *eventbus.TaskDeletedEvent) {
+	bus.TaskDeleted.Subscribe(func(ctx context.Context, event *eventbus.TaskDeletedEvent) error {
 		err := extraService.DeleteExtra(ctx, &extra.DeleteExtraInput{
-			ID: event.TaskID,
+			Username: event.Username,
+			ID:       event.TaskID,
+			Now:      event.Now,
 		})
-		if err != nil {
-			log.Printf("failed to delete extra: %v", err)
+		if err != nil && !errors.Is(err, extra.ErrExtraNotFound) { // 다시 전달된 이벤트이다
+			return fmt.Errorf("failed to delete extra: %w", err)
 		}
+
+		return nil
 	})
-	bus.TaskRelationUpdated.Subscribe(func(ctx context.Context, event *eventbus.TaskRelationUpdatedEvent) {
+	bus.TaskRelationUpdated.Subscribe(func(ctx context.Context, event *eventbus.TaskRelationUpdatedEvent) error {
 		if event.OldParentID == event.NewParentID {
-			return
+			return nil
 		}
 
 		err := extraService.UpdateParent(ctx, &extra.UpdateParentInput{
+			Username: event.Username,
 			ID:       event.TaskID,
 			ParentID: event.NewParentID,
+			Now:      event.Now,
 		})
 		if err != nil {
-			log.Printf("failed to update parent extra: %v", err)
+			return fmt.Errorf("failed to update parent extra: %w", err)
 		}
+
+		return nil
 	})
 
-	bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) {
+	bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
 		err := traceService.CreateTrace(ctx, &trace.CreateTraceInput{
+			Username: event.Username,
 			ID:       event.TaskID,
 			ParentID: event.ParentID,
 		})
 		if err != nil {
-			log.Printf("failed to create trace: %v", err)
+			return fmt.Errorf("failed to create trace: %w", err)
 		}
+
+		return nil
 	})
-	bus.TaskDeleted.Subscribe(func(ctx context.Context, event *eventbus.TaskDeletedEvent) {
+	bus.TaskDeleted.Subscribe(func(ctx context.Context, event *eventbus.TaskDeletedEvent) error {
 		err := traceService.DeleteTrace(ctx, &trace.DeleteTraceInput{
-			ID: event.TaskID,
+			Username: event.Username,
+			ID:       event.TaskID,
+			Now:      event.Now,
 		})
-		if err != nil {
-			log.Printf("failed to delete trace: %v", err)
+		if err != nil && !errors.Is(err, trace.ErrTraceNotFound) { // 다시 전달된 이벤트이다
+			return fmt.Errorf("failed to delete trace: %w", err)
 		}
+
+		return nil
 	})
-	bus.TaskRelationUpdated.Subscribe(func(ctx context.Context, event *eventbus.TaskRelationUpdatedEvent) {
+	bus.TaskRelationUpdated.Subscribe(func(ctx context.Context, event *eventbus.TaskRelationUpdatedEvent) error {
 		if event.OldParentID == event.NewParentID {
-			return
+			return nil
 		}
 
 		err := traceService.UpdateParent(ctx, &trace.UpdateParentInput{
+			Username: event.Username,
 			ID:       event.TaskID,
 			ParentID: event.NewParentID,
+			Now:      event.Now,
 		})
 		if err != nil {
-			log.Printf("failed to update parent trace: %v", err)
+			return fmt.Errorf("failed to update parent trace: %w", err)
 		}
+
+		return nil
 	})
 
-	bus.ExtraStatusUpdated.Subscribe(func(ctx context.Context, event *eventbus.ExtraStatusUpdatedEvent) {
+	bus.ExtraStatusUpdated.Subscribe(func(ctx context.Context, event *eventbus.ExtraStatusUpdatedEvent) error {
+		// rollup으로 doing이 된 부모는 자식의 시간이 이미 올라오므로 따로 시간을 재지 않는다.
+		if event.Derived && event.Category == string(domain.TaskStatusDoing) {
+			return nil
+		}
+
 		err := traceService.UpdateStatus(ctx, &trace.UpdateStatusInput{
-			ID:     event.ExtraID,
-			Status: event.Status,
-			Now:    event.Now,
+			Username: event.Username,
+			ID:       event.ExtraID,
+			Status:   event.Category,
+			Now:      event.Now,
 		})
 		if err != nil {
-			log.Printf("failed to start trace: %v", err)
+			return fmt.Errorf("failed to start trace: %w", err)
 		}
+
+		return nil
 	})
+}
 
-	err = server.ListenAndServe()
-	if err != nil {
-		return fmt.Errorf("failed to listen and serve: %w", err)
-	}
+// subscribeStream은 bus의 이벤트를 구독 중인 연결로 보낸다.
+func subscribeStream(bus *eventbus.Bus, streamService *stream.Service) {
+	bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		return streamService.Publish(ctx, &stream.PublishInput{
+			Username: event.Username,
+			Kind:     stream.KindTaskCreated,
+			TaskID:   event.TaskID,
+			ParentID: event.ParentID,
+			NextID:   "",
+			Status:   "",
+			At:       event.Now,
+		})
+	})
+	bus.TaskDeleted.Subscribe(func(ctx context.Context, event *eventbus.TaskDeletedEvent) error {
+		return streamService.Publish(ctx, &stream.PublishInput{
+			Username: event.Username,
+			Kind:     stream.KindTaskDeleted,
+			TaskID:   event.TaskID,
+			ParentID: "",
+			NextID:   "",
+			Status:   "",
+			At:       event.Now,
+		})
+	})
+	bus.TaskRelationUpdated.Subscribe(func(ctx context.Context, event *eventbus.TaskRelationUpdatedEvent) error {
+		return streamService.Publish(ctx, &stream.PublishInput{
+			Username: event.Username,
+			Kind:     stream.KindTaskMoved,
+			TaskID:   event.TaskID,
+			ParentID: event.NewParentID,
+			NextID:   event.NewNextID,
+			Status:   "",
+			At:       event.Now,
+		})
+	})
+	bus.ExtraStatusUpdated.Subscribe(func(ctx context.Context, event *eventbus.ExtraStatusUpdatedEvent) error {
+		return streamService.Publish(ctx, &stream.PublishInput{
+			Username: event.Username,
+			Kind:     stream.KindStatusChanged,
+			TaskID:   event.ExtraID,
+			ParentID: "",
+			NextID:   "",
+			Status:   event.Status,
+			At:       event.Now,
+		})
+	})
+}
 
-	return nil
+// subscribeWebhook은 bus의 이벤트를 webhook으로 보낼 기록으로 남긴다.
+func subscribeWebhook(bus *eventbus.Bus, webhookService *webhook.Service) { //nolint:funlen
+	bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		return webhookService.Publish(ctx, &webhook.PublishInput{
+			Username:    event.Username,
+			Event:       string(domain.WebhookEventTaskCreated),
+			TaskID:      event.TaskID,
+			ParentID:    event.ParentID,
+			OldParentID: "",
+			Status:      "",
+			At:          event.Now,
+		})
+	})
+	bus.TaskDeleted.Subscribe(func(ctx context.Context, event *eventbus.TaskDeletedEvent) error {
+		return webhookService.Publish(ctx, &webhook.PublishInput{
+			Username:    event.Username,
+			Event:       string(domain.WebhookEventTaskDeleted),
+			TaskID:      event.TaskID,
+			ParentID:    "",
+			OldParentID: "",
+			Status:      "",
+			At:          event.Now,
+		})
+	})
+	bus.TaskRelationUpdated.Subscribe(func(ctx context.Context, event *eventbus.TaskRelationUpdatedEvent) error {
+		return webhookService.Publish(ctx, &webhook.PublishInput{
+			Username:    event.Username,
+			Event:       string(domain.WebhookEventTaskMoved),
+			TaskID:      event.TaskID,
+			ParentID:    event.NewParentID,
+			OldParentID: event.OldParentID,
+			Status:      "",
+			At:          event.Now,
+		})
+	})
+	bus.ExtraStatusUpdated.Subscribe(func(ctx context.Context, event *eventbus.ExtraStatusUpdatedEvent) error {
+		// 완료된 상태끼리 옮긴 것은 새로 완료한 것이 아니다.
+		if event.Category != string(domain.TaskStatusDone) || event.OldCategory == string(domain.TaskStatusDone) {
+			return nil
+		}
+
+		return webhookService.Publish(ctx, &webhook.PublishInput{
+			Username:    event.Username,
+			Event:       string(domain.WebhookEventTaskCompleted),
+			TaskID:      event.ExtraID,
+			ParentID:    "",
+			OldParentID: "",
+			Status:      event.Status,
+			At:          event.Now,
+		})
+	})
+	bus.TraceTimerStarted.Subscribe(func(ctx context.Context, event *eventbus.TraceTimerStartedEvent) error {
+		return webhookService.Publish(ctx, &webhook.PublishInput{
+			Username:    event.Username,
+			Event:       string(domain.WebhookEventTimerStarted),
+			TaskID:      event.TraceID,
+			ParentID:    "",
+			OldParentID: "",
+			Status:      "",
+			At:          event.StartedAt,
+		})
+	})
+	bus.TraceTimerStopped.Subscribe(func(ctx context.Context, event *eventbus.TraceTimerStoppedEvent) error {
+		return webhookService.Publish(ctx, &webhook.PublishInput{
+			Username:    event.Username,
+			Event:       string(domain.WebhookEventTimerStopped),
+			TaskID:      event.TraceID,
+			ParentID:    "",
+			OldParentID: "",
+			Status:      "",
+			At:          event.StoppedAt,
+		})
+	})
 }
 
-func newServer(flowService *flow.Service, extraService *extra.Service, traceService *trace.Service) *http.Server {
+func newServer(
+	cfg *serverConfig,
+	flowService *flow.Service,
+	extraService *extra.Service,
+	traceService *trace.Service,
+	calendarService *calendar.Service,
+	sheetService *timesheet.Service,
+	analyticsService *analytics.Service,
+	autostopService *autostop.Service,
+	burndownService *burndown.Service,
+	projectionService *projection.Service,
+	streamService *stream.Service,
+	webhookService *webhook.Service,
+) *http.Server {
 	mux := goahttp.NewMuxer()
 	requestDecoder := goahttp.RequestDecoder
 	responseEncoder := goahttp.ResponseEncoder
 
-	handler := NewHandler(flowService, extraService, traceService)
+	handler := NewHandler(
+		flowService,
+		extraService,
+		traceService,
+		calendarService,
+		sheetService,
+		analyticsService,
+		autostopService,
+		burndownService,
+		projectionService,
+		streamService,
+		webhookService,
+	)
 	endpoints := task.NewEndpoints(handler)
 	taskServer := taskserver.New(endpoints, mux, requestDecoder, responseEncoder, nil, nil)
 	taskServer.Mount(mux)
 
-	return &http.Server{ //nolint:exhaustruct,gosec
-		Addr:    ":8080",
-		Handler: mux,
+	return &http.Server{ //nolint:exhaustruct
+		Addr:              cfg.Addr,
+		Handler:           withRequestID(mux),
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
 	}
 }
+
+// withRequestID는 요청의 X-Request-ID를, 없으면 새로 만든 ID를 ctx에 담는다.
+func withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestIDHeader)
+		if id == "" {
+			id = rand.Text()
+		}
+
+		w.Header().Set(requestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(eventbus.WithRequestID(r.Context(), id)))
+	})
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/urfave/cli/v3"
+)
+
+func newMigrateCommand() *cli.Command {
+	return &cli.Command{ //nolint:exhaustruct
+		Name:  "migrate",
+		Usage: "apply, revert or list schema migrations",
+		Commands: []*cli.Command{
+			{
+				Name:  "up",
+				Usage: "apply pending migrations in order",
+				Flags: append(newDatabaseFlags(),
+					&cli.IntFlag{ //nolint:exhaustruct
+						Name:      "steps",
+						Usage:     "number of migrations to apply, 0 for all",
+						Validator: nonNegative[int],
+					},
+				),
+				Action: func(ctx context.Context, c *cli.Command) error {
+					log.Println("migrate up")
+
+					cfg, err := loadDatabaseConfig(c)
+					if err != nil {
+						return err
+					}
+
+					return runMigrateUp(ctx, cfg, c.Int("steps"))
+				},
+			},
+			{
+				Name:  "down",
+				Usage: "revert applied migrations, newest first",
+				Flags: append(newDatabaseFlags(),
+					&cli.IntFlag{ //nolint:exhaustruct
+						Name:      "steps",
+						Usage:     "number of migrations to revert, 0 for all",
+						Value:     1,
+						Validator: nonNegative[int],
+					},
+				),
+				Action: func(ctx context.Context, c *cli.Command) error {
+					log.Println("migrate down")
+
+					cfg, err := loadDatabaseConfig(c)
+					if err != nil {
+						return err
+					}
+
+					return runMigrateDown(ctx, cfg, c.Int("steps"))
+				},
+			},
+			{
+				Name:  "status",
+				Usage: "list migrations and when they were applied",
+				Flags: newDatabaseFlags(),
+				Action: func(ctx context.Context, c *cli.Command) error {
+					log.Println("migrate status")
+
+					cfg, err := loadDatabaseConfig(c)
+					if err != nil {
+						return err
+					}
+
+					return runMigrateStatus(ctx, cfg)
+				},
+			},
+		},
+	}
+}
+
+func runMigrateUp(ctx context.Context, cfg *databaseConfig, steps int) error {
+	repo, err := connectRepository(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	applied, err := repo.MigrateUp(ctx, steps)
+	for _, status := range applied {
+		log.Printf("applied %d %s", status.Version, status.Name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to migrate up: %w", err)
+	}
+
+	log.Printf("%d migrations applied", len(applied))
+
+	return nil
+}
+
+func runMigrateDown(ctx context.Context, cfg *databaseConfig, steps int) error {
+	repo, err := connectRepository(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	reverted, err := repo.MigrateDown(ctx, steps)
+	for _, status := range reverted {
+		log.Printf("reverted %d %s", status.Version, status.Name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to migrate down: %w", err)
+	}
+
+	log.Printf("%d migrations reverted", len(reverted))
+
+	return nil
+}
+
+func runMigrateStatus(ctx context.Context, cfg *databaseConfig) error {
+	repo, err := connectRepository(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	statuses, err := repo.MigrationStatuses(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get migration status: %w", err)
+	}
+
+	for _, status := range statuses {
+		appliedAt := "pending"
+		if !status.AppliedAt.IsZero() {
+			appliedAt = status.AppliedAt.Format(time.RFC3339)
+		}
+
+		log.Printf("%d %s %s", status.Version, status.Name, appliedAt)
+	}
+
+	return nil
+}
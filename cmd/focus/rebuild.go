@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/outbox"
+	"github.com/neatflowcv/focus/internal/app/projection"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/clock/system"
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/neatflowcv/focus/internal/pkg/idmaker/ulid"
+	"github.com/urfave/cli/v3"
+)
+
+func newRebuildCommand() *cli.Command {
+	return &cli.Command{ //nolint:exhaustruct
+		Name:  "rebuild-projections",
+		Usage: "rebuild extras and traces from the task tree",
+		Flags: append(newDatabaseFlags(),
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:  "user",
+				Usage: "owner of the tasks (default: every user)",
+			},
+			&cli.BoolFlag{ //nolint:exhaustruct
+				Name:  "dry-run",
+				Usage: "print the changes without saving them",
+			},
+		),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			log.Println("rebuild projections")
+
+			cfg, err := loadDatabaseConfig(c)
+			if err != nil {
+				return err
+			}
+
+			return runRebuild(ctx, cfg, c.String("user"), c.Bool("dry-run"))
+		},
+	}
+}
+
+func runRebuild(ctx context.Context, cfg *databaseConfig, username string, dryRun bool) error {
+	repo, err := openRepository(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	bus := eventbus.NewBus()
+
+	// Listen하지 않으므로 rebuild 중의 이벤트는 바로 전달된다.
+	flowService := flow.NewService(outbox.NewService(system.NewClock(), repo, bus), ulid.NewIDMaker(), repo)
+	extraService := extra.NewService(bus, repo, repo)
+	traceService := trace.NewService(bus, ulid.NewIDMaker(), repo)
+
+	subscribe(bus, extraService, traceService)
+
+	service := projection.NewService(flowService, extraService, traceService)
+
+	usernames := []string{username}
+	if username == "" {
+		usernames, err = repo.ListUsernames(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list usernames: %w", err)
+		}
+	}
+
+	count := 0
+
+	for _, username := range usernames {
+		out, err := service.Rebuild(ctx, &projection.RebuildInput{
+			Username: username,
+			DryRun:   dryRun,
+			Now:      time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to rebuild %s: %w", username, err)
+		}
+
+		for _, change := range out.Changes {
+			_, err := fmt.Fprintln(os.Stdout, formatProjectionChange(username, change))
+			if err != nil {
+				return fmt.Errorf("failed to write change: %w", err)
+			}
+		}
+
+		count += len(out.Changes)
+	}
+
+	if dryRun {
+		log.Printf("%d changes (dry run)", count)
+	} else {
+		log.Printf("%d changes", count)
+	}
+
+	return nil
+}
+
+// formatProjectionChange는 바뀌는 값 하나를 diff처럼 한 줄로 나타낸다.
+func formatProjectionChange(username string, change *projection.Change) string {
+	switch change.Kind {
+	case extra.ChangeCreate:
+		return fmt.Sprintf("+ %s %s %s parent=%q", username, change.Projection, change.ID, change.To)
+	case extra.ChangeDelete:
+		return fmt.Sprintf("- %s %s %s parent=%q", username, change.Projection, change.ID, change.From)
+	default:
+		return fmt.Sprintf("~ %s %s %s %s: %s -> %s",
+			username, change.Projection, change.ID, change.Kind, change.From, change.To)
+	}
+}
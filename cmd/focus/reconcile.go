@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/neatflowcv/focus/internal/pkg/idmaker/ulid"
+	"github.com/urfave/cli/v3"
+)
+
+func newReconcileCommand() *cli.Command {
+	return &cli.Command{ //nolint:exhaustruct
+		Name:  "reconcile",
+		Usage: "recompute every actual time from self times and report mismatches",
+		Flags: append(newDatabaseFlags(),
+			&cli.BoolFlag{ //nolint:exhaustruct
+				Name:  "fix",
+				Usage: "overwrite mismatched actual times",
+			},
+		),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			log.Println("reconcile")
+
+			cfg, err := loadDatabaseConfig(c)
+			if err != nil {
+				return err
+			}
+
+			return runReconcile(ctx, cfg, c.Bool("fix"))
+		},
+	}
+}
+
+func runReconcile(ctx context.Context, cfg *databaseConfig, fix bool) error {
+	repo, err := openRepository(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	service := trace.NewService(eventbus.NewBus(), ulid.NewIDMaker(), repo)
+
+	usernames, err := repo.ListUsernames(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list usernames: %w", err)
+	}
+
+	count := 0
+
+	for _, username := range usernames {
+		out, err := service.Reconcile(ctx, &trace.ReconcileInput{
+			Username: username,
+			Fix:      fix,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to reconcile %s: %w", username, err)
+		}
+
+		for _, item := range out.Mismatches {
+			log.Printf("trace %s of %s: stored %s, expected %s", item.ID, username, item.Stored, item.Expected)
+		}
+
+		count += len(out.Mismatches)
+	}
+
+	log.Printf("%d mismatches", count)
+
+	return nil
+}
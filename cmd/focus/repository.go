@@ -0,0 +1,24 @@
+package main
+
+import (
+	"github.com/neatflowcv/focus/internal/pkg/repository/gorm"
+	"github.com/neatflowcv/focus/internal/pkg/repository/sqlite"
+)
+
+// openRepository는 cfg의 backend에 따라 SQLite 파일이나 PostgreSQL을 열고 스키마를 최신 버전으로 올린다.
+func openRepository(cfg *databaseConfig) (*gorm.Repository, error) {
+	if cfg.Backend == sqliteBackend {
+		return sqlite.NewRepository(cfg.DSN)
+	}
+
+	return gorm.NewRepository(cfg.DSN, cfg.Pool)
+}
+
+// connectRepository는 openRepository와 같은 데이터베이스에 연결하지만 스키마를 올리지 않는다.
+func connectRepository(cfg *databaseConfig) (*gorm.Repository, error) {
+	if cfg.Backend == sqliteBackend {
+		return sqlite.Open(cfg.DSN)
+	}
+
+	return gorm.OpenPostgres(cfg.DSN, cfg.Pool)
+}
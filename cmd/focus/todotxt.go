@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/outbox"
+	"github.com/neatflowcv/focus/internal/app/todotxt"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/clock/system"
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/neatflowcv/focus/internal/pkg/idmaker/ulid"
+	"github.com/urfave/cli/v3"
+)
+
+func newTodotxtCommand() *cli.Command {
+	return &cli.Command{ //nolint:exhaustruct
+		Name:  "todotxt",
+		Usage: "exchange tasks in the todo.txt format",
+		Commands: []*cli.Command{
+			{
+				Name:  "export",
+				Usage: "write tasks as todo.txt lines",
+				Flags: append(newDatabaseFlags(),
+					newUserFlag(),
+					&cli.StringFlag{ //nolint:exhaustruct
+						Name:  "output",
+						Usage: "file to write (default: stdout)",
+					},
+				),
+				Action: func(ctx context.Context, c *cli.Command) error {
+					log.Println("todotxt export")
+
+					cfg, err := loadDatabaseConfig(c)
+					if err != nil {
+						return err
+					}
+
+					return runTodotxtExport(ctx, cfg, c.String("user"), c.String("output"))
+				},
+			},
+			{
+				Name:  "import",
+				Usage: "create or update tasks from todo.txt lines",
+				Flags: append(newDatabaseFlags(),
+					newUserFlag(),
+					&cli.StringFlag{ //nolint:exhaustruct
+						Name:  "input",
+						Usage: "file to read (default: stdin)",
+					},
+				),
+				Action: func(ctx context.Context, c *cli.Command) error {
+					log.Println("todotxt import")
+
+					cfg, err := loadDatabaseConfig(c)
+					if err != nil {
+						return err
+					}
+
+					return runTodotxtImport(ctx, cfg, c.String("user"), c.String("input"))
+				},
+			},
+		},
+	}
+}
+
+func newUserFlag() *cli.StringFlag {
+	return &cli.StringFlag{ //nolint:exhaustruct
+		Name:     "user",
+		Usage:    "owner of the tasks",
+		Required: true,
+	}
+}
+
+func newTodotxtService(cfg *databaseConfig) (*todotxt.Service, error) {
+	repo, err := openRepository(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	bus := eventbus.NewBus()
+
+	// Listen하지 않으므로 import 중의 이벤트는 바로 전달된다.
+	flowService := flow.NewService(outbox.NewService(system.NewClock(), repo, bus), ulid.NewIDMaker(), repo)
+	extraService := extra.NewService(bus, repo, repo)
+	traceService := trace.NewService(bus, ulid.NewIDMaker(), repo)
+
+	subscribe(bus, extraService, traceService)
+
+	return todotxt.NewService(flowService, extraService, traceService), nil
+}
+
+func runTodotxtExport(ctx context.Context, cfg *databaseConfig, username string, output string) error {
+	service, err := newTodotxtService(cfg)
+	if err != nil {
+		return err
+	}
+
+	out, err := service.Export(ctx, &todotxt.ExportInput{
+		Username: username,
+		Now:      time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export: %w", err)
+	}
+
+	var writer io.Writer = os.Stdout
+
+	if output != "" {
+		file, err := os.Create(output) //nolint:gosec
+		if err != nil {
+			return fmt.Errorf("failed to create output: %w", err)
+		}
+		defer file.Close() //nolint:errcheck
+
+		writer = file
+	}
+
+	for _, line := range out.Lines {
+		_, err := fmt.Fprintln(writer, line)
+		if err != nil {
+			return fmt.Errorf("failed to write line: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func runTodotxtImport(ctx context.Context, cfg *databaseConfig, username string, input string) error {
+	var reader io.Reader = os.Stdin
+
+	if input != "" {
+		file, err := os.Open(input) //nolint:gosec
+		if err != nil {
+			return fmt.Errorf("failed to open input: %w", err)
+		}
+		defer file.Close() //nolint:errcheck
+
+		reader = file
+	}
+
+	var lines []string
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return fmt.Errorf("failed to read input: %w", err)
+	}
+
+	service, err := newTodotxtService(cfg)
+	if err != nil {
+		return err
+	}
+
+	out, err := service.Import(ctx, &todotxt.ImportInput{
+		Username: username,
+		Lines:    lines,
+		Now:      time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to import: %w", err)
+	}
+
+	log.Printf("created %d, updated %d", out.Created, out.Updated)
+
+	return nil
+}
@@ -18,6 +18,12 @@ var _ = dsl.Service("task", func() {
 	dsl.Error("Unauthorized", dsl.ErrorResult, "Unauthorized")
 	dsl.Error("InternalServerError", dsl.ErrorResult, "Internal server error")
 	dsl.Error("TaskNotFound", dsl.ErrorResult, "Task not found")
+	dsl.Error("BadRequest", dsl.ErrorResult, "Bad request")
+	dsl.Error("SessionNotFound", dsl.ErrorResult, "Session not found")
+	dsl.Error("Conflict", dsl.ErrorResult, "Conflict")
+	dsl.Error("ProjectNotFound", dsl.ErrorResult, "Project not found")
+	dsl.Error("WebhookNotFound", dsl.ErrorResult, "Webhook not found")
+	dsl.Error("DeliveryNotFound", dsl.ErrorResult, "Webhook delivery not found")
 
 	dsl.Method("setup", func() {
 		dsl.Description("Setup the task service.")
@@ -89,8 +95,10 @@ var _ = dsl.Service("task", func() {
 			dsl.Header("authorization", dsl.String, "The authorization header")
 
 			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
 			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
 			dsl.Response("TaskNotFound", dsl.StatusNotFound)
+			dsl.Response("Conflict", dsl.StatusConflict)
 			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
 		})
 	})
@@ -111,6 +119,626 @@ var _ = dsl.Service("task", func() {
 			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
 		})
 	})
+
+	dsl.Method("calendar_token", func() {
+		dsl.Description("Issue a token for subscribing to the calendar feed.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+
+			dsl.Required("authorization")
+		})
+		dsl.Result(CalendarTokenOutput)
+
+		dsl.HTTP(func() {
+			dsl.POST("/calendar/token")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("calendar", func() {
+		dsl.Description("Get the iCalendar feed of due tasks and recorded work sessions.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("token", dsl.String, "The calendar token")
+			dsl.Attribute("due_as_event", dsl.Boolean, "Whether to list due tasks as VEVENT instead of VTODO")
+
+			dsl.Required("token")
+		})
+		dsl.Result(func() {
+			dsl.Attribute("content_type", dsl.String, "The content type of the feed")
+
+			dsl.Required("content_type")
+		})
+
+		dsl.HTTP(func() {
+			dsl.GET("/calendar.ics")
+
+			dsl.Param("token")
+			dsl.Param("due_as_event")
+
+			dsl.SkipResponseBodyEncodeDecode()
+
+			dsl.Response(dsl.StatusOK, func() {
+				dsl.Header("content_type:Content-Type")
+			})
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("timesheet", func() {
+		dsl.Description("Export the time spent per task per day.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("from", dsl.String, "The first day to include", func() {
+				dsl.Format(dsl.FormatDate)
+			})
+			dsl.Attribute("to", dsl.String, "The last day to include", func() {
+				dsl.Format(dsl.FormatDate)
+			})
+			dsl.Attribute("timezone", dsl.String, "The IANA time zone used to split days", func() {
+				dsl.Default("UTC")
+			})
+			dsl.Attribute("parent_id", dsl.String, "Only include this task and its subtasks")
+			dsl.Attribute("tag", dsl.String, "Only include tasks whose title contains this word")
+			dsl.Attribute("round", dsl.Int, "Round each row to this many minutes", func() {
+				dsl.Enum(0, 6, 15)
+				dsl.Default(0)
+			})
+			dsl.Attribute("format", dsl.String, "The format of the timesheet", func() {
+				dsl.Enum("csv", "json")
+				dsl.Default("csv")
+			})
+
+			dsl.Required("authorization")
+		})
+		dsl.Result(func() {
+			dsl.Attribute("content_type", dsl.String, "The content type of the timesheet")
+
+			dsl.Required("content_type")
+		})
+
+		dsl.HTTP(func() {
+			dsl.GET("/timesheet")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+			dsl.Param("from")
+			dsl.Param("to")
+			dsl.Param("timezone")
+			dsl.Param("parent_id")
+			dsl.Param("tag")
+			dsl.Param("round")
+			dsl.Param("format")
+
+			dsl.SkipResponseBodyEncodeDecode()
+
+			dsl.Response(dsl.StatusOK, func() {
+				dsl.ContentType("text/csv")
+				dsl.Header("content_type:Content-Type")
+			})
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("TaskNotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("estimates", func() {
+		dsl.Description("Compare estimated and actual time of completed leaf tasks.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("parent_id", dsl.String, "Only analyze the subtasks of this task")
+			dsl.Attribute("period", dsl.String, "The period of the timeline", func() {
+				dsl.Enum("week", "month")
+				dsl.Default("week")
+			})
+
+			dsl.Required("authorization")
+		})
+		dsl.Result(EstimatesOutput)
+
+		dsl.HTTP(func() {
+			dsl.GET("/analytics/estimates")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+			dsl.Param("parent_id")
+			dsl.Param("period")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("TaskNotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("lifecycle", func() {
+		dsl.Description("List tasks completed in a range of days with their cycle and lead time.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("parent_id", dsl.String, "Only analyze the subtasks of this task")
+			dsl.Attribute("from", dsl.String, "The first day of completion to include", func() {
+				dsl.Format(dsl.FormatDate)
+			})
+			dsl.Attribute("to", dsl.String, "The last day of completion to include", func() {
+				dsl.Format(dsl.FormatDate)
+			})
+			dsl.Attribute("timezone", dsl.String, "The IANA time zone used to split days", func() {
+				dsl.Default("UTC")
+			})
+
+			dsl.Required("authorization")
+		})
+		dsl.Result(LifecycleOutput)
+
+		dsl.HTTP(func() {
+			dsl.GET("/analytics/lifecycle")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+			dsl.Param("parent_id")
+			dsl.Param("from")
+			dsl.Param("to")
+			dsl.Param("timezone")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("TaskNotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("sessions", func() {
+		dsl.Description("List recorded work sessions.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("task_id", dsl.String, "Only list the sessions of this task")
+
+			dsl.Required("authorization")
+		})
+		dsl.Result(dsl.ArrayOf(SessionOutput))
+
+		dsl.HTTP(func() {
+			dsl.GET("/sessions")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+			dsl.Param("task_id")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("TaskNotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("add_session", func() {
+		dsl.Description("Log time spent on a task afterward.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("task_id", dsl.String, "The ID of the task")
+			dsl.Extend(SessionInput)
+
+			dsl.Required("authorization", "task_id")
+		})
+		dsl.Result(SessionOutput)
+
+		dsl.HTTP(func() {
+			dsl.POST("/{task_id}/sessions")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("TaskNotFound", dsl.StatusNotFound)
+			dsl.Response("Conflict", dsl.StatusConflict)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("update_session", func() {
+		dsl.Description("Correct a recorded work session.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("session_id", dsl.String, "The ID of the session")
+			dsl.Extend(SessionInput)
+
+			dsl.Required("authorization", "session_id")
+		})
+		dsl.Result(SessionOutput)
+
+		dsl.HTTP(func() {
+			dsl.PUT("/sessions/{session_id}")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("SessionNotFound", dsl.StatusNotFound)
+			dsl.Response("Conflict", dsl.StatusConflict)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("delete_session", func() {
+		dsl.Description("Delete a recorded work session.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("session_id", dsl.String, "The ID of the session")
+
+			dsl.Required("authorization", "session_id")
+		})
+
+		dsl.HTTP(func() {
+			dsl.DELETE("/sessions/{session_id}")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusNoContent)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("SessionNotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("track_project", func() {
+		dsl.Description("Record a daily burndown snapshot of the task and its subtasks.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("task_id", dsl.String, "The ID of the root task of the project")
+			dsl.Attribute("timezone", dsl.String, "The IANA time zone where a day ends", func() {
+				dsl.Default("UTC")
+			})
+
+			dsl.Required("authorization", "task_id")
+		})
+
+		dsl.HTTP(func() {
+			dsl.PUT("/{task_id}/tracking")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusNoContent)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("TaskNotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("untrack_project", func() {
+		dsl.Description("Stop tracking the project and delete its snapshots.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("task_id", dsl.String, "The ID of the root task of the project")
+
+			dsl.Required("authorization", "task_id")
+		})
+
+		dsl.HTTP(func() {
+			dsl.DELETE("/{task_id}/tracking")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusNoContent)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("ProjectNotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("burndown", func() {
+		dsl.Description("List the daily snapshots of a tracked project for burndown and burnup charts.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("task_id", dsl.String, "The ID of the root task of the project")
+
+			dsl.Required("authorization", "task_id")
+		})
+		dsl.Result(BurndownOutput)
+
+		dsl.HTTP(func() {
+			dsl.GET("/{task_id}/burndown")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("ProjectNotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("recompute", func() {
+		dsl.Description("Recompute the leaf flag and status of the caller's tasks from their task tree.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+
+			dsl.Required("authorization")
+		})
+		dsl.Result(RecomputeOutput)
+
+		dsl.HTTP(func() {
+			dsl.POST("/recompute")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("stream", func() {
+		dsl.Description("Stream changes of the tasks as Server-Sent Events.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("last_event_id", dsl.String, "Resume after this event ID")
+
+			dsl.Required("authorization")
+		})
+		dsl.StreamingResult(StreamEvent)
+
+		dsl.HTTP(func() {
+			dsl.GET("/stream")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+			dsl.Header("last_event_id:Last-Event-ID", dsl.String, "The ID of the last received event")
+
+			dsl.ServerSentEvents(func() {
+				dsl.SSEEventID("id")
+				dsl.SSEEventType("kind")
+			})
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("rebuild_projections", func() {
+		dsl.Description("Rebuild the caller's extras and traces from their task tree and report what was changed.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("dry_run", dsl.Boolean, "Report the changes without saving them", func() {
+				dsl.Default(false)
+			})
+
+			dsl.Required("authorization")
+		})
+		dsl.Result(RebuildProjectionsOutput)
+
+		dsl.HTTP(func() {
+			dsl.POST("/rebuild-projections")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+			dsl.Param("dry_run")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("get_workflow", func() {
+		dsl.Description("Get the statuses and allowed transitions of tasks.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+
+			dsl.Required("authorization")
+		})
+		dsl.Result(Workflow)
+
+		dsl.HTTP(func() {
+			dsl.GET("/settings/workflow")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("set_workflow", func() {
+		dsl.Description("Set the statuses and allowed transitions of tasks.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Extend(Workflow)
+
+			dsl.Required("authorization")
+		})
+		dsl.Result(Workflow)
+
+		dsl.HTTP(func() {
+			dsl.PUT("/settings/workflow")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("get_auto_stop", func() {
+		dsl.Description("Get the policy for stopping forgotten running tasks.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+
+			dsl.Required("authorization")
+		})
+		dsl.Result(AutoStopPolicy)
+
+		dsl.HTTP(func() {
+			dsl.GET("/settings/auto-stop")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("create_webhook", func() {
+		dsl.Description("Register a URL that receives signed events of the tasks.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Extend(WebhookInput)
+
+			dsl.Required("authorization")
+		})
+		dsl.Result(WebhookOutput)
+
+		dsl.HTTP(func() {
+			dsl.POST("/webhooks")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusCreated)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("list_webhooks", func() {
+		dsl.Description("List the registered webhooks.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+
+			dsl.Required("authorization")
+		})
+		dsl.Result(dsl.ArrayOf(WebhookOutput))
+
+		dsl.HTTP(func() {
+			dsl.GET("/webhooks")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("delete_webhook", func() {
+		dsl.Description("Delete a webhook and its delivery log.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("webhook_id", dsl.String, "The ID of the webhook")
+
+			dsl.Required("authorization", "webhook_id")
+		})
+
+		dsl.HTTP(func() {
+			dsl.DELETE("/webhooks/{webhook_id}")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusNoContent)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("WebhookNotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("list_webhook_deliveries", func() {
+		dsl.Description("List the deliveries of a webhook in the order they were created.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("webhook_id", dsl.String, "The ID of the webhook")
+
+			dsl.Required("authorization", "webhook_id")
+		})
+		dsl.Result(dsl.ArrayOf(WebhookDelivery))
+
+		dsl.HTTP(func() {
+			dsl.GET("/webhooks/{webhook_id}/deliveries")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("WebhookNotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("redeliver_webhook", func() {
+		dsl.Description("Send the payload of a delivery again as a new delivery.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Attribute("webhook_id", dsl.String, "The ID of the webhook")
+			dsl.Attribute("delivery_id", dsl.String, "The ID of the delivery to send again")
+
+			dsl.Required("authorization", "webhook_id", "delivery_id")
+		})
+		dsl.Result(WebhookDelivery)
+
+		dsl.HTTP(func() {
+			dsl.POST("/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusAccepted)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("DeliveryNotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
+
+	dsl.Method("set_auto_stop", func() {
+		dsl.Description("Set the policy for stopping forgotten running tasks.")
+
+		dsl.Payload(func() {
+			dsl.Attribute("authorization", dsl.String, "The authorization header")
+			dsl.Extend(AutoStopPolicy)
+
+			dsl.Required("authorization")
+		})
+		dsl.Result(AutoStopPolicy)
+
+		dsl.HTTP(func() {
+			dsl.PUT("/settings/auto-stop")
+
+			dsl.Header("authorization", dsl.String, "The authorization header")
+
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("Unauthorized", dsl.StatusUnauthorized)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+		})
+	})
 })
 
 var CreateTaskInput = dsl.Type("CreateTaskInput", func() { //nolint:gochecknoglobals
@@ -130,9 +758,14 @@ var CreateTaskOutput = dsl.ResultType("CreateTaskOutput", func() { //nolint:goch
 	dsl.Attribute("estimated_time", dsl.Int64, "The estimated time of the task")
 	dsl.Attribute("actual_time", dsl.Int64, "The actual time of the task")
 	dsl.Attribute("started_at", dsl.Int64, "The timestamp when the task was started")
+	dsl.Attribute("due_at", dsl.Int64, "The timestamp when the task is due")
 
 	dsl.Attribute("is_leaf", dsl.Boolean, "Whether the task is a leaf task")
 	dsl.Attribute("status", dsl.String, "The status of the task")
+	dsl.Attribute("rollup", dsl.Boolean, "Whether the status is derived from the subtasks")
+	dsl.Attribute("first_started_at", dsl.Int64, "The timestamp when the task was first started")
+	dsl.Attribute("completed_at", dsl.Int64, "The timestamp when the task was completed")
+	dsl.Attribute("reopens", dsl.Int, "The number of times the task was reopened after completion")
 
 	dsl.Required("id", "title", "created_at")
 })
@@ -146,6 +779,8 @@ var TaskUpdateInput = dsl.Type("TaskUpdateInput", func() { //nolint:gochecknoglo
 	dsl.Attribute("next_id", dsl.String, "The next ID of the task")
 	dsl.Attribute("status", dsl.String, "The status of the task")
 	dsl.Attribute("estimated_time", dsl.Int64, "The estimated time of the task")
+	dsl.Attribute("due_at", dsl.Int64, "The timestamp when the task is due, 0 to clear")
+	dsl.Attribute("rollup", dsl.Boolean, "Whether to derive the status from the subtasks")
 
 	dsl.Required("authorization", "task_id", "title", "status")
 })
@@ -162,3 +797,244 @@ var SetupTaskInput = dsl.Type("SetupTaskInput", func() { //nolint:gochecknogloba
 
 	dsl.Required("authorization")
 })
+
+var CalendarTokenOutput = dsl.Type("CalendarTokenOutput", func() { //nolint:gochecknoglobals
+	dsl.Attribute("token", dsl.String, "The token for the calendar feed")
+	dsl.Attribute("expires_at", dsl.Int64, "The timestamp when the token expires")
+
+	dsl.Required("token", "expires_at")
+})
+
+var EstimateStats = dsl.Type("EstimateStats", func() { //nolint:gochecknoglobals
+	dsl.Attribute("count", dsl.Int, "The number of completed leaf tasks")
+	dsl.Attribute("median_ratio", dsl.Float64, "The median of actual time divided by estimated time")
+	dsl.Attribute("mean_accuracy", dsl.Float64, "The mean of the smaller time divided by the larger time")
+	dsl.Attribute("median_overrun", dsl.Int64, "The median of actual time minus estimated time in seconds")
+
+	dsl.Required("count", "median_ratio", "mean_accuracy", "median_overrun")
+})
+
+var EstimateGroup = dsl.Type("EstimateGroup", func() { //nolint:gochecknoglobals
+	dsl.Attribute("key", dsl.String, "The key of the group")
+	dsl.Attribute("stats", EstimateStats, "The statistics of the group")
+
+	dsl.Required("key", "stats")
+})
+
+var EstimatesOutput = dsl.Type("EstimatesOutput", func() { //nolint:gochecknoglobals
+	dsl.Attribute("summary", EstimateStats, "The statistics of all completed leaf tasks")
+	dsl.Attribute("timeline", dsl.ArrayOf(EstimateGroup), "The statistics per period of completion")
+	dsl.Attribute("by_depth", dsl.ArrayOf(EstimateGroup), "The statistics per depth")
+	dsl.Attribute("by_tag", dsl.ArrayOf(EstimateGroup), "The statistics per +project and @context tag")
+	dsl.Attribute("by_subtree", dsl.ArrayOf(EstimateGroup), "The statistics per top-level task ID")
+
+	dsl.Required("summary", "timeline", "by_depth", "by_tag", "by_subtree")
+})
+
+var LifecycleTask = dsl.Type("LifecycleTask", func() { //nolint:gochecknoglobals
+	dsl.Attribute("id", dsl.String, "The ID of the task")
+	dsl.Attribute("title", dsl.String, "The title of the task")
+	dsl.Attribute("is_leaf", dsl.Boolean, "Whether the task is a leaf task")
+	dsl.Attribute("created_at", dsl.Int64, "The timestamp when the task was created")
+	dsl.Attribute("first_started_at", dsl.Int64, "The timestamp when the task was first started")
+	dsl.Attribute("completed_at", dsl.Int64, "The timestamp when the task was completed")
+	dsl.Attribute("reopens", dsl.Int, "The number of times the task was reopened after completion")
+	dsl.Attribute("cycle_time", dsl.Int64, "The seconds from the first start to completion")
+	dsl.Attribute("lead_time", dsl.Int64, "The seconds from creation to completion")
+
+	dsl.Required("id", "title", "is_leaf", "created_at", "completed_at", "reopens", "lead_time")
+})
+
+var FlowStats = dsl.Type("FlowStats", func() { //nolint:gochecknoglobals
+	dsl.Attribute("count", dsl.Int, "The number of completed leaf tasks")
+	dsl.Attribute("started", dsl.Int, "The number of completed leaf tasks that were started")
+	dsl.Attribute("reopens", dsl.Int, "The total number of reopens")
+	dsl.Attribute("median_cycle_time", dsl.Int64, "The median cycle time in seconds")
+	dsl.Attribute("mean_cycle_time", dsl.Int64, "The mean cycle time in seconds")
+	dsl.Attribute("median_lead_time", dsl.Int64, "The median lead time in seconds")
+	dsl.Attribute("mean_lead_time", dsl.Int64, "The mean lead time in seconds")
+
+	dsl.Required(
+		"count", "started", "reopens",
+		"median_cycle_time", "mean_cycle_time", "median_lead_time", "mean_lead_time",
+	)
+})
+
+var FlowGroup = dsl.Type("FlowGroup", func() { //nolint:gochecknoglobals
+	dsl.Attribute("key", dsl.String, "The key of the group")
+	dsl.Attribute("stats", FlowStats, "The statistics of the group")
+
+	dsl.Required("key", "stats")
+})
+
+var LifecycleOutput = dsl.Type("LifecycleOutput", func() { //nolint:gochecknoglobals
+	dsl.Attribute("tasks", dsl.ArrayOf(LifecycleTask), "The completed tasks in order of completion")
+	dsl.Attribute("summary", FlowStats, "The statistics of all completed leaf tasks")
+	dsl.Attribute("by_subtree", dsl.ArrayOf(FlowGroup), "The statistics per top-level task ID")
+
+	dsl.Required("tasks", "summary", "by_subtree")
+})
+
+var RecomputeOutput = dsl.Type("RecomputeOutput", func() { //nolint:gochecknoglobals
+	dsl.Attribute("created", dsl.Int, "The number of missing records that were created")
+	dsl.Attribute("updated", dsl.Int, "The number of records that were fixed")
+	dsl.Attribute("deleted", dsl.Int, "The number of records without a task that were deleted")
+
+	dsl.Required("created", "updated", "deleted")
+})
+
+var StreamEvent = dsl.Type("StreamEvent", func() { //nolint:gochecknoglobals
+	dsl.Attribute("id", dsl.String, "The ID to resume from, empty for timer ticks")
+	dsl.Attribute("kind", dsl.String, "The kind of the event", func() {
+		dsl.Enum("reset", "task.created", "task.deleted", "task.moved", "status.changed", "timer.tick")
+	})
+	dsl.Attribute("task_id", dsl.String, "The ID of the task")
+	dsl.Attribute("parent_id", dsl.String, "The parent ID of the task")
+	dsl.Attribute("next_id", dsl.String, "The next ID of the task")
+	dsl.Attribute("status", dsl.String, "The status of the task")
+	dsl.Attribute("actual_time", dsl.Int64, "The actual time of the running task")
+	dsl.Attribute("started_at", dsl.Int64, "The timestamp when the running task was started")
+	dsl.Attribute("at", dsl.Int64, "The timestamp of the event")
+
+	dsl.Required("id", "kind", "at")
+})
+
+var ProjectionChange = dsl.Type("ProjectionChange", func() { //nolint:gochecknoglobals
+	dsl.Attribute("projection", dsl.String, "The projection that is changed", func() {
+		dsl.Enum("extra", "trace")
+	})
+	dsl.Attribute("id", dsl.String, "The ID of the task")
+	dsl.Attribute("kind", dsl.String, "The kind of the change, delete_session removes the session in from", func() {
+		dsl.Enum("create", "reparent", "delete", "delete_session", "leaf", "status", "actual")
+	})
+	dsl.Attribute("from", dsl.String, "The stored value")
+	dsl.Attribute("to", dsl.String, "The rebuilt value")
+
+	dsl.Required("projection", "id", "kind", "from", "to")
+})
+
+var RebuildProjectionsOutput = dsl.Type("RebuildProjectionsOutput", func() { //nolint:gochecknoglobals
+	dsl.Attribute("dry_run", dsl.Boolean, "Whether the changes were only reported")
+	dsl.Attribute("extras", RecomputeOutput, "The changed extras")
+	dsl.Attribute("traces", RecomputeOutput, "The changed traces")
+	dsl.Attribute("changes", dsl.ArrayOf(ProjectionChange), "The changes in the order they are applied")
+
+	dsl.Required("dry_run", "extras", "traces", "changes")
+})
+
+var WorkflowStatus = dsl.Type("WorkflowStatus", func() { //nolint:gochecknoglobals
+	dsl.Attribute("name", dsl.String, "The name of the status", func() {
+		dsl.Pattern("^[a-z][a-z0-9_-]{0,31}$")
+	})
+	dsl.Attribute("category", dsl.String, "The core status that decides whether time is being spent", func() {
+		dsl.Enum("todo", "doing", "done")
+	})
+
+	dsl.Required("name", "category")
+})
+
+var WorkflowTransition = dsl.Type("WorkflowTransition", func() { //nolint:gochecknoglobals
+	dsl.Attribute("from", dsl.String, "The status before the transition")
+	dsl.Attribute("to", dsl.String, "The status after the transition")
+
+	dsl.Required("from", "to")
+})
+
+var Workflow = dsl.Type("Workflow", func() { //nolint:gochecknoglobals
+	dsl.Attribute("statuses", dsl.ArrayOf(WorkflowStatus), "The statuses, todo, doing and done are always included")
+	dsl.Attribute("transitions", dsl.ArrayOf(WorkflowTransition), "The allowed transitions, empty to allow all")
+
+	dsl.Required("statuses", "transitions")
+})
+
+var BurndownPoint = dsl.Type("BurndownPoint", func() { //nolint:gochecknoglobals
+	dsl.Attribute("date", dsl.String, "The date of the snapshot", func() {
+		dsl.Format(dsl.FormatDate)
+	})
+	dsl.Attribute("remaining_time", dsl.Int64, "The estimated time of open leaf tasks in seconds")
+	dsl.Attribute("completed_time", dsl.Int64, "The estimated time of done leaf tasks in seconds")
+	dsl.Attribute("actual_time", dsl.Int64, "The actual time of the project in seconds")
+	dsl.Attribute("open", dsl.Int, "The number of open leaf tasks")
+	dsl.Attribute("done", dsl.Int, "The number of done leaf tasks")
+
+	dsl.Required("date", "remaining_time", "completed_time", "actual_time", "open", "done")
+})
+
+var BurndownOutput = dsl.Type("BurndownOutput", func() { //nolint:gochecknoglobals
+	dsl.Attribute("timezone", dsl.String, "The time zone where a day ends")
+	dsl.Attribute("points", dsl.ArrayOf(BurndownPoint), "The snapshots in date order, the last one is live")
+
+	dsl.Required("timezone", "points")
+})
+
+var SessionOutput = dsl.Type("SessionOutput", func() { //nolint:gochecknoglobals
+	dsl.Attribute("id", dsl.String, "The ID of the session")
+	dsl.Attribute("task_id", dsl.String, "The ID of the task")
+	dsl.Attribute("started_at", dsl.Int64, "The timestamp when the session was started")
+	dsl.Attribute("ended_at", dsl.Int64, "The timestamp when the session was ended")
+	dsl.Attribute("auto", dsl.Boolean, "Whether the session was stopped automatically")
+	dsl.Attribute("note", dsl.String, "The note of the session")
+
+	dsl.Required("id", "task_id", "started_at", "ended_at", "auto")
+})
+
+var SessionInput = dsl.Type("SessionInput", func() { //nolint:gochecknoglobals
+	dsl.Attribute("started_at", dsl.Int64, "The timestamp when the session was started")
+	dsl.Attribute("ended_at", dsl.Int64, "The timestamp when the session was ended")
+	dsl.Attribute("note", dsl.String, "The note of the session")
+
+	dsl.Required("started_at", "ended_at")
+})
+
+var WebhookInput = dsl.Type("WebhookInput", func() { //nolint:gochecknoglobals
+	dsl.Attribute("url", dsl.String, "The http or https URL that receives the events")
+	dsl.Attribute("events", dsl.ArrayOf(dsl.String, func() {
+		dsl.Enum("task.created", "task.completed", "task.moved", "task.deleted", "timer.started", "timer.stopped")
+	}), "The events to send", func() {
+		dsl.MinLength(1)
+	})
+
+	dsl.Required("url", "events")
+})
+
+var WebhookOutput = dsl.Type("WebhookOutput", func() { //nolint:gochecknoglobals
+	dsl.Attribute("id", dsl.String, "The ID of the webhook")
+	dsl.Attribute("url", dsl.String, "The URL that receives the events")
+	dsl.Attribute("events", dsl.ArrayOf(dsl.String), "The events to send")
+	dsl.Attribute("secret", dsl.String,
+		"The key of the HMAC-SHA256 signature in the X-Focus-Signature-256 header, returned only on creation")
+	dsl.Attribute("created_at", dsl.Int64, "The timestamp when the webhook was created")
+
+	dsl.Required("id", "url", "events", "created_at")
+})
+
+var WebhookDelivery = dsl.Type("WebhookDelivery", func() { //nolint:gochecknoglobals
+	dsl.Attribute("id", dsl.String, "The ID of the delivery")
+	dsl.Attribute("webhook_id", dsl.String, "The ID of the webhook")
+	dsl.Attribute("event", dsl.String, "The event that was sent")
+	dsl.Attribute("payload", dsl.String, "The JSON body that was sent")
+	dsl.Attribute("status", dsl.String, "The state of the delivery", func() {
+		dsl.Enum("pending", "delivered", "failed")
+	})
+	dsl.Attribute("attempts", dsl.Int, "The number of attempts so far")
+	dsl.Attribute("next_attempt_at", dsl.Int64, "The timestamp of the next attempt while pending")
+	dsl.Attribute("last_status_code", dsl.Int, "The status code of the last response")
+	dsl.Attribute("last_error", dsl.String, "The error of the last attempt")
+	dsl.Attribute("created_at", dsl.Int64, "The timestamp when the delivery was created")
+	dsl.Attribute("delivered_at", dsl.Int64, "The timestamp when the delivery succeeded")
+
+	dsl.Required("id", "webhook_id", "event", "payload", "status", "attempts", "created_at")
+})
+
+var AutoStopPolicy = dsl.Type("AutoStopPolicy", func() { //nolint:gochecknoglobals
+	dsl.Attribute("threshold", dsl.Int64, "Stop tasks running longer than this many seconds, 0 to disable", func() {
+		dsl.Minimum(0)
+	})
+	dsl.Attribute("cap", dsl.Int64, "Record at most this many seconds for a stopped task, 0 for no cap", func() {
+		dsl.Minimum(0)
+	})
+	dsl.Attribute("midnight", dsl.Boolean, "Stop tasks still running past midnight")
+	dsl.Attribute("timezone", dsl.String, "The IANA time zone used to find midnight")
+
+	dsl.Required("threshold", "cap", "midnight", "timezone")
+})
@@ -23,13 +23,13 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"task (setup|create|list|update|delete)",
+		"task (setup|create|list|update|delete|calendar-token|calendar|timesheet|estimates|lifecycle|sessions|add-session|update-session|delete-session|track-project|untrack-project|burndown|recompute|stream|rebuild-projections|get-workflow|set-workflow|get-auto-stop|create-webhook|list-webhooks|delete-webhook|list-webhook-deliveries|redeliver-webhook|set-auto-stop)",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` task setup --authorization "Odio aut enim saepe voluptatem."` + "\n" +
+	return os.Args[0] + ` task setup --authorization "Tempora error nostrum est eum recusandae."` + "\n" +
 		""
 }
 
@@ -65,6 +65,111 @@ func ParseEndpoint(
 		taskDeleteFlags             = flag.NewFlagSet("delete", flag.ExitOnError)
 		taskDeleteTaskIDFlag        = taskDeleteFlags.String("task-id", "REQUIRED", "The ID of the task")
 		taskDeleteAuthorizationFlag = taskDeleteFlags.String("authorization", "REQUIRED", "")
+
+		taskCalendarTokenFlags             = flag.NewFlagSet("calendar-token", flag.ExitOnError)
+		taskCalendarTokenAuthorizationFlag = taskCalendarTokenFlags.String("authorization", "REQUIRED", "")
+
+		taskCalendarFlags          = flag.NewFlagSet("calendar", flag.ExitOnError)
+		taskCalendarTokenFlag      = taskCalendarFlags.String("token", "REQUIRED", "")
+		taskCalendarDueAsEventFlag = taskCalendarFlags.String("due-as-event", "", "")
+
+		taskTimesheetFlags             = flag.NewFlagSet("timesheet", flag.ExitOnError)
+		taskTimesheetFromFlag          = taskTimesheetFlags.String("from", "", "")
+		taskTimesheetToFlag            = taskTimesheetFlags.String("to", "", "")
+		taskTimesheetTimezoneFlag      = taskTimesheetFlags.String("timezone", "UTC", "")
+		taskTimesheetParentIDFlag      = taskTimesheetFlags.String("parent-id", "", "")
+		taskTimesheetTagFlag           = taskTimesheetFlags.String("tag", "", "")
+		taskTimesheetRoundFlag         = taskTimesheetFlags.String("round", "", "")
+		taskTimesheetFormatFlag        = taskTimesheetFlags.String("format", "csv", "")
+		taskTimesheetAuthorizationFlag = taskTimesheetFlags.String("authorization", "REQUIRED", "")
+
+		taskEstimatesFlags             = flag.NewFlagSet("estimates", flag.ExitOnError)
+		taskEstimatesParentIDFlag      = taskEstimatesFlags.String("parent-id", "", "")
+		taskEstimatesPeriodFlag        = taskEstimatesFlags.String("period", "week", "")
+		taskEstimatesAuthorizationFlag = taskEstimatesFlags.String("authorization", "REQUIRED", "")
+
+		taskLifecycleFlags             = flag.NewFlagSet("lifecycle", flag.ExitOnError)
+		taskLifecycleParentIDFlag      = taskLifecycleFlags.String("parent-id", "", "")
+		taskLifecycleFromFlag          = taskLifecycleFlags.String("from", "", "")
+		taskLifecycleToFlag            = taskLifecycleFlags.String("to", "", "")
+		taskLifecycleTimezoneFlag      = taskLifecycleFlags.String("timezone", "UTC", "")
+		taskLifecycleAuthorizationFlag = taskLifecycleFlags.String("authorization", "REQUIRED", "")
+
+		taskSessionsFlags             = flag.NewFlagSet("sessions", flag.ExitOnError)
+		taskSessionsTaskIDFlag        = taskSessionsFlags.String("task-id", "", "")
+		taskSessionsAuthorizationFlag = taskSessionsFlags.String("authorization", "REQUIRED", "")
+
+		taskAddSessionFlags             = flag.NewFlagSet("add-session", flag.ExitOnError)
+		taskAddSessionBodyFlag          = taskAddSessionFlags.String("body", "REQUIRED", "")
+		taskAddSessionTaskIDFlag        = taskAddSessionFlags.String("task-id", "REQUIRED", "The ID of the task")
+		taskAddSessionAuthorizationFlag = taskAddSessionFlags.String("authorization", "REQUIRED", "")
+
+		taskUpdateSessionFlags             = flag.NewFlagSet("update-session", flag.ExitOnError)
+		taskUpdateSessionBodyFlag          = taskUpdateSessionFlags.String("body", "REQUIRED", "")
+		taskUpdateSessionSessionIDFlag     = taskUpdateSessionFlags.String("session-id", "REQUIRED", "The ID of the session")
+		taskUpdateSessionAuthorizationFlag = taskUpdateSessionFlags.String("authorization", "REQUIRED", "")
+
+		taskDeleteSessionFlags             = flag.NewFlagSet("delete-session", flag.ExitOnError)
+		taskDeleteSessionSessionIDFlag     = taskDeleteSessionFlags.String("session-id", "REQUIRED", "The ID of the session")
+		taskDeleteSessionAuthorizationFlag = taskDeleteSessionFlags.String("authorization", "REQUIRED", "")
+
+		taskTrackProjectFlags             = flag.NewFlagSet("track-project", flag.ExitOnError)
+		taskTrackProjectBodyFlag          = taskTrackProjectFlags.String("body", "REQUIRED", "")
+		taskTrackProjectTaskIDFlag        = taskTrackProjectFlags.String("task-id", "REQUIRED", "The ID of the root task of the project")
+		taskTrackProjectAuthorizationFlag = taskTrackProjectFlags.String("authorization", "REQUIRED", "")
+
+		taskUntrackProjectFlags             = flag.NewFlagSet("untrack-project", flag.ExitOnError)
+		taskUntrackProjectTaskIDFlag        = taskUntrackProjectFlags.String("task-id", "REQUIRED", "The ID of the root task of the project")
+		taskUntrackProjectAuthorizationFlag = taskUntrackProjectFlags.String("authorization", "REQUIRED", "")
+
+		taskBurndownFlags             = flag.NewFlagSet("burndown", flag.ExitOnError)
+		taskBurndownTaskIDFlag        = taskBurndownFlags.String("task-id", "REQUIRED", "The ID of the root task of the project")
+		taskBurndownAuthorizationFlag = taskBurndownFlags.String("authorization", "REQUIRED", "")
+
+		taskRecomputeFlags             = flag.NewFlagSet("recompute", flag.ExitOnError)
+		taskRecomputeAuthorizationFlag = taskRecomputeFlags.String("authorization", "REQUIRED", "")
+
+		taskStreamFlags             = flag.NewFlagSet("stream", flag.ExitOnError)
+		taskStreamAuthorizationFlag = taskStreamFlags.String("authorization", "REQUIRED", "")
+		taskStreamLastEventIDFlag   = taskStreamFlags.String("last-event-id", "", "")
+
+		taskRebuildProjectionsFlags             = flag.NewFlagSet("rebuild-projections", flag.ExitOnError)
+		taskRebuildProjectionsDryRunFlag        = taskRebuildProjectionsFlags.String("dry-run", "", "")
+		taskRebuildProjectionsAuthorizationFlag = taskRebuildProjectionsFlags.String("authorization", "REQUIRED", "")
+
+		taskGetWorkflowFlags             = flag.NewFlagSet("get-workflow", flag.ExitOnError)
+		taskGetWorkflowAuthorizationFlag = taskGetWorkflowFlags.String("authorization", "REQUIRED", "")
+
+		taskSetWorkflowFlags             = flag.NewFlagSet("set-workflow", flag.ExitOnError)
+		taskSetWorkflowBodyFlag          = taskSetWorkflowFlags.String("body", "REQUIRED", "")
+		taskSetWorkflowAuthorizationFlag = taskSetWorkflowFlags.String("authorization", "REQUIRED", "")
+
+		taskGetAutoStopFlags             = flag.NewFlagSet("get-auto-stop", flag.ExitOnError)
+		taskGetAutoStopAuthorizationFlag = taskGetAutoStopFlags.String("authorization", "REQUIRED", "")
+
+		taskCreateWebhookFlags             = flag.NewFlagSet("create-webhook", flag.ExitOnError)
+		taskCreateWebhookBodyFlag          = taskCreateWebhookFlags.String("body", "REQUIRED", "")
+		taskCreateWebhookAuthorizationFlag = taskCreateWebhookFlags.String("authorization", "REQUIRED", "")
+
+		taskListWebhooksFlags             = flag.NewFlagSet("list-webhooks", flag.ExitOnError)
+		taskListWebhooksAuthorizationFlag = taskListWebhooksFlags.String("authorization", "REQUIRED", "")
+
+		taskDeleteWebhookFlags             = flag.NewFlagSet("delete-webhook", flag.ExitOnError)
+		taskDeleteWebhookWebhookIDFlag     = taskDeleteWebhookFlags.String("webhook-id", "REQUIRED", "The ID of the webhook")
+		taskDeleteWebhookAuthorizationFlag = taskDeleteWebhookFlags.String("authorization", "REQUIRED", "")
+
+		taskListWebhookDeliveriesFlags             = flag.NewFlagSet("list-webhook-deliveries", flag.ExitOnError)
+		taskListWebhookDeliveriesWebhookIDFlag     = taskListWebhookDeliveriesFlags.String("webhook-id", "REQUIRED", "The ID of the webhook")
+		taskListWebhookDeliveriesAuthorizationFlag = taskListWebhookDeliveriesFlags.String("authorization", "REQUIRED", "")
+
+		taskRedeliverWebhookFlags             = flag.NewFlagSet("redeliver-webhook", flag.ExitOnError)
+		taskRedeliverWebhookWebhookIDFlag     = taskRedeliverWebhookFlags.String("webhook-id", "REQUIRED", "The ID of the webhook")
+		taskRedeliverWebhookDeliveryIDFlag    = taskRedeliverWebhookFlags.String("delivery-id", "REQUIRED", "The ID of the delivery to send again")
+		taskRedeliverWebhookAuthorizationFlag = taskRedeliverWebhookFlags.String("authorization", "REQUIRED", "")
+
+		taskSetAutoStopFlags             = flag.NewFlagSet("set-auto-stop", flag.ExitOnError)
+		taskSetAutoStopBodyFlag          = taskSetAutoStopFlags.String("body", "REQUIRED", "")
+		taskSetAutoStopAuthorizationFlag = taskSetAutoStopFlags.String("authorization", "REQUIRED", "")
 	)
 	taskFlags.Usage = taskUsage
 	taskSetupFlags.Usage = taskSetupUsage
@@ -72,6 +177,30 @@ func ParseEndpoint(
 	taskListFlags.Usage = taskListUsage
 	taskUpdateFlags.Usage = taskUpdateUsage
 	taskDeleteFlags.Usage = taskDeleteUsage
+	taskCalendarTokenFlags.Usage = taskCalendarTokenUsage
+	taskCalendarFlags.Usage = taskCalendarUsage
+	taskTimesheetFlags.Usage = taskTimesheetUsage
+	taskEstimatesFlags.Usage = taskEstimatesUsage
+	taskLifecycleFlags.Usage = taskLifecycleUsage
+	taskSessionsFlags.Usage = taskSessionsUsage
+	taskAddSessionFlags.Usage = taskAddSessionUsage
+	taskUpdateSessionFlags.Usage = taskUpdateSessionUsage
+	taskDeleteSessionFlags.Usage = taskDeleteSessionUsage
+	taskTrackProjectFlags.Usage = taskTrackProjectUsage
+	taskUntrackProjectFlags.Usage = taskUntrackProjectUsage
+	taskBurndownFlags.Usage = taskBurndownUsage
+	taskRecomputeFlags.Usage = taskRecomputeUsage
+	taskStreamFlags.Usage = taskStreamUsage
+	taskRebuildProjectionsFlags.Usage = taskRebuildProjectionsUsage
+	taskGetWorkflowFlags.Usage = taskGetWorkflowUsage
+	taskSetWorkflowFlags.Usage = taskSetWorkflowUsage
+	taskGetAutoStopFlags.Usage = taskGetAutoStopUsage
+	taskCreateWebhookFlags.Usage = taskCreateWebhookUsage
+	taskListWebhooksFlags.Usage = taskListWebhooksUsage
+	taskDeleteWebhookFlags.Usage = taskDeleteWebhookUsage
+	taskListWebhookDeliveriesFlags.Usage = taskListWebhookDeliveriesUsage
+	taskRedeliverWebhookFlags.Usage = taskRedeliverWebhookUsage
+	taskSetAutoStopFlags.Usage = taskSetAutoStopUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -122,6 +251,78 @@ func ParseEndpoint(
 			case "delete":
 				epf = taskDeleteFlags
 
+			case "calendar-token":
+				epf = taskCalendarTokenFlags
+
+			case "calendar":
+				epf = taskCalendarFlags
+
+			case "timesheet":
+				epf = taskTimesheetFlags
+
+			case "estimates":
+				epf = taskEstimatesFlags
+
+			case "lifecycle":
+				epf = taskLifecycleFlags
+
+			case "sessions":
+				epf = taskSessionsFlags
+
+			case "add-session":
+				epf = taskAddSessionFlags
+
+			case "update-session":
+				epf = taskUpdateSessionFlags
+
+			case "delete-session":
+				epf = taskDeleteSessionFlags
+
+			case "track-project":
+				epf = taskTrackProjectFlags
+
+			case "untrack-project":
+				epf = taskUntrackProjectFlags
+
+			case "burndown":
+				epf = taskBurndownFlags
+
+			case "recompute":
+				epf = taskRecomputeFlags
+
+			case "stream":
+				epf = taskStreamFlags
+
+			case "rebuild-projections":
+				epf = taskRebuildProjectionsFlags
+
+			case "get-workflow":
+				epf = taskGetWorkflowFlags
+
+			case "set-workflow":
+				epf = taskSetWorkflowFlags
+
+			case "get-auto-stop":
+				epf = taskGetAutoStopFlags
+
+			case "create-webhook":
+				epf = taskCreateWebhookFlags
+
+			case "list-webhooks":
+				epf = taskListWebhooksFlags
+
+			case "delete-webhook":
+				epf = taskDeleteWebhookFlags
+
+			case "list-webhook-deliveries":
+				epf = taskListWebhookDeliveriesFlags
+
+			case "redeliver-webhook":
+				epf = taskRedeliverWebhookFlags
+
+			case "set-auto-stop":
+				epf = taskSetAutoStopFlags
+
 			}
 
 		}
@@ -162,6 +363,78 @@ func ParseEndpoint(
 			case "delete":
 				endpoint = c.Delete()
 				data, err = taskc.BuildDeletePayload(*taskDeleteTaskIDFlag, *taskDeleteAuthorizationFlag)
+			case "calendar-token":
+				endpoint = c.CalendarToken()
+				data, err = taskc.BuildCalendarTokenPayload(*taskCalendarTokenAuthorizationFlag)
+			case "calendar":
+				endpoint = c.Calendar()
+				data, err = taskc.BuildCalendarPayload(*taskCalendarTokenFlag, *taskCalendarDueAsEventFlag)
+			case "timesheet":
+				endpoint = c.Timesheet()
+				data, err = taskc.BuildTimesheetPayload(*taskTimesheetFromFlag, *taskTimesheetToFlag, *taskTimesheetTimezoneFlag, *taskTimesheetParentIDFlag, *taskTimesheetTagFlag, *taskTimesheetRoundFlag, *taskTimesheetFormatFlag, *taskTimesheetAuthorizationFlag)
+			case "estimates":
+				endpoint = c.Estimates()
+				data, err = taskc.BuildEstimatesPayload(*taskEstimatesParentIDFlag, *taskEstimatesPeriodFlag, *taskEstimatesAuthorizationFlag)
+			case "lifecycle":
+				endpoint = c.Lifecycle()
+				data, err = taskc.BuildLifecyclePayload(*taskLifecycleParentIDFlag, *taskLifecycleFromFlag, *taskLifecycleToFlag, *taskLifecycleTimezoneFlag, *taskLifecycleAuthorizationFlag)
+			case "sessions":
+				endpoint = c.Sessions()
+				data, err = taskc.BuildSessionsPayload(*taskSessionsTaskIDFlag, *taskSessionsAuthorizationFlag)
+			case "add-session":
+				endpoint = c.AddSession()
+				data, err = taskc.BuildAddSessionPayload(*taskAddSessionBodyFlag, *taskAddSessionTaskIDFlag, *taskAddSessionAuthorizationFlag)
+			case "update-session":
+				endpoint = c.UpdateSession()
+				data, err = taskc.BuildUpdateSessionPayload(*taskUpdateSessionBodyFlag, *taskUpdateSessionSessionIDFlag, *taskUpdateSessionAuthorizationFlag)
+			case "delete-session":
+				endpoint = c.DeleteSession()
+				data, err = taskc.BuildDeleteSessionPayload(*taskDeleteSessionSessionIDFlag, *taskDeleteSessionAuthorizationFlag)
+			case "track-project":
+				endpoint = c.TrackProject()
+				data, err = taskc.BuildTrackProjectPayload(*taskTrackProjectBodyFlag, *taskTrackProjectTaskIDFlag, *taskTrackProjectAuthorizationFlag)
+			case "untrack-project":
+				endpoint = c.UntrackProject()
+				data, err = taskc.BuildUntrackProjectPayload(*taskUntrackProjectTaskIDFlag, *taskUntrackProjectAuthorizationFlag)
+			case "burndown":
+				endpoint = c.Burndown()
+				data, err = taskc.BuildBurndownPayload(*taskBurndownTaskIDFlag, *taskBurndownAuthorizationFlag)
+			case "recompute":
+				endpoint = c.Recompute()
+				data, err = taskc.BuildRecomputePayload(*taskRecomputeAuthorizationFlag)
+			case "stream":
+				endpoint = c.Stream()
+				data, err = taskc.BuildStreamPayload(*taskStreamAuthorizationFlag, *taskStreamLastEventIDFlag)
+			case "rebuild-projections":
+				endpoint = c.RebuildProjections()
+				data, err = taskc.BuildRebuildProjectionsPayload(*taskRebuildProjectionsDryRunFlag, *taskRebuildProjectionsAuthorizationFlag)
+			case "get-workflow":
+				endpoint = c.GetWorkflow()
+				data, err = taskc.BuildGetWorkflowPayload(*taskGetWorkflowAuthorizationFlag)
+			case "set-workflow":
+				endpoint = c.SetWorkflow()
+				data, err = taskc.BuildSetWorkflowPayload(*taskSetWorkflowBodyFlag, *taskSetWorkflowAuthorizationFlag)
+			case "get-auto-stop":
+				endpoint = c.GetAutoStop()
+				data, err = taskc.BuildGetAutoStopPayload(*taskGetAutoStopAuthorizationFlag)
+			case "create-webhook":
+				endpoint = c.CreateWebhook()
+				data, err = taskc.BuildCreateWebhookPayload(*taskCreateWebhookBodyFlag, *taskCreateWebhookAuthorizationFlag)
+			case "list-webhooks":
+				endpoint = c.ListWebhooks()
+				data, err = taskc.BuildListWebhooksPayload(*taskListWebhooksAuthorizationFlag)
+			case "delete-webhook":
+				endpoint = c.DeleteWebhook()
+				data, err = taskc.BuildDeleteWebhookPayload(*taskDeleteWebhookWebhookIDFlag, *taskDeleteWebhookAuthorizationFlag)
+			case "list-webhook-deliveries":
+				endpoint = c.ListWebhookDeliveries()
+				data, err = taskc.BuildListWebhookDeliveriesPayload(*taskListWebhookDeliveriesWebhookIDFlag, *taskListWebhookDeliveriesAuthorizationFlag)
+			case "redeliver-webhook":
+				endpoint = c.RedeliverWebhook()
+				data, err = taskc.BuildRedeliverWebhookPayload(*taskRedeliverWebhookWebhookIDFlag, *taskRedeliverWebhookDeliveryIDFlag, *taskRedeliverWebhookAuthorizationFlag)
+			case "set-auto-stop":
+				endpoint = c.SetAutoStop()
+				data, err = taskc.BuildSetAutoStopPayload(*taskSetAutoStopBodyFlag, *taskSetAutoStopAuthorizationFlag)
 			}
 		}
 	}
@@ -182,6 +455,30 @@ func taskUsage() {
 	fmt.Fprintln(os.Stderr, `    list: List all tasks.`)
 	fmt.Fprintln(os.Stderr, `    update: Update a task.`)
 	fmt.Fprintln(os.Stderr, `    delete: Delete a task.`)
+	fmt.Fprintln(os.Stderr, `    calendar-token: Issue a token for subscribing to the calendar feed.`)
+	fmt.Fprintln(os.Stderr, `    calendar: Get the iCalendar feed of due tasks and recorded work sessions.`)
+	fmt.Fprintln(os.Stderr, `    timesheet: Export the time spent per task per day.`)
+	fmt.Fprintln(os.Stderr, `    estimates: Compare estimated and actual time of completed leaf tasks.`)
+	fmt.Fprintln(os.Stderr, `    lifecycle: List tasks completed in a range of days with their cycle and lead time.`)
+	fmt.Fprintln(os.Stderr, `    sessions: List recorded work sessions.`)
+	fmt.Fprintln(os.Stderr, `    add-session: Log time spent on a task afterward.`)
+	fmt.Fprintln(os.Stderr, `    update-session: Correct a recorded work session.`)
+	fmt.Fprintln(os.Stderr, `    delete-session: Delete a recorded work session.`)
+	fmt.Fprintln(os.Stderr, `    track-project: Record a daily burndown snapshot of the task and its subtasks.`)
+	fmt.Fprintln(os.Stderr, `    untrack-project: Stop tracking the project and delete its snapshots.`)
+	fmt.Fprintln(os.Stderr, `    burndown: List the daily snapshots of a tracked project for burndown and burnup charts.`)
+	fmt.Fprintln(os.Stderr, `    recompute: Recompute the leaf flag and status of the caller's tasks from their task tree.`)
+	fmt.Fprintln(os.Stderr, `    stream: Stream changes of the tasks as Server-Sent Events.`)
+	fmt.Fprintln(os.Stderr, `    rebuild-projections: Rebuild the caller's extras and traces from their task tree and report what was changed.`)
+	fmt.Fprintln(os.Stderr, `    get-workflow: Get the statuses and allowed transitions of tasks.`)
+	fmt.Fprintln(os.Stderr, `    set-workflow: Set the statuses and allowed transitions of tasks.`)
+	fmt.Fprintln(os.Stderr, `    get-auto-stop: Get the policy for stopping forgotten running tasks.`)
+	fmt.Fprintln(os.Stderr, `    create-webhook: Register a URL that receives signed events of the tasks.`)
+	fmt.Fprintln(os.Stderr, `    list-webhooks: List the registered webhooks.`)
+	fmt.Fprintln(os.Stderr, `    delete-webhook: Delete a webhook and its delivery log.`)
+	fmt.Fprintln(os.Stderr, `    list-webhook-deliveries: List the deliveries of a webhook in the order they were created.`)
+	fmt.Fprintln(os.Stderr, `    redeliver-webhook: Send the payload of a delivery again as a new delivery.`)
+	fmt.Fprintln(os.Stderr, `    set-auto-stop: Set the policy for stopping forgotten running tasks.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s task COMMAND --help\n", os.Args[0])
@@ -202,7 +499,7 @@ func taskSetupUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task setup --authorization "Odio aut enim saepe voluptatem."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task setup --authorization "Tempora error nostrum est eum recusandae."`)
 }
 
 func taskCreateUsage() {
@@ -224,9 +521,9 @@ func taskCreateUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task create --body '{
-      "parent_id": "Nisi qui ut corporis quia numquam.",
-      "title": "Beatae nobis."
-   }' --authorization "Aut omnis laborum eligendi repudiandae ratione quis."`)
+      "parent_id": "Fuga sed aut voluptas.",
+      "title": "Ad dolore suscipit qui animi ut."
+   }' --authorization "Quos atque quia et unde sit."`)
 }
 
 func taskListUsage() {
@@ -249,7 +546,7 @@ func taskListUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task list --parent-id "Quidem sequi occaecati possimus." --recursive true --authorization "Assumenda dolores culpa dolore possimus reiciendis dolorum."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task list --parent-id "Porro et sequi maxime." --recursive true --authorization "Qui et non enim totam."`)
 }
 
 func taskUpdateUsage() {
@@ -273,12 +570,14 @@ func taskUpdateUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task update --body '{
-      "estimated_time": 6786124471785536548,
-      "next_id": "Ut neque totam ipsa.",
-      "parent_id": "Aut recusandae vitae quo non accusamus explicabo.",
-      "status": "Non corrupti.",
-      "title": "Ea ut omnis."
-   }' --task-id "Nisi in fugiat qui ut a." --authorization "Asperiores voluptatibus magni facilis."`)
+      "due_at": 5708573210082658729,
+      "estimated_time": 9005132194262411821,
+      "next_id": "Placeat id aut fugiat qui.",
+      "parent_id": "Fugit tempore est est delectus qui.",
+      "rollup": true,
+      "status": "Dolorem soluta.",
+      "title": "Adipisci voluptate quod."
+   }' --task-id "Autem ipsa voluptatem." --authorization "Non tenetur."`)
 }
 
 func taskDeleteUsage() {
@@ -299,5 +598,586 @@ func taskDeleteUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task delete --task-id "Voluptatem qui." --authorization "Fugit repudiandae commodi beatae deserunt maiores laudantium."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task delete --task-id "Aperiam cumque ab quas maiores." --authorization "Rerum quia occaecati quod sint."`)
+}
+
+func taskCalendarTokenUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task calendar-token", os.Args[0])
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Issue a token for subscribing to the calendar feed.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task calendar-token --authorization "Dolor repudiandae."`)
+}
+
+func taskCalendarUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task calendar", os.Args[0])
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprint(os.Stderr, " -due-as-event BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the iCalendar feed of due tasks and recorded work sessions.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -due-as-event BOOL: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task calendar --token "Ea ut consequatur quam." --due-as-event true`)
+}
+
+func taskTimesheetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task timesheet", os.Args[0])
+	fmt.Fprint(os.Stderr, " -from STRING")
+	fmt.Fprint(os.Stderr, " -to STRING")
+	fmt.Fprint(os.Stderr, " -timezone STRING")
+	fmt.Fprint(os.Stderr, " -parent-id STRING")
+	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -round INT")
+	fmt.Fprint(os.Stderr, " -format STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Export the time spent per task per day.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -from STRING: `)
+	fmt.Fprintln(os.Stderr, `    -to STRING: `)
+	fmt.Fprintln(os.Stderr, `    -timezone STRING: `)
+	fmt.Fprintln(os.Stderr, `    -parent-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -tag STRING: `)
+	fmt.Fprintln(os.Stderr, `    -round INT: `)
+	fmt.Fprintln(os.Stderr, `    -format STRING: `)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task timesheet --from "1978-04-28" --to "1984-11-03" --timezone "Ipsam fugiat quis qui." --parent-id "Iusto perspiciatis et reprehenderit laudantium voluptate." --tag "Nam modi placeat et ut." --round 0 --format "csv" --authorization "Odio non et."`)
+}
+
+func taskEstimatesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task estimates", os.Args[0])
+	fmt.Fprint(os.Stderr, " -parent-id STRING")
+	fmt.Fprint(os.Stderr, " -period STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Compare estimated and actual time of completed leaf tasks.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -parent-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -period STRING: `)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task estimates --parent-id "Accusantium at reprehenderit rerum." --period "month" --authorization "Pariatur quae beatae magnam."`)
+}
+
+func taskLifecycleUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task lifecycle", os.Args[0])
+	fmt.Fprint(os.Stderr, " -parent-id STRING")
+	fmt.Fprint(os.Stderr, " -from STRING")
+	fmt.Fprint(os.Stderr, " -to STRING")
+	fmt.Fprint(os.Stderr, " -timezone STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List tasks completed in a range of days with their cycle and lead time.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -parent-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -from STRING: `)
+	fmt.Fprintln(os.Stderr, `    -to STRING: `)
+	fmt.Fprintln(os.Stderr, `    -timezone STRING: `)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task lifecycle --parent-id "Voluptates atque eum nihil." --from "1989-04-21" --to "1988-09-02" --timezone "Dolorum eum eligendi aspernatur velit." --authorization "Omnis rerum inventore atque."`)
+}
+
+func taskSessionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task sessions", os.Args[0])
+	fmt.Fprint(os.Stderr, " -task-id STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List recorded work sessions.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -task-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task sessions --task-id "Nihil id quia sint voluptas." --authorization "Dicta hic."`)
+}
+
+func taskAddSessionUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task add-session", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -task-id STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Log time spent on a task afterward.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -task-id STRING: The ID of the task`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task add-session --body '{
+      "ended_at": 2842344400843417308,
+      "note": "Quam soluta temporibus illum.",
+      "started_at": 751941906381446231
+   }' --task-id "Cupiditate quasi porro." --authorization "Quibusdam repellat quas porro."`)
+}
+
+func taskUpdateSessionUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task update-session", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -session-id STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Correct a recorded work session.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -session-id STRING: The ID of the session`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task update-session --body '{
+      "ended_at": 1203935083651239766,
+      "note": "Quae cupiditate fugit sed.",
+      "started_at": 1961547285213621432
+   }' --session-id "Laudantium maiores." --authorization "Iure ducimus reiciendis libero est deserunt."`)
+}
+
+func taskDeleteSessionUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task delete-session", os.Args[0])
+	fmt.Fprint(os.Stderr, " -session-id STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete a recorded work session.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -session-id STRING: The ID of the session`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task delete-session --session-id "Est molestias doloribus assumenda sit quaerat." --authorization "Dolore molestiae deleniti vero."`)
+}
+
+func taskTrackProjectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task track-project", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -task-id STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Record a daily burndown snapshot of the task and its subtasks.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -task-id STRING: The ID of the root task of the project`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task track-project --body '{
+      "timezone": "Officiis consectetur quibusdam cupiditate repudiandae autem aut."
+   }' --task-id "Perspiciatis nulla sunt cumque ipsam suscipit." --authorization "Quae ut error in molestiae."`)
+}
+
+func taskUntrackProjectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task untrack-project", os.Args[0])
+	fmt.Fprint(os.Stderr, " -task-id STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Stop tracking the project and delete its snapshots.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -task-id STRING: The ID of the root task of the project`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task untrack-project --task-id "Ducimus autem possimus qui." --authorization "Aut natus corporis sunt velit."`)
+}
+
+func taskBurndownUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task burndown", os.Args[0])
+	fmt.Fprint(os.Stderr, " -task-id STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the daily snapshots of a tracked project for burndown and burnup charts.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -task-id STRING: The ID of the root task of the project`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task burndown --task-id "Saepe quis quisquam nulla laborum eligendi." --authorization "Ratione odit non officiis accusamus omnis placeat."`)
+}
+
+func taskRecomputeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task recompute", os.Args[0])
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Recompute the leaf flag and status of the caller's tasks from their task tree.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task recompute --authorization "Minima veniam quo."`)
+}
+
+func taskStreamUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task stream", os.Args[0])
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprint(os.Stderr, " -last-event-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Stream changes of the tasks as Server-Sent Events.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+	fmt.Fprintln(os.Stderr, `    -last-event-id STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task stream --authorization "Dolorem ut vero velit omnis molestiae." --last-event-id "Repellat nesciunt possimus."`)
+}
+
+func taskRebuildProjectionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task rebuild-projections", os.Args[0])
+	fmt.Fprint(os.Stderr, " -dry-run BOOL")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Rebuild the caller's extras and traces from their task tree and report what was changed.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -dry-run BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task rebuild-projections --dry-run false --authorization "Doloremque delectus magni unde."`)
+}
+
+func taskGetWorkflowUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task get-workflow", os.Args[0])
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the statuses and allowed transitions of tasks.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task get-workflow --authorization "Sequi dicta perspiciatis et iusto est libero."`)
+}
+
+func taskSetWorkflowUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task set-workflow", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Set the statuses and allowed transitions of tasks.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task set-workflow --body '{
+      "statuses": [
+         {
+            "category": "todo",
+            "name": "a"
+         },
+         {
+            "category": "todo",
+            "name": "a"
+         },
+         {
+            "category": "todo",
+            "name": "a"
+         },
+         {
+            "category": "todo",
+            "name": "a"
+         }
+      ],
+      "transitions": [
+         {
+            "from": "Saepe sunt ad ut expedita expedita consequatur.",
+            "to": "Cupiditate et."
+         },
+         {
+            "from": "Saepe sunt ad ut expedita expedita consequatur.",
+            "to": "Cupiditate et."
+         },
+         {
+            "from": "Saepe sunt ad ut expedita expedita consequatur.",
+            "to": "Cupiditate et."
+         },
+         {
+            "from": "Saepe sunt ad ut expedita expedita consequatur.",
+            "to": "Cupiditate et."
+         }
+      ]
+   }' --authorization "Sed laboriosam quam minima expedita quia."`)
+}
+
+func taskGetAutoStopUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task get-auto-stop", os.Args[0])
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the policy for stopping forgotten running tasks.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task get-auto-stop --authorization "Commodi et ut nemo omnis consequatur ea."`)
+}
+
+func taskCreateWebhookUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task create-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Register a URL that receives signed events of the tasks.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task create-webhook --body '{
+      "events": [
+         "timer.stopped",
+         "task.created",
+         "timer.started"
+      ],
+      "url": "Corporis non."
+   }' --authorization "Neque alias nihil aut quidem nam voluptas."`)
+}
+
+func taskListWebhooksUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task list-webhooks", os.Args[0])
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the registered webhooks.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task list-webhooks --authorization "Omnis doloribus."`)
+}
+
+func taskDeleteWebhookUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task delete-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -webhook-id STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete a webhook and its delivery log.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -webhook-id STRING: The ID of the webhook`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task delete-webhook --webhook-id "Et atque quisquam quo ipsum." --authorization "Aut voluptatibus voluptas vel odio omnis."`)
+}
+
+func taskListWebhookDeliveriesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task list-webhook-deliveries", os.Args[0])
+	fmt.Fprint(os.Stderr, " -webhook-id STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the deliveries of a webhook in the order they were created.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -webhook-id STRING: The ID of the webhook`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task list-webhook-deliveries --webhook-id "Nemo sunt." --authorization "Fugit ipsa ut."`)
+}
+
+func taskRedeliverWebhookUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task redeliver-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -webhook-id STRING")
+	fmt.Fprint(os.Stderr, " -delivery-id STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Send the payload of a delivery again as a new delivery.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -webhook-id STRING: The ID of the webhook`)
+	fmt.Fprintln(os.Stderr, `    -delivery-id STRING: The ID of the delivery to send again`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task redeliver-webhook --webhook-id "Quia voluptate ut quos." --delivery-id "Dolorum animi temporibus." --authorization "Perspiciatis inventore ea."`)
+}
+
+func taskSetAutoStopUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] task set-auto-stop", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Set the policy for stopping forgotten running tasks.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `task set-auto-stop --body '{
+      "cap": 5430996770449248655,
+      "midnight": false,
+      "threshold": 2569720017931515326,
+      "timezone": "Soluta libero est nisi illo."
+   }' --authorization "Dignissimos magni impedit distinctio."`)
 }
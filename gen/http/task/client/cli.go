@@ -13,6 +13,7 @@ import (
 	"strconv"
 
 	task "github.com/neatflowcv/focus/gen/task"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildSetupPayload builds the payload for the task setup endpoint from CLI
@@ -36,7 +37,7 @@ func BuildCreatePayload(taskCreateBody string, taskCreateAuthorization string) (
 	{
 		err = json.Unmarshal([]byte(taskCreateBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"parent_id\": \"Nisi qui ut corporis quia numquam.\",\n      \"title\": \"Beatae nobis.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"parent_id\": \"Fuga sed aut voluptas.\",\n      \"title\": \"Ad dolore suscipit qui animi ut.\"\n   }'")
 		}
 	}
 	var authorization string
@@ -93,7 +94,7 @@ func BuildUpdatePayload(taskUpdateBody string, taskUpdateTaskID string, taskUpda
 	{
 		err = json.Unmarshal([]byte(taskUpdateBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"estimated_time\": 6786124471785536548,\n      \"next_id\": \"Ut neque totam ipsa.\",\n      \"parent_id\": \"Aut recusandae vitae quo non accusamus explicabo.\",\n      \"status\": \"Non corrupti.\",\n      \"title\": \"Ea ut omnis.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"due_at\": 5708573210082658729,\n      \"estimated_time\": 9005132194262411821,\n      \"next_id\": \"Placeat id aut fugiat qui.\",\n      \"parent_id\": \"Fugit tempore est est delectus qui.\",\n      \"rollup\": true,\n      \"status\": \"Dolorem soluta.\",\n      \"title\": \"Adipisci voluptate quod.\"\n   }'")
 		}
 	}
 	var taskID string
@@ -110,6 +111,8 @@ func BuildUpdatePayload(taskUpdateBody string, taskUpdateTaskID string, taskUpda
 		NextID:        body.NextID,
 		Status:        body.Status,
 		EstimatedTime: body.EstimatedTime,
+		DueAt:         body.DueAt,
+		Rollup:        body.Rollup,
 	}
 	v.TaskID = taskID
 	v.Authorization = authorization
@@ -134,3 +137,670 @@ func BuildDeletePayload(taskDeleteTaskID string, taskDeleteAuthorization string)
 
 	return v, nil
 }
+
+// BuildCalendarTokenPayload builds the payload for the task calendar_token
+// endpoint from CLI flags.
+func BuildCalendarTokenPayload(taskCalendarTokenAuthorization string) (*task.CalendarTokenPayload, error) {
+	var authorization string
+	{
+		authorization = taskCalendarTokenAuthorization
+	}
+	v := &task.CalendarTokenPayload{}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildCalendarPayload builds the payload for the task calendar endpoint from
+// CLI flags.
+func BuildCalendarPayload(taskCalendarToken string, taskCalendarDueAsEvent string) (*task.CalendarPayload, error) {
+	var err error
+	var token string
+	{
+		token = taskCalendarToken
+	}
+	var dueAsEvent *bool
+	{
+		if taskCalendarDueAsEvent != "" {
+			var val bool
+			val, err = strconv.ParseBool(taskCalendarDueAsEvent)
+			dueAsEvent = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for dueAsEvent, must be BOOL")
+			}
+		}
+	}
+	v := &task.CalendarPayload{}
+	v.Token = token
+	v.DueAsEvent = dueAsEvent
+
+	return v, nil
+}
+
+// BuildTimesheetPayload builds the payload for the task timesheet endpoint
+// from CLI flags.
+func BuildTimesheetPayload(taskTimesheetFrom string, taskTimesheetTo string, taskTimesheetTimezone string, taskTimesheetParentID string, taskTimesheetTag string, taskTimesheetRound string, taskTimesheetFormat string, taskTimesheetAuthorization string) (*task.TimesheetPayload, error) {
+	var err error
+	var from *string
+	{
+		if taskTimesheetFrom != "" {
+			from = &taskTimesheetFrom
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var to *string
+	{
+		if taskTimesheetTo != "" {
+			to = &taskTimesheetTo
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var timezone string
+	{
+		if taskTimesheetTimezone != "" {
+			timezone = taskTimesheetTimezone
+		}
+	}
+	var parentID *string
+	{
+		if taskTimesheetParentID != "" {
+			parentID = &taskTimesheetParentID
+		}
+	}
+	var tag *string
+	{
+		if taskTimesheetTag != "" {
+			tag = &taskTimesheetTag
+		}
+	}
+	var round int
+	{
+		if taskTimesheetRound != "" {
+			var v int64
+			v, err = strconv.ParseInt(taskTimesheetRound, 10, strconv.IntSize)
+			round = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for round, must be INT")
+			}
+			if !(round == 0 || round == 6 || round == 15) {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("round", round, []any{0, 6, 15}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var format string
+	{
+		if taskTimesheetFormat != "" {
+			format = taskTimesheetFormat
+			if !(format == "csv" || format == "json") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"csv", "json"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var authorization string
+	{
+		authorization = taskTimesheetAuthorization
+	}
+	v := &task.TimesheetPayload{}
+	v.From = from
+	v.To = to
+	v.Timezone = timezone
+	v.ParentID = parentID
+	v.Tag = tag
+	v.Round = round
+	v.Format = format
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildEstimatesPayload builds the payload for the task estimates endpoint
+// from CLI flags.
+func BuildEstimatesPayload(taskEstimatesParentID string, taskEstimatesPeriod string, taskEstimatesAuthorization string) (*task.EstimatesPayload, error) {
+	var err error
+	var parentID *string
+	{
+		if taskEstimatesParentID != "" {
+			parentID = &taskEstimatesParentID
+		}
+	}
+	var period string
+	{
+		if taskEstimatesPeriod != "" {
+			period = taskEstimatesPeriod
+			if !(period == "week" || period == "month") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("period", period, []any{"week", "month"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var authorization string
+	{
+		authorization = taskEstimatesAuthorization
+	}
+	v := &task.EstimatesPayload{}
+	v.ParentID = parentID
+	v.Period = period
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildLifecyclePayload builds the payload for the task lifecycle endpoint
+// from CLI flags.
+func BuildLifecyclePayload(taskLifecycleParentID string, taskLifecycleFrom string, taskLifecycleTo string, taskLifecycleTimezone string, taskLifecycleAuthorization string) (*task.LifecyclePayload, error) {
+	var err error
+	var parentID *string
+	{
+		if taskLifecycleParentID != "" {
+			parentID = &taskLifecycleParentID
+		}
+	}
+	var from *string
+	{
+		if taskLifecycleFrom != "" {
+			from = &taskLifecycleFrom
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var to *string
+	{
+		if taskLifecycleTo != "" {
+			to = &taskLifecycleTo
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var timezone string
+	{
+		if taskLifecycleTimezone != "" {
+			timezone = taskLifecycleTimezone
+		}
+	}
+	var authorization string
+	{
+		authorization = taskLifecycleAuthorization
+	}
+	v := &task.LifecyclePayload{}
+	v.ParentID = parentID
+	v.From = from
+	v.To = to
+	v.Timezone = timezone
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildSessionsPayload builds the payload for the task sessions endpoint from
+// CLI flags.
+func BuildSessionsPayload(taskSessionsTaskID string, taskSessionsAuthorization string) (*task.SessionsPayload, error) {
+	var taskID *string
+	{
+		if taskSessionsTaskID != "" {
+			taskID = &taskSessionsTaskID
+		}
+	}
+	var authorization string
+	{
+		authorization = taskSessionsAuthorization
+	}
+	v := &task.SessionsPayload{}
+	v.TaskID = taskID
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildAddSessionPayload builds the payload for the task add_session endpoint
+// from CLI flags.
+func BuildAddSessionPayload(taskAddSessionBody string, taskAddSessionTaskID string, taskAddSessionAuthorization string) (*task.AddSessionPayload, error) {
+	var err error
+	var body AddSessionRequestBody
+	{
+		err = json.Unmarshal([]byte(taskAddSessionBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"ended_at\": 2842344400843417308,\n      \"note\": \"Quam soluta temporibus illum.\",\n      \"started_at\": 751941906381446231\n   }'")
+		}
+	}
+	var taskID string
+	{
+		taskID = taskAddSessionTaskID
+	}
+	var authorization string
+	{
+		authorization = taskAddSessionAuthorization
+	}
+	v := &task.AddSessionPayload{
+		StartedAt: body.StartedAt,
+		EndedAt:   body.EndedAt,
+		Note:      body.Note,
+	}
+	v.TaskID = taskID
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildUpdateSessionPayload builds the payload for the task update_session
+// endpoint from CLI flags.
+func BuildUpdateSessionPayload(taskUpdateSessionBody string, taskUpdateSessionSessionID string, taskUpdateSessionAuthorization string) (*task.UpdateSessionPayload, error) {
+	var err error
+	var body UpdateSessionRequestBody
+	{
+		err = json.Unmarshal([]byte(taskUpdateSessionBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"ended_at\": 1203935083651239766,\n      \"note\": \"Quae cupiditate fugit sed.\",\n      \"started_at\": 1961547285213621432\n   }'")
+		}
+	}
+	var sessionID string
+	{
+		sessionID = taskUpdateSessionSessionID
+	}
+	var authorization string
+	{
+		authorization = taskUpdateSessionAuthorization
+	}
+	v := &task.UpdateSessionPayload{
+		StartedAt: body.StartedAt,
+		EndedAt:   body.EndedAt,
+		Note:      body.Note,
+	}
+	v.SessionID = sessionID
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildDeleteSessionPayload builds the payload for the task delete_session
+// endpoint from CLI flags.
+func BuildDeleteSessionPayload(taskDeleteSessionSessionID string, taskDeleteSessionAuthorization string) (*task.DeleteSessionPayload, error) {
+	var sessionID string
+	{
+		sessionID = taskDeleteSessionSessionID
+	}
+	var authorization string
+	{
+		authorization = taskDeleteSessionAuthorization
+	}
+	v := &task.DeleteSessionPayload{}
+	v.SessionID = sessionID
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildTrackProjectPayload builds the payload for the task track_project
+// endpoint from CLI flags.
+func BuildTrackProjectPayload(taskTrackProjectBody string, taskTrackProjectTaskID string, taskTrackProjectAuthorization string) (*task.TrackProjectPayload, error) {
+	var err error
+	var body TrackProjectRequestBody
+	{
+		err = json.Unmarshal([]byte(taskTrackProjectBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"timezone\": \"Officiis consectetur quibusdam cupiditate repudiandae autem aut.\"\n   }'")
+		}
+	}
+	var taskID string
+	{
+		taskID = taskTrackProjectTaskID
+	}
+	var authorization string
+	{
+		authorization = taskTrackProjectAuthorization
+	}
+	v := &task.TrackProjectPayload{
+		Timezone: body.Timezone,
+	}
+	{
+		var zero string
+		if v.Timezone == zero {
+			v.Timezone = "UTC"
+		}
+	}
+	v.TaskID = taskID
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildUntrackProjectPayload builds the payload for the task untrack_project
+// endpoint from CLI flags.
+func BuildUntrackProjectPayload(taskUntrackProjectTaskID string, taskUntrackProjectAuthorization string) (*task.UntrackProjectPayload, error) {
+	var taskID string
+	{
+		taskID = taskUntrackProjectTaskID
+	}
+	var authorization string
+	{
+		authorization = taskUntrackProjectAuthorization
+	}
+	v := &task.UntrackProjectPayload{}
+	v.TaskID = taskID
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildBurndownPayload builds the payload for the task burndown endpoint from
+// CLI flags.
+func BuildBurndownPayload(taskBurndownTaskID string, taskBurndownAuthorization string) (*task.BurndownPayload, error) {
+	var taskID string
+	{
+		taskID = taskBurndownTaskID
+	}
+	var authorization string
+	{
+		authorization = taskBurndownAuthorization
+	}
+	v := &task.BurndownPayload{}
+	v.TaskID = taskID
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildRecomputePayload builds the payload for the task recompute endpoint
+// from CLI flags.
+func BuildRecomputePayload(taskRecomputeAuthorization string) (*task.RecomputePayload, error) {
+	var authorization string
+	{
+		authorization = taskRecomputeAuthorization
+	}
+	v := &task.RecomputePayload{}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildStreamPayload builds the payload for the task stream endpoint from CLI
+// flags.
+func BuildStreamPayload(taskStreamAuthorization string, taskStreamLastEventID string) (*task.StreamPayload, error) {
+	var authorization string
+	{
+		authorization = taskStreamAuthorization
+	}
+	var lastEventID *string
+	{
+		if taskStreamLastEventID != "" {
+			lastEventID = &taskStreamLastEventID
+		}
+	}
+	v := &task.StreamPayload{}
+	v.Authorization = authorization
+	v.LastEventID = lastEventID
+
+	return v, nil
+}
+
+// BuildRebuildProjectionsPayload builds the payload for the task
+// rebuild_projections endpoint from CLI flags.
+func BuildRebuildProjectionsPayload(taskRebuildProjectionsDryRun string, taskRebuildProjectionsAuthorization string) (*task.RebuildProjectionsPayload, error) {
+	var err error
+	var dryRun bool
+	{
+		if taskRebuildProjectionsDryRun != "" {
+			dryRun, err = strconv.ParseBool(taskRebuildProjectionsDryRun)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for dryRun, must be BOOL")
+			}
+		}
+	}
+	var authorization string
+	{
+		authorization = taskRebuildProjectionsAuthorization
+	}
+	v := &task.RebuildProjectionsPayload{}
+	v.DryRun = dryRun
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildGetWorkflowPayload builds the payload for the task get_workflow
+// endpoint from CLI flags.
+func BuildGetWorkflowPayload(taskGetWorkflowAuthorization string) (*task.GetWorkflowPayload, error) {
+	var authorization string
+	{
+		authorization = taskGetWorkflowAuthorization
+	}
+	v := &task.GetWorkflowPayload{}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildSetWorkflowPayload builds the payload for the task set_workflow
+// endpoint from CLI flags.
+func BuildSetWorkflowPayload(taskSetWorkflowBody string, taskSetWorkflowAuthorization string) (*task.SetWorkflowPayload, error) {
+	var err error
+	var body SetWorkflowRequestBody
+	{
+		err = json.Unmarshal([]byte(taskSetWorkflowBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"statuses\": [\n         {\n            \"category\": \"todo\",\n            \"name\": \"a\"\n         },\n         {\n            \"category\": \"todo\",\n            \"name\": \"a\"\n         },\n         {\n            \"category\": \"todo\",\n            \"name\": \"a\"\n         },\n         {\n            \"category\": \"todo\",\n            \"name\": \"a\"\n         }\n      ],\n      \"transitions\": [\n         {\n            \"from\": \"Saepe sunt ad ut expedita expedita consequatur.\",\n            \"to\": \"Cupiditate et.\"\n         },\n         {\n            \"from\": \"Saepe sunt ad ut expedita expedita consequatur.\",\n            \"to\": \"Cupiditate et.\"\n         },\n         {\n            \"from\": \"Saepe sunt ad ut expedita expedita consequatur.\",\n            \"to\": \"Cupiditate et.\"\n         },\n         {\n            \"from\": \"Saepe sunt ad ut expedita expedita consequatur.\",\n            \"to\": \"Cupiditate et.\"\n         }\n      ]\n   }'")
+		}
+		if body.Statuses == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("statuses", "body"))
+		}
+		if body.Transitions == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("transitions", "body"))
+		}
+		for _, e := range body.Statuses {
+			if e != nil {
+				if err2 := ValidateWorkflowStatusRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var authorization string
+	{
+		authorization = taskSetWorkflowAuthorization
+	}
+	v := &task.SetWorkflowPayload{}
+	if body.Statuses != nil {
+		v.Statuses = make([]*task.WorkflowStatus, len(body.Statuses))
+		for i, val := range body.Statuses {
+			v.Statuses[i] = marshalWorkflowStatusRequestBodyToTaskWorkflowStatus(val)
+		}
+	} else {
+		v.Statuses = []*task.WorkflowStatus{}
+	}
+	if body.Transitions != nil {
+		v.Transitions = make([]*task.WorkflowTransition, len(body.Transitions))
+		for i, val := range body.Transitions {
+			v.Transitions[i] = marshalWorkflowTransitionRequestBodyToTaskWorkflowTransition(val)
+		}
+	} else {
+		v.Transitions = []*task.WorkflowTransition{}
+	}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildGetAutoStopPayload builds the payload for the task get_auto_stop
+// endpoint from CLI flags.
+func BuildGetAutoStopPayload(taskGetAutoStopAuthorization string) (*task.GetAutoStopPayload, error) {
+	var authorization string
+	{
+		authorization = taskGetAutoStopAuthorization
+	}
+	v := &task.GetAutoStopPayload{}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildCreateWebhookPayload builds the payload for the task create_webhook
+// endpoint from CLI flags.
+func BuildCreateWebhookPayload(taskCreateWebhookBody string, taskCreateWebhookAuthorization string) (*task.CreateWebhookPayload, error) {
+	var err error
+	var body CreateWebhookRequestBody
+	{
+		err = json.Unmarshal([]byte(taskCreateWebhookBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"events\": [\n         \"timer.stopped\",\n         \"task.created\",\n         \"timer.started\"\n      ],\n      \"url\": \"Corporis non.\"\n   }'")
+		}
+		if body.Events == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("events", "body"))
+		}
+		if len(body.Events) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.events", body.Events, len(body.Events), 1, true))
+		}
+		for _, e := range body.Events {
+			if !(e == "task.created" || e == "task.completed" || e == "task.moved" || e == "task.deleted" || e == "timer.started" || e == "timer.stopped") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.events[*]", e, []any{"task.created", "task.completed", "task.moved", "task.deleted", "timer.started", "timer.stopped"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var authorization string
+	{
+		authorization = taskCreateWebhookAuthorization
+	}
+	v := &task.CreateWebhookPayload{
+		URL: body.URL,
+	}
+	if body.Events != nil {
+		v.Events = make([]string, len(body.Events))
+		for i, val := range body.Events {
+			v.Events[i] = val
+		}
+	} else {
+		v.Events = []string{}
+	}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildListWebhooksPayload builds the payload for the task list_webhooks
+// endpoint from CLI flags.
+func BuildListWebhooksPayload(taskListWebhooksAuthorization string) (*task.ListWebhooksPayload, error) {
+	var authorization string
+	{
+		authorization = taskListWebhooksAuthorization
+	}
+	v := &task.ListWebhooksPayload{}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildDeleteWebhookPayload builds the payload for the task delete_webhook
+// endpoint from CLI flags.
+func BuildDeleteWebhookPayload(taskDeleteWebhookWebhookID string, taskDeleteWebhookAuthorization string) (*task.DeleteWebhookPayload, error) {
+	var webhookID string
+	{
+		webhookID = taskDeleteWebhookWebhookID
+	}
+	var authorization string
+	{
+		authorization = taskDeleteWebhookAuthorization
+	}
+	v := &task.DeleteWebhookPayload{}
+	v.WebhookID = webhookID
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildListWebhookDeliveriesPayload builds the payload for the task
+// list_webhook_deliveries endpoint from CLI flags.
+func BuildListWebhookDeliveriesPayload(taskListWebhookDeliveriesWebhookID string, taskListWebhookDeliveriesAuthorization string) (*task.ListWebhookDeliveriesPayload, error) {
+	var webhookID string
+	{
+		webhookID = taskListWebhookDeliveriesWebhookID
+	}
+	var authorization string
+	{
+		authorization = taskListWebhookDeliveriesAuthorization
+	}
+	v := &task.ListWebhookDeliveriesPayload{}
+	v.WebhookID = webhookID
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildRedeliverWebhookPayload builds the payload for the task
+// redeliver_webhook endpoint from CLI flags.
+func BuildRedeliverWebhookPayload(taskRedeliverWebhookWebhookID string, taskRedeliverWebhookDeliveryID string, taskRedeliverWebhookAuthorization string) (*task.RedeliverWebhookPayload, error) {
+	var webhookID string
+	{
+		webhookID = taskRedeliverWebhookWebhookID
+	}
+	var deliveryID string
+	{
+		deliveryID = taskRedeliverWebhookDeliveryID
+	}
+	var authorization string
+	{
+		authorization = taskRedeliverWebhookAuthorization
+	}
+	v := &task.RedeliverWebhookPayload{}
+	v.WebhookID = webhookID
+	v.DeliveryID = deliveryID
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildSetAutoStopPayload builds the payload for the task set_auto_stop
+// endpoint from CLI flags.
+func BuildSetAutoStopPayload(taskSetAutoStopBody string, taskSetAutoStopAuthorization string) (*task.SetAutoStopPayload, error) {
+	var err error
+	var body SetAutoStopRequestBody
+	{
+		err = json.Unmarshal([]byte(taskSetAutoStopBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"cap\": 5430996770449248655,\n      \"midnight\": false,\n      \"threshold\": 2569720017931515326,\n      \"timezone\": \"Soluta libero est nisi illo.\"\n   }'")
+		}
+		if body.Threshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.threshold", body.Threshold, 0, true))
+		}
+		if body.Cap < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cap", body.Cap, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var authorization string
+	{
+		authorization = taskSetAutoStopAuthorization
+	}
+	v := &task.SetAutoStopPayload{
+		Threshold: body.Threshold,
+		Cap:       body.Cap,
+		Midnight:  body.Midnight,
+		Timezone:  body.Timezone,
+	}
+	v.Authorization = authorization
+
+	return v, nil
+}
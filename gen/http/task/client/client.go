@@ -9,8 +9,11 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
+	task "github.com/neatflowcv/focus/gen/task"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -32,6 +35,101 @@ type Client struct {
 	// Delete Doer is the HTTP client used to make requests to the delete endpoint.
 	DeleteDoer goahttp.Doer
 
+	// CalendarToken Doer is the HTTP client used to make requests to the
+	// calendar_token endpoint.
+	CalendarTokenDoer goahttp.Doer
+
+	// Calendar Doer is the HTTP client used to make requests to the calendar
+	// endpoint.
+	CalendarDoer goahttp.Doer
+
+	// Timesheet Doer is the HTTP client used to make requests to the timesheet
+	// endpoint.
+	TimesheetDoer goahttp.Doer
+
+	// Estimates Doer is the HTTP client used to make requests to the estimates
+	// endpoint.
+	EstimatesDoer goahttp.Doer
+
+	// Lifecycle Doer is the HTTP client used to make requests to the lifecycle
+	// endpoint.
+	LifecycleDoer goahttp.Doer
+
+	// Sessions Doer is the HTTP client used to make requests to the sessions
+	// endpoint.
+	SessionsDoer goahttp.Doer
+
+	// AddSession Doer is the HTTP client used to make requests to the add_session
+	// endpoint.
+	AddSessionDoer goahttp.Doer
+
+	// UpdateSession Doer is the HTTP client used to make requests to the
+	// update_session endpoint.
+	UpdateSessionDoer goahttp.Doer
+
+	// DeleteSession Doer is the HTTP client used to make requests to the
+	// delete_session endpoint.
+	DeleteSessionDoer goahttp.Doer
+
+	// TrackProject Doer is the HTTP client used to make requests to the
+	// track_project endpoint.
+	TrackProjectDoer goahttp.Doer
+
+	// UntrackProject Doer is the HTTP client used to make requests to the
+	// untrack_project endpoint.
+	UntrackProjectDoer goahttp.Doer
+
+	// Burndown Doer is the HTTP client used to make requests to the burndown
+	// endpoint.
+	BurndownDoer goahttp.Doer
+
+	// Recompute Doer is the HTTP client used to make requests to the recompute
+	// endpoint.
+	RecomputeDoer goahttp.Doer
+
+	// Stream Doer is the HTTP client used to make requests to the stream endpoint.
+	StreamDoer goahttp.Doer
+
+	// RebuildProjections Doer is the HTTP client used to make requests to the
+	// rebuild_projections endpoint.
+	RebuildProjectionsDoer goahttp.Doer
+
+	// GetWorkflow Doer is the HTTP client used to make requests to the
+	// get_workflow endpoint.
+	GetWorkflowDoer goahttp.Doer
+
+	// SetWorkflow Doer is the HTTP client used to make requests to the
+	// set_workflow endpoint.
+	SetWorkflowDoer goahttp.Doer
+
+	// GetAutoStop Doer is the HTTP client used to make requests to the
+	// get_auto_stop endpoint.
+	GetAutoStopDoer goahttp.Doer
+
+	// CreateWebhook Doer is the HTTP client used to make requests to the
+	// create_webhook endpoint.
+	CreateWebhookDoer goahttp.Doer
+
+	// ListWebhooks Doer is the HTTP client used to make requests to the
+	// list_webhooks endpoint.
+	ListWebhooksDoer goahttp.Doer
+
+	// DeleteWebhook Doer is the HTTP client used to make requests to the
+	// delete_webhook endpoint.
+	DeleteWebhookDoer goahttp.Doer
+
+	// ListWebhookDeliveries Doer is the HTTP client used to make requests to the
+	// list_webhook_deliveries endpoint.
+	ListWebhookDeliveriesDoer goahttp.Doer
+
+	// RedeliverWebhook Doer is the HTTP client used to make requests to the
+	// redeliver_webhook endpoint.
+	RedeliverWebhookDoer goahttp.Doer
+
+	// SetAutoStop Doer is the HTTP client used to make requests to the
+	// set_auto_stop endpoint.
+	SetAutoStopDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -52,16 +150,40 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		SetupDoer:           doer,
-		CreateDoer:          doer,
-		ListDoer:            doer,
-		UpdateDoer:          doer,
-		DeleteDoer:          doer,
-		RestoreResponseBody: restoreBody,
-		scheme:              scheme,
-		host:                host,
-		decoder:             dec,
-		encoder:             enc,
+		SetupDoer:                 doer,
+		CreateDoer:                doer,
+		ListDoer:                  doer,
+		UpdateDoer:                doer,
+		DeleteDoer:                doer,
+		CalendarTokenDoer:         doer,
+		CalendarDoer:              doer,
+		TimesheetDoer:             doer,
+		EstimatesDoer:             doer,
+		LifecycleDoer:             doer,
+		SessionsDoer:              doer,
+		AddSessionDoer:            doer,
+		UpdateSessionDoer:         doer,
+		DeleteSessionDoer:         doer,
+		TrackProjectDoer:          doer,
+		UntrackProjectDoer:        doer,
+		BurndownDoer:              doer,
+		RecomputeDoer:             doer,
+		StreamDoer:                doer,
+		RebuildProjectionsDoer:    doer,
+		GetWorkflowDoer:           doer,
+		SetWorkflowDoer:           doer,
+		GetAutoStopDoer:           doer,
+		CreateWebhookDoer:         doer,
+		ListWebhooksDoer:          doer,
+		DeleteWebhookDoer:         doer,
+		ListWebhookDeliveriesDoer: doer,
+		RedeliverWebhookDoer:      doer,
+		SetAutoStopDoer:           doer,
+		RestoreResponseBody:       restoreBody,
+		scheme:                    scheme,
+		host:                      host,
+		decoder:                   dec,
+		encoder:                   enc,
 	}
 }
 
@@ -184,3 +306,601 @@ func (c *Client) Delete() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// CalendarToken returns an endpoint that makes HTTP requests to the task
+// service calendar_token server.
+func (c *Client) CalendarToken() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCalendarTokenRequest(c.encoder)
+		decodeResponse = DecodeCalendarTokenResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCalendarTokenRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CalendarTokenDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "calendar_token", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Calendar returns an endpoint that makes HTTP requests to the task service
+// calendar server.
+func (c *Client) Calendar() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCalendarRequest(c.encoder)
+		decodeResponse = DecodeCalendarResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCalendarRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CalendarDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "calendar", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &task.CalendarResponseData{Result: res.(*task.CalendarResult), Body: resp.Body}, nil
+	}
+}
+
+// Timesheet returns an endpoint that makes HTTP requests to the task service
+// timesheet server.
+func (c *Client) Timesheet() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeTimesheetRequest(c.encoder)
+		decodeResponse = DecodeTimesheetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildTimesheetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.TimesheetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "timesheet", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &task.TimesheetResponseData{Result: res.(*task.TimesheetResult), Body: resp.Body}, nil
+	}
+}
+
+// Estimates returns an endpoint that makes HTTP requests to the task service
+// estimates server.
+func (c *Client) Estimates() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeEstimatesRequest(c.encoder)
+		decodeResponse = DecodeEstimatesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildEstimatesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.EstimatesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "estimates", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Lifecycle returns an endpoint that makes HTTP requests to the task service
+// lifecycle server.
+func (c *Client) Lifecycle() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeLifecycleRequest(c.encoder)
+		decodeResponse = DecodeLifecycleResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildLifecycleRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.LifecycleDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "lifecycle", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Sessions returns an endpoint that makes HTTP requests to the task service
+// sessions server.
+func (c *Client) Sessions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSessionsRequest(c.encoder)
+		decodeResponse = DecodeSessionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSessionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SessionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "sessions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AddSession returns an endpoint that makes HTTP requests to the task service
+// add_session server.
+func (c *Client) AddSession() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAddSessionRequest(c.encoder)
+		decodeResponse = DecodeAddSessionResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAddSessionRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AddSessionDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "add_session", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateSession returns an endpoint that makes HTTP requests to the task
+// service update_session server.
+func (c *Client) UpdateSession() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateSessionRequest(c.encoder)
+		decodeResponse = DecodeUpdateSessionResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateSessionRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateSessionDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "update_session", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteSession returns an endpoint that makes HTTP requests to the task
+// service delete_session server.
+func (c *Client) DeleteSession() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteSessionRequest(c.encoder)
+		decodeResponse = DecodeDeleteSessionResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteSessionRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteSessionDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "delete_session", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// TrackProject returns an endpoint that makes HTTP requests to the task
+// service track_project server.
+func (c *Client) TrackProject() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeTrackProjectRequest(c.encoder)
+		decodeResponse = DecodeTrackProjectResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildTrackProjectRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.TrackProjectDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "track_project", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UntrackProject returns an endpoint that makes HTTP requests to the task
+// service untrack_project server.
+func (c *Client) UntrackProject() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUntrackProjectRequest(c.encoder)
+		decodeResponse = DecodeUntrackProjectResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUntrackProjectRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UntrackProjectDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "untrack_project", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Burndown returns an endpoint that makes HTTP requests to the task service
+// burndown server.
+func (c *Client) Burndown() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBurndownRequest(c.encoder)
+		decodeResponse = DecodeBurndownResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBurndownRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BurndownDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "burndown", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Recompute returns an endpoint that makes HTTP requests to the task service
+// recompute server.
+func (c *Client) Recompute() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRecomputeRequest(c.encoder)
+		decodeResponse = DecodeRecomputeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRecomputeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RecomputeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "recompute", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Stream returns an endpoint that makes HTTP requests to the task service
+// stream server.
+func (c *Client) Stream() goa.Endpoint {
+	var (
+		encodeRequest = EncodeStreamRequest(c.encoder)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildStreamRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		// For SSE endpoints, connect and return a stream
+		resp, err := c.StreamDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "stream", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected status from SSE endpoint: %d", resp.StatusCode)
+		}
+
+		contentType := resp.Header.Get("Content-Type")
+		if contentType != "" && !strings.HasPrefix(contentType, "text/event-stream") {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected content type: %s (expected text/event-stream)", contentType)
+		}
+
+		return NewStreamStream(resp, c.decoder), nil
+	}
+}
+
+// RebuildProjections returns an endpoint that makes HTTP requests to the task
+// service rebuild_projections server.
+func (c *Client) RebuildProjections() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRebuildProjectionsRequest(c.encoder)
+		decodeResponse = DecodeRebuildProjectionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRebuildProjectionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RebuildProjectionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "rebuild_projections", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetWorkflow returns an endpoint that makes HTTP requests to the task service
+// get_workflow server.
+func (c *Client) GetWorkflow() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetWorkflowRequest(c.encoder)
+		decodeResponse = DecodeGetWorkflowResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetWorkflowRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetWorkflowDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "get_workflow", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SetWorkflow returns an endpoint that makes HTTP requests to the task service
+// set_workflow server.
+func (c *Client) SetWorkflow() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetWorkflowRequest(c.encoder)
+		decodeResponse = DecodeSetWorkflowResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetWorkflowRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetWorkflowDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "set_workflow", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetAutoStop returns an endpoint that makes HTTP requests to the task service
+// get_auto_stop server.
+func (c *Client) GetAutoStop() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetAutoStopRequest(c.encoder)
+		decodeResponse = DecodeGetAutoStopResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetAutoStopRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetAutoStopDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "get_auto_stop", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateWebhook returns an endpoint that makes HTTP requests to the task
+// service create_webhook server.
+func (c *Client) CreateWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateWebhookRequest(c.encoder)
+		decodeResponse = DecodeCreateWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "create_webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWebhooks returns an endpoint that makes HTTP requests to the task
+// service list_webhooks server.
+func (c *Client) ListWebhooks() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWebhooksRequest(c.encoder)
+		decodeResponse = DecodeListWebhooksResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWebhooksRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWebhooksDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "list_webhooks", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteWebhook returns an endpoint that makes HTTP requests to the task
+// service delete_webhook server.
+func (c *Client) DeleteWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteWebhookRequest(c.encoder)
+		decodeResponse = DecodeDeleteWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "delete_webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWebhookDeliveries returns an endpoint that makes HTTP requests to the
+// task service list_webhook_deliveries server.
+func (c *Client) ListWebhookDeliveries() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWebhookDeliveriesRequest(c.encoder)
+		decodeResponse = DecodeListWebhookDeliveriesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWebhookDeliveriesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWebhookDeliveriesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "list_webhook_deliveries", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RedeliverWebhook returns an endpoint that makes HTTP requests to the task
+// service redeliver_webhook server.
+func (c *Client) RedeliverWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRedeliverWebhookRequest(c.encoder)
+		decodeResponse = DecodeRedeliverWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRedeliverWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RedeliverWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "redeliver_webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SetAutoStop returns an endpoint that makes HTTP requests to the task service
+// set_auto_stop server.
+func (c *Client) SetAutoStop() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetAutoStopRequest(c.encoder)
+		decodeResponse = DecodeSetAutoStopResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetAutoStopRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetAutoStopDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("task", "set_auto_stop", err)
+		}
+		return decodeResponse(resp)
+	}
+}
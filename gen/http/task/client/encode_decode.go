@@ -18,6 +18,7 @@ import (
 	task "github.com/neatflowcv/focus/gen/task"
 	taskviews "github.com/neatflowcv/focus/gen/task/views"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildSetupRequest instantiates a HTTP request object with method and path
@@ -381,8 +382,10 @@ func EncodeUpdateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 // update endpoint. restoreBody controls whether the response body should be
 // restored after having been read.
 // DecodeUpdateResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
 //   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
 //   - "TaskNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "Conflict" (type *goa.ServiceError): http.StatusConflict
 //   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
 //   - error: internal error
 func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
@@ -417,6 +420,20 @@ func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 			}
 			res := task.NewCreatetaskoutput(vres)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "update", err)
+			}
+			err = ValidateUpdateBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "update", err)
+			}
+			return nil, NewUpdateBadRequest(&body)
 		case http.StatusUnauthorized:
 			var (
 				body UpdateUnauthorizedResponseBody
@@ -445,6 +462,20 @@ func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 				return nil, goahttp.ErrValidationError("task", "update", err)
 			}
 			return nil, NewUpdateTaskNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "update", err)
+			}
+			err = ValidateUpdateConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "update", err)
+			}
+			return nil, NewUpdateConflict(&body)
 		case http.StatusInternalServerError:
 			var (
 				body UpdateInternalServerErrorResponseBody
@@ -581,20 +612,3202 @@ func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 	}
 }
 
-// unmarshalCreatetaskoutputResponseToTaskviewsCreatetaskoutputView builds a
-// value of type *taskviews.CreatetaskoutputView from a value of type
-// *CreatetaskoutputResponse.
-func unmarshalCreatetaskoutputResponseToTaskviewsCreatetaskoutputView(v *CreatetaskoutputResponse) *taskviews.CreatetaskoutputView {
-	res := &taskviews.CreatetaskoutputView{
-		ID:            v.ID,
-		ParentID:      v.ParentID,
-		Title:         v.Title,
-		CreatedAt:     v.CreatedAt,
-		EstimatedTime: v.EstimatedTime,
-		ActualTime:    v.ActualTime,
-		StartedAt:     v.StartedAt,
-		IsLeaf:        v.IsLeaf,
-		Status:        v.Status,
+// BuildCalendarTokenRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "calendar_token" endpoint
+func (c *Client) BuildCalendarTokenRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CalendarTokenTaskPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "calendar_token", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCalendarTokenRequest returns an encoder for requests sent to the task
+// calendar_token server.
+func EncodeCalendarTokenRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.CalendarTokenPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "calendar_token", "*task.CalendarTokenPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeCalendarTokenResponse returns a decoder for responses returned by the
+// task calendar_token endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCalendarTokenResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeCalendarTokenResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CalendarTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "calendar_token", err)
+			}
+			err = ValidateCalendarTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "calendar_token", err)
+			}
+			res := NewCalendarTokenOutputOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CalendarTokenUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "calendar_token", err)
+			}
+			err = ValidateCalendarTokenUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "calendar_token", err)
+			}
+			return nil, NewCalendarTokenUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CalendarTokenInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "calendar_token", err)
+			}
+			err = ValidateCalendarTokenInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "calendar_token", err)
+			}
+			return nil, NewCalendarTokenInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "calendar_token", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCalendarRequest instantiates a HTTP request object with method and path
+// set to call the "task" service "calendar" endpoint
+func (c *Client) BuildCalendarRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CalendarTaskPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "calendar", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCalendarRequest returns an encoder for requests sent to the task
+// calendar server.
+func EncodeCalendarRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.CalendarPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "calendar", "*task.CalendarPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("token", p.Token)
+		if p.DueAsEvent != nil {
+			values.Add("due_as_event", fmt.Sprintf("%v", *p.DueAsEvent))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeCalendarResponse returns a decoder for responses returned by the task
+// calendar endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeCalendarResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeCalendarResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType string
+				err         error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "header"))
+			}
+			contentType = contentTypeRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "calendar", err)
+			}
+			res := NewCalendarResultOK(contentType)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CalendarUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "calendar", err)
+			}
+			err = ValidateCalendarUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "calendar", err)
+			}
+			return nil, NewCalendarUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CalendarInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "calendar", err)
+			}
+			err = ValidateCalendarInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "calendar", err)
+			}
+			return nil, NewCalendarInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "calendar", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildTimesheetRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "timesheet" endpoint
+func (c *Client) BuildTimesheetRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: TimesheetTaskPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "timesheet", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeTimesheetRequest returns an encoder for requests sent to the task
+// timesheet server.
+func EncodeTimesheetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.TimesheetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "timesheet", "*task.TimesheetPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		values := req.URL.Query()
+		if p.From != nil {
+			values.Add("from", *p.From)
+		}
+		if p.To != nil {
+			values.Add("to", *p.To)
+		}
+		values.Add("timezone", p.Timezone)
+		if p.ParentID != nil {
+			values.Add("parent_id", *p.ParentID)
+		}
+		if p.Tag != nil {
+			values.Add("tag", *p.Tag)
+		}
+		values.Add("round", fmt.Sprintf("%v", p.Round))
+		values.Add("format", p.Format)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeTimesheetResponse returns a decoder for responses returned by the task
+// timesheet endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeTimesheetResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "TaskNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeTimesheetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType string
+				err         error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "header"))
+			}
+			contentType = contentTypeRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "timesheet", err)
+			}
+			res := NewTimesheetResultOK(contentType)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body TimesheetBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "timesheet", err)
+			}
+			err = ValidateTimesheetBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "timesheet", err)
+			}
+			return nil, NewTimesheetBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body TimesheetUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "timesheet", err)
+			}
+			err = ValidateTimesheetUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "timesheet", err)
+			}
+			return nil, NewTimesheetUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body TimesheetTaskNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "timesheet", err)
+			}
+			err = ValidateTimesheetTaskNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "timesheet", err)
+			}
+			return nil, NewTimesheetTaskNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body TimesheetInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "timesheet", err)
+			}
+			err = ValidateTimesheetInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "timesheet", err)
+			}
+			return nil, NewTimesheetInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "timesheet", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildEstimatesRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "estimates" endpoint
+func (c *Client) BuildEstimatesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: EstimatesTaskPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "estimates", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeEstimatesRequest returns an encoder for requests sent to the task
+// estimates server.
+func EncodeEstimatesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.EstimatesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "estimates", "*task.EstimatesPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		values := req.URL.Query()
+		if p.ParentID != nil {
+			values.Add("parent_id", *p.ParentID)
+		}
+		values.Add("period", p.Period)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeEstimatesResponse returns a decoder for responses returned by the task
+// estimates endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeEstimatesResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "TaskNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeEstimatesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body EstimatesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "estimates", err)
+			}
+			err = ValidateEstimatesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "estimates", err)
+			}
+			res := NewEstimatesOutputOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body EstimatesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "estimates", err)
+			}
+			err = ValidateEstimatesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "estimates", err)
+			}
+			return nil, NewEstimatesUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body EstimatesTaskNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "estimates", err)
+			}
+			err = ValidateEstimatesTaskNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "estimates", err)
+			}
+			return nil, NewEstimatesTaskNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body EstimatesInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "estimates", err)
+			}
+			err = ValidateEstimatesInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "estimates", err)
+			}
+			return nil, NewEstimatesInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "estimates", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildLifecycleRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "lifecycle" endpoint
+func (c *Client) BuildLifecycleRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: LifecycleTaskPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "lifecycle", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeLifecycleRequest returns an encoder for requests sent to the task
+// lifecycle server.
+func EncodeLifecycleRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.LifecyclePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "lifecycle", "*task.LifecyclePayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		values := req.URL.Query()
+		if p.ParentID != nil {
+			values.Add("parent_id", *p.ParentID)
+		}
+		if p.From != nil {
+			values.Add("from", *p.From)
+		}
+		if p.To != nil {
+			values.Add("to", *p.To)
+		}
+		values.Add("timezone", p.Timezone)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeLifecycleResponse returns a decoder for responses returned by the task
+// lifecycle endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeLifecycleResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "TaskNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeLifecycleResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body LifecycleResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "lifecycle", err)
+			}
+			err = ValidateLifecycleResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "lifecycle", err)
+			}
+			res := NewLifecycleOutputOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body LifecycleBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "lifecycle", err)
+			}
+			err = ValidateLifecycleBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "lifecycle", err)
+			}
+			return nil, NewLifecycleBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body LifecycleUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "lifecycle", err)
+			}
+			err = ValidateLifecycleUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "lifecycle", err)
+			}
+			return nil, NewLifecycleUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body LifecycleTaskNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "lifecycle", err)
+			}
+			err = ValidateLifecycleTaskNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "lifecycle", err)
+			}
+			return nil, NewLifecycleTaskNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body LifecycleInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "lifecycle", err)
+			}
+			err = ValidateLifecycleInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "lifecycle", err)
+			}
+			return nil, NewLifecycleInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "lifecycle", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSessionsRequest instantiates a HTTP request object with method and path
+// set to call the "task" service "sessions" endpoint
+func (c *Client) BuildSessionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SessionsTaskPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "sessions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSessionsRequest returns an encoder for requests sent to the task
+// sessions server.
+func EncodeSessionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.SessionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "sessions", "*task.SessionsPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		values := req.URL.Query()
+		if p.TaskID != nil {
+			values.Add("task_id", *p.TaskID)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeSessionsResponse returns a decoder for responses returned by the task
+// sessions endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeSessionsResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "TaskNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeSessionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SessionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "sessions", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateSessionOutputResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "sessions", err)
+			}
+			res := NewSessionsSessionOutputOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body SessionsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "sessions", err)
+			}
+			err = ValidateSessionsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "sessions", err)
+			}
+			return nil, NewSessionsUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body SessionsTaskNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "sessions", err)
+			}
+			err = ValidateSessionsTaskNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "sessions", err)
+			}
+			return nil, NewSessionsTaskNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body SessionsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "sessions", err)
+			}
+			err = ValidateSessionsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "sessions", err)
+			}
+			return nil, NewSessionsInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "sessions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAddSessionRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "add_session" endpoint
+func (c *Client) BuildAddSessionRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		taskID string
+	)
+	{
+		p, ok := v.(*task.AddSessionPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("task", "add_session", "*task.AddSessionPayload", v)
+		}
+		taskID = p.TaskID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AddSessionTaskPath(taskID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "add_session", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAddSessionRequest returns an encoder for requests sent to the task
+// add_session server.
+func EncodeAddSessionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.AddSessionPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "add_session", "*task.AddSessionPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		body := NewAddSessionRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("task", "add_session", err)
+		}
+		return nil
+	}
+}
+
+// DecodeAddSessionResponse returns a decoder for responses returned by the
+// task add_session endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeAddSessionResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "TaskNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "Conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeAddSessionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body AddSessionResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "add_session", err)
+			}
+			err = ValidateAddSessionResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "add_session", err)
+			}
+			res := NewAddSessionSessionOutputOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body AddSessionBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "add_session", err)
+			}
+			err = ValidateAddSessionBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "add_session", err)
+			}
+			return nil, NewAddSessionBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body AddSessionUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "add_session", err)
+			}
+			err = ValidateAddSessionUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "add_session", err)
+			}
+			return nil, NewAddSessionUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body AddSessionTaskNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "add_session", err)
+			}
+			err = ValidateAddSessionTaskNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "add_session", err)
+			}
+			return nil, NewAddSessionTaskNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body AddSessionConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "add_session", err)
+			}
+			err = ValidateAddSessionConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "add_session", err)
+			}
+			return nil, NewAddSessionConflict(&body)
+		case http.StatusInternalServerError:
+			var (
+				body AddSessionInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "add_session", err)
+			}
+			err = ValidateAddSessionInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "add_session", err)
+			}
+			return nil, NewAddSessionInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "add_session", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateSessionRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "update_session" endpoint
+func (c *Client) BuildUpdateSessionRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		sessionID string
+	)
+	{
+		p, ok := v.(*task.UpdateSessionPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("task", "update_session", "*task.UpdateSessionPayload", v)
+		}
+		sessionID = p.SessionID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateSessionTaskPath(sessionID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "update_session", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateSessionRequest returns an encoder for requests sent to the task
+// update_session server.
+func EncodeUpdateSessionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.UpdateSessionPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "update_session", "*task.UpdateSessionPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		body := NewUpdateSessionRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("task", "update_session", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateSessionResponse returns a decoder for responses returned by the
+// task update_session endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeUpdateSessionResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "SessionNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "Conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeUpdateSessionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateSessionResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "update_session", err)
+			}
+			err = ValidateUpdateSessionResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "update_session", err)
+			}
+			res := NewUpdateSessionSessionOutputOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateSessionBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "update_session", err)
+			}
+			err = ValidateUpdateSessionBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "update_session", err)
+			}
+			return nil, NewUpdateSessionBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UpdateSessionUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "update_session", err)
+			}
+			err = ValidateUpdateSessionUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "update_session", err)
+			}
+			return nil, NewUpdateSessionUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateSessionSessionNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "update_session", err)
+			}
+			err = ValidateUpdateSessionSessionNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "update_session", err)
+			}
+			return nil, NewUpdateSessionSessionNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateSessionConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "update_session", err)
+			}
+			err = ValidateUpdateSessionConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "update_session", err)
+			}
+			return nil, NewUpdateSessionConflict(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateSessionInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "update_session", err)
+			}
+			err = ValidateUpdateSessionInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "update_session", err)
+			}
+			return nil, NewUpdateSessionInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "update_session", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteSessionRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "delete_session" endpoint
+func (c *Client) BuildDeleteSessionRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		sessionID string
+	)
+	{
+		p, ok := v.(*task.DeleteSessionPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("task", "delete_session", "*task.DeleteSessionPayload", v)
+		}
+		sessionID = p.SessionID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteSessionTaskPath(sessionID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "delete_session", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteSessionRequest returns an encoder for requests sent to the task
+// delete_session server.
+func EncodeDeleteSessionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.DeleteSessionPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "delete_session", "*task.DeleteSessionPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteSessionResponse returns a decoder for responses returned by the
+// task delete_session endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeleteSessionResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "SessionNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDeleteSessionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body DeleteSessionUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "delete_session", err)
+			}
+			err = ValidateDeleteSessionUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "delete_session", err)
+			}
+			return nil, NewDeleteSessionUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteSessionSessionNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "delete_session", err)
+			}
+			err = ValidateDeleteSessionSessionNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "delete_session", err)
+			}
+			return nil, NewDeleteSessionSessionNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteSessionInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "delete_session", err)
+			}
+			err = ValidateDeleteSessionInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "delete_session", err)
+			}
+			return nil, NewDeleteSessionInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "delete_session", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildTrackProjectRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "track_project" endpoint
+func (c *Client) BuildTrackProjectRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		taskID string
+	)
+	{
+		p, ok := v.(*task.TrackProjectPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("task", "track_project", "*task.TrackProjectPayload", v)
+		}
+		taskID = p.TaskID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: TrackProjectTaskPath(taskID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "track_project", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeTrackProjectRequest returns an encoder for requests sent to the task
+// track_project server.
+func EncodeTrackProjectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.TrackProjectPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "track_project", "*task.TrackProjectPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		body := NewTrackProjectRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("task", "track_project", err)
+		}
+		return nil
+	}
+}
+
+// DecodeTrackProjectResponse returns a decoder for responses returned by the
+// task track_project endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeTrackProjectResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "TaskNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeTrackProjectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body TrackProjectBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "track_project", err)
+			}
+			err = ValidateTrackProjectBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "track_project", err)
+			}
+			return nil, NewTrackProjectBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body TrackProjectUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "track_project", err)
+			}
+			err = ValidateTrackProjectUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "track_project", err)
+			}
+			return nil, NewTrackProjectUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body TrackProjectTaskNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "track_project", err)
+			}
+			err = ValidateTrackProjectTaskNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "track_project", err)
+			}
+			return nil, NewTrackProjectTaskNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body TrackProjectInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "track_project", err)
+			}
+			err = ValidateTrackProjectInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "track_project", err)
+			}
+			return nil, NewTrackProjectInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "track_project", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUntrackProjectRequest instantiates a HTTP request object with method
+// and path set to call the "task" service "untrack_project" endpoint
+func (c *Client) BuildUntrackProjectRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		taskID string
+	)
+	{
+		p, ok := v.(*task.UntrackProjectPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("task", "untrack_project", "*task.UntrackProjectPayload", v)
+		}
+		taskID = p.TaskID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UntrackProjectTaskPath(taskID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "untrack_project", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUntrackProjectRequest returns an encoder for requests sent to the task
+// untrack_project server.
+func EncodeUntrackProjectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.UntrackProjectPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "untrack_project", "*task.UntrackProjectPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeUntrackProjectResponse returns a decoder for responses returned by the
+// task untrack_project endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeUntrackProjectResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "ProjectNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeUntrackProjectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body UntrackProjectUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "untrack_project", err)
+			}
+			err = ValidateUntrackProjectUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "untrack_project", err)
+			}
+			return nil, NewUntrackProjectUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body UntrackProjectProjectNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "untrack_project", err)
+			}
+			err = ValidateUntrackProjectProjectNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "untrack_project", err)
+			}
+			return nil, NewUntrackProjectProjectNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UntrackProjectInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "untrack_project", err)
+			}
+			err = ValidateUntrackProjectInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "untrack_project", err)
+			}
+			return nil, NewUntrackProjectInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "untrack_project", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBurndownRequest instantiates a HTTP request object with method and path
+// set to call the "task" service "burndown" endpoint
+func (c *Client) BuildBurndownRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		taskID string
+	)
+	{
+		p, ok := v.(*task.BurndownPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("task", "burndown", "*task.BurndownPayload", v)
+		}
+		taskID = p.TaskID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BurndownTaskPath(taskID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "burndown", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBurndownRequest returns an encoder for requests sent to the task
+// burndown server.
+func EncodeBurndownRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.BurndownPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "burndown", "*task.BurndownPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeBurndownResponse returns a decoder for responses returned by the task
+// burndown endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeBurndownResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "ProjectNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeBurndownResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BurndownResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "burndown", err)
+			}
+			err = ValidateBurndownResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "burndown", err)
+			}
+			res := NewBurndownOutputOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body BurndownUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "burndown", err)
+			}
+			err = ValidateBurndownUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "burndown", err)
+			}
+			return nil, NewBurndownUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body BurndownProjectNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "burndown", err)
+			}
+			err = ValidateBurndownProjectNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "burndown", err)
+			}
+			return nil, NewBurndownProjectNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body BurndownInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "burndown", err)
+			}
+			err = ValidateBurndownInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "burndown", err)
+			}
+			return nil, NewBurndownInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "burndown", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRecomputeRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "recompute" endpoint
+func (c *Client) BuildRecomputeRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RecomputeTaskPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "recompute", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRecomputeRequest returns an encoder for requests sent to the task
+// recompute server.
+func EncodeRecomputeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.RecomputePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "recompute", "*task.RecomputePayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeRecomputeResponse returns a decoder for responses returned by the task
+// recompute endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeRecomputeResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRecomputeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RecomputeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "recompute", err)
+			}
+			err = ValidateRecomputeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "recompute", err)
+			}
+			res := NewRecomputeOutputOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body RecomputeUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "recompute", err)
+			}
+			err = ValidateRecomputeUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "recompute", err)
+			}
+			return nil, NewRecomputeUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RecomputeInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "recompute", err)
+			}
+			err = ValidateRecomputeInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "recompute", err)
+			}
+			return nil, NewRecomputeInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "recompute", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildStreamRequest instantiates a HTTP request object with method and path
+// set to call the "task" service "stream" endpoint
+func (c *Client) BuildStreamRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: StreamTaskPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "stream", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeStreamRequest returns an encoder for requests sent to the task stream
+// server.
+func EncodeStreamRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.StreamPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "stream", "*task.StreamPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		if p.LastEventID != nil {
+			head := *p.LastEventID
+			req.Header.Set("Last-Event-ID", head)
+		}
+		return nil
+	}
+}
+
+// DecodeStreamResponse returns a decoder for responses returned by the task
+// stream endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeStreamResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeStreamResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body StreamResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "stream", err)
+			}
+			err = ValidateStreamResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "stream", err)
+			}
+			res := NewStreamEventOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body StreamUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "stream", err)
+			}
+			err = ValidateStreamUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "stream", err)
+			}
+			return nil, NewStreamUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body StreamInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "stream", err)
+			}
+			err = ValidateStreamInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "stream", err)
+			}
+			return nil, NewStreamInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "stream", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRebuildProjectionsRequest instantiates a HTTP request object with
+// method and path set to call the "task" service "rebuild_projections" endpoint
+func (c *Client) BuildRebuildProjectionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RebuildProjectionsTaskPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "rebuild_projections", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRebuildProjectionsRequest returns an encoder for requests sent to the
+// task rebuild_projections server.
+func EncodeRebuildProjectionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.RebuildProjectionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "rebuild_projections", "*task.RebuildProjectionsPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		values := req.URL.Query()
+		values.Add("dry_run", fmt.Sprintf("%v", p.DryRun))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeRebuildProjectionsResponse returns a decoder for responses returned by
+// the task rebuild_projections endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRebuildProjectionsResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRebuildProjectionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RebuildProjectionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "rebuild_projections", err)
+			}
+			err = ValidateRebuildProjectionsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "rebuild_projections", err)
+			}
+			res := NewRebuildProjectionsOutputOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body RebuildProjectionsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "rebuild_projections", err)
+			}
+			err = ValidateRebuildProjectionsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "rebuild_projections", err)
+			}
+			return nil, NewRebuildProjectionsUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RebuildProjectionsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "rebuild_projections", err)
+			}
+			err = ValidateRebuildProjectionsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "rebuild_projections", err)
+			}
+			return nil, NewRebuildProjectionsInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "rebuild_projections", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetWorkflowRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "get_workflow" endpoint
+func (c *Client) BuildGetWorkflowRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetWorkflowTaskPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "get_workflow", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetWorkflowRequest returns an encoder for requests sent to the task
+// get_workflow server.
+func EncodeGetWorkflowRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.GetWorkflowPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "get_workflow", "*task.GetWorkflowPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeGetWorkflowResponse returns a decoder for responses returned by the
+// task get_workflow endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetWorkflowResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetWorkflowResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetWorkflowResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "get_workflow", err)
+			}
+			err = ValidateGetWorkflowResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "get_workflow", err)
+			}
+			res := NewGetWorkflowWorkflowOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body GetWorkflowUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "get_workflow", err)
+			}
+			err = ValidateGetWorkflowUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "get_workflow", err)
+			}
+			return nil, NewGetWorkflowUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetWorkflowInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "get_workflow", err)
+			}
+			err = ValidateGetWorkflowInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "get_workflow", err)
+			}
+			return nil, NewGetWorkflowInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "get_workflow", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSetWorkflowRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "set_workflow" endpoint
+func (c *Client) BuildSetWorkflowRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetWorkflowTaskPath()}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "set_workflow", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetWorkflowRequest returns an encoder for requests sent to the task
+// set_workflow server.
+func EncodeSetWorkflowRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.SetWorkflowPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "set_workflow", "*task.SetWorkflowPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		body := NewSetWorkflowRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("task", "set_workflow", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetWorkflowResponse returns a decoder for responses returned by the
+// task set_workflow endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeSetWorkflowResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeSetWorkflowResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SetWorkflowResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "set_workflow", err)
+			}
+			err = ValidateSetWorkflowResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "set_workflow", err)
+			}
+			res := NewSetWorkflowWorkflowOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body SetWorkflowBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "set_workflow", err)
+			}
+			err = ValidateSetWorkflowBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "set_workflow", err)
+			}
+			return nil, NewSetWorkflowBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body SetWorkflowUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "set_workflow", err)
+			}
+			err = ValidateSetWorkflowUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "set_workflow", err)
+			}
+			return nil, NewSetWorkflowUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body SetWorkflowInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "set_workflow", err)
+			}
+			err = ValidateSetWorkflowInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "set_workflow", err)
+			}
+			return nil, NewSetWorkflowInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "set_workflow", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAutoStopRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "get_auto_stop" endpoint
+func (c *Client) BuildGetAutoStopRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAutoStopTaskPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "get_auto_stop", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetAutoStopRequest returns an encoder for requests sent to the task
+// get_auto_stop server.
+func EncodeGetAutoStopRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.GetAutoStopPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "get_auto_stop", "*task.GetAutoStopPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeGetAutoStopResponse returns a decoder for responses returned by the
+// task get_auto_stop endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetAutoStopResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetAutoStopResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetAutoStopResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "get_auto_stop", err)
+			}
+			err = ValidateGetAutoStopResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "get_auto_stop", err)
+			}
+			res := NewGetAutoStopAutoStopPolicyOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body GetAutoStopUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "get_auto_stop", err)
+			}
+			err = ValidateGetAutoStopUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "get_auto_stop", err)
+			}
+			return nil, NewGetAutoStopUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetAutoStopInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "get_auto_stop", err)
+			}
+			err = ValidateGetAutoStopInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "get_auto_stop", err)
+			}
+			return nil, NewGetAutoStopInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "get_auto_stop", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "create_webhook" endpoint
+func (c *Client) BuildCreateWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateWebhookTaskPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "create_webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateWebhookRequest returns an encoder for requests sent to the task
+// create_webhook server.
+func EncodeCreateWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.CreateWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "create_webhook", "*task.CreateWebhookPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		body := NewCreateWebhookRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("task", "create_webhook", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateWebhookResponse returns a decoder for responses returned by the
+// task create_webhook endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCreateWebhookResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeCreateWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateWebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "create_webhook", err)
+			}
+			err = ValidateCreateWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "create_webhook", err)
+			}
+			res := NewCreateWebhookWebhookOutputCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateWebhookBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "create_webhook", err)
+			}
+			err = ValidateCreateWebhookBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "create_webhook", err)
+			}
+			return nil, NewCreateWebhookBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateWebhookUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "create_webhook", err)
+			}
+			err = ValidateCreateWebhookUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "create_webhook", err)
+			}
+			return nil, NewCreateWebhookUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateWebhookInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "create_webhook", err)
+			}
+			err = ValidateCreateWebhookInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "create_webhook", err)
+			}
+			return nil, NewCreateWebhookInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "create_webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWebhooksRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "list_webhooks" endpoint
+func (c *Client) BuildListWebhooksRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhooksTaskPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "list_webhooks", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWebhooksRequest returns an encoder for requests sent to the task
+// list_webhooks server.
+func EncodeListWebhooksRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.ListWebhooksPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "list_webhooks", "*task.ListWebhooksPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeListWebhooksResponse returns a decoder for responses returned by the
+// task list_webhooks endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListWebhooksResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListWebhooksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWebhooksResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "list_webhooks", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWebhookOutputResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "list_webhooks", err)
+			}
+			res := NewListWebhooksWebhookOutputOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListWebhooksUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "list_webhooks", err)
+			}
+			err = ValidateListWebhooksUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "list_webhooks", err)
+			}
+			return nil, NewListWebhooksUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListWebhooksInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "list_webhooks", err)
+			}
+			err = ValidateListWebhooksInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "list_webhooks", err)
+			}
+			return nil, NewListWebhooksInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "list_webhooks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "delete_webhook" endpoint
+func (c *Client) BuildDeleteWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		webhookID string
+	)
+	{
+		p, ok := v.(*task.DeleteWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("task", "delete_webhook", "*task.DeleteWebhookPayload", v)
+		}
+		webhookID = p.WebhookID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteWebhookTaskPath(webhookID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "delete_webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteWebhookRequest returns an encoder for requests sent to the task
+// delete_webhook server.
+func EncodeDeleteWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.DeleteWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "delete_webhook", "*task.DeleteWebhookPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteWebhookResponse returns a decoder for responses returned by the
+// task delete_webhook endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeleteWebhookResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "WebhookNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDeleteWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body DeleteWebhookUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "delete_webhook", err)
+			}
+			err = ValidateDeleteWebhookUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "delete_webhook", err)
+			}
+			return nil, NewDeleteWebhookUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteWebhookWebhookNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "delete_webhook", err)
+			}
+			err = ValidateDeleteWebhookWebhookNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "delete_webhook", err)
+			}
+			return nil, NewDeleteWebhookWebhookNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteWebhookInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "delete_webhook", err)
+			}
+			err = ValidateDeleteWebhookInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "delete_webhook", err)
+			}
+			return nil, NewDeleteWebhookInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "delete_webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWebhookDeliveriesRequest instantiates a HTTP request object with
+// method and path set to call the "task" service "list_webhook_deliveries"
+// endpoint
+func (c *Client) BuildListWebhookDeliveriesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		webhookID string
+	)
+	{
+		p, ok := v.(*task.ListWebhookDeliveriesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("task", "list_webhook_deliveries", "*task.ListWebhookDeliveriesPayload", v)
+		}
+		webhookID = p.WebhookID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhookDeliveriesTaskPath(webhookID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "list_webhook_deliveries", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWebhookDeliveriesRequest returns an encoder for requests sent to
+// the task list_webhook_deliveries server.
+func EncodeListWebhookDeliveriesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.ListWebhookDeliveriesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "list_webhook_deliveries", "*task.ListWebhookDeliveriesPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeListWebhookDeliveriesResponse returns a decoder for responses returned
+// by the task list_webhook_deliveries endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListWebhookDeliveriesResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "WebhookNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListWebhookDeliveriesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWebhookDeliveriesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "list_webhook_deliveries", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWebhookDeliveryResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "list_webhook_deliveries", err)
+			}
+			res := NewListWebhookDeliveriesWebhookDeliveryOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListWebhookDeliveriesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "list_webhook_deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "list_webhook_deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body ListWebhookDeliveriesWebhookNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "list_webhook_deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesWebhookNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "list_webhook_deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesWebhookNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListWebhookDeliveriesInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "list_webhook_deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "list_webhook_deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "list_webhook_deliveries", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRedeliverWebhookRequest instantiates a HTTP request object with method
+// and path set to call the "task" service "redeliver_webhook" endpoint
+func (c *Client) BuildRedeliverWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		webhookID  string
+		deliveryID string
+	)
+	{
+		p, ok := v.(*task.RedeliverWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("task", "redeliver_webhook", "*task.RedeliverWebhookPayload", v)
+		}
+		webhookID = p.WebhookID
+		deliveryID = p.DeliveryID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RedeliverWebhookTaskPath(webhookID, deliveryID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "redeliver_webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRedeliverWebhookRequest returns an encoder for requests sent to the
+// task redeliver_webhook server.
+func EncodeRedeliverWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.RedeliverWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "redeliver_webhook", "*task.RedeliverWebhookPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeRedeliverWebhookResponse returns a decoder for responses returned by
+// the task redeliver_webhook endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRedeliverWebhookResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "DeliveryNotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRedeliverWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			var (
+				body RedeliverWebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "redeliver_webhook", err)
+			}
+			err = ValidateRedeliverWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "redeliver_webhook", err)
+			}
+			res := NewRedeliverWebhookWebhookDeliveryAccepted(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body RedeliverWebhookUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "redeliver_webhook", err)
+			}
+			err = ValidateRedeliverWebhookUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "redeliver_webhook", err)
+			}
+			return nil, NewRedeliverWebhookUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body RedeliverWebhookDeliveryNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "redeliver_webhook", err)
+			}
+			err = ValidateRedeliverWebhookDeliveryNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "redeliver_webhook", err)
+			}
+			return nil, NewRedeliverWebhookDeliveryNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RedeliverWebhookInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "redeliver_webhook", err)
+			}
+			err = ValidateRedeliverWebhookInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "redeliver_webhook", err)
+			}
+			return nil, NewRedeliverWebhookInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "redeliver_webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSetAutoStopRequest instantiates a HTTP request object with method and
+// path set to call the "task" service "set_auto_stop" endpoint
+func (c *Client) BuildSetAutoStopRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetAutoStopTaskPath()}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("task", "set_auto_stop", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetAutoStopRequest returns an encoder for requests sent to the task
+// set_auto_stop server.
+func EncodeSetAutoStopRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*task.SetAutoStopPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("task", "set_auto_stop", "*task.SetAutoStopPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("authorization", head)
+		}
+		body := NewSetAutoStopRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("task", "set_auto_stop", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetAutoStopResponse returns a decoder for responses returned by the
+// task set_auto_stop endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeSetAutoStopResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeSetAutoStopResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SetAutoStopResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "set_auto_stop", err)
+			}
+			err = ValidateSetAutoStopResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "set_auto_stop", err)
+			}
+			res := NewSetAutoStopAutoStopPolicyOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body SetAutoStopBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "set_auto_stop", err)
+			}
+			err = ValidateSetAutoStopBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "set_auto_stop", err)
+			}
+			return nil, NewSetAutoStopBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body SetAutoStopUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "set_auto_stop", err)
+			}
+			err = ValidateSetAutoStopUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "set_auto_stop", err)
+			}
+			return nil, NewSetAutoStopUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body SetAutoStopInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("task", "set_auto_stop", err)
+			}
+			err = ValidateSetAutoStopInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("task", "set_auto_stop", err)
+			}
+			return nil, NewSetAutoStopInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("task", "set_auto_stop", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalCreatetaskoutputResponseToTaskviewsCreatetaskoutputView builds a
+// value of type *taskviews.CreatetaskoutputView from a value of type
+// *CreatetaskoutputResponse.
+func unmarshalCreatetaskoutputResponseToTaskviewsCreatetaskoutputView(v *CreatetaskoutputResponse) *taskviews.CreatetaskoutputView {
+	res := &taskviews.CreatetaskoutputView{
+		ID:             v.ID,
+		ParentID:       v.ParentID,
+		Title:          v.Title,
+		CreatedAt:      v.CreatedAt,
+		EstimatedTime:  v.EstimatedTime,
+		ActualTime:     v.ActualTime,
+		StartedAt:      v.StartedAt,
+		DueAt:          v.DueAt,
+		IsLeaf:         v.IsLeaf,
+		Status:         v.Status,
+		Rollup:         v.Rollup,
+		FirstStartedAt: v.FirstStartedAt,
+		CompletedAt:    v.CompletedAt,
+		Reopens:        v.Reopens,
+	}
+
+	return res
+}
+
+// unmarshalEstimateStatsResponseBodyToTaskEstimateStats builds a value of type
+// *task.EstimateStats from a value of type *EstimateStatsResponseBody.
+func unmarshalEstimateStatsResponseBodyToTaskEstimateStats(v *EstimateStatsResponseBody) *task.EstimateStats {
+	res := &task.EstimateStats{
+		Count:         *v.Count,
+		MedianRatio:   *v.MedianRatio,
+		MeanAccuracy:  *v.MeanAccuracy,
+		MedianOverrun: *v.MedianOverrun,
+	}
+
+	return res
+}
+
+// unmarshalEstimateGroupResponseBodyToTaskEstimateGroup builds a value of type
+// *task.EstimateGroup from a value of type *EstimateGroupResponseBody.
+func unmarshalEstimateGroupResponseBodyToTaskEstimateGroup(v *EstimateGroupResponseBody) *task.EstimateGroup {
+	res := &task.EstimateGroup{
+		Key: *v.Key,
+	}
+	res.Stats = unmarshalEstimateStatsResponseBodyToTaskEstimateStats(v.Stats)
+
+	return res
+}
+
+// unmarshalLifecycleTaskResponseBodyToTaskLifecycleTask builds a value of type
+// *task.LifecycleTask from a value of type *LifecycleTaskResponseBody.
+func unmarshalLifecycleTaskResponseBodyToTaskLifecycleTask(v *LifecycleTaskResponseBody) *task.LifecycleTask {
+	res := &task.LifecycleTask{
+		ID:             *v.ID,
+		Title:          *v.Title,
+		IsLeaf:         *v.IsLeaf,
+		CreatedAt:      *v.CreatedAt,
+		FirstStartedAt: v.FirstStartedAt,
+		CompletedAt:    *v.CompletedAt,
+		Reopens:        *v.Reopens,
+		CycleTime:      v.CycleTime,
+		LeadTime:       *v.LeadTime,
+	}
+
+	return res
+}
+
+// unmarshalFlowStatsResponseBodyToTaskFlowStats builds a value of type
+// *task.FlowStats from a value of type *FlowStatsResponseBody.
+func unmarshalFlowStatsResponseBodyToTaskFlowStats(v *FlowStatsResponseBody) *task.FlowStats {
+	res := &task.FlowStats{
+		Count:           *v.Count,
+		Started:         *v.Started,
+		Reopens:         *v.Reopens,
+		MedianCycleTime: *v.MedianCycleTime,
+		MeanCycleTime:   *v.MeanCycleTime,
+		MedianLeadTime:  *v.MedianLeadTime,
+		MeanLeadTime:    *v.MeanLeadTime,
+	}
+
+	return res
+}
+
+// unmarshalFlowGroupResponseBodyToTaskFlowGroup builds a value of type
+// *task.FlowGroup from a value of type *FlowGroupResponseBody.
+func unmarshalFlowGroupResponseBodyToTaskFlowGroup(v *FlowGroupResponseBody) *task.FlowGroup {
+	res := &task.FlowGroup{
+		Key: *v.Key,
+	}
+	res.Stats = unmarshalFlowStatsResponseBodyToTaskFlowStats(v.Stats)
+
+	return res
+}
+
+// unmarshalSessionOutputResponseToTaskSessionOutput builds a value of type
+// *task.SessionOutput from a value of type *SessionOutputResponse.
+func unmarshalSessionOutputResponseToTaskSessionOutput(v *SessionOutputResponse) *task.SessionOutput {
+	res := &task.SessionOutput{
+		ID:        *v.ID,
+		TaskID:    *v.TaskID,
+		StartedAt: *v.StartedAt,
+		EndedAt:   *v.EndedAt,
+		Auto:      *v.Auto,
+		Note:      v.Note,
+	}
+
+	return res
+}
+
+// unmarshalBurndownPointResponseBodyToTaskBurndownPoint builds a value of type
+// *task.BurndownPoint from a value of type *BurndownPointResponseBody.
+func unmarshalBurndownPointResponseBodyToTaskBurndownPoint(v *BurndownPointResponseBody) *task.BurndownPoint {
+	res := &task.BurndownPoint{
+		Date:          *v.Date,
+		RemainingTime: *v.RemainingTime,
+		CompletedTime: *v.CompletedTime,
+		ActualTime:    *v.ActualTime,
+		Open:          *v.Open,
+		Done:          *v.Done,
+	}
+
+	return res
+}
+
+// unmarshalRecomputeOutputResponseBodyToTaskRecomputeOutput builds a value of
+// type *task.RecomputeOutput from a value of type *RecomputeOutputResponseBody.
+func unmarshalRecomputeOutputResponseBodyToTaskRecomputeOutput(v *RecomputeOutputResponseBody) *task.RecomputeOutput {
+	res := &task.RecomputeOutput{
+		Created: *v.Created,
+		Updated: *v.Updated,
+		Deleted: *v.Deleted,
+	}
+
+	return res
+}
+
+// unmarshalProjectionChangeResponseBodyToTaskProjectionChange builds a value
+// of type *task.ProjectionChange from a value of type
+// *ProjectionChangeResponseBody.
+func unmarshalProjectionChangeResponseBodyToTaskProjectionChange(v *ProjectionChangeResponseBody) *task.ProjectionChange {
+	res := &task.ProjectionChange{
+		Projection: *v.Projection,
+		ID:         *v.ID,
+		Kind:       *v.Kind,
+		From:       *v.From,
+		To:         *v.To,
+	}
+
+	return res
+}
+
+// unmarshalWorkflowStatusResponseBodyToTaskWorkflowStatus builds a value of
+// type *task.WorkflowStatus from a value of type *WorkflowStatusResponseBody.
+func unmarshalWorkflowStatusResponseBodyToTaskWorkflowStatus(v *WorkflowStatusResponseBody) *task.WorkflowStatus {
+	res := &task.WorkflowStatus{
+		Name:     *v.Name,
+		Category: *v.Category,
+	}
+
+	return res
+}
+
+// unmarshalWorkflowTransitionResponseBodyToTaskWorkflowTransition builds a
+// value of type *task.WorkflowTransition from a value of type
+// *WorkflowTransitionResponseBody.
+func unmarshalWorkflowTransitionResponseBodyToTaskWorkflowTransition(v *WorkflowTransitionResponseBody) *task.WorkflowTransition {
+	res := &task.WorkflowTransition{
+		From: *v.From,
+		To:   *v.To,
+	}
+
+	return res
+}
+
+// marshalTaskWorkflowStatusToWorkflowStatusRequestBody builds a value of type
+// *WorkflowStatusRequestBody from a value of type *task.WorkflowStatus.
+func marshalTaskWorkflowStatusToWorkflowStatusRequestBody(v *task.WorkflowStatus) *WorkflowStatusRequestBody {
+	res := &WorkflowStatusRequestBody{
+		Name:     v.Name,
+		Category: v.Category,
+	}
+
+	return res
+}
+
+// marshalTaskWorkflowTransitionToWorkflowTransitionRequestBody builds a value
+// of type *WorkflowTransitionRequestBody from a value of type
+// *task.WorkflowTransition.
+func marshalTaskWorkflowTransitionToWorkflowTransitionRequestBody(v *task.WorkflowTransition) *WorkflowTransitionRequestBody {
+	res := &WorkflowTransitionRequestBody{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// marshalWorkflowStatusRequestBodyToTaskWorkflowStatus builds a value of type
+// *task.WorkflowStatus from a value of type *WorkflowStatusRequestBody.
+func marshalWorkflowStatusRequestBodyToTaskWorkflowStatus(v *WorkflowStatusRequestBody) *task.WorkflowStatus {
+	res := &task.WorkflowStatus{
+		Name:     v.Name,
+		Category: v.Category,
+	}
+
+	return res
+}
+
+// marshalWorkflowTransitionRequestBodyToTaskWorkflowTransition builds a value
+// of type *task.WorkflowTransition from a value of type
+// *WorkflowTransitionRequestBody.
+func marshalWorkflowTransitionRequestBodyToTaskWorkflowTransition(v *WorkflowTransitionRequestBody) *task.WorkflowTransition {
+	res := &task.WorkflowTransition{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// unmarshalWebhookOutputResponseToTaskWebhookOutput builds a value of type
+// *task.WebhookOutput from a value of type *WebhookOutputResponse.
+func unmarshalWebhookOutputResponseToTaskWebhookOutput(v *WebhookOutputResponse) *task.WebhookOutput {
+	res := &task.WebhookOutput{
+		ID:        *v.ID,
+		URL:       *v.URL,
+		Secret:    v.Secret,
+		CreatedAt: *v.CreatedAt,
+	}
+	res.Events = make([]string, len(v.Events))
+	for i, val := range v.Events {
+		res.Events[i] = val
+	}
+
+	return res
+}
+
+// unmarshalWebhookDeliveryResponseToTaskWebhookDelivery builds a value of type
+// *task.WebhookDelivery from a value of type *WebhookDeliveryResponse.
+func unmarshalWebhookDeliveryResponseToTaskWebhookDelivery(v *WebhookDeliveryResponse) *task.WebhookDelivery {
+	res := &task.WebhookDelivery{
+		ID:             *v.ID,
+		WebhookID:      *v.WebhookID,
+		Event:          *v.Event,
+		Payload:        *v.Payload,
+		Status:         *v.Status,
+		Attempts:       *v.Attempts,
+		NextAttemptAt:  v.NextAttemptAt,
+		LastStatusCode: v.LastStatusCode,
+		LastError:      v.LastError,
+		CreatedAt:      *v.CreatedAt,
+		DeliveredAt:    v.DeliveredAt,
 	}
 
 	return res
@@ -35,3 +35,123 @@ func UpdateTaskPath(taskID string) string {
 func DeleteTaskPath(taskID string) string {
 	return fmt.Sprintf("/focus/tasks/%v", taskID)
 }
+
+// CalendarTokenTaskPath returns the URL path to the task service calendar_token HTTP endpoint.
+func CalendarTokenTaskPath() string {
+	return "/focus/tasks/calendar/token"
+}
+
+// CalendarTaskPath returns the URL path to the task service calendar HTTP endpoint.
+func CalendarTaskPath() string {
+	return "/focus/tasks/calendar.ics"
+}
+
+// TimesheetTaskPath returns the URL path to the task service timesheet HTTP endpoint.
+func TimesheetTaskPath() string {
+	return "/focus/tasks/timesheet"
+}
+
+// EstimatesTaskPath returns the URL path to the task service estimates HTTP endpoint.
+func EstimatesTaskPath() string {
+	return "/focus/tasks/analytics/estimates"
+}
+
+// LifecycleTaskPath returns the URL path to the task service lifecycle HTTP endpoint.
+func LifecycleTaskPath() string {
+	return "/focus/tasks/analytics/lifecycle"
+}
+
+// SessionsTaskPath returns the URL path to the task service sessions HTTP endpoint.
+func SessionsTaskPath() string {
+	return "/focus/tasks/sessions"
+}
+
+// AddSessionTaskPath returns the URL path to the task service add_session HTTP endpoint.
+func AddSessionTaskPath(taskID string) string {
+	return fmt.Sprintf("/focus/tasks/%v/sessions", taskID)
+}
+
+// UpdateSessionTaskPath returns the URL path to the task service update_session HTTP endpoint.
+func UpdateSessionTaskPath(sessionID string) string {
+	return fmt.Sprintf("/focus/tasks/sessions/%v", sessionID)
+}
+
+// DeleteSessionTaskPath returns the URL path to the task service delete_session HTTP endpoint.
+func DeleteSessionTaskPath(sessionID string) string {
+	return fmt.Sprintf("/focus/tasks/sessions/%v", sessionID)
+}
+
+// TrackProjectTaskPath returns the URL path to the task service track_project HTTP endpoint.
+func TrackProjectTaskPath(taskID string) string {
+	return fmt.Sprintf("/focus/tasks/%v/tracking", taskID)
+}
+
+// UntrackProjectTaskPath returns the URL path to the task service untrack_project HTTP endpoint.
+func UntrackProjectTaskPath(taskID string) string {
+	return fmt.Sprintf("/focus/tasks/%v/tracking", taskID)
+}
+
+// BurndownTaskPath returns the URL path to the task service burndown HTTP endpoint.
+func BurndownTaskPath(taskID string) string {
+	return fmt.Sprintf("/focus/tasks/%v/burndown", taskID)
+}
+
+// RecomputeTaskPath returns the URL path to the task service recompute HTTP endpoint.
+func RecomputeTaskPath() string {
+	return "/focus/tasks/recompute"
+}
+
+// StreamTaskPath returns the URL path to the task service stream HTTP endpoint.
+func StreamTaskPath() string {
+	return "/focus/tasks/stream"
+}
+
+// RebuildProjectionsTaskPath returns the URL path to the task service rebuild_projections HTTP endpoint.
+func RebuildProjectionsTaskPath() string {
+	return "/focus/tasks/rebuild-projections"
+}
+
+// GetWorkflowTaskPath returns the URL path to the task service get_workflow HTTP endpoint.
+func GetWorkflowTaskPath() string {
+	return "/focus/tasks/settings/workflow"
+}
+
+// SetWorkflowTaskPath returns the URL path to the task service set_workflow HTTP endpoint.
+func SetWorkflowTaskPath() string {
+	return "/focus/tasks/settings/workflow"
+}
+
+// GetAutoStopTaskPath returns the URL path to the task service get_auto_stop HTTP endpoint.
+func GetAutoStopTaskPath() string {
+	return "/focus/tasks/settings/auto-stop"
+}
+
+// CreateWebhookTaskPath returns the URL path to the task service create_webhook HTTP endpoint.
+func CreateWebhookTaskPath() string {
+	return "/focus/tasks/webhooks"
+}
+
+// ListWebhooksTaskPath returns the URL path to the task service list_webhooks HTTP endpoint.
+func ListWebhooksTaskPath() string {
+	return "/focus/tasks/webhooks"
+}
+
+// DeleteWebhookTaskPath returns the URL path to the task service delete_webhook HTTP endpoint.
+func DeleteWebhookTaskPath(webhookID string) string {
+	return fmt.Sprintf("/focus/tasks/webhooks/%v", webhookID)
+}
+
+// ListWebhookDeliveriesTaskPath returns the URL path to the task service list_webhook_deliveries HTTP endpoint.
+func ListWebhookDeliveriesTaskPath(webhookID string) string {
+	return fmt.Sprintf("/focus/tasks/webhooks/%v/deliveries", webhookID)
+}
+
+// RedeliverWebhookTaskPath returns the URL path to the task service redeliver_webhook HTTP endpoint.
+func RedeliverWebhookTaskPath(webhookID string, deliveryID string) string {
+	return fmt.Sprintf("/focus/tasks/webhooks/%v/deliveries/%v/redeliver", webhookID, deliveryID)
+}
+
+// SetAutoStopTaskPath returns the URL path to the task service set_auto_stop HTTP endpoint.
+func SetAutoStopTaskPath() string {
+	return "/focus/tasks/settings/auto-stop"
+}
@@ -35,6 +35,70 @@ type UpdateRequestBody struct {
 	Status string `form:"status" json:"status" xml:"status"`
 	// The estimated time of the task
 	EstimatedTime *int64 `form:"estimated_time,omitempty" json:"estimated_time,omitempty" xml:"estimated_time,omitempty"`
+	// The timestamp when the task is due, 0 to clear
+	DueAt *int64 `form:"due_at,omitempty" json:"due_at,omitempty" xml:"due_at,omitempty"`
+	// Whether to derive the status from the subtasks
+	Rollup *bool `form:"rollup,omitempty" json:"rollup,omitempty" xml:"rollup,omitempty"`
+}
+
+// AddSessionRequestBody is the type of the "task" service "add_session"
+// endpoint HTTP request body.
+type AddSessionRequestBody struct {
+	// The timestamp when the session was started
+	StartedAt int64 `form:"started_at" json:"started_at" xml:"started_at"`
+	// The timestamp when the session was ended
+	EndedAt int64 `form:"ended_at" json:"ended_at" xml:"ended_at"`
+	// The note of the session
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// UpdateSessionRequestBody is the type of the "task" service "update_session"
+// endpoint HTTP request body.
+type UpdateSessionRequestBody struct {
+	// The timestamp when the session was started
+	StartedAt int64 `form:"started_at" json:"started_at" xml:"started_at"`
+	// The timestamp when the session was ended
+	EndedAt int64 `form:"ended_at" json:"ended_at" xml:"ended_at"`
+	// The note of the session
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// TrackProjectRequestBody is the type of the "task" service "track_project"
+// endpoint HTTP request body.
+type TrackProjectRequestBody struct {
+	// The IANA time zone where a day ends
+	Timezone string `form:"timezone" json:"timezone" xml:"timezone"`
+}
+
+// SetWorkflowRequestBody is the type of the "task" service "set_workflow"
+// endpoint HTTP request body.
+type SetWorkflowRequestBody struct {
+	// The statuses, todo, doing and done are always included
+	Statuses []*WorkflowStatusRequestBody `form:"statuses" json:"statuses" xml:"statuses"`
+	// The allowed transitions, empty to allow all
+	Transitions []*WorkflowTransitionRequestBody `form:"transitions" json:"transitions" xml:"transitions"`
+}
+
+// CreateWebhookRequestBody is the type of the "task" service "create_webhook"
+// endpoint HTTP request body.
+type CreateWebhookRequestBody struct {
+	// The http or https URL that receives the events
+	URL string `form:"url" json:"url" xml:"url"`
+	// The events to send
+	Events []string `form:"events" json:"events" xml:"events"`
+}
+
+// SetAutoStopRequestBody is the type of the "task" service "set_auto_stop"
+// endpoint HTTP request body.
+type SetAutoStopRequestBody struct {
+	// Stop tasks running longer than this many seconds, 0 to disable
+	Threshold int64 `form:"threshold" json:"threshold" xml:"threshold"`
+	// Record at most this many seconds for a stopped task, 0 for no cap
+	Cap int64 `form:"cap" json:"cap" xml:"cap"`
+	// Stop tasks still running past midnight
+	Midnight bool `form:"midnight" json:"midnight" xml:"midnight"`
+	// The IANA time zone used to find midnight
+	Timezone string `form:"timezone" json:"timezone" xml:"timezone"`
 }
 
 // CreateResponseBody is the type of the "task" service "create" endpoint HTTP
@@ -54,10 +118,20 @@ type CreateResponseBody struct {
 	ActualTime *int64 `form:"actual_time,omitempty" json:"actual_time,omitempty" xml:"actual_time,omitempty"`
 	// The timestamp when the task was started
 	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// The timestamp when the task is due
+	DueAt *int64 `form:"due_at,omitempty" json:"due_at,omitempty" xml:"due_at,omitempty"`
 	// Whether the task is a leaf task
 	IsLeaf *bool `form:"is_leaf,omitempty" json:"is_leaf,omitempty" xml:"is_leaf,omitempty"`
 	// The status of the task
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Whether the status is derived from the subtasks
+	Rollup *bool `form:"rollup,omitempty" json:"rollup,omitempty" xml:"rollup,omitempty"`
+	// The timestamp when the task was first started
+	FirstStartedAt *int64 `form:"first_started_at,omitempty" json:"first_started_at,omitempty" xml:"first_started_at,omitempty"`
+	// The timestamp when the task was completed
+	CompletedAt *int64 `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+	// The number of times the task was reopened after completion
+	Reopens *int `form:"reopens,omitempty" json:"reopens,omitempty" xml:"reopens,omitempty"`
 }
 
 // ListResponseBody is the type of the "task" service "list" endpoint HTTP
@@ -81,10 +155,244 @@ type UpdateResponseBody struct {
 	ActualTime *int64 `form:"actual_time,omitempty" json:"actual_time,omitempty" xml:"actual_time,omitempty"`
 	// The timestamp when the task was started
 	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// The timestamp when the task is due
+	DueAt *int64 `form:"due_at,omitempty" json:"due_at,omitempty" xml:"due_at,omitempty"`
 	// Whether the task is a leaf task
 	IsLeaf *bool `form:"is_leaf,omitempty" json:"is_leaf,omitempty" xml:"is_leaf,omitempty"`
 	// The status of the task
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Whether the status is derived from the subtasks
+	Rollup *bool `form:"rollup,omitempty" json:"rollup,omitempty" xml:"rollup,omitempty"`
+	// The timestamp when the task was first started
+	FirstStartedAt *int64 `form:"first_started_at,omitempty" json:"first_started_at,omitempty" xml:"first_started_at,omitempty"`
+	// The timestamp when the task was completed
+	CompletedAt *int64 `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+	// The number of times the task was reopened after completion
+	Reopens *int `form:"reopens,omitempty" json:"reopens,omitempty" xml:"reopens,omitempty"`
+}
+
+// CalendarTokenResponseBody is the type of the "task" service "calendar_token"
+// endpoint HTTP response body.
+type CalendarTokenResponseBody struct {
+	// The token for the calendar feed
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+	// The timestamp when the token expires
+	ExpiresAt *int64 `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+}
+
+// EstimatesResponseBody is the type of the "task" service "estimates" endpoint
+// HTTP response body.
+type EstimatesResponseBody struct {
+	// The statistics of all completed leaf tasks
+	Summary *EstimateStatsResponseBody `form:"summary,omitempty" json:"summary,omitempty" xml:"summary,omitempty"`
+	// The statistics per period of completion
+	Timeline []*EstimateGroupResponseBody `form:"timeline,omitempty" json:"timeline,omitempty" xml:"timeline,omitempty"`
+	// The statistics per depth
+	ByDepth []*EstimateGroupResponseBody `form:"by_depth,omitempty" json:"by_depth,omitempty" xml:"by_depth,omitempty"`
+	// The statistics per +project and @context tag
+	ByTag []*EstimateGroupResponseBody `form:"by_tag,omitempty" json:"by_tag,omitempty" xml:"by_tag,omitempty"`
+	// The statistics per top-level task ID
+	BySubtree []*EstimateGroupResponseBody `form:"by_subtree,omitempty" json:"by_subtree,omitempty" xml:"by_subtree,omitempty"`
+}
+
+// LifecycleResponseBody is the type of the "task" service "lifecycle" endpoint
+// HTTP response body.
+type LifecycleResponseBody struct {
+	// The completed tasks in order of completion
+	Tasks []*LifecycleTaskResponseBody `form:"tasks,omitempty" json:"tasks,omitempty" xml:"tasks,omitempty"`
+	// The statistics of all completed leaf tasks
+	Summary *FlowStatsResponseBody `form:"summary,omitempty" json:"summary,omitempty" xml:"summary,omitempty"`
+	// The statistics per top-level task ID
+	BySubtree []*FlowGroupResponseBody `form:"by_subtree,omitempty" json:"by_subtree,omitempty" xml:"by_subtree,omitempty"`
+}
+
+// SessionsResponseBody is the type of the "task" service "sessions" endpoint
+// HTTP response body.
+type SessionsResponseBody []*SessionOutputResponse
+
+// AddSessionResponseBody is the type of the "task" service "add_session"
+// endpoint HTTP response body.
+type AddSessionResponseBody struct {
+	// The ID of the session
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The ID of the task
+	TaskID *string `form:"task_id,omitempty" json:"task_id,omitempty" xml:"task_id,omitempty"`
+	// The timestamp when the session was started
+	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// The timestamp when the session was ended
+	EndedAt *int64 `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// Whether the session was stopped automatically
+	Auto *bool `form:"auto,omitempty" json:"auto,omitempty" xml:"auto,omitempty"`
+	// The note of the session
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// UpdateSessionResponseBody is the type of the "task" service "update_session"
+// endpoint HTTP response body.
+type UpdateSessionResponseBody struct {
+	// The ID of the session
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The ID of the task
+	TaskID *string `form:"task_id,omitempty" json:"task_id,omitempty" xml:"task_id,omitempty"`
+	// The timestamp when the session was started
+	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// The timestamp when the session was ended
+	EndedAt *int64 `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// Whether the session was stopped automatically
+	Auto *bool `form:"auto,omitempty" json:"auto,omitempty" xml:"auto,omitempty"`
+	// The note of the session
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// BurndownResponseBody is the type of the "task" service "burndown" endpoint
+// HTTP response body.
+type BurndownResponseBody struct {
+	// The time zone where a day ends
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// The snapshots in date order, the last one is live
+	Points []*BurndownPointResponseBody `form:"points,omitempty" json:"points,omitempty" xml:"points,omitempty"`
+}
+
+// RecomputeResponseBody is the type of the "task" service "recompute" endpoint
+// HTTP response body.
+type RecomputeResponseBody struct {
+	// The number of missing records that were created
+	Created *int `form:"created,omitempty" json:"created,omitempty" xml:"created,omitempty"`
+	// The number of records that were fixed
+	Updated *int `form:"updated,omitempty" json:"updated,omitempty" xml:"updated,omitempty"`
+	// The number of records without a task that were deleted
+	Deleted *int `form:"deleted,omitempty" json:"deleted,omitempty" xml:"deleted,omitempty"`
+}
+
+// StreamResponseBody is the type of the "task" service "stream" endpoint HTTP
+// response body.
+type StreamResponseBody struct {
+	// The ID to resume from, empty for timer ticks
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The kind of the event
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// The ID of the task
+	TaskID *string `form:"task_id,omitempty" json:"task_id,omitempty" xml:"task_id,omitempty"`
+	// The parent ID of the task
+	ParentID *string `form:"parent_id,omitempty" json:"parent_id,omitempty" xml:"parent_id,omitempty"`
+	// The next ID of the task
+	NextID *string `form:"next_id,omitempty" json:"next_id,omitempty" xml:"next_id,omitempty"`
+	// The status of the task
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The actual time of the running task
+	ActualTime *int64 `form:"actual_time,omitempty" json:"actual_time,omitempty" xml:"actual_time,omitempty"`
+	// The timestamp when the running task was started
+	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// The timestamp of the event
+	At *int64 `form:"at,omitempty" json:"at,omitempty" xml:"at,omitempty"`
+}
+
+// RebuildProjectionsResponseBody is the type of the "task" service
+// "rebuild_projections" endpoint HTTP response body.
+type RebuildProjectionsResponseBody struct {
+	// Whether the changes were only reported
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// The changed extras
+	Extras *RecomputeOutputResponseBody `form:"extras,omitempty" json:"extras,omitempty" xml:"extras,omitempty"`
+	// The changed traces
+	Traces *RecomputeOutputResponseBody `form:"traces,omitempty" json:"traces,omitempty" xml:"traces,omitempty"`
+	// The changes in the order they are applied
+	Changes []*ProjectionChangeResponseBody `form:"changes,omitempty" json:"changes,omitempty" xml:"changes,omitempty"`
+}
+
+// GetWorkflowResponseBody is the type of the "task" service "get_workflow"
+// endpoint HTTP response body.
+type GetWorkflowResponseBody struct {
+	// The statuses, todo, doing and done are always included
+	Statuses []*WorkflowStatusResponseBody `form:"statuses,omitempty" json:"statuses,omitempty" xml:"statuses,omitempty"`
+	// The allowed transitions, empty to allow all
+	Transitions []*WorkflowTransitionResponseBody `form:"transitions,omitempty" json:"transitions,omitempty" xml:"transitions,omitempty"`
+}
+
+// SetWorkflowResponseBody is the type of the "task" service "set_workflow"
+// endpoint HTTP response body.
+type SetWorkflowResponseBody struct {
+	// The statuses, todo, doing and done are always included
+	Statuses []*WorkflowStatusResponseBody `form:"statuses,omitempty" json:"statuses,omitempty" xml:"statuses,omitempty"`
+	// The allowed transitions, empty to allow all
+	Transitions []*WorkflowTransitionResponseBody `form:"transitions,omitempty" json:"transitions,omitempty" xml:"transitions,omitempty"`
+}
+
+// GetAutoStopResponseBody is the type of the "task" service "get_auto_stop"
+// endpoint HTTP response body.
+type GetAutoStopResponseBody struct {
+	// Stop tasks running longer than this many seconds, 0 to disable
+	Threshold *int64 `form:"threshold,omitempty" json:"threshold,omitempty" xml:"threshold,omitempty"`
+	// Record at most this many seconds for a stopped task, 0 for no cap
+	Cap *int64 `form:"cap,omitempty" json:"cap,omitempty" xml:"cap,omitempty"`
+	// Stop tasks still running past midnight
+	Midnight *bool `form:"midnight,omitempty" json:"midnight,omitempty" xml:"midnight,omitempty"`
+	// The IANA time zone used to find midnight
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+}
+
+// CreateWebhookResponseBody is the type of the "task" service "create_webhook"
+// endpoint HTTP response body.
+type CreateWebhookResponseBody struct {
+	// The ID of the webhook
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The URL that receives the events
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// The events to send
+	Events []string `form:"events,omitempty" json:"events,omitempty" xml:"events,omitempty"`
+	// The key of the HMAC-SHA256 signature in the X-Focus-Signature-256 header,
+	// returned only on creation
+	Secret *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	// The timestamp when the webhook was created
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// ListWebhooksResponseBody is the type of the "task" service "list_webhooks"
+// endpoint HTTP response body.
+type ListWebhooksResponseBody []*WebhookOutputResponse
+
+// ListWebhookDeliveriesResponseBody is the type of the "task" service
+// "list_webhook_deliveries" endpoint HTTP response body.
+type ListWebhookDeliveriesResponseBody []*WebhookDeliveryResponse
+
+// RedeliverWebhookResponseBody is the type of the "task" service
+// "redeliver_webhook" endpoint HTTP response body.
+type RedeliverWebhookResponseBody struct {
+	// The ID of the delivery
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The ID of the webhook
+	WebhookID *string `form:"webhook_id,omitempty" json:"webhook_id,omitempty" xml:"webhook_id,omitempty"`
+	// The event that was sent
+	Event *string `form:"event,omitempty" json:"event,omitempty" xml:"event,omitempty"`
+	// The JSON body that was sent
+	Payload *string `form:"payload,omitempty" json:"payload,omitempty" xml:"payload,omitempty"`
+	// The state of the delivery
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The number of attempts so far
+	Attempts *int `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// The timestamp of the next attempt while pending
+	NextAttemptAt *int64 `form:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty" xml:"next_attempt_at,omitempty"`
+	// The status code of the last response
+	LastStatusCode *int `form:"last_status_code,omitempty" json:"last_status_code,omitempty" xml:"last_status_code,omitempty"`
+	// The error of the last attempt
+	LastError *string `form:"last_error,omitempty" json:"last_error,omitempty" xml:"last_error,omitempty"`
+	// The timestamp when the delivery was created
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The timestamp when the delivery succeeded
+	DeliveredAt *int64 `form:"delivered_at,omitempty" json:"delivered_at,omitempty" xml:"delivered_at,omitempty"`
+}
+
+// SetAutoStopResponseBody is the type of the "task" service "set_auto_stop"
+// endpoint HTTP response body.
+type SetAutoStopResponseBody struct {
+	// Stop tasks running longer than this many seconds, 0 to disable
+	Threshold *int64 `form:"threshold,omitempty" json:"threshold,omitempty" xml:"threshold,omitempty"`
+	// Record at most this many seconds for a stopped task, 0 for no cap
+	Cap *int64 `form:"cap,omitempty" json:"cap,omitempty" xml:"cap,omitempty"`
+	// Stop tasks still running past midnight
+	Midnight *bool `form:"midnight,omitempty" json:"midnight,omitempty" xml:"midnight,omitempty"`
+	// The IANA time zone used to find midnight
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
 }
 
 // SetupUnauthorizedResponseBody is the type of the "task" service "setup"
@@ -195,6 +503,24 @@ type ListInternalServerErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// UpdateBadRequestResponseBody is the type of the "task" service "update"
+// endpoint HTTP response body for the "BadRequest" error.
+type UpdateBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // UpdateUnauthorizedResponseBody is the type of the "task" service "update"
 // endpoint HTTP response body for the "Unauthorized" error.
 type UpdateUnauthorizedResponseBody struct {
@@ -231,6 +557,24 @@ type UpdateTaskNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// UpdateConflictResponseBody is the type of the "task" service "update"
+// endpoint HTTP response body for the "Conflict" error.
+type UpdateConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // UpdateInternalServerErrorResponseBody is the type of the "task" service
 // "update" endpoint HTTP response body for the "InternalServerError" error.
 type UpdateInternalServerErrorResponseBody struct {
@@ -303,279 +647,5409 @@ type DeleteInternalServerErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// CreatetaskoutputResponse is used to define fields on response body types.
-type CreatetaskoutputResponse struct {
-	// The ID of the task
+// CalendarTokenUnauthorizedResponseBody is the type of the "task" service
+// "calendar_token" endpoint HTTP response body for the "Unauthorized" error.
+type CalendarTokenUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
 	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// The parent ID of the task
-	ParentID *string `form:"parent_id,omitempty" json:"parent_id,omitempty" xml:"parent_id,omitempty"`
-	// The title of the task
-	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
-	// The timestamp when the task was created
-	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// The estimated time of the task
-	EstimatedTime *int64 `form:"estimated_time,omitempty" json:"estimated_time,omitempty" xml:"estimated_time,omitempty"`
-	// The actual time of the task
-	ActualTime *int64 `form:"actual_time,omitempty" json:"actual_time,omitempty" xml:"actual_time,omitempty"`
-	// The timestamp when the task was started
-	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
-	// Whether the task is a leaf task
-	IsLeaf *bool `form:"is_leaf,omitempty" json:"is_leaf,omitempty" xml:"is_leaf,omitempty"`
-	// The status of the task
-	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCreateRequestBody builds the HTTP request body from the payload of the
-// "create" endpoint of the "task" service.
-func NewCreateRequestBody(p *task.CreateTaskInput) *CreateRequestBody {
-	body := &CreateRequestBody{
-		ParentID: p.ParentID,
-		Title:    p.Title,
-	}
-	return body
+// CalendarTokenInternalServerErrorResponseBody is the type of the "task"
+// service "calendar_token" endpoint HTTP response body for the
+// "InternalServerError" error.
+type CalendarTokenInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewUpdateRequestBody builds the HTTP request body from the payload of the
-// "update" endpoint of the "task" service.
-func NewUpdateRequestBody(p *task.TaskUpdateInput) *UpdateRequestBody {
-	body := &UpdateRequestBody{
-		Title:         p.Title,
-		ParentID:      p.ParentID,
-		NextID:        p.NextID,
-		Status:        p.Status,
-		EstimatedTime: p.EstimatedTime,
-	}
-	return body
+// CalendarUnauthorizedResponseBody is the type of the "task" service
+// "calendar" endpoint HTTP response body for the "Unauthorized" error.
+type CalendarUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewSetupUnauthorized builds a task service setup endpoint Unauthorized error.
-func NewSetupUnauthorized(body *SetupUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
+// CalendarInternalServerErrorResponseBody is the type of the "task" service
+// "calendar" endpoint HTTP response body for the "InternalServerError" error.
+type CalendarInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewSetupInternalServerError builds a task service setup endpoint
-// InternalServerError error.
-func NewSetupInternalServerError(body *SetupInternalServerErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
+// TimesheetBadRequestResponseBody is the type of the "task" service
+// "timesheet" endpoint HTTP response body for the "BadRequest" error.
+type TimesheetBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCreatetaskoutputViewOK builds a "task" service "create" endpoint result
-// from a HTTP "OK" response.
-func NewCreatetaskoutputViewOK(body *CreateResponseBody) *taskviews.CreatetaskoutputView {
-	v := &taskviews.CreatetaskoutputView{
-		ID:            body.ID,
-		ParentID:      body.ParentID,
-		Title:         body.Title,
-		CreatedAt:     body.CreatedAt,
-		EstimatedTime: body.EstimatedTime,
-		ActualTime:    body.ActualTime,
-		StartedAt:     body.StartedAt,
-		IsLeaf:        body.IsLeaf,
-		Status:        body.Status,
-	}
-
-	return v
+// TimesheetUnauthorizedResponseBody is the type of the "task" service
+// "timesheet" endpoint HTTP response body for the "Unauthorized" error.
+type TimesheetUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCreateUnauthorized builds a task service create endpoint Unauthorized
-// error.
-func NewCreateUnauthorized(body *CreateUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
+// TimesheetTaskNotFoundResponseBody is the type of the "task" service
+// "timesheet" endpoint HTTP response body for the "TaskNotFound" error.
+type TimesheetTaskNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// TimesheetInternalServerErrorResponseBody is the type of the "task" service
+// "timesheet" endpoint HTTP response body for the "InternalServerError" error.
+type TimesheetInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// EstimatesUnauthorizedResponseBody is the type of the "task" service
+// "estimates" endpoint HTTP response body for the "Unauthorized" error.
+type EstimatesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// EstimatesTaskNotFoundResponseBody is the type of the "task" service
+// "estimates" endpoint HTTP response body for the "TaskNotFound" error.
+type EstimatesTaskNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// EstimatesInternalServerErrorResponseBody is the type of the "task" service
+// "estimates" endpoint HTTP response body for the "InternalServerError" error.
+type EstimatesInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// LifecycleBadRequestResponseBody is the type of the "task" service
+// "lifecycle" endpoint HTTP response body for the "BadRequest" error.
+type LifecycleBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// LifecycleUnauthorizedResponseBody is the type of the "task" service
+// "lifecycle" endpoint HTTP response body for the "Unauthorized" error.
+type LifecycleUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// LifecycleTaskNotFoundResponseBody is the type of the "task" service
+// "lifecycle" endpoint HTTP response body for the "TaskNotFound" error.
+type LifecycleTaskNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// LifecycleInternalServerErrorResponseBody is the type of the "task" service
+// "lifecycle" endpoint HTTP response body for the "InternalServerError" error.
+type LifecycleInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SessionsUnauthorizedResponseBody is the type of the "task" service
+// "sessions" endpoint HTTP response body for the "Unauthorized" error.
+type SessionsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SessionsTaskNotFoundResponseBody is the type of the "task" service
+// "sessions" endpoint HTTP response body for the "TaskNotFound" error.
+type SessionsTaskNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SessionsInternalServerErrorResponseBody is the type of the "task" service
+// "sessions" endpoint HTTP response body for the "InternalServerError" error.
+type SessionsInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AddSessionBadRequestResponseBody is the type of the "task" service
+// "add_session" endpoint HTTP response body for the "BadRequest" error.
+type AddSessionBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AddSessionUnauthorizedResponseBody is the type of the "task" service
+// "add_session" endpoint HTTP response body for the "Unauthorized" error.
+type AddSessionUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AddSessionTaskNotFoundResponseBody is the type of the "task" service
+// "add_session" endpoint HTTP response body for the "TaskNotFound" error.
+type AddSessionTaskNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AddSessionConflictResponseBody is the type of the "task" service
+// "add_session" endpoint HTTP response body for the "Conflict" error.
+type AddSessionConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AddSessionInternalServerErrorResponseBody is the type of the "task" service
+// "add_session" endpoint HTTP response body for the "InternalServerError"
+// error.
+type AddSessionInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateSessionBadRequestResponseBody is the type of the "task" service
+// "update_session" endpoint HTTP response body for the "BadRequest" error.
+type UpdateSessionBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateSessionUnauthorizedResponseBody is the type of the "task" service
+// "update_session" endpoint HTTP response body for the "Unauthorized" error.
+type UpdateSessionUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateSessionSessionNotFoundResponseBody is the type of the "task" service
+// "update_session" endpoint HTTP response body for the "SessionNotFound" error.
+type UpdateSessionSessionNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateSessionConflictResponseBody is the type of the "task" service
+// "update_session" endpoint HTTP response body for the "Conflict" error.
+type UpdateSessionConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateSessionInternalServerErrorResponseBody is the type of the "task"
+// service "update_session" endpoint HTTP response body for the
+// "InternalServerError" error.
+type UpdateSessionInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteSessionUnauthorizedResponseBody is the type of the "task" service
+// "delete_session" endpoint HTTP response body for the "Unauthorized" error.
+type DeleteSessionUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteSessionSessionNotFoundResponseBody is the type of the "task" service
+// "delete_session" endpoint HTTP response body for the "SessionNotFound" error.
+type DeleteSessionSessionNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteSessionInternalServerErrorResponseBody is the type of the "task"
+// service "delete_session" endpoint HTTP response body for the
+// "InternalServerError" error.
+type DeleteSessionInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// TrackProjectBadRequestResponseBody is the type of the "task" service
+// "track_project" endpoint HTTP response body for the "BadRequest" error.
+type TrackProjectBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// TrackProjectUnauthorizedResponseBody is the type of the "task" service
+// "track_project" endpoint HTTP response body for the "Unauthorized" error.
+type TrackProjectUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// TrackProjectTaskNotFoundResponseBody is the type of the "task" service
+// "track_project" endpoint HTTP response body for the "TaskNotFound" error.
+type TrackProjectTaskNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// TrackProjectInternalServerErrorResponseBody is the type of the "task"
+// service "track_project" endpoint HTTP response body for the
+// "InternalServerError" error.
+type TrackProjectInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UntrackProjectUnauthorizedResponseBody is the type of the "task" service
+// "untrack_project" endpoint HTTP response body for the "Unauthorized" error.
+type UntrackProjectUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UntrackProjectProjectNotFoundResponseBody is the type of the "task" service
+// "untrack_project" endpoint HTTP response body for the "ProjectNotFound"
+// error.
+type UntrackProjectProjectNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UntrackProjectInternalServerErrorResponseBody is the type of the "task"
+// service "untrack_project" endpoint HTTP response body for the
+// "InternalServerError" error.
+type UntrackProjectInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// BurndownUnauthorizedResponseBody is the type of the "task" service
+// "burndown" endpoint HTTP response body for the "Unauthorized" error.
+type BurndownUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// BurndownProjectNotFoundResponseBody is the type of the "task" service
+// "burndown" endpoint HTTP response body for the "ProjectNotFound" error.
+type BurndownProjectNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// BurndownInternalServerErrorResponseBody is the type of the "task" service
+// "burndown" endpoint HTTP response body for the "InternalServerError" error.
+type BurndownInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RecomputeUnauthorizedResponseBody is the type of the "task" service
+// "recompute" endpoint HTTP response body for the "Unauthorized" error.
+type RecomputeUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RecomputeInternalServerErrorResponseBody is the type of the "task" service
+// "recompute" endpoint HTTP response body for the "InternalServerError" error.
+type RecomputeInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// StreamUnauthorizedResponseBody is the type of the "task" service "stream"
+// endpoint HTTP response body for the "Unauthorized" error.
+type StreamUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// StreamInternalServerErrorResponseBody is the type of the "task" service
+// "stream" endpoint HTTP response body for the "InternalServerError" error.
+type StreamInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RebuildProjectionsUnauthorizedResponseBody is the type of the "task" service
+// "rebuild_projections" endpoint HTTP response body for the "Unauthorized"
+// error.
+type RebuildProjectionsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RebuildProjectionsInternalServerErrorResponseBody is the type of the "task"
+// service "rebuild_projections" endpoint HTTP response body for the
+// "InternalServerError" error.
+type RebuildProjectionsInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetWorkflowUnauthorizedResponseBody is the type of the "task" service
+// "get_workflow" endpoint HTTP response body for the "Unauthorized" error.
+type GetWorkflowUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetWorkflowInternalServerErrorResponseBody is the type of the "task" service
+// "get_workflow" endpoint HTTP response body for the "InternalServerError"
+// error.
+type GetWorkflowInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetWorkflowBadRequestResponseBody is the type of the "task" service
+// "set_workflow" endpoint HTTP response body for the "BadRequest" error.
+type SetWorkflowBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetWorkflowUnauthorizedResponseBody is the type of the "task" service
+// "set_workflow" endpoint HTTP response body for the "Unauthorized" error.
+type SetWorkflowUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetWorkflowInternalServerErrorResponseBody is the type of the "task" service
+// "set_workflow" endpoint HTTP response body for the "InternalServerError"
+// error.
+type SetWorkflowInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetAutoStopUnauthorizedResponseBody is the type of the "task" service
+// "get_auto_stop" endpoint HTTP response body for the "Unauthorized" error.
+type GetAutoStopUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetAutoStopInternalServerErrorResponseBody is the type of the "task" service
+// "get_auto_stop" endpoint HTTP response body for the "InternalServerError"
+// error.
+type GetAutoStopInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateWebhookBadRequestResponseBody is the type of the "task" service
+// "create_webhook" endpoint HTTP response body for the "BadRequest" error.
+type CreateWebhookBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateWebhookUnauthorizedResponseBody is the type of the "task" service
+// "create_webhook" endpoint HTTP response body for the "Unauthorized" error.
+type CreateWebhookUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateWebhookInternalServerErrorResponseBody is the type of the "task"
+// service "create_webhook" endpoint HTTP response body for the
+// "InternalServerError" error.
+type CreateWebhookInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListWebhooksUnauthorizedResponseBody is the type of the "task" service
+// "list_webhooks" endpoint HTTP response body for the "Unauthorized" error.
+type ListWebhooksUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListWebhooksInternalServerErrorResponseBody is the type of the "task"
+// service "list_webhooks" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListWebhooksInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteWebhookUnauthorizedResponseBody is the type of the "task" service
+// "delete_webhook" endpoint HTTP response body for the "Unauthorized" error.
+type DeleteWebhookUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteWebhookWebhookNotFoundResponseBody is the type of the "task" service
+// "delete_webhook" endpoint HTTP response body for the "WebhookNotFound" error.
+type DeleteWebhookWebhookNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteWebhookInternalServerErrorResponseBody is the type of the "task"
+// service "delete_webhook" endpoint HTTP response body for the
+// "InternalServerError" error.
+type DeleteWebhookInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListWebhookDeliveriesUnauthorizedResponseBody is the type of the "task"
+// service "list_webhook_deliveries" endpoint HTTP response body for the
+// "Unauthorized" error.
+type ListWebhookDeliveriesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListWebhookDeliveriesWebhookNotFoundResponseBody is the type of the "task"
+// service "list_webhook_deliveries" endpoint HTTP response body for the
+// "WebhookNotFound" error.
+type ListWebhookDeliveriesWebhookNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListWebhookDeliveriesInternalServerErrorResponseBody is the type of the
+// "task" service "list_webhook_deliveries" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListWebhookDeliveriesInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RedeliverWebhookUnauthorizedResponseBody is the type of the "task" service
+// "redeliver_webhook" endpoint HTTP response body for the "Unauthorized" error.
+type RedeliverWebhookUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RedeliverWebhookDeliveryNotFoundResponseBody is the type of the "task"
+// service "redeliver_webhook" endpoint HTTP response body for the
+// "DeliveryNotFound" error.
+type RedeliverWebhookDeliveryNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RedeliverWebhookInternalServerErrorResponseBody is the type of the "task"
+// service "redeliver_webhook" endpoint HTTP response body for the
+// "InternalServerError" error.
+type RedeliverWebhookInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetAutoStopBadRequestResponseBody is the type of the "task" service
+// "set_auto_stop" endpoint HTTP response body for the "BadRequest" error.
+type SetAutoStopBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetAutoStopUnauthorizedResponseBody is the type of the "task" service
+// "set_auto_stop" endpoint HTTP response body for the "Unauthorized" error.
+type SetAutoStopUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetAutoStopInternalServerErrorResponseBody is the type of the "task" service
+// "set_auto_stop" endpoint HTTP response body for the "InternalServerError"
+// error.
+type SetAutoStopInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreatetaskoutputResponse is used to define fields on response body types.
+type CreatetaskoutputResponse struct {
+	// The ID of the task
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The parent ID of the task
+	ParentID *string `form:"parent_id,omitempty" json:"parent_id,omitempty" xml:"parent_id,omitempty"`
+	// The title of the task
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// The timestamp when the task was created
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The estimated time of the task
+	EstimatedTime *int64 `form:"estimated_time,omitempty" json:"estimated_time,omitempty" xml:"estimated_time,omitempty"`
+	// The actual time of the task
+	ActualTime *int64 `form:"actual_time,omitempty" json:"actual_time,omitempty" xml:"actual_time,omitempty"`
+	// The timestamp when the task was started
+	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// The timestamp when the task is due
+	DueAt *int64 `form:"due_at,omitempty" json:"due_at,omitempty" xml:"due_at,omitempty"`
+	// Whether the task is a leaf task
+	IsLeaf *bool `form:"is_leaf,omitempty" json:"is_leaf,omitempty" xml:"is_leaf,omitempty"`
+	// The status of the task
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Whether the status is derived from the subtasks
+	Rollup *bool `form:"rollup,omitempty" json:"rollup,omitempty" xml:"rollup,omitempty"`
+	// The timestamp when the task was first started
+	FirstStartedAt *int64 `form:"first_started_at,omitempty" json:"first_started_at,omitempty" xml:"first_started_at,omitempty"`
+	// The timestamp when the task was completed
+	CompletedAt *int64 `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+	// The number of times the task was reopened after completion
+	Reopens *int `form:"reopens,omitempty" json:"reopens,omitempty" xml:"reopens,omitempty"`
+}
+
+// EstimateStatsResponseBody is used to define fields on response body types.
+type EstimateStatsResponseBody struct {
+	// The number of completed leaf tasks
+	Count *int `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
+	// The median of actual time divided by estimated time
+	MedianRatio *float64 `form:"median_ratio,omitempty" json:"median_ratio,omitempty" xml:"median_ratio,omitempty"`
+	// The mean of the smaller time divided by the larger time
+	MeanAccuracy *float64 `form:"mean_accuracy,omitempty" json:"mean_accuracy,omitempty" xml:"mean_accuracy,omitempty"`
+	// The median of actual time minus estimated time in seconds
+	MedianOverrun *int64 `form:"median_overrun,omitempty" json:"median_overrun,omitempty" xml:"median_overrun,omitempty"`
+}
+
+// EstimateGroupResponseBody is used to define fields on response body types.
+type EstimateGroupResponseBody struct {
+	// The key of the group
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// The statistics of the group
+	Stats *EstimateStatsResponseBody `form:"stats,omitempty" json:"stats,omitempty" xml:"stats,omitempty"`
+}
+
+// LifecycleTaskResponseBody is used to define fields on response body types.
+type LifecycleTaskResponseBody struct {
+	// The ID of the task
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The title of the task
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Whether the task is a leaf task
+	IsLeaf *bool `form:"is_leaf,omitempty" json:"is_leaf,omitempty" xml:"is_leaf,omitempty"`
+	// The timestamp when the task was created
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The timestamp when the task was first started
+	FirstStartedAt *int64 `form:"first_started_at,omitempty" json:"first_started_at,omitempty" xml:"first_started_at,omitempty"`
+	// The timestamp when the task was completed
+	CompletedAt *int64 `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+	// The number of times the task was reopened after completion
+	Reopens *int `form:"reopens,omitempty" json:"reopens,omitempty" xml:"reopens,omitempty"`
+	// The seconds from the first start to completion
+	CycleTime *int64 `form:"cycle_time,omitempty" json:"cycle_time,omitempty" xml:"cycle_time,omitempty"`
+	// The seconds from creation to completion
+	LeadTime *int64 `form:"lead_time,omitempty" json:"lead_time,omitempty" xml:"lead_time,omitempty"`
+}
+
+// FlowStatsResponseBody is used to define fields on response body types.
+type FlowStatsResponseBody struct {
+	// The number of completed leaf tasks
+	Count *int `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
+	// The number of completed leaf tasks that were started
+	Started *int `form:"started,omitempty" json:"started,omitempty" xml:"started,omitempty"`
+	// The total number of reopens
+	Reopens *int `form:"reopens,omitempty" json:"reopens,omitempty" xml:"reopens,omitempty"`
+	// The median cycle time in seconds
+	MedianCycleTime *int64 `form:"median_cycle_time,omitempty" json:"median_cycle_time,omitempty" xml:"median_cycle_time,omitempty"`
+	// The mean cycle time in seconds
+	MeanCycleTime *int64 `form:"mean_cycle_time,omitempty" json:"mean_cycle_time,omitempty" xml:"mean_cycle_time,omitempty"`
+	// The median lead time in seconds
+	MedianLeadTime *int64 `form:"median_lead_time,omitempty" json:"median_lead_time,omitempty" xml:"median_lead_time,omitempty"`
+	// The mean lead time in seconds
+	MeanLeadTime *int64 `form:"mean_lead_time,omitempty" json:"mean_lead_time,omitempty" xml:"mean_lead_time,omitempty"`
+}
+
+// FlowGroupResponseBody is used to define fields on response body types.
+type FlowGroupResponseBody struct {
+	// The key of the group
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// The statistics of the group
+	Stats *FlowStatsResponseBody `form:"stats,omitempty" json:"stats,omitempty" xml:"stats,omitempty"`
+}
+
+// SessionOutputResponse is used to define fields on response body types.
+type SessionOutputResponse struct {
+	// The ID of the session
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The ID of the task
+	TaskID *string `form:"task_id,omitempty" json:"task_id,omitempty" xml:"task_id,omitempty"`
+	// The timestamp when the session was started
+	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// The timestamp when the session was ended
+	EndedAt *int64 `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// Whether the session was stopped automatically
+	Auto *bool `form:"auto,omitempty" json:"auto,omitempty" xml:"auto,omitempty"`
+	// The note of the session
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// BurndownPointResponseBody is used to define fields on response body types.
+type BurndownPointResponseBody struct {
+	// The date of the snapshot
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// The estimated time of open leaf tasks in seconds
+	RemainingTime *int64 `form:"remaining_time,omitempty" json:"remaining_time,omitempty" xml:"remaining_time,omitempty"`
+	// The estimated time of done leaf tasks in seconds
+	CompletedTime *int64 `form:"completed_time,omitempty" json:"completed_time,omitempty" xml:"completed_time,omitempty"`
+	// The actual time of the project in seconds
+	ActualTime *int64 `form:"actual_time,omitempty" json:"actual_time,omitempty" xml:"actual_time,omitempty"`
+	// The number of open leaf tasks
+	Open *int `form:"open,omitempty" json:"open,omitempty" xml:"open,omitempty"`
+	// The number of done leaf tasks
+	Done *int `form:"done,omitempty" json:"done,omitempty" xml:"done,omitempty"`
+}
+
+// RecomputeOutputResponseBody is used to define fields on response body types.
+type RecomputeOutputResponseBody struct {
+	// The number of missing records that were created
+	Created *int `form:"created,omitempty" json:"created,omitempty" xml:"created,omitempty"`
+	// The number of records that were fixed
+	Updated *int `form:"updated,omitempty" json:"updated,omitempty" xml:"updated,omitempty"`
+	// The number of records without a task that were deleted
+	Deleted *int `form:"deleted,omitempty" json:"deleted,omitempty" xml:"deleted,omitempty"`
+}
+
+// ProjectionChangeResponseBody is used to define fields on response body types.
+type ProjectionChangeResponseBody struct {
+	// The projection that is changed
+	Projection *string `form:"projection,omitempty" json:"projection,omitempty" xml:"projection,omitempty"`
+	// The ID of the task
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The kind of the change, delete_session removes the session in from
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// The stored value
+	From *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// The rebuilt value
+	To *string `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+}
+
+// WorkflowStatusResponseBody is used to define fields on response body types.
+type WorkflowStatusResponseBody struct {
+	// The name of the status
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// The core status that decides whether time is being spent
+	Category *string `form:"category,omitempty" json:"category,omitempty" xml:"category,omitempty"`
+}
+
+// WorkflowTransitionResponseBody is used to define fields on response body
+// types.
+type WorkflowTransitionResponseBody struct {
+	// The status before the transition
+	From *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// The status after the transition
+	To *string `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+}
+
+// WorkflowStatusRequestBody is used to define fields on request body types.
+type WorkflowStatusRequestBody struct {
+	// The name of the status
+	Name string `form:"name" json:"name" xml:"name"`
+	// The core status that decides whether time is being spent
+	Category string `form:"category" json:"category" xml:"category"`
+}
+
+// WorkflowTransitionRequestBody is used to define fields on request body types.
+type WorkflowTransitionRequestBody struct {
+	// The status before the transition
+	From string `form:"from" json:"from" xml:"from"`
+	// The status after the transition
+	To string `form:"to" json:"to" xml:"to"`
+}
+
+// WebhookOutputResponse is used to define fields on response body types.
+type WebhookOutputResponse struct {
+	// The ID of the webhook
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The URL that receives the events
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// The events to send
+	Events []string `form:"events,omitempty" json:"events,omitempty" xml:"events,omitempty"`
+	// The key of the HMAC-SHA256 signature in the X-Focus-Signature-256 header,
+	// returned only on creation
+	Secret *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	// The timestamp when the webhook was created
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// WebhookDeliveryResponse is used to define fields on response body types.
+type WebhookDeliveryResponse struct {
+	// The ID of the delivery
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The ID of the webhook
+	WebhookID *string `form:"webhook_id,omitempty" json:"webhook_id,omitempty" xml:"webhook_id,omitempty"`
+	// The event that was sent
+	Event *string `form:"event,omitempty" json:"event,omitempty" xml:"event,omitempty"`
+	// The JSON body that was sent
+	Payload *string `form:"payload,omitempty" json:"payload,omitempty" xml:"payload,omitempty"`
+	// The state of the delivery
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The number of attempts so far
+	Attempts *int `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// The timestamp of the next attempt while pending
+	NextAttemptAt *int64 `form:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty" xml:"next_attempt_at,omitempty"`
+	// The status code of the last response
+	LastStatusCode *int `form:"last_status_code,omitempty" json:"last_status_code,omitempty" xml:"last_status_code,omitempty"`
+	// The error of the last attempt
+	LastError *string `form:"last_error,omitempty" json:"last_error,omitempty" xml:"last_error,omitempty"`
+	// The timestamp when the delivery was created
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The timestamp when the delivery succeeded
+	DeliveredAt *int64 `form:"delivered_at,omitempty" json:"delivered_at,omitempty" xml:"delivered_at,omitempty"`
+}
+
+// NewCreateRequestBody builds the HTTP request body from the payload of the
+// "create" endpoint of the "task" service.
+func NewCreateRequestBody(p *task.CreateTaskInput) *CreateRequestBody {
+	body := &CreateRequestBody{
+		ParentID: p.ParentID,
+		Title:    p.Title,
+	}
+	return body
+}
+
+// NewUpdateRequestBody builds the HTTP request body from the payload of the
+// "update" endpoint of the "task" service.
+func NewUpdateRequestBody(p *task.TaskUpdateInput) *UpdateRequestBody {
+	body := &UpdateRequestBody{
+		Title:         p.Title,
+		ParentID:      p.ParentID,
+		NextID:        p.NextID,
+		Status:        p.Status,
+		EstimatedTime: p.EstimatedTime,
+		DueAt:         p.DueAt,
+		Rollup:        p.Rollup,
+	}
+	return body
+}
+
+// NewAddSessionRequestBody builds the HTTP request body from the payload of
+// the "add_session" endpoint of the "task" service.
+func NewAddSessionRequestBody(p *task.AddSessionPayload) *AddSessionRequestBody {
+	body := &AddSessionRequestBody{
+		StartedAt: p.StartedAt,
+		EndedAt:   p.EndedAt,
+		Note:      p.Note,
+	}
+	return body
+}
+
+// NewUpdateSessionRequestBody builds the HTTP request body from the payload of
+// the "update_session" endpoint of the "task" service.
+func NewUpdateSessionRequestBody(p *task.UpdateSessionPayload) *UpdateSessionRequestBody {
+	body := &UpdateSessionRequestBody{
+		StartedAt: p.StartedAt,
+		EndedAt:   p.EndedAt,
+		Note:      p.Note,
+	}
+	return body
+}
+
+// NewTrackProjectRequestBody builds the HTTP request body from the payload of
+// the "track_project" endpoint of the "task" service.
+func NewTrackProjectRequestBody(p *task.TrackProjectPayload) *TrackProjectRequestBody {
+	body := &TrackProjectRequestBody{
+		Timezone: p.Timezone,
+	}
+	{
+		var zero string
+		if body.Timezone == zero {
+			body.Timezone = "UTC"
+		}
+	}
+	return body
+}
+
+// NewSetWorkflowRequestBody builds the HTTP request body from the payload of
+// the "set_workflow" endpoint of the "task" service.
+func NewSetWorkflowRequestBody(p *task.SetWorkflowPayload) *SetWorkflowRequestBody {
+	body := &SetWorkflowRequestBody{}
+	if p.Statuses != nil {
+		body.Statuses = make([]*WorkflowStatusRequestBody, len(p.Statuses))
+		for i, val := range p.Statuses {
+			body.Statuses[i] = marshalTaskWorkflowStatusToWorkflowStatusRequestBody(val)
+		}
+	} else {
+		body.Statuses = []*WorkflowStatusRequestBody{}
+	}
+	if p.Transitions != nil {
+		body.Transitions = make([]*WorkflowTransitionRequestBody, len(p.Transitions))
+		for i, val := range p.Transitions {
+			body.Transitions[i] = marshalTaskWorkflowTransitionToWorkflowTransitionRequestBody(val)
+		}
+	} else {
+		body.Transitions = []*WorkflowTransitionRequestBody{}
+	}
+	return body
+}
+
+// NewCreateWebhookRequestBody builds the HTTP request body from the payload of
+// the "create_webhook" endpoint of the "task" service.
+func NewCreateWebhookRequestBody(p *task.CreateWebhookPayload) *CreateWebhookRequestBody {
+	body := &CreateWebhookRequestBody{
+		URL: p.URL,
+	}
+	if p.Events != nil {
+		body.Events = make([]string, len(p.Events))
+		for i, val := range p.Events {
+			body.Events[i] = val
+		}
+	} else {
+		body.Events = []string{}
+	}
+	return body
+}
+
+// NewSetAutoStopRequestBody builds the HTTP request body from the payload of
+// the "set_auto_stop" endpoint of the "task" service.
+func NewSetAutoStopRequestBody(p *task.SetAutoStopPayload) *SetAutoStopRequestBody {
+	body := &SetAutoStopRequestBody{
+		Threshold: p.Threshold,
+		Cap:       p.Cap,
+		Midnight:  p.Midnight,
+		Timezone:  p.Timezone,
+	}
+	return body
+}
+
+// NewSetupUnauthorized builds a task service setup endpoint Unauthorized error.
+func NewSetupUnauthorized(body *SetupUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetupInternalServerError builds a task service setup endpoint
+// InternalServerError error.
+func NewSetupInternalServerError(body *SetupInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreatetaskoutputViewOK builds a "task" service "create" endpoint result
+// from a HTTP "OK" response.
+func NewCreatetaskoutputViewOK(body *CreateResponseBody) *taskviews.CreatetaskoutputView {
+	v := &taskviews.CreatetaskoutputView{
+		ID:             body.ID,
+		ParentID:       body.ParentID,
+		Title:          body.Title,
+		CreatedAt:      body.CreatedAt,
+		EstimatedTime:  body.EstimatedTime,
+		ActualTime:     body.ActualTime,
+		StartedAt:      body.StartedAt,
+		DueAt:          body.DueAt,
+		IsLeaf:         body.IsLeaf,
+		Status:         body.Status,
+		Rollup:         body.Rollup,
+		FirstStartedAt: body.FirstStartedAt,
+		CompletedAt:    body.CompletedAt,
+		Reopens:        body.Reopens,
+	}
+
+	return v
+}
+
+// NewCreateUnauthorized builds a task service create endpoint Unauthorized
+// error.
+func NewCreateUnauthorized(body *CreateUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateInternalServerError builds a task service create endpoint
+// InternalServerError error.
+func NewCreateInternalServerError(body *CreateInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListCreatetaskoutputCollectionOK builds a "task" service "list" endpoint
+// result from a HTTP "OK" response.
+func NewListCreatetaskoutputCollectionOK(body ListResponseBody) taskviews.CreatetaskoutputCollectionView {
+	v := make([]*taskviews.CreatetaskoutputView, len(body))
+	for i, val := range body {
+		v[i] = unmarshalCreatetaskoutputResponseToTaskviewsCreatetaskoutputView(val)
+	}
+
+	return v
+}
+
+// NewListUnauthorized builds a task service list endpoint Unauthorized error.
+func NewListUnauthorized(body *ListUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListInternalServerError builds a task service list endpoint
+// InternalServerError error.
+func NewListInternalServerError(body *ListInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateCreatetaskoutputOK builds a "task" service "update" endpoint result
+// from a HTTP "OK" response.
+func NewUpdateCreatetaskoutputOK(body *UpdateResponseBody) *taskviews.CreatetaskoutputView {
+	v := &taskviews.CreatetaskoutputView{
+		ID:             body.ID,
+		ParentID:       body.ParentID,
+		Title:          body.Title,
+		CreatedAt:      body.CreatedAt,
+		EstimatedTime:  body.EstimatedTime,
+		ActualTime:     body.ActualTime,
+		StartedAt:      body.StartedAt,
+		DueAt:          body.DueAt,
+		IsLeaf:         body.IsLeaf,
+		Status:         body.Status,
+		Rollup:         body.Rollup,
+		FirstStartedAt: body.FirstStartedAt,
+		CompletedAt:    body.CompletedAt,
+		Reopens:        body.Reopens,
+	}
+
+	return v
+}
+
+// NewUpdateBadRequest builds a task service update endpoint BadRequest error.
+func NewUpdateBadRequest(body *UpdateBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateUnauthorized builds a task service update endpoint Unauthorized
+// error.
+func NewUpdateUnauthorized(body *UpdateUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateTaskNotFound builds a task service update endpoint TaskNotFound
+// error.
+func NewUpdateTaskNotFound(body *UpdateTaskNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateConflict builds a task service update endpoint Conflict error.
+func NewUpdateConflict(body *UpdateConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateInternalServerError builds a task service update endpoint
+// InternalServerError error.
+func NewUpdateInternalServerError(body *UpdateInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteUnauthorized builds a task service delete endpoint Unauthorized
+// error.
+func NewDeleteUnauthorized(body *DeleteUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteTaskNotFound builds a task service delete endpoint TaskNotFound
+// error.
+func NewDeleteTaskNotFound(body *DeleteTaskNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteInternalServerError builds a task service delete endpoint
+// InternalServerError error.
+func NewDeleteInternalServerError(body *DeleteInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCalendarTokenOutputOK builds a "task" service "calendar_token" endpoint
+// result from a HTTP "OK" response.
+func NewCalendarTokenOutputOK(body *CalendarTokenResponseBody) *task.CalendarTokenOutput {
+	v := &task.CalendarTokenOutput{
+		Token:     *body.Token,
+		ExpiresAt: *body.ExpiresAt,
+	}
+
+	return v
+}
+
+// NewCalendarTokenUnauthorized builds a task service calendar_token endpoint
+// Unauthorized error.
+func NewCalendarTokenUnauthorized(body *CalendarTokenUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCalendarTokenInternalServerError builds a task service calendar_token
+// endpoint InternalServerError error.
+func NewCalendarTokenInternalServerError(body *CalendarTokenInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCalendarResultOK builds a "task" service "calendar" endpoint result from
+// a HTTP "OK" response.
+func NewCalendarResultOK(contentType string) *task.CalendarResult {
+	v := &task.CalendarResult{}
+	v.ContentType = contentType
+
+	return v
+}
+
+// NewCalendarUnauthorized builds a task service calendar endpoint Unauthorized
+// error.
+func NewCalendarUnauthorized(body *CalendarUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCalendarInternalServerError builds a task service calendar endpoint
+// InternalServerError error.
+func NewCalendarInternalServerError(body *CalendarInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewTimesheetResultOK builds a "task" service "timesheet" endpoint result
+// from a HTTP "OK" response.
+func NewTimesheetResultOK(contentType string) *task.TimesheetResult {
+	v := &task.TimesheetResult{}
+	v.ContentType = contentType
+
+	return v
+}
+
+// NewTimesheetBadRequest builds a task service timesheet endpoint BadRequest
+// error.
+func NewTimesheetBadRequest(body *TimesheetBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewTimesheetUnauthorized builds a task service timesheet endpoint
+// Unauthorized error.
+func NewTimesheetUnauthorized(body *TimesheetUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewTimesheetTaskNotFound builds a task service timesheet endpoint
+// TaskNotFound error.
+func NewTimesheetTaskNotFound(body *TimesheetTaskNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewTimesheetInternalServerError builds a task service timesheet endpoint
+// InternalServerError error.
+func NewTimesheetInternalServerError(body *TimesheetInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewEstimatesOutputOK builds a "task" service "estimates" endpoint result
+// from a HTTP "OK" response.
+func NewEstimatesOutputOK(body *EstimatesResponseBody) *task.EstimatesOutput {
+	v := &task.EstimatesOutput{}
+	v.Summary = unmarshalEstimateStatsResponseBodyToTaskEstimateStats(body.Summary)
+	v.Timeline = make([]*task.EstimateGroup, len(body.Timeline))
+	for i, val := range body.Timeline {
+		v.Timeline[i] = unmarshalEstimateGroupResponseBodyToTaskEstimateGroup(val)
+	}
+	v.ByDepth = make([]*task.EstimateGroup, len(body.ByDepth))
+	for i, val := range body.ByDepth {
+		v.ByDepth[i] = unmarshalEstimateGroupResponseBodyToTaskEstimateGroup(val)
+	}
+	v.ByTag = make([]*task.EstimateGroup, len(body.ByTag))
+	for i, val := range body.ByTag {
+		v.ByTag[i] = unmarshalEstimateGroupResponseBodyToTaskEstimateGroup(val)
+	}
+	v.BySubtree = make([]*task.EstimateGroup, len(body.BySubtree))
+	for i, val := range body.BySubtree {
+		v.BySubtree[i] = unmarshalEstimateGroupResponseBodyToTaskEstimateGroup(val)
+	}
+
+	return v
+}
+
+// NewEstimatesUnauthorized builds a task service estimates endpoint
+// Unauthorized error.
+func NewEstimatesUnauthorized(body *EstimatesUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewEstimatesTaskNotFound builds a task service estimates endpoint
+// TaskNotFound error.
+func NewEstimatesTaskNotFound(body *EstimatesTaskNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewEstimatesInternalServerError builds a task service estimates endpoint
+// InternalServerError error.
+func NewEstimatesInternalServerError(body *EstimatesInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewLifecycleOutputOK builds a "task" service "lifecycle" endpoint result
+// from a HTTP "OK" response.
+func NewLifecycleOutputOK(body *LifecycleResponseBody) *task.LifecycleOutput {
+	v := &task.LifecycleOutput{}
+	v.Tasks = make([]*task.LifecycleTask, len(body.Tasks))
+	for i, val := range body.Tasks {
+		v.Tasks[i] = unmarshalLifecycleTaskResponseBodyToTaskLifecycleTask(val)
+	}
+	v.Summary = unmarshalFlowStatsResponseBodyToTaskFlowStats(body.Summary)
+	v.BySubtree = make([]*task.FlowGroup, len(body.BySubtree))
+	for i, val := range body.BySubtree {
+		v.BySubtree[i] = unmarshalFlowGroupResponseBodyToTaskFlowGroup(val)
+	}
+
+	return v
+}
+
+// NewLifecycleBadRequest builds a task service lifecycle endpoint BadRequest
+// error.
+func NewLifecycleBadRequest(body *LifecycleBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewLifecycleUnauthorized builds a task service lifecycle endpoint
+// Unauthorized error.
+func NewLifecycleUnauthorized(body *LifecycleUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewLifecycleTaskNotFound builds a task service lifecycle endpoint
+// TaskNotFound error.
+func NewLifecycleTaskNotFound(body *LifecycleTaskNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewLifecycleInternalServerError builds a task service lifecycle endpoint
+// InternalServerError error.
+func NewLifecycleInternalServerError(body *LifecycleInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSessionsSessionOutputOK builds a "task" service "sessions" endpoint
+// result from a HTTP "OK" response.
+func NewSessionsSessionOutputOK(body []*SessionOutputResponse) []*task.SessionOutput {
+	v := make([]*task.SessionOutput, len(body))
+	for i, val := range body {
+		v[i] = unmarshalSessionOutputResponseToTaskSessionOutput(val)
+	}
+
+	return v
+}
+
+// NewSessionsUnauthorized builds a task service sessions endpoint Unauthorized
+// error.
+func NewSessionsUnauthorized(body *SessionsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSessionsTaskNotFound builds a task service sessions endpoint TaskNotFound
+// error.
+func NewSessionsTaskNotFound(body *SessionsTaskNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSessionsInternalServerError builds a task service sessions endpoint
+// InternalServerError error.
+func NewSessionsInternalServerError(body *SessionsInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewAddSessionSessionOutputOK builds a "task" service "add_session" endpoint
+// result from a HTTP "OK" response.
+func NewAddSessionSessionOutputOK(body *AddSessionResponseBody) *task.SessionOutput {
+	v := &task.SessionOutput{
+		ID:        *body.ID,
+		TaskID:    *body.TaskID,
+		StartedAt: *body.StartedAt,
+		EndedAt:   *body.EndedAt,
+		Auto:      *body.Auto,
+		Note:      body.Note,
+	}
+
+	return v
+}
+
+// NewAddSessionBadRequest builds a task service add_session endpoint
+// BadRequest error.
+func NewAddSessionBadRequest(body *AddSessionBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewAddSessionUnauthorized builds a task service add_session endpoint
+// Unauthorized error.
+func NewAddSessionUnauthorized(body *AddSessionUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewAddSessionTaskNotFound builds a task service add_session endpoint
+// TaskNotFound error.
+func NewAddSessionTaskNotFound(body *AddSessionTaskNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewAddSessionConflict builds a task service add_session endpoint Conflict
+// error.
+func NewAddSessionConflict(body *AddSessionConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewAddSessionInternalServerError builds a task service add_session endpoint
+// InternalServerError error.
+func NewAddSessionInternalServerError(body *AddSessionInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateSessionSessionOutputOK builds a "task" service "update_session"
+// endpoint result from a HTTP "OK" response.
+func NewUpdateSessionSessionOutputOK(body *UpdateSessionResponseBody) *task.SessionOutput {
+	v := &task.SessionOutput{
+		ID:        *body.ID,
+		TaskID:    *body.TaskID,
+		StartedAt: *body.StartedAt,
+		EndedAt:   *body.EndedAt,
+		Auto:      *body.Auto,
+		Note:      body.Note,
+	}
+
+	return v
+}
+
+// NewUpdateSessionBadRequest builds a task service update_session endpoint
+// BadRequest error.
+func NewUpdateSessionBadRequest(body *UpdateSessionBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateSessionUnauthorized builds a task service update_session endpoint
+// Unauthorized error.
+func NewUpdateSessionUnauthorized(body *UpdateSessionUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateSessionSessionNotFound builds a task service update_session
+// endpoint SessionNotFound error.
+func NewUpdateSessionSessionNotFound(body *UpdateSessionSessionNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateSessionConflict builds a task service update_session endpoint
+// Conflict error.
+func NewUpdateSessionConflict(body *UpdateSessionConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateSessionInternalServerError builds a task service update_session
+// endpoint InternalServerError error.
+func NewUpdateSessionInternalServerError(body *UpdateSessionInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteSessionUnauthorized builds a task service delete_session endpoint
+// Unauthorized error.
+func NewDeleteSessionUnauthorized(body *DeleteSessionUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteSessionSessionNotFound builds a task service delete_session
+// endpoint SessionNotFound error.
+func NewDeleteSessionSessionNotFound(body *DeleteSessionSessionNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteSessionInternalServerError builds a task service delete_session
+// endpoint InternalServerError error.
+func NewDeleteSessionInternalServerError(body *DeleteSessionInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewTrackProjectBadRequest builds a task service track_project endpoint
+// BadRequest error.
+func NewTrackProjectBadRequest(body *TrackProjectBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewTrackProjectUnauthorized builds a task service track_project endpoint
+// Unauthorized error.
+func NewTrackProjectUnauthorized(body *TrackProjectUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewTrackProjectTaskNotFound builds a task service track_project endpoint
+// TaskNotFound error.
+func NewTrackProjectTaskNotFound(body *TrackProjectTaskNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewTrackProjectInternalServerError builds a task service track_project
+// endpoint InternalServerError error.
+func NewTrackProjectInternalServerError(body *TrackProjectInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUntrackProjectUnauthorized builds a task service untrack_project endpoint
+// Unauthorized error.
+func NewUntrackProjectUnauthorized(body *UntrackProjectUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUntrackProjectProjectNotFound builds a task service untrack_project
+// endpoint ProjectNotFound error.
+func NewUntrackProjectProjectNotFound(body *UntrackProjectProjectNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUntrackProjectInternalServerError builds a task service untrack_project
+// endpoint InternalServerError error.
+func NewUntrackProjectInternalServerError(body *UntrackProjectInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewBurndownOutputOK builds a "task" service "burndown" endpoint result from
+// a HTTP "OK" response.
+func NewBurndownOutputOK(body *BurndownResponseBody) *task.BurndownOutput {
+	v := &task.BurndownOutput{
+		Timezone: *body.Timezone,
+	}
+	v.Points = make([]*task.BurndownPoint, len(body.Points))
+	for i, val := range body.Points {
+		v.Points[i] = unmarshalBurndownPointResponseBodyToTaskBurndownPoint(val)
+	}
+
+	return v
+}
+
+// NewBurndownUnauthorized builds a task service burndown endpoint Unauthorized
+// error.
+func NewBurndownUnauthorized(body *BurndownUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewBurndownProjectNotFound builds a task service burndown endpoint
+// ProjectNotFound error.
+func NewBurndownProjectNotFound(body *BurndownProjectNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewBurndownInternalServerError builds a task service burndown endpoint
+// InternalServerError error.
+func NewBurndownInternalServerError(body *BurndownInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRecomputeOutputOK builds a "task" service "recompute" endpoint result
+// from a HTTP "OK" response.
+func NewRecomputeOutputOK(body *RecomputeResponseBody) *task.RecomputeOutput {
+	v := &task.RecomputeOutput{
+		Created: *body.Created,
+		Updated: *body.Updated,
+		Deleted: *body.Deleted,
+	}
+
+	return v
+}
+
+// NewRecomputeUnauthorized builds a task service recompute endpoint
+// Unauthorized error.
+func NewRecomputeUnauthorized(body *RecomputeUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRecomputeInternalServerError builds a task service recompute endpoint
+// InternalServerError error.
+func NewRecomputeInternalServerError(body *RecomputeInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewStreamEventOK builds a "task" service "stream" endpoint result from a
+// HTTP "OK" response.
+func NewStreamEventOK(body *StreamResponseBody) *task.StreamEvent {
+	v := &task.StreamEvent{
+		ID:         *body.ID,
+		Kind:       *body.Kind,
+		TaskID:     body.TaskID,
+		ParentID:   body.ParentID,
+		NextID:     body.NextID,
+		Status:     body.Status,
+		ActualTime: body.ActualTime,
+		StartedAt:  body.StartedAt,
+		At:         *body.At,
+	}
+
+	return v
+}
+
+// NewStreamUnauthorized builds a task service stream endpoint Unauthorized
+// error.
+func NewStreamUnauthorized(body *StreamUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewStreamInternalServerError builds a task service stream endpoint
+// InternalServerError error.
+func NewStreamInternalServerError(body *StreamInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRebuildProjectionsOutputOK builds a "task" service "rebuild_projections"
+// endpoint result from a HTTP "OK" response.
+func NewRebuildProjectionsOutputOK(body *RebuildProjectionsResponseBody) *task.RebuildProjectionsOutput {
+	v := &task.RebuildProjectionsOutput{
+		DryRun: *body.DryRun,
+	}
+	v.Extras = unmarshalRecomputeOutputResponseBodyToTaskRecomputeOutput(body.Extras)
+	v.Traces = unmarshalRecomputeOutputResponseBodyToTaskRecomputeOutput(body.Traces)
+	v.Changes = make([]*task.ProjectionChange, len(body.Changes))
+	for i, val := range body.Changes {
+		v.Changes[i] = unmarshalProjectionChangeResponseBodyToTaskProjectionChange(val)
+	}
+
+	return v
+}
+
+// NewRebuildProjectionsUnauthorized builds a task service rebuild_projections
+// endpoint Unauthorized error.
+func NewRebuildProjectionsUnauthorized(body *RebuildProjectionsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRebuildProjectionsInternalServerError builds a task service
+// rebuild_projections endpoint InternalServerError error.
+func NewRebuildProjectionsInternalServerError(body *RebuildProjectionsInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetWorkflowWorkflowOK builds a "task" service "get_workflow" endpoint
+// result from a HTTP "OK" response.
+func NewGetWorkflowWorkflowOK(body *GetWorkflowResponseBody) *task.Workflow {
+	v := &task.Workflow{}
+	v.Statuses = make([]*task.WorkflowStatus, len(body.Statuses))
+	for i, val := range body.Statuses {
+		v.Statuses[i] = unmarshalWorkflowStatusResponseBodyToTaskWorkflowStatus(val)
+	}
+	v.Transitions = make([]*task.WorkflowTransition, len(body.Transitions))
+	for i, val := range body.Transitions {
+		v.Transitions[i] = unmarshalWorkflowTransitionResponseBodyToTaskWorkflowTransition(val)
+	}
+
+	return v
+}
+
+// NewGetWorkflowUnauthorized builds a task service get_workflow endpoint
+// Unauthorized error.
+func NewGetWorkflowUnauthorized(body *GetWorkflowUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetWorkflowInternalServerError builds a task service get_workflow
+// endpoint InternalServerError error.
+func NewGetWorkflowInternalServerError(body *GetWorkflowInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetWorkflowWorkflowOK builds a "task" service "set_workflow" endpoint
+// result from a HTTP "OK" response.
+func NewSetWorkflowWorkflowOK(body *SetWorkflowResponseBody) *task.Workflow {
+	v := &task.Workflow{}
+	v.Statuses = make([]*task.WorkflowStatus, len(body.Statuses))
+	for i, val := range body.Statuses {
+		v.Statuses[i] = unmarshalWorkflowStatusResponseBodyToTaskWorkflowStatus(val)
+	}
+	v.Transitions = make([]*task.WorkflowTransition, len(body.Transitions))
+	for i, val := range body.Transitions {
+		v.Transitions[i] = unmarshalWorkflowTransitionResponseBodyToTaskWorkflowTransition(val)
+	}
+
+	return v
+}
+
+// NewSetWorkflowBadRequest builds a task service set_workflow endpoint
+// BadRequest error.
+func NewSetWorkflowBadRequest(body *SetWorkflowBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetWorkflowUnauthorized builds a task service set_workflow endpoint
+// Unauthorized error.
+func NewSetWorkflowUnauthorized(body *SetWorkflowUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetWorkflowInternalServerError builds a task service set_workflow
+// endpoint InternalServerError error.
+func NewSetWorkflowInternalServerError(body *SetWorkflowInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetAutoStopAutoStopPolicyOK builds a "task" service "get_auto_stop"
+// endpoint result from a HTTP "OK" response.
+func NewGetAutoStopAutoStopPolicyOK(body *GetAutoStopResponseBody) *task.AutoStopPolicy {
+	v := &task.AutoStopPolicy{
+		Threshold: *body.Threshold,
+		Cap:       *body.Cap,
+		Midnight:  *body.Midnight,
+		Timezone:  *body.Timezone,
+	}
+
+	return v
+}
+
+// NewGetAutoStopUnauthorized builds a task service get_auto_stop endpoint
+// Unauthorized error.
+func NewGetAutoStopUnauthorized(body *GetAutoStopUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetAutoStopInternalServerError builds a task service get_auto_stop
+// endpoint InternalServerError error.
+func NewGetAutoStopInternalServerError(body *GetAutoStopInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateWebhookWebhookOutputCreated builds a "task" service
+// "create_webhook" endpoint result from a HTTP "Created" response.
+func NewCreateWebhookWebhookOutputCreated(body *CreateWebhookResponseBody) *task.WebhookOutput {
+	v := &task.WebhookOutput{
+		ID:        *body.ID,
+		URL:       *body.URL,
+		Secret:    body.Secret,
+		CreatedAt: *body.CreatedAt,
+	}
+	v.Events = make([]string, len(body.Events))
+	for i, val := range body.Events {
+		v.Events[i] = val
+	}
+
+	return v
+}
+
+// NewCreateWebhookBadRequest builds a task service create_webhook endpoint
+// BadRequest error.
+func NewCreateWebhookBadRequest(body *CreateWebhookBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateWebhookUnauthorized builds a task service create_webhook endpoint
+// Unauthorized error.
+func NewCreateWebhookUnauthorized(body *CreateWebhookUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateWebhookInternalServerError builds a task service create_webhook
+// endpoint InternalServerError error.
+func NewCreateWebhookInternalServerError(body *CreateWebhookInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListWebhooksWebhookOutputOK builds a "task" service "list_webhooks"
+// endpoint result from a HTTP "OK" response.
+func NewListWebhooksWebhookOutputOK(body []*WebhookOutputResponse) []*task.WebhookOutput {
+	v := make([]*task.WebhookOutput, len(body))
+	for i, val := range body {
+		v[i] = unmarshalWebhookOutputResponseToTaskWebhookOutput(val)
+	}
+
+	return v
+}
+
+// NewListWebhooksUnauthorized builds a task service list_webhooks endpoint
+// Unauthorized error.
+func NewListWebhooksUnauthorized(body *ListWebhooksUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListWebhooksInternalServerError builds a task service list_webhooks
+// endpoint InternalServerError error.
+func NewListWebhooksInternalServerError(body *ListWebhooksInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteWebhookUnauthorized builds a task service delete_webhook endpoint
+// Unauthorized error.
+func NewDeleteWebhookUnauthorized(body *DeleteWebhookUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteWebhookWebhookNotFound builds a task service delete_webhook
+// endpoint WebhookNotFound error.
+func NewDeleteWebhookWebhookNotFound(body *DeleteWebhookWebhookNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteWebhookInternalServerError builds a task service delete_webhook
+// endpoint InternalServerError error.
+func NewDeleteWebhookInternalServerError(body *DeleteWebhookInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListWebhookDeliveriesWebhookDeliveryOK builds a "task" service
+// "list_webhook_deliveries" endpoint result from a HTTP "OK" response.
+func NewListWebhookDeliveriesWebhookDeliveryOK(body []*WebhookDeliveryResponse) []*task.WebhookDelivery {
+	v := make([]*task.WebhookDelivery, len(body))
+	for i, val := range body {
+		v[i] = unmarshalWebhookDeliveryResponseToTaskWebhookDelivery(val)
+	}
+
+	return v
+}
+
+// NewListWebhookDeliveriesUnauthorized builds a task service
+// list_webhook_deliveries endpoint Unauthorized error.
+func NewListWebhookDeliveriesUnauthorized(body *ListWebhookDeliveriesUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListWebhookDeliveriesWebhookNotFound builds a task service
+// list_webhook_deliveries endpoint WebhookNotFound error.
+func NewListWebhookDeliveriesWebhookNotFound(body *ListWebhookDeliveriesWebhookNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListWebhookDeliveriesInternalServerError builds a task service
+// list_webhook_deliveries endpoint InternalServerError error.
+func NewListWebhookDeliveriesInternalServerError(body *ListWebhookDeliveriesInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRedeliverWebhookWebhookDeliveryAccepted builds a "task" service
+// "redeliver_webhook" endpoint result from a HTTP "Accepted" response.
+func NewRedeliverWebhookWebhookDeliveryAccepted(body *RedeliverWebhookResponseBody) *task.WebhookDelivery {
+	v := &task.WebhookDelivery{
+		ID:             *body.ID,
+		WebhookID:      *body.WebhookID,
+		Event:          *body.Event,
+		Payload:        *body.Payload,
+		Status:         *body.Status,
+		Attempts:       *body.Attempts,
+		NextAttemptAt:  body.NextAttemptAt,
+		LastStatusCode: body.LastStatusCode,
+		LastError:      body.LastError,
+		CreatedAt:      *body.CreatedAt,
+		DeliveredAt:    body.DeliveredAt,
+	}
+
+	return v
+}
+
+// NewRedeliverWebhookUnauthorized builds a task service redeliver_webhook
+// endpoint Unauthorized error.
+func NewRedeliverWebhookUnauthorized(body *RedeliverWebhookUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRedeliverWebhookDeliveryNotFound builds a task service redeliver_webhook
+// endpoint DeliveryNotFound error.
+func NewRedeliverWebhookDeliveryNotFound(body *RedeliverWebhookDeliveryNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRedeliverWebhookInternalServerError builds a task service
+// redeliver_webhook endpoint InternalServerError error.
+func NewRedeliverWebhookInternalServerError(body *RedeliverWebhookInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetAutoStopAutoStopPolicyOK builds a "task" service "set_auto_stop"
+// endpoint result from a HTTP "OK" response.
+func NewSetAutoStopAutoStopPolicyOK(body *SetAutoStopResponseBody) *task.AutoStopPolicy {
+	v := &task.AutoStopPolicy{
+		Threshold: *body.Threshold,
+		Cap:       *body.Cap,
+		Midnight:  *body.Midnight,
+		Timezone:  *body.Timezone,
+	}
+
+	return v
+}
+
+// NewSetAutoStopBadRequest builds a task service set_auto_stop endpoint
+// BadRequest error.
+func NewSetAutoStopBadRequest(body *SetAutoStopBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetAutoStopUnauthorized builds a task service set_auto_stop endpoint
+// Unauthorized error.
+func NewSetAutoStopUnauthorized(body *SetAutoStopUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetAutoStopInternalServerError builds a task service set_auto_stop
+// endpoint InternalServerError error.
+func NewSetAutoStopInternalServerError(body *SetAutoStopInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
 	return v
 }
 
-// NewCreateInternalServerError builds a task service create endpoint
-// InternalServerError error.
-func NewCreateInternalServerError(body *CreateInternalServerErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCalendarTokenResponseBody runs the validations defined on
+// calendar_token_response_body
+func ValidateCalendarTokenResponseBody(body *CalendarTokenResponseBody) (err error) {
+	if body.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_at", "body"))
+	}
+	return
+}
+
+// ValidateEstimatesResponseBody runs the validations defined on
+// EstimatesResponseBody
+func ValidateEstimatesResponseBody(body *EstimatesResponseBody) (err error) {
+	if body.Summary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("summary", "body"))
+	}
+	if body.Timeline == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeline", "body"))
+	}
+	if body.ByDepth == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("by_depth", "body"))
+	}
+	if body.ByTag == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("by_tag", "body"))
+	}
+	if body.BySubtree == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("by_subtree", "body"))
+	}
+	if body.Summary != nil {
+		if err2 := ValidateEstimateStatsResponseBody(body.Summary); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Timeline {
+		if e != nil {
+			if err2 := ValidateEstimateGroupResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ByDepth {
+		if e != nil {
+			if err2 := ValidateEstimateGroupResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ByTag {
+		if e != nil {
+			if err2 := ValidateEstimateGroupResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.BySubtree {
+		if e != nil {
+			if err2 := ValidateEstimateGroupResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateLifecycleResponseBody runs the validations defined on
+// LifecycleResponseBody
+func ValidateLifecycleResponseBody(body *LifecycleResponseBody) (err error) {
+	if body.Tasks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tasks", "body"))
+	}
+	if body.Summary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("summary", "body"))
+	}
+	if body.BySubtree == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("by_subtree", "body"))
+	}
+	for _, e := range body.Tasks {
+		if e != nil {
+			if err2 := ValidateLifecycleTaskResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Summary != nil {
+		if err2 := ValidateFlowStatsResponseBody(body.Summary); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.BySubtree {
+		if e != nil {
+			if err2 := ValidateFlowGroupResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAddSessionResponseBody runs the validations defined on
+// add_session_response_body
+func ValidateAddSessionResponseBody(body *AddSessionResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.TaskID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("task_id", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.EndedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ended_at", "body"))
+	}
+	if body.Auto == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("auto", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSessionResponseBody runs the validations defined on
+// update_session_response_body
+func ValidateUpdateSessionResponseBody(body *UpdateSessionResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.TaskID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("task_id", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.EndedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ended_at", "body"))
+	}
+	if body.Auto == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("auto", "body"))
+	}
+	return
+}
+
+// ValidateBurndownResponseBody runs the validations defined on
+// BurndownResponseBody
+func ValidateBurndownResponseBody(body *BurndownResponseBody) (err error) {
+	if body.Timezone == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timezone", "body"))
+	}
+	if body.Points == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("points", "body"))
+	}
+	for _, e := range body.Points {
+		if e != nil {
+			if err2 := ValidateBurndownPointResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRecomputeResponseBody runs the validations defined on
+// RecomputeResponseBody
+func ValidateRecomputeResponseBody(body *RecomputeResponseBody) (err error) {
+	if body.Created == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created", "body"))
+	}
+	if body.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "body"))
+	}
+	if body.Deleted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deleted", "body"))
+	}
+	return
+}
+
+// ValidateStreamResponseBody runs the validations defined on StreamResponseBody
+func ValidateStreamResponseBody(body *StreamResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.At == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("at", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "reset" || *body.Kind == "task.created" || *body.Kind == "task.deleted" || *body.Kind == "task.moved" || *body.Kind == "status.changed" || *body.Kind == "timer.tick") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"reset", "task.created", "task.deleted", "task.moved", "status.changed", "timer.tick"}))
+		}
+	}
+	return
+}
+
+// ValidateRebuildProjectionsResponseBody runs the validations defined on
+// rebuild_projections_response_body
+func ValidateRebuildProjectionsResponseBody(body *RebuildProjectionsResponseBody) (err error) {
+	if body.DryRun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dry_run", "body"))
+	}
+	if body.Extras == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("extras", "body"))
+	}
+	if body.Traces == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("traces", "body"))
+	}
+	if body.Changes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("changes", "body"))
+	}
+	if body.Extras != nil {
+		if err2 := ValidateRecomputeOutputResponseBody(body.Extras); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Traces != nil {
+		if err2 := ValidateRecomputeOutputResponseBody(body.Traces); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Changes {
+		if e != nil {
+			if err2 := ValidateProjectionChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetWorkflowResponseBody runs the validations defined on
+// get_workflow_response_body
+func ValidateGetWorkflowResponseBody(body *GetWorkflowResponseBody) (err error) {
+	if body.Statuses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("statuses", "body"))
+	}
+	if body.Transitions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transitions", "body"))
+	}
+	for _, e := range body.Statuses {
+		if e != nil {
+			if err2 := ValidateWorkflowStatusResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Transitions {
+		if e != nil {
+			if err2 := ValidateWorkflowTransitionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSetWorkflowResponseBody runs the validations defined on
+// set_workflow_response_body
+func ValidateSetWorkflowResponseBody(body *SetWorkflowResponseBody) (err error) {
+	if body.Statuses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("statuses", "body"))
+	}
+	if body.Transitions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transitions", "body"))
+	}
+	for _, e := range body.Statuses {
+		if e != nil {
+			if err2 := ValidateWorkflowStatusResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Transitions {
+		if e != nil {
+			if err2 := ValidateWorkflowTransitionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetAutoStopResponseBody runs the validations defined on
+// get_auto_stop_response_body
+func ValidateGetAutoStopResponseBody(body *GetAutoStopResponseBody) (err error) {
+	if body.Threshold == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("threshold", "body"))
+	}
+	if body.Cap == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cap", "body"))
+	}
+	if body.Midnight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("midnight", "body"))
+	}
+	if body.Timezone == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timezone", "body"))
+	}
+	if body.Threshold != nil {
+		if *body.Threshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.threshold", *body.Threshold, 0, true))
+		}
+	}
+	if body.Cap != nil {
+		if *body.Cap < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cap", *body.Cap, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateCreateWebhookResponseBody runs the validations defined on
+// create_webhook_response_body
+func ValidateCreateWebhookResponseBody(body *CreateWebhookResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.Events == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("events", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	return
+}
+
+// ValidateRedeliverWebhookResponseBody runs the validations defined on
+// redeliver_webhook_response_body
+func ValidateRedeliverWebhookResponseBody(body *RedeliverWebhookResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.WebhookID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("webhook_id", "body"))
+	}
+	if body.Event == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event", "body"))
+	}
+	if body.Payload == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("payload", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "delivered" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "delivered", "failed"}))
+		}
+	}
+	return
+}
+
+// ValidateSetAutoStopResponseBody runs the validations defined on
+// set_auto_stop_response_body
+func ValidateSetAutoStopResponseBody(body *SetAutoStopResponseBody) (err error) {
+	if body.Threshold == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("threshold", "body"))
+	}
+	if body.Cap == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cap", "body"))
+	}
+	if body.Midnight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("midnight", "body"))
+	}
+	if body.Timezone == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timezone", "body"))
+	}
+	if body.Threshold != nil {
+		if *body.Threshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.threshold", *body.Threshold, 0, true))
+		}
+	}
+	if body.Cap != nil {
+		if *body.Cap < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cap", *body.Cap, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateSetupUnauthorizedResponseBody runs the validations defined on
+// setup_Unauthorized_response_body
+func ValidateSetupUnauthorizedResponseBody(body *SetupUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSetupInternalServerErrorResponseBody runs the validations defined on
+// setup_InternalServerError_response_body
+func ValidateSetupInternalServerErrorResponseBody(body *SetupInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateUnauthorizedResponseBody runs the validations defined on
+// create_Unauthorized_response_body
+func ValidateCreateUnauthorizedResponseBody(body *CreateUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateInternalServerErrorResponseBody runs the validations defined
+// on create_InternalServerError_response_body
+func ValidateCreateInternalServerErrorResponseBody(body *CreateInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListUnauthorizedResponseBody runs the validations defined on
+// list_Unauthorized_response_body
+func ValidateListUnauthorizedResponseBody(body *ListUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListInternalServerErrorResponseBody runs the validations defined on
+// list_InternalServerError_response_body
+func ValidateListInternalServerErrorResponseBody(body *ListInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateBadRequestResponseBody runs the validations defined on
+// update_BadRequest_response_body
+func ValidateUpdateBadRequestResponseBody(body *UpdateBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateUnauthorizedResponseBody runs the validations defined on
+// update_Unauthorized_response_body
+func ValidateUpdateUnauthorizedResponseBody(body *UpdateUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateTaskNotFoundResponseBody runs the validations defined on
+// update_TaskNotFound_response_body
+func ValidateUpdateTaskNotFoundResponseBody(body *UpdateTaskNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateConflictResponseBody runs the validations defined on
+// update_Conflict_response_body
+func ValidateUpdateConflictResponseBody(body *UpdateConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateInternalServerErrorResponseBody runs the validations defined
+// on update_InternalServerError_response_body
+func ValidateUpdateInternalServerErrorResponseBody(body *UpdateInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteUnauthorizedResponseBody runs the validations defined on
+// delete_Unauthorized_response_body
+func ValidateDeleteUnauthorizedResponseBody(body *DeleteUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteTaskNotFoundResponseBody runs the validations defined on
+// delete_TaskNotFound_response_body
+func ValidateDeleteTaskNotFoundResponseBody(body *DeleteTaskNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteInternalServerErrorResponseBody runs the validations defined
+// on delete_InternalServerError_response_body
+func ValidateDeleteInternalServerErrorResponseBody(body *DeleteInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCalendarTokenUnauthorizedResponseBody runs the validations defined
+// on calendar_token_Unauthorized_response_body
+func ValidateCalendarTokenUnauthorizedResponseBody(body *CalendarTokenUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCalendarTokenInternalServerErrorResponseBody runs the validations
+// defined on calendar_token_InternalServerError_response_body
+func ValidateCalendarTokenInternalServerErrorResponseBody(body *CalendarTokenInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCalendarUnauthorizedResponseBody runs the validations defined on
+// calendar_Unauthorized_response_body
+func ValidateCalendarUnauthorizedResponseBody(body *CalendarUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCalendarInternalServerErrorResponseBody runs the validations defined
+// on calendar_InternalServerError_response_body
+func ValidateCalendarInternalServerErrorResponseBody(body *CalendarInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateTimesheetBadRequestResponseBody runs the validations defined on
+// timesheet_BadRequest_response_body
+func ValidateTimesheetBadRequestResponseBody(body *TimesheetBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateTimesheetUnauthorizedResponseBody runs the validations defined on
+// timesheet_Unauthorized_response_body
+func ValidateTimesheetUnauthorizedResponseBody(body *TimesheetUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateTimesheetTaskNotFoundResponseBody runs the validations defined on
+// timesheet_TaskNotFound_response_body
+func ValidateTimesheetTaskNotFoundResponseBody(body *TimesheetTaskNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateTimesheetInternalServerErrorResponseBody runs the validations
+// defined on timesheet_InternalServerError_response_body
+func ValidateTimesheetInternalServerErrorResponseBody(body *TimesheetInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateEstimatesUnauthorizedResponseBody runs the validations defined on
+// estimates_Unauthorized_response_body
+func ValidateEstimatesUnauthorizedResponseBody(body *EstimatesUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateEstimatesTaskNotFoundResponseBody runs the validations defined on
+// estimates_TaskNotFound_response_body
+func ValidateEstimatesTaskNotFoundResponseBody(body *EstimatesTaskNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateEstimatesInternalServerErrorResponseBody runs the validations
+// defined on estimates_InternalServerError_response_body
+func ValidateEstimatesInternalServerErrorResponseBody(body *EstimatesInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateLifecycleBadRequestResponseBody runs the validations defined on
+// lifecycle_BadRequest_response_body
+func ValidateLifecycleBadRequestResponseBody(body *LifecycleBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateLifecycleUnauthorizedResponseBody runs the validations defined on
+// lifecycle_Unauthorized_response_body
+func ValidateLifecycleUnauthorizedResponseBody(body *LifecycleUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateLifecycleTaskNotFoundResponseBody runs the validations defined on
+// lifecycle_TaskNotFound_response_body
+func ValidateLifecycleTaskNotFoundResponseBody(body *LifecycleTaskNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateLifecycleInternalServerErrorResponseBody runs the validations
+// defined on lifecycle_InternalServerError_response_body
+func ValidateLifecycleInternalServerErrorResponseBody(body *LifecycleInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSessionsUnauthorizedResponseBody runs the validations defined on
+// sessions_Unauthorized_response_body
+func ValidateSessionsUnauthorizedResponseBody(body *SessionsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSessionsTaskNotFoundResponseBody runs the validations defined on
+// sessions_TaskNotFound_response_body
+func ValidateSessionsTaskNotFoundResponseBody(body *SessionsTaskNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSessionsInternalServerErrorResponseBody runs the validations defined
+// on sessions_InternalServerError_response_body
+func ValidateSessionsInternalServerErrorResponseBody(body *SessionsInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateAddSessionBadRequestResponseBody runs the validations defined on
+// add_session_BadRequest_response_body
+func ValidateAddSessionBadRequestResponseBody(body *AddSessionBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateAddSessionUnauthorizedResponseBody runs the validations defined on
+// add_session_Unauthorized_response_body
+func ValidateAddSessionUnauthorizedResponseBody(body *AddSessionUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateAddSessionTaskNotFoundResponseBody runs the validations defined on
+// add_session_TaskNotFound_response_body
+func ValidateAddSessionTaskNotFoundResponseBody(body *AddSessionTaskNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateAddSessionConflictResponseBody runs the validations defined on
+// add_session_Conflict_response_body
+func ValidateAddSessionConflictResponseBody(body *AddSessionConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateAddSessionInternalServerErrorResponseBody runs the validations
+// defined on add_session_InternalServerError_response_body
+func ValidateAddSessionInternalServerErrorResponseBody(body *AddSessionInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSessionBadRequestResponseBody runs the validations defined on
+// update_session_BadRequest_response_body
+func ValidateUpdateSessionBadRequestResponseBody(body *UpdateSessionBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSessionUnauthorizedResponseBody runs the validations defined
+// on update_session_Unauthorized_response_body
+func ValidateUpdateSessionUnauthorizedResponseBody(body *UpdateSessionUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSessionSessionNotFoundResponseBody runs the validations
+// defined on update_session_SessionNotFound_response_body
+func ValidateUpdateSessionSessionNotFoundResponseBody(body *UpdateSessionSessionNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSessionConflictResponseBody runs the validations defined on
+// update_session_Conflict_response_body
+func ValidateUpdateSessionConflictResponseBody(body *UpdateSessionConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSessionInternalServerErrorResponseBody runs the validations
+// defined on update_session_InternalServerError_response_body
+func ValidateUpdateSessionInternalServerErrorResponseBody(body *UpdateSessionInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteSessionUnauthorizedResponseBody runs the validations defined
+// on delete_session_Unauthorized_response_body
+func ValidateDeleteSessionUnauthorizedResponseBody(body *DeleteSessionUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteSessionSessionNotFoundResponseBody runs the validations
+// defined on delete_session_SessionNotFound_response_body
+func ValidateDeleteSessionSessionNotFoundResponseBody(body *DeleteSessionSessionNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteSessionInternalServerErrorResponseBody runs the validations
+// defined on delete_session_InternalServerError_response_body
+func ValidateDeleteSessionInternalServerErrorResponseBody(body *DeleteSessionInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateTrackProjectBadRequestResponseBody runs the validations defined on
+// track_project_BadRequest_response_body
+func ValidateTrackProjectBadRequestResponseBody(body *TrackProjectBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateTrackProjectUnauthorizedResponseBody runs the validations defined on
+// track_project_Unauthorized_response_body
+func ValidateTrackProjectUnauthorizedResponseBody(body *TrackProjectUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateTrackProjectTaskNotFoundResponseBody runs the validations defined on
+// track_project_TaskNotFound_response_body
+func ValidateTrackProjectTaskNotFoundResponseBody(body *TrackProjectTaskNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateTrackProjectInternalServerErrorResponseBody runs the validations
+// defined on track_project_InternalServerError_response_body
+func ValidateTrackProjectInternalServerErrorResponseBody(body *TrackProjectInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUntrackProjectUnauthorizedResponseBody runs the validations defined
+// on untrack_project_Unauthorized_response_body
+func ValidateUntrackProjectUnauthorizedResponseBody(body *UntrackProjectUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUntrackProjectProjectNotFoundResponseBody runs the validations
+// defined on untrack_project_ProjectNotFound_response_body
+func ValidateUntrackProjectProjectNotFoundResponseBody(body *UntrackProjectProjectNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUntrackProjectInternalServerErrorResponseBody runs the validations
+// defined on untrack_project_InternalServerError_response_body
+func ValidateUntrackProjectInternalServerErrorResponseBody(body *UntrackProjectInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateBurndownUnauthorizedResponseBody runs the validations defined on
+// burndown_Unauthorized_response_body
+func ValidateBurndownUnauthorizedResponseBody(body *BurndownUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateBurndownProjectNotFoundResponseBody runs the validations defined on
+// burndown_ProjectNotFound_response_body
+func ValidateBurndownProjectNotFoundResponseBody(body *BurndownProjectNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateBurndownInternalServerErrorResponseBody runs the validations defined
+// on burndown_InternalServerError_response_body
+func ValidateBurndownInternalServerErrorResponseBody(body *BurndownInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRecomputeUnauthorizedResponseBody runs the validations defined on
+// recompute_Unauthorized_response_body
+func ValidateRecomputeUnauthorizedResponseBody(body *RecomputeUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRecomputeInternalServerErrorResponseBody runs the validations
+// defined on recompute_InternalServerError_response_body
+func ValidateRecomputeInternalServerErrorResponseBody(body *RecomputeInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateStreamUnauthorizedResponseBody runs the validations defined on
+// stream_Unauthorized_response_body
+func ValidateStreamUnauthorizedResponseBody(body *StreamUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateStreamInternalServerErrorResponseBody runs the validations defined
+// on stream_InternalServerError_response_body
+func ValidateStreamInternalServerErrorResponseBody(body *StreamInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRebuildProjectionsUnauthorizedResponseBody runs the validations
+// defined on rebuild_projections_Unauthorized_response_body
+func ValidateRebuildProjectionsUnauthorizedResponseBody(body *RebuildProjectionsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewListCreatetaskoutputCollectionOK builds a "task" service "list" endpoint
-// result from a HTTP "OK" response.
-func NewListCreatetaskoutputCollectionOK(body ListResponseBody) taskviews.CreatetaskoutputCollectionView {
-	v := make([]*taskviews.CreatetaskoutputView, len(body))
-	for i, val := range body {
-		v[i] = unmarshalCreatetaskoutputResponseToTaskviewsCreatetaskoutputView(val)
+// ValidateRebuildProjectionsInternalServerErrorResponseBody runs the
+// validations defined on rebuild_projections_InternalServerError_response_body
+func ValidateRebuildProjectionsInternalServerErrorResponseBody(body *RebuildProjectionsInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewListUnauthorized builds a task service list endpoint Unauthorized error.
-func NewListUnauthorized(body *ListUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetWorkflowUnauthorizedResponseBody runs the validations defined on
+// get_workflow_Unauthorized_response_body
+func ValidateGetWorkflowUnauthorizedResponseBody(body *GetWorkflowUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewListInternalServerError builds a task service list endpoint
-// InternalServerError error.
-func NewListInternalServerError(body *ListInternalServerErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetWorkflowInternalServerErrorResponseBody runs the validations
+// defined on get_workflow_InternalServerError_response_body
+func ValidateGetWorkflowInternalServerErrorResponseBody(body *GetWorkflowInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateCreatetaskoutputOK builds a "task" service "update" endpoint result
-// from a HTTP "OK" response.
-func NewUpdateCreatetaskoutputOK(body *UpdateResponseBody) *taskviews.CreatetaskoutputView {
-	v := &taskviews.CreatetaskoutputView{
-		ID:            body.ID,
-		ParentID:      body.ParentID,
-		Title:         body.Title,
-		CreatedAt:     body.CreatedAt,
-		EstimatedTime: body.EstimatedTime,
-		ActualTime:    body.ActualTime,
-		StartedAt:     body.StartedAt,
-		IsLeaf:        body.IsLeaf,
-		Status:        body.Status,
+// ValidateSetWorkflowBadRequestResponseBody runs the validations defined on
+// set_workflow_BadRequest_response_body
+func ValidateSetWorkflowBadRequestResponseBody(body *SetWorkflowBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
+	return
+}
 
-	return v
+// ValidateSetWorkflowUnauthorizedResponseBody runs the validations defined on
+// set_workflow_Unauthorized_response_body
+func ValidateSetWorkflowUnauthorizedResponseBody(body *SetWorkflowUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateUnauthorized builds a task service update endpoint Unauthorized
-// error.
-func NewUpdateUnauthorized(body *UpdateUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateSetWorkflowInternalServerErrorResponseBody runs the validations
+// defined on set_workflow_InternalServerError_response_body
+func ValidateSetWorkflowInternalServerErrorResponseBody(body *SetWorkflowInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
 
-	return v
+// ValidateGetAutoStopUnauthorizedResponseBody runs the validations defined on
+// get_auto_stop_Unauthorized_response_body
+func ValidateGetAutoStopUnauthorizedResponseBody(body *GetAutoStopUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateTaskNotFound builds a task service update endpoint TaskNotFound
-// error.
-func NewUpdateTaskNotFound(body *UpdateTaskNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetAutoStopInternalServerErrorResponseBody runs the validations
+// defined on get_auto_stop_InternalServerError_response_body
+func ValidateGetAutoStopInternalServerErrorResponseBody(body *GetAutoStopInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
 
-	return v
+// ValidateCreateWebhookBadRequestResponseBody runs the validations defined on
+// create_webhook_BadRequest_response_body
+func ValidateCreateWebhookBadRequestResponseBody(body *CreateWebhookBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateInternalServerError builds a task service update endpoint
-// InternalServerError error.
-func NewUpdateInternalServerError(body *UpdateInternalServerErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateWebhookUnauthorizedResponseBody runs the validations defined
+// on create_webhook_Unauthorized_response_body
+func ValidateCreateWebhookUnauthorizedResponseBody(body *CreateWebhookUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewDeleteUnauthorized builds a task service delete endpoint Unauthorized
-// error.
-func NewDeleteUnauthorized(body *DeleteUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateWebhookInternalServerErrorResponseBody runs the validations
+// defined on create_webhook_InternalServerError_response_body
+func ValidateCreateWebhookInternalServerErrorResponseBody(body *CreateWebhookInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteTaskNotFound builds a task service delete endpoint TaskNotFound
-// error.
-func NewDeleteTaskNotFound(body *DeleteTaskNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListWebhooksUnauthorizedResponseBody runs the validations defined on
+// list_webhooks_Unauthorized_response_body
+func ValidateListWebhooksUnauthorizedResponseBody(body *ListWebhooksUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteInternalServerError builds a task service delete endpoint
-// InternalServerError error.
-func NewDeleteInternalServerError(body *DeleteInternalServerErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListWebhooksInternalServerErrorResponseBody runs the validations
+// defined on list_webhooks_InternalServerError_response_body
+func ValidateListWebhooksInternalServerErrorResponseBody(body *ListWebhooksInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// ValidateSetupUnauthorizedResponseBody runs the validations defined on
-// setup_Unauthorized_response_body
-func ValidateSetupUnauthorizedResponseBody(body *SetupUnauthorizedResponseBody) (err error) {
+// ValidateDeleteWebhookUnauthorizedResponseBody runs the validations defined
+// on delete_webhook_Unauthorized_response_body
+func ValidateDeleteWebhookUnauthorizedResponseBody(body *DeleteWebhookUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -597,9 +6071,9 @@ func ValidateSetupUnauthorizedResponseBody(body *SetupUnauthorizedResponseBody)
 	return
 }
 
-// ValidateSetupInternalServerErrorResponseBody runs the validations defined on
-// setup_InternalServerError_response_body
-func ValidateSetupInternalServerErrorResponseBody(body *SetupInternalServerErrorResponseBody) (err error) {
+// ValidateDeleteWebhookWebhookNotFoundResponseBody runs the validations
+// defined on delete_webhook_WebhookNotFound_response_body
+func ValidateDeleteWebhookWebhookNotFoundResponseBody(body *DeleteWebhookWebhookNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -621,9 +6095,9 @@ func ValidateSetupInternalServerErrorResponseBody(body *SetupInternalServerError
 	return
 }
 
-// ValidateCreateUnauthorizedResponseBody runs the validations defined on
-// create_Unauthorized_response_body
-func ValidateCreateUnauthorizedResponseBody(body *CreateUnauthorizedResponseBody) (err error) {
+// ValidateDeleteWebhookInternalServerErrorResponseBody runs the validations
+// defined on delete_webhook_InternalServerError_response_body
+func ValidateDeleteWebhookInternalServerErrorResponseBody(body *DeleteWebhookInternalServerErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -645,9 +6119,9 @@ func ValidateCreateUnauthorizedResponseBody(body *CreateUnauthorizedResponseBody
 	return
 }
 
-// ValidateCreateInternalServerErrorResponseBody runs the validations defined
-// on create_InternalServerError_response_body
-func ValidateCreateInternalServerErrorResponseBody(body *CreateInternalServerErrorResponseBody) (err error) {
+// ValidateListWebhookDeliveriesUnauthorizedResponseBody runs the validations
+// defined on list_webhook_deliveries_Unauthorized_response_body
+func ValidateListWebhookDeliveriesUnauthorizedResponseBody(body *ListWebhookDeliveriesUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -669,9 +6143,9 @@ func ValidateCreateInternalServerErrorResponseBody(body *CreateInternalServerErr
 	return
 }
 
-// ValidateListUnauthorizedResponseBody runs the validations defined on
-// list_Unauthorized_response_body
-func ValidateListUnauthorizedResponseBody(body *ListUnauthorizedResponseBody) (err error) {
+// ValidateListWebhookDeliveriesWebhookNotFoundResponseBody runs the
+// validations defined on list_webhook_deliveries_WebhookNotFound_response_body
+func ValidateListWebhookDeliveriesWebhookNotFoundResponseBody(body *ListWebhookDeliveriesWebhookNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -693,9 +6167,10 @@ func ValidateListUnauthorizedResponseBody(body *ListUnauthorizedResponseBody) (e
 	return
 }
 
-// ValidateListInternalServerErrorResponseBody runs the validations defined on
-// list_InternalServerError_response_body
-func ValidateListInternalServerErrorResponseBody(body *ListInternalServerErrorResponseBody) (err error) {
+// ValidateListWebhookDeliveriesInternalServerErrorResponseBody runs the
+// validations defined on
+// list_webhook_deliveries_InternalServerError_response_body
+func ValidateListWebhookDeliveriesInternalServerErrorResponseBody(body *ListWebhookDeliveriesInternalServerErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -717,9 +6192,9 @@ func ValidateListInternalServerErrorResponseBody(body *ListInternalServerErrorRe
 	return
 }
 
-// ValidateUpdateUnauthorizedResponseBody runs the validations defined on
-// update_Unauthorized_response_body
-func ValidateUpdateUnauthorizedResponseBody(body *UpdateUnauthorizedResponseBody) (err error) {
+// ValidateRedeliverWebhookUnauthorizedResponseBody runs the validations
+// defined on redeliver_webhook_Unauthorized_response_body
+func ValidateRedeliverWebhookUnauthorizedResponseBody(body *RedeliverWebhookUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -741,9 +6216,9 @@ func ValidateUpdateUnauthorizedResponseBody(body *UpdateUnauthorizedResponseBody
 	return
 }
 
-// ValidateUpdateTaskNotFoundResponseBody runs the validations defined on
-// update_TaskNotFound_response_body
-func ValidateUpdateTaskNotFoundResponseBody(body *UpdateTaskNotFoundResponseBody) (err error) {
+// ValidateRedeliverWebhookDeliveryNotFoundResponseBody runs the validations
+// defined on redeliver_webhook_DeliveryNotFound_response_body
+func ValidateRedeliverWebhookDeliveryNotFoundResponseBody(body *RedeliverWebhookDeliveryNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -765,9 +6240,9 @@ func ValidateUpdateTaskNotFoundResponseBody(body *UpdateTaskNotFoundResponseBody
 	return
 }
 
-// ValidateUpdateInternalServerErrorResponseBody runs the validations defined
-// on update_InternalServerError_response_body
-func ValidateUpdateInternalServerErrorResponseBody(body *UpdateInternalServerErrorResponseBody) (err error) {
+// ValidateRedeliverWebhookInternalServerErrorResponseBody runs the validations
+// defined on redeliver_webhook_InternalServerError_response_body
+func ValidateRedeliverWebhookInternalServerErrorResponseBody(body *RedeliverWebhookInternalServerErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -789,9 +6264,9 @@ func ValidateUpdateInternalServerErrorResponseBody(body *UpdateInternalServerErr
 	return
 }
 
-// ValidateDeleteUnauthorizedResponseBody runs the validations defined on
-// delete_Unauthorized_response_body
-func ValidateDeleteUnauthorizedResponseBody(body *DeleteUnauthorizedResponseBody) (err error) {
+// ValidateSetAutoStopBadRequestResponseBody runs the validations defined on
+// set_auto_stop_BadRequest_response_body
+func ValidateSetAutoStopBadRequestResponseBody(body *SetAutoStopBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -813,9 +6288,9 @@ func ValidateDeleteUnauthorizedResponseBody(body *DeleteUnauthorizedResponseBody
 	return
 }
 
-// ValidateDeleteTaskNotFoundResponseBody runs the validations defined on
-// delete_TaskNotFound_response_body
-func ValidateDeleteTaskNotFoundResponseBody(body *DeleteTaskNotFoundResponseBody) (err error) {
+// ValidateSetAutoStopUnauthorizedResponseBody runs the validations defined on
+// set_auto_stop_Unauthorized_response_body
+func ValidateSetAutoStopUnauthorizedResponseBody(body *SetAutoStopUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -837,9 +6312,9 @@ func ValidateDeleteTaskNotFoundResponseBody(body *DeleteTaskNotFoundResponseBody
 	return
 }
 
-// ValidateDeleteInternalServerErrorResponseBody runs the validations defined
-// on delete_InternalServerError_response_body
-func ValidateDeleteInternalServerErrorResponseBody(body *DeleteInternalServerErrorResponseBody) (err error) {
+// ValidateSetAutoStopInternalServerErrorResponseBody runs the validations
+// defined on set_auto_stop_InternalServerError_response_body
+func ValidateSetAutoStopInternalServerErrorResponseBody(body *SetAutoStopInternalServerErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -875,3 +6350,295 @@ func ValidateCreatetaskoutputResponse(body *CreatetaskoutputResponse) (err error
 	}
 	return
 }
+
+// ValidateEstimateStatsResponseBody runs the validations defined on
+// EstimateStatsResponseBody
+func ValidateEstimateStatsResponseBody(body *EstimateStatsResponseBody) (err error) {
+	if body.Count == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+	}
+	if body.MedianRatio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("median_ratio", "body"))
+	}
+	if body.MeanAccuracy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mean_accuracy", "body"))
+	}
+	if body.MedianOverrun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("median_overrun", "body"))
+	}
+	return
+}
+
+// ValidateEstimateGroupResponseBody runs the validations defined on
+// EstimateGroupResponseBody
+func ValidateEstimateGroupResponseBody(body *EstimateGroupResponseBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Stats == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stats", "body"))
+	}
+	if body.Stats != nil {
+		if err2 := ValidateEstimateStatsResponseBody(body.Stats); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateLifecycleTaskResponseBody runs the validations defined on
+// LifecycleTaskResponseBody
+func ValidateLifecycleTaskResponseBody(body *LifecycleTaskResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Title == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	}
+	if body.IsLeaf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("is_leaf", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.CompletedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("completed_at", "body"))
+	}
+	if body.Reopens == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reopens", "body"))
+	}
+	if body.LeadTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lead_time", "body"))
+	}
+	return
+}
+
+// ValidateFlowStatsResponseBody runs the validations defined on
+// FlowStatsResponseBody
+func ValidateFlowStatsResponseBody(body *FlowStatsResponseBody) (err error) {
+	if body.Count == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+	}
+	if body.Started == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started", "body"))
+	}
+	if body.Reopens == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reopens", "body"))
+	}
+	if body.MedianCycleTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("median_cycle_time", "body"))
+	}
+	if body.MeanCycleTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mean_cycle_time", "body"))
+	}
+	if body.MedianLeadTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("median_lead_time", "body"))
+	}
+	if body.MeanLeadTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mean_lead_time", "body"))
+	}
+	return
+}
+
+// ValidateFlowGroupResponseBody runs the validations defined on
+// FlowGroupResponseBody
+func ValidateFlowGroupResponseBody(body *FlowGroupResponseBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Stats == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stats", "body"))
+	}
+	if body.Stats != nil {
+		if err2 := ValidateFlowStatsResponseBody(body.Stats); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateSessionOutputResponse runs the validations defined on
+// SessionOutputResponse
+func ValidateSessionOutputResponse(body *SessionOutputResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.TaskID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("task_id", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.EndedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ended_at", "body"))
+	}
+	if body.Auto == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("auto", "body"))
+	}
+	return
+}
+
+// ValidateBurndownPointResponseBody runs the validations defined on
+// BurndownPointResponseBody
+func ValidateBurndownPointResponseBody(body *BurndownPointResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.RemainingTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("remaining_time", "body"))
+	}
+	if body.CompletedTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("completed_time", "body"))
+	}
+	if body.ActualTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actual_time", "body"))
+	}
+	if body.Open == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("open", "body"))
+	}
+	if body.Done == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("done", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateRecomputeOutputResponseBody runs the validations defined on
+// RecomputeOutputResponseBody
+func ValidateRecomputeOutputResponseBody(body *RecomputeOutputResponseBody) (err error) {
+	if body.Created == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created", "body"))
+	}
+	if body.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "body"))
+	}
+	if body.Deleted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deleted", "body"))
+	}
+	return
+}
+
+// ValidateProjectionChangeResponseBody runs the validations defined on
+// ProjectionChangeResponseBody
+func ValidateProjectionChangeResponseBody(body *ProjectionChangeResponseBody) (err error) {
+	if body.Projection == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("projection", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.From == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from", "body"))
+	}
+	if body.To == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("to", "body"))
+	}
+	if body.Projection != nil {
+		if !(*body.Projection == "extra" || *body.Projection == "trace") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.projection", *body.Projection, []any{"extra", "trace"}))
+		}
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "create" || *body.Kind == "reparent" || *body.Kind == "delete" || *body.Kind == "delete_session" || *body.Kind == "leaf" || *body.Kind == "status" || *body.Kind == "actual") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"create", "reparent", "delete", "delete_session", "leaf", "status", "actual"}))
+		}
+	}
+	return
+}
+
+// ValidateWorkflowStatusResponseBody runs the validations defined on
+// WorkflowStatusResponseBody
+func ValidateWorkflowStatusResponseBody(body *WorkflowStatusResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Category == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("category", "body"))
+	}
+	if body.Name != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.name", *body.Name, "^[a-z][a-z0-9_-]{0,31}$"))
+	}
+	if body.Category != nil {
+		if !(*body.Category == "todo" || *body.Category == "doing" || *body.Category == "done") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.category", *body.Category, []any{"todo", "doing", "done"}))
+		}
+	}
+	return
+}
+
+// ValidateWorkflowTransitionResponseBody runs the validations defined on
+// WorkflowTransitionResponseBody
+func ValidateWorkflowTransitionResponseBody(body *WorkflowTransitionResponseBody) (err error) {
+	if body.From == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from", "body"))
+	}
+	if body.To == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("to", "body"))
+	}
+	return
+}
+
+// ValidateWorkflowStatusRequestBody runs the validations defined on
+// WorkflowStatusRequestBody
+func ValidateWorkflowStatusRequestBody(body *WorkflowStatusRequestBody) (err error) {
+	err = goa.MergeErrors(err, goa.ValidatePattern("body.name", body.Name, "^[a-z][a-z0-9_-]{0,31}$"))
+	if !(body.Category == "todo" || body.Category == "doing" || body.Category == "done") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.category", body.Category, []any{"todo", "doing", "done"}))
+	}
+	return
+}
+
+// ValidateWebhookOutputResponse runs the validations defined on
+// WebhookOutputResponse
+func ValidateWebhookOutputResponse(body *WebhookOutputResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.Events == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("events", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	return
+}
+
+// ValidateWebhookDeliveryResponse runs the validations defined on
+// WebhookDeliveryResponse
+func ValidateWebhookDeliveryResponse(body *WebhookDeliveryResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.WebhookID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("webhook_id", "body"))
+	}
+	if body.Event == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event", "body"))
+	}
+	if body.Payload == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("payload", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "delivered" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "delivered", "failed"}))
+		}
+	}
+	return
+}
@@ -344,6 +344,19 @@ func EncodeUpdateError(encoder func(context.Context, http.ResponseWriter) goahtt
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "Unauthorized":
 			var res *goa.ServiceError
 			errors.As(v, &res)
@@ -370,6 +383,19 @@ func EncodeUpdateError(encoder func(context.Context, http.ResponseWriter) goahtt
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
+		case "Conflict":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
 		case "InternalServerError":
 			var res *goa.ServiceError
 			errors.As(v, &res)
@@ -478,20 +504,2612 @@ func EncodeDeleteError(encoder func(context.Context, http.ResponseWriter) goahtt
 	}
 }
 
-// marshalTaskviewsCreatetaskoutputViewToCreatetaskoutputResponse builds a
-// value of type *CreatetaskoutputResponse from a value of type
-// *taskviews.CreatetaskoutputView.
-func marshalTaskviewsCreatetaskoutputViewToCreatetaskoutputResponse(v *taskviews.CreatetaskoutputView) *CreatetaskoutputResponse {
-	res := &CreatetaskoutputResponse{
-		ID:            *v.ID,
-		ParentID:      v.ParentID,
-		Title:         *v.Title,
-		CreatedAt:     *v.CreatedAt,
-		EstimatedTime: v.EstimatedTime,
-		ActualTime:    v.ActualTime,
-		StartedAt:     v.StartedAt,
-		IsLeaf:        v.IsLeaf,
-		Status:        v.Status,
+// EncodeCalendarTokenResponse returns an encoder for responses returned by the
+// task calendar_token endpoint.
+func EncodeCalendarTokenResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.CalendarTokenOutput)
+		enc := encoder(ctx, w)
+		body := NewCalendarTokenResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCalendarTokenRequest returns a decoder for requests sent to the task
+// calendar_token endpoint.
+func DecodeCalendarTokenRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.CalendarTokenPayload, error) {
+	return func(r *http.Request) (*task.CalendarTokenPayload, error) {
+		var (
+			authorization string
+			err           error
+		)
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCalendarTokenPayload(authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeCalendarTokenError returns an encoder for errors returned by the
+// calendar_token task endpoint.
+func EncodeCalendarTokenError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCalendarTokenUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCalendarTokenInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCalendarResponse returns an encoder for responses returned by the task
+// calendar endpoint.
+func EncodeCalendarResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.CalendarResult)
+		w.Header().Set("Content-Type", res.ContentType)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeCalendarRequest returns a decoder for requests sent to the task
+// calendar endpoint.
+func DecodeCalendarRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.CalendarPayload, error) {
+	return func(r *http.Request) (*task.CalendarPayload, error) {
+		var (
+			token      string
+			dueAsEvent *bool
+			err        error
+		)
+		qp := r.URL.Query()
+		token = qp.Get("token")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "query string"))
+		}
+		{
+			dueAsEventRaw := qp.Get("due_as_event")
+			if dueAsEventRaw != "" {
+				v, err2 := strconv.ParseBool(dueAsEventRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("due_as_event", dueAsEventRaw, "boolean"))
+				}
+				dueAsEvent = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCalendarPayload(token, dueAsEvent)
+
+		return payload, nil
+	}
+}
+
+// EncodeCalendarError returns an encoder for errors returned by the calendar
+// task endpoint.
+func EncodeCalendarError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCalendarUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCalendarInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeTimesheetResponse returns an encoder for responses returned by the
+// task timesheet endpoint.
+func EncodeTimesheetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.TimesheetResult)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/csv")
+		w.Header().Set("Content-Type", res.ContentType)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeTimesheetRequest returns a decoder for requests sent to the task
+// timesheet endpoint.
+func DecodeTimesheetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.TimesheetPayload, error) {
+	return func(r *http.Request) (*task.TimesheetPayload, error) {
+		var (
+			from          *string
+			to            *string
+			timezone      string
+			parentID      *string
+			tag           *string
+			round         int
+			format        string
+			authorization string
+			err           error
+		)
+		qp := r.URL.Query()
+		fromRaw := qp.Get("from")
+		if fromRaw != "" {
+			from = &fromRaw
+		}
+		if from != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDate))
+		}
+		toRaw := qp.Get("to")
+		if toRaw != "" {
+			to = &toRaw
+		}
+		if to != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDate))
+		}
+		timezoneRaw := qp.Get("timezone")
+		if timezoneRaw != "" {
+			timezone = timezoneRaw
+		} else {
+			timezone = "UTC"
+		}
+		parentIDRaw := qp.Get("parent_id")
+		if parentIDRaw != "" {
+			parentID = &parentIDRaw
+		}
+		tagRaw := qp.Get("tag")
+		if tagRaw != "" {
+			tag = &tagRaw
+		}
+		{
+			roundRaw := qp.Get("round")
+			if roundRaw != "" {
+				v, err2 := strconv.ParseInt(roundRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("round", roundRaw, "integer"))
+				}
+				round = int(v)
+			}
+		}
+		if !(round == 0 || round == 6 || round == 15) {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("round", round, []any{0, 6, 15}))
+		}
+		formatRaw := qp.Get("format")
+		if formatRaw != "" {
+			format = formatRaw
+		} else {
+			format = "csv"
+		}
+		if !(format == "csv" || format == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"csv", "json"}))
+		}
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewTimesheetPayload(from, to, timezone, parentID, tag, round, format, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeTimesheetError returns an encoder for errors returned by the timesheet
+// task endpoint.
+func EncodeTimesheetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTimesheetBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTimesheetUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "TaskNotFound":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTimesheetTaskNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTimesheetInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeEstimatesResponse returns an encoder for responses returned by the
+// task estimates endpoint.
+func EncodeEstimatesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.EstimatesOutput)
+		enc := encoder(ctx, w)
+		body := NewEstimatesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeEstimatesRequest returns a decoder for requests sent to the task
+// estimates endpoint.
+func DecodeEstimatesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.EstimatesPayload, error) {
+	return func(r *http.Request) (*task.EstimatesPayload, error) {
+		var (
+			parentID      *string
+			period        string
+			authorization string
+			err           error
+		)
+		qp := r.URL.Query()
+		parentIDRaw := qp.Get("parent_id")
+		if parentIDRaw != "" {
+			parentID = &parentIDRaw
+		}
+		periodRaw := qp.Get("period")
+		if periodRaw != "" {
+			period = periodRaw
+		} else {
+			period = "week"
+		}
+		if !(period == "week" || period == "month") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("period", period, []any{"week", "month"}))
+		}
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewEstimatesPayload(parentID, period, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeEstimatesError returns an encoder for errors returned by the estimates
+// task endpoint.
+func EncodeEstimatesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewEstimatesUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "TaskNotFound":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewEstimatesTaskNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewEstimatesInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeLifecycleResponse returns an encoder for responses returned by the
+// task lifecycle endpoint.
+func EncodeLifecycleResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.LifecycleOutput)
+		enc := encoder(ctx, w)
+		body := NewLifecycleResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeLifecycleRequest returns a decoder for requests sent to the task
+// lifecycle endpoint.
+func DecodeLifecycleRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.LifecyclePayload, error) {
+	return func(r *http.Request) (*task.LifecyclePayload, error) {
+		var (
+			parentID      *string
+			from          *string
+			to            *string
+			timezone      string
+			authorization string
+			err           error
+		)
+		qp := r.URL.Query()
+		parentIDRaw := qp.Get("parent_id")
+		if parentIDRaw != "" {
+			parentID = &parentIDRaw
+		}
+		fromRaw := qp.Get("from")
+		if fromRaw != "" {
+			from = &fromRaw
+		}
+		if from != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDate))
+		}
+		toRaw := qp.Get("to")
+		if toRaw != "" {
+			to = &toRaw
+		}
+		if to != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDate))
+		}
+		timezoneRaw := qp.Get("timezone")
+		if timezoneRaw != "" {
+			timezone = timezoneRaw
+		} else {
+			timezone = "UTC"
+		}
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewLifecyclePayload(parentID, from, to, timezone, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeLifecycleError returns an encoder for errors returned by the lifecycle
+// task endpoint.
+func EncodeLifecycleError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewLifecycleBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewLifecycleUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "TaskNotFound":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewLifecycleTaskNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewLifecycleInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSessionsResponse returns an encoder for responses returned by the task
+// sessions endpoint.
+func EncodeSessionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*task.SessionOutput)
+		enc := encoder(ctx, w)
+		body := NewSessionsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSessionsRequest returns a decoder for requests sent to the task
+// sessions endpoint.
+func DecodeSessionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.SessionsPayload, error) {
+	return func(r *http.Request) (*task.SessionsPayload, error) {
+		var (
+			taskID        *string
+			authorization string
+			err           error
+		)
+		taskIDRaw := r.URL.Query().Get("task_id")
+		if taskIDRaw != "" {
+			taskID = &taskIDRaw
+		}
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewSessionsPayload(taskID, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeSessionsError returns an encoder for errors returned by the sessions
+// task endpoint.
+func EncodeSessionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSessionsUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "TaskNotFound":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSessionsTaskNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSessionsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeAddSessionResponse returns an encoder for responses returned by the
+// task add_session endpoint.
+func EncodeAddSessionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.SessionOutput)
+		enc := encoder(ctx, w)
+		body := NewAddSessionResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeAddSessionRequest returns a decoder for requests sent to the task
+// add_session endpoint.
+func DecodeAddSessionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.AddSessionPayload, error) {
+	return func(r *http.Request) (*task.AddSessionPayload, error) {
+		var (
+			body AddSessionRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateAddSessionRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			taskID        string
+			authorization string
+
+			params = mux.Vars(r)
+		)
+		taskID = params["task_id"]
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewAddSessionPayload(&body, taskID, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeAddSessionError returns an encoder for errors returned by the
+// add_session task endpoint.
+func EncodeAddSessionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddSessionBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddSessionUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "TaskNotFound":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddSessionTaskNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddSessionConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddSessionInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateSessionResponse returns an encoder for responses returned by the
+// task update_session endpoint.
+func EncodeUpdateSessionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.SessionOutput)
+		enc := encoder(ctx, w)
+		body := NewUpdateSessionResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateSessionRequest returns a decoder for requests sent to the task
+// update_session endpoint.
+func DecodeUpdateSessionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.UpdateSessionPayload, error) {
+	return func(r *http.Request) (*task.UpdateSessionPayload, error) {
+		var (
+			body UpdateSessionRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateSessionRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			sessionID     string
+			authorization string
+
+			params = mux.Vars(r)
+		)
+		sessionID = params["session_id"]
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateSessionPayload(&body, sessionID, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateSessionError returns an encoder for errors returned by the
+// update_session task endpoint.
+func EncodeUpdateSessionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateSessionBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateSessionUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "SessionNotFound":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateSessionSessionNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateSessionConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateSessionInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteSessionResponse returns an encoder for responses returned by the
+// task delete_session endpoint.
+func EncodeDeleteSessionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeDeleteSessionRequest returns a decoder for requests sent to the task
+// delete_session endpoint.
+func DecodeDeleteSessionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.DeleteSessionPayload, error) {
+	return func(r *http.Request) (*task.DeleteSessionPayload, error) {
+		var (
+			sessionID     string
+			authorization string
+			err           error
+
+			params = mux.Vars(r)
+		)
+		sessionID = params["session_id"]
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteSessionPayload(sessionID, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteSessionError returns an encoder for errors returned by the
+// delete_session task endpoint.
+func EncodeDeleteSessionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteSessionUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "SessionNotFound":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteSessionSessionNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteSessionInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeTrackProjectResponse returns an encoder for responses returned by the
+// task track_project endpoint.
+func EncodeTrackProjectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeTrackProjectRequest returns a decoder for requests sent to the task
+// track_project endpoint.
+func DecodeTrackProjectRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.TrackProjectPayload, error) {
+	return func(r *http.Request) (*task.TrackProjectPayload, error) {
+		var (
+			body TrackProjectRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+
+		var (
+			taskID        string
+			authorization string
+
+			params = mux.Vars(r)
+		)
+		taskID = params["task_id"]
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewTrackProjectPayload(&body, taskID, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeTrackProjectError returns an encoder for errors returned by the
+// track_project task endpoint.
+func EncodeTrackProjectError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTrackProjectBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTrackProjectUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "TaskNotFound":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTrackProjectTaskNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTrackProjectInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUntrackProjectResponse returns an encoder for responses returned by
+// the task untrack_project endpoint.
+func EncodeUntrackProjectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeUntrackProjectRequest returns a decoder for requests sent to the task
+// untrack_project endpoint.
+func DecodeUntrackProjectRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.UntrackProjectPayload, error) {
+	return func(r *http.Request) (*task.UntrackProjectPayload, error) {
+		var (
+			taskID        string
+			authorization string
+			err           error
+
+			params = mux.Vars(r)
+		)
+		taskID = params["task_id"]
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUntrackProjectPayload(taskID, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeUntrackProjectError returns an encoder for errors returned by the
+// untrack_project task endpoint.
+func EncodeUntrackProjectError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUntrackProjectUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "ProjectNotFound":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUntrackProjectProjectNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUntrackProjectInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeBurndownResponse returns an encoder for responses returned by the task
+// burndown endpoint.
+func EncodeBurndownResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.BurndownOutput)
+		enc := encoder(ctx, w)
+		body := NewBurndownResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeBurndownRequest returns a decoder for requests sent to the task
+// burndown endpoint.
+func DecodeBurndownRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.BurndownPayload, error) {
+	return func(r *http.Request) (*task.BurndownPayload, error) {
+		var (
+			taskID        string
+			authorization string
+			err           error
+
+			params = mux.Vars(r)
+		)
+		taskID = params["task_id"]
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewBurndownPayload(taskID, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeBurndownError returns an encoder for errors returned by the burndown
+// task endpoint.
+func EncodeBurndownError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBurndownUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "ProjectNotFound":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBurndownProjectNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBurndownInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRecomputeResponse returns an encoder for responses returned by the
+// task recompute endpoint.
+func EncodeRecomputeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.RecomputeOutput)
+		enc := encoder(ctx, w)
+		body := NewRecomputeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRecomputeRequest returns a decoder for requests sent to the task
+// recompute endpoint.
+func DecodeRecomputeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.RecomputePayload, error) {
+	return func(r *http.Request) (*task.RecomputePayload, error) {
+		var (
+			authorization string
+			err           error
+		)
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRecomputePayload(authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeRecomputeError returns an encoder for errors returned by the recompute
+// task endpoint.
+func EncodeRecomputeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRecomputeUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRecomputeInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeStreamResponse returns an encoder for responses returned by the task
+// stream endpoint.
+func EncodeStreamResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.StreamEvent)
+		enc := encoder(ctx, w)
+		body := NewStreamResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeStreamRequest returns a decoder for requests sent to the task stream
+// endpoint.
+func DecodeStreamRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.StreamPayload, error) {
+	return func(r *http.Request) (*task.StreamPayload, error) {
+		var (
+			authorization string
+			lastEventID   *string
+			err           error
+		)
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		lastEventIDRaw := r.Header.Get("Last-Event-ID")
+		if lastEventIDRaw != "" {
+			lastEventID = &lastEventIDRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewStreamPayload(authorization, lastEventID)
+
+		return payload, nil
+	}
+}
+
+// EncodeStreamError returns an encoder for errors returned by the stream task
+// endpoint.
+func EncodeStreamError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewStreamUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewStreamInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRebuildProjectionsResponse returns an encoder for responses returned
+// by the task rebuild_projections endpoint.
+func EncodeRebuildProjectionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.RebuildProjectionsOutput)
+		enc := encoder(ctx, w)
+		body := NewRebuildProjectionsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRebuildProjectionsRequest returns a decoder for requests sent to the
+// task rebuild_projections endpoint.
+func DecodeRebuildProjectionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.RebuildProjectionsPayload, error) {
+	return func(r *http.Request) (*task.RebuildProjectionsPayload, error) {
+		var (
+			dryRun        bool
+			authorization string
+			err           error
+		)
+		{
+			dryRunRaw := r.URL.Query().Get("dry_run")
+			if dryRunRaw != "" {
+				v, err2 := strconv.ParseBool(dryRunRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("dry_run", dryRunRaw, "boolean"))
+				}
+				dryRun = v
+			}
+		}
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRebuildProjectionsPayload(dryRun, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeRebuildProjectionsError returns an encoder for errors returned by the
+// rebuild_projections task endpoint.
+func EncodeRebuildProjectionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRebuildProjectionsUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRebuildProjectionsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetWorkflowResponse returns an encoder for responses returned by the
+// task get_workflow endpoint.
+func EncodeGetWorkflowResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.Workflow)
+		enc := encoder(ctx, w)
+		body := NewGetWorkflowResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetWorkflowRequest returns a decoder for requests sent to the task
+// get_workflow endpoint.
+func DecodeGetWorkflowRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.GetWorkflowPayload, error) {
+	return func(r *http.Request) (*task.GetWorkflowPayload, error) {
+		var (
+			authorization string
+			err           error
+		)
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetWorkflowPayload(authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetWorkflowError returns an encoder for errors returned by the
+// get_workflow task endpoint.
+func EncodeGetWorkflowError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetWorkflowUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetWorkflowInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSetWorkflowResponse returns an encoder for responses returned by the
+// task set_workflow endpoint.
+func EncodeSetWorkflowResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.Workflow)
+		enc := encoder(ctx, w)
+		body := NewSetWorkflowResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSetWorkflowRequest returns a decoder for requests sent to the task
+// set_workflow endpoint.
+func DecodeSetWorkflowRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.SetWorkflowPayload, error) {
+	return func(r *http.Request) (*task.SetWorkflowPayload, error) {
+		var (
+			body SetWorkflowRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateSetWorkflowRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			authorization string
+		)
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewSetWorkflowPayload(&body, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeSetWorkflowError returns an encoder for errors returned by the
+// set_workflow task endpoint.
+func EncodeSetWorkflowError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSetWorkflowBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSetWorkflowUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSetWorkflowInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAutoStopResponse returns an encoder for responses returned by the
+// task get_auto_stop endpoint.
+func EncodeGetAutoStopResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.AutoStopPolicy)
+		enc := encoder(ctx, w)
+		body := NewGetAutoStopResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAutoStopRequest returns a decoder for requests sent to the task
+// get_auto_stop endpoint.
+func DecodeGetAutoStopRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.GetAutoStopPayload, error) {
+	return func(r *http.Request) (*task.GetAutoStopPayload, error) {
+		var (
+			authorization string
+			err           error
+		)
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetAutoStopPayload(authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAutoStopError returns an encoder for errors returned by the
+// get_auto_stop task endpoint.
+func EncodeGetAutoStopError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetAutoStopUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetAutoStopInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCreateWebhookResponse returns an encoder for responses returned by the
+// task create_webhook endpoint.
+func EncodeCreateWebhookResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.WebhookOutput)
+		enc := encoder(ctx, w)
+		body := NewCreateWebhookResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateWebhookRequest returns a decoder for requests sent to the task
+// create_webhook endpoint.
+func DecodeCreateWebhookRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.CreateWebhookPayload, error) {
+	return func(r *http.Request) (*task.CreateWebhookPayload, error) {
+		var (
+			body CreateWebhookRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateWebhookRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			authorization string
+		)
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateWebhookPayload(&body, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateWebhookError returns an encoder for errors returned by the
+// create_webhook task endpoint.
+func EncodeCreateWebhookError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateWebhookBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateWebhookUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateWebhookInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListWebhooksResponse returns an encoder for responses returned by the
+// task list_webhooks endpoint.
+func EncodeListWebhooksResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*task.WebhookOutput)
+		enc := encoder(ctx, w)
+		body := NewListWebhooksResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListWebhooksRequest returns a decoder for requests sent to the task
+// list_webhooks endpoint.
+func DecodeListWebhooksRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.ListWebhooksPayload, error) {
+	return func(r *http.Request) (*task.ListWebhooksPayload, error) {
+		var (
+			authorization string
+			err           error
+		)
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListWebhooksPayload(authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeListWebhooksError returns an encoder for errors returned by the
+// list_webhooks task endpoint.
+func EncodeListWebhooksError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhooksUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhooksInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteWebhookResponse returns an encoder for responses returned by the
+// task delete_webhook endpoint.
+func EncodeDeleteWebhookResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeDeleteWebhookRequest returns a decoder for requests sent to the task
+// delete_webhook endpoint.
+func DecodeDeleteWebhookRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.DeleteWebhookPayload, error) {
+	return func(r *http.Request) (*task.DeleteWebhookPayload, error) {
+		var (
+			webhookID     string
+			authorization string
+			err           error
+
+			params = mux.Vars(r)
+		)
+		webhookID = params["webhook_id"]
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteWebhookPayload(webhookID, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteWebhookError returns an encoder for errors returned by the
+// delete_webhook task endpoint.
+func EncodeDeleteWebhookError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteWebhookUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "WebhookNotFound":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteWebhookWebhookNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteWebhookInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListWebhookDeliveriesResponse returns an encoder for responses
+// returned by the task list_webhook_deliveries endpoint.
+func EncodeListWebhookDeliveriesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*task.WebhookDelivery)
+		enc := encoder(ctx, w)
+		body := NewListWebhookDeliveriesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListWebhookDeliveriesRequest returns a decoder for requests sent to
+// the task list_webhook_deliveries endpoint.
+func DecodeListWebhookDeliveriesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.ListWebhookDeliveriesPayload, error) {
+	return func(r *http.Request) (*task.ListWebhookDeliveriesPayload, error) {
+		var (
+			webhookID     string
+			authorization string
+			err           error
+
+			params = mux.Vars(r)
+		)
+		webhookID = params["webhook_id"]
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListWebhookDeliveriesPayload(webhookID, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeListWebhookDeliveriesError returns an encoder for errors returned by
+// the list_webhook_deliveries task endpoint.
+func EncodeListWebhookDeliveriesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhookDeliveriesUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "WebhookNotFound":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhookDeliveriesWebhookNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhookDeliveriesInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRedeliverWebhookResponse returns an encoder for responses returned by
+// the task redeliver_webhook endpoint.
+func EncodeRedeliverWebhookResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.WebhookDelivery)
+		enc := encoder(ctx, w)
+		body := NewRedeliverWebhookResponseBody(res)
+		w.WriteHeader(http.StatusAccepted)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRedeliverWebhookRequest returns a decoder for requests sent to the
+// task redeliver_webhook endpoint.
+func DecodeRedeliverWebhookRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.RedeliverWebhookPayload, error) {
+	return func(r *http.Request) (*task.RedeliverWebhookPayload, error) {
+		var (
+			webhookID     string
+			deliveryID    string
+			authorization string
+			err           error
+
+			params = mux.Vars(r)
+		)
+		webhookID = params["webhook_id"]
+		deliveryID = params["delivery_id"]
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRedeliverWebhookPayload(webhookID, deliveryID, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeRedeliverWebhookError returns an encoder for errors returned by the
+// redeliver_webhook task endpoint.
+func EncodeRedeliverWebhookError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRedeliverWebhookUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "DeliveryNotFound":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRedeliverWebhookDeliveryNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRedeliverWebhookInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSetAutoStopResponse returns an encoder for responses returned by the
+// task set_auto_stop endpoint.
+func EncodeSetAutoStopResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*task.AutoStopPolicy)
+		enc := encoder(ctx, w)
+		body := NewSetAutoStopResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSetAutoStopRequest returns a decoder for requests sent to the task
+// set_auto_stop endpoint.
+func DecodeSetAutoStopRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*task.SetAutoStopPayload, error) {
+	return func(r *http.Request) (*task.SetAutoStopPayload, error) {
+		var (
+			body SetAutoStopRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateSetAutoStopRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			authorization string
+		)
+		authorization = r.Header.Get("authorization")
+		if authorization == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewSetAutoStopPayload(&body, authorization)
+
+		return payload, nil
+	}
+}
+
+// EncodeSetAutoStopError returns an encoder for errors returned by the
+// set_auto_stop task endpoint.
+func EncodeSetAutoStopError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSetAutoStopBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSetAutoStopUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSetAutoStopInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalTaskviewsCreatetaskoutputViewToCreatetaskoutputResponse builds a
+// value of type *CreatetaskoutputResponse from a value of type
+// *taskviews.CreatetaskoutputView.
+func marshalTaskviewsCreatetaskoutputViewToCreatetaskoutputResponse(v *taskviews.CreatetaskoutputView) *CreatetaskoutputResponse {
+	res := &CreatetaskoutputResponse{
+		ID:             *v.ID,
+		ParentID:       v.ParentID,
+		Title:          *v.Title,
+		CreatedAt:      *v.CreatedAt,
+		EstimatedTime:  v.EstimatedTime,
+		ActualTime:     v.ActualTime,
+		StartedAt:      v.StartedAt,
+		DueAt:          v.DueAt,
+		IsLeaf:         v.IsLeaf,
+		Status:         v.Status,
+		Rollup:         v.Rollup,
+		FirstStartedAt: v.FirstStartedAt,
+		CompletedAt:    v.CompletedAt,
+		Reopens:        v.Reopens,
+	}
+
+	return res
+}
+
+// marshalTaskEstimateStatsToEstimateStatsResponseBody builds a value of type
+// *EstimateStatsResponseBody from a value of type *task.EstimateStats.
+func marshalTaskEstimateStatsToEstimateStatsResponseBody(v *task.EstimateStats) *EstimateStatsResponseBody {
+	res := &EstimateStatsResponseBody{
+		Count:         v.Count,
+		MedianRatio:   v.MedianRatio,
+		MeanAccuracy:  v.MeanAccuracy,
+		MedianOverrun: v.MedianOverrun,
+	}
+
+	return res
+}
+
+// marshalTaskEstimateGroupToEstimateGroupResponseBody builds a value of type
+// *EstimateGroupResponseBody from a value of type *task.EstimateGroup.
+func marshalTaskEstimateGroupToEstimateGroupResponseBody(v *task.EstimateGroup) *EstimateGroupResponseBody {
+	res := &EstimateGroupResponseBody{
+		Key: v.Key,
+	}
+	if v.Stats != nil {
+		res.Stats = marshalTaskEstimateStatsToEstimateStatsResponseBody(v.Stats)
+	}
+
+	return res
+}
+
+// marshalTaskLifecycleTaskToLifecycleTaskResponseBody builds a value of type
+// *LifecycleTaskResponseBody from a value of type *task.LifecycleTask.
+func marshalTaskLifecycleTaskToLifecycleTaskResponseBody(v *task.LifecycleTask) *LifecycleTaskResponseBody {
+	res := &LifecycleTaskResponseBody{
+		ID:             v.ID,
+		Title:          v.Title,
+		IsLeaf:         v.IsLeaf,
+		CreatedAt:      v.CreatedAt,
+		FirstStartedAt: v.FirstStartedAt,
+		CompletedAt:    v.CompletedAt,
+		Reopens:        v.Reopens,
+		CycleTime:      v.CycleTime,
+		LeadTime:       v.LeadTime,
+	}
+
+	return res
+}
+
+// marshalTaskFlowStatsToFlowStatsResponseBody builds a value of type
+// *FlowStatsResponseBody from a value of type *task.FlowStats.
+func marshalTaskFlowStatsToFlowStatsResponseBody(v *task.FlowStats) *FlowStatsResponseBody {
+	res := &FlowStatsResponseBody{
+		Count:           v.Count,
+		Started:         v.Started,
+		Reopens:         v.Reopens,
+		MedianCycleTime: v.MedianCycleTime,
+		MeanCycleTime:   v.MeanCycleTime,
+		MedianLeadTime:  v.MedianLeadTime,
+		MeanLeadTime:    v.MeanLeadTime,
+	}
+
+	return res
+}
+
+// marshalTaskFlowGroupToFlowGroupResponseBody builds a value of type
+// *FlowGroupResponseBody from a value of type *task.FlowGroup.
+func marshalTaskFlowGroupToFlowGroupResponseBody(v *task.FlowGroup) *FlowGroupResponseBody {
+	res := &FlowGroupResponseBody{
+		Key: v.Key,
+	}
+	if v.Stats != nil {
+		res.Stats = marshalTaskFlowStatsToFlowStatsResponseBody(v.Stats)
+	}
+
+	return res
+}
+
+// marshalTaskSessionOutputToSessionOutputResponse builds a value of type
+// *SessionOutputResponse from a value of type *task.SessionOutput.
+func marshalTaskSessionOutputToSessionOutputResponse(v *task.SessionOutput) *SessionOutputResponse {
+	res := &SessionOutputResponse{
+		ID:        v.ID,
+		TaskID:    v.TaskID,
+		StartedAt: v.StartedAt,
+		EndedAt:   v.EndedAt,
+		Auto:      v.Auto,
+		Note:      v.Note,
+	}
+
+	return res
+}
+
+// marshalTaskBurndownPointToBurndownPointResponseBody builds a value of type
+// *BurndownPointResponseBody from a value of type *task.BurndownPoint.
+func marshalTaskBurndownPointToBurndownPointResponseBody(v *task.BurndownPoint) *BurndownPointResponseBody {
+	res := &BurndownPointResponseBody{
+		Date:          v.Date,
+		RemainingTime: v.RemainingTime,
+		CompletedTime: v.CompletedTime,
+		ActualTime:    v.ActualTime,
+		Open:          v.Open,
+		Done:          v.Done,
+	}
+
+	return res
+}
+
+// marshalTaskRecomputeOutputToRecomputeOutputResponseBody builds a value of
+// type *RecomputeOutputResponseBody from a value of type *task.RecomputeOutput.
+func marshalTaskRecomputeOutputToRecomputeOutputResponseBody(v *task.RecomputeOutput) *RecomputeOutputResponseBody {
+	res := &RecomputeOutputResponseBody{
+		Created: v.Created,
+		Updated: v.Updated,
+		Deleted: v.Deleted,
+	}
+
+	return res
+}
+
+// marshalTaskProjectionChangeToProjectionChangeResponseBody builds a value of
+// type *ProjectionChangeResponseBody from a value of type
+// *task.ProjectionChange.
+func marshalTaskProjectionChangeToProjectionChangeResponseBody(v *task.ProjectionChange) *ProjectionChangeResponseBody {
+	res := &ProjectionChangeResponseBody{
+		Projection: v.Projection,
+		ID:         v.ID,
+		Kind:       v.Kind,
+		From:       v.From,
+		To:         v.To,
+	}
+
+	return res
+}
+
+// marshalTaskWorkflowStatusToWorkflowStatusResponseBody builds a value of type
+// *WorkflowStatusResponseBody from a value of type *task.WorkflowStatus.
+func marshalTaskWorkflowStatusToWorkflowStatusResponseBody(v *task.WorkflowStatus) *WorkflowStatusResponseBody {
+	res := &WorkflowStatusResponseBody{
+		Name:     v.Name,
+		Category: v.Category,
+	}
+
+	return res
+}
+
+// marshalTaskWorkflowTransitionToWorkflowTransitionResponseBody builds a value
+// of type *WorkflowTransitionResponseBody from a value of type
+// *task.WorkflowTransition.
+func marshalTaskWorkflowTransitionToWorkflowTransitionResponseBody(v *task.WorkflowTransition) *WorkflowTransitionResponseBody {
+	res := &WorkflowTransitionResponseBody{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// unmarshalWorkflowStatusRequestBodyToTaskWorkflowStatus builds a value of
+// type *task.WorkflowStatus from a value of type *WorkflowStatusRequestBody.
+func unmarshalWorkflowStatusRequestBodyToTaskWorkflowStatus(v *WorkflowStatusRequestBody) *task.WorkflowStatus {
+	res := &task.WorkflowStatus{
+		Name:     *v.Name,
+		Category: *v.Category,
+	}
+
+	return res
+}
+
+// unmarshalWorkflowTransitionRequestBodyToTaskWorkflowTransition builds a
+// value of type *task.WorkflowTransition from a value of type
+// *WorkflowTransitionRequestBody.
+func unmarshalWorkflowTransitionRequestBodyToTaskWorkflowTransition(v *WorkflowTransitionRequestBody) *task.WorkflowTransition {
+	res := &task.WorkflowTransition{
+		From: *v.From,
+		To:   *v.To,
+	}
+
+	return res
+}
+
+// marshalTaskWebhookOutputToWebhookOutputResponse builds a value of type
+// *WebhookOutputResponse from a value of type *task.WebhookOutput.
+func marshalTaskWebhookOutputToWebhookOutputResponse(v *task.WebhookOutput) *WebhookOutputResponse {
+	res := &WebhookOutputResponse{
+		ID:        v.ID,
+		URL:       v.URL,
+		Secret:    v.Secret,
+		CreatedAt: v.CreatedAt,
+	}
+	if v.Events != nil {
+		res.Events = make([]string, len(v.Events))
+		for i, val := range v.Events {
+			res.Events[i] = val
+		}
+	} else {
+		res.Events = []string{}
+	}
+
+	return res
+}
+
+// marshalTaskWebhookDeliveryToWebhookDeliveryResponse builds a value of type
+// *WebhookDeliveryResponse from a value of type *task.WebhookDelivery.
+func marshalTaskWebhookDeliveryToWebhookDeliveryResponse(v *task.WebhookDelivery) *WebhookDeliveryResponse {
+	res := &WebhookDeliveryResponse{
+		ID:             v.ID,
+		WebhookID:      v.WebhookID,
+		Event:          v.Event,
+		Payload:        v.Payload,
+		Status:         v.Status,
+		Attempts:       v.Attempts,
+		NextAttemptAt:  v.NextAttemptAt,
+		LastStatusCode: v.LastStatusCode,
+		LastError:      v.LastError,
+		CreatedAt:      v.CreatedAt,
+		DeliveredAt:    v.DeliveredAt,
 	}
 
 	return res
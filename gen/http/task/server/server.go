@@ -8,7 +8,9 @@
 package server
 
 import (
+	"bufio"
 	"context"
+	"io"
 	"net/http"
 
 	task "github.com/neatflowcv/focus/gen/task"
@@ -18,12 +20,36 @@ import (
 
 // Server lists the task service endpoint HTTP handlers.
 type Server struct {
-	Mounts []*MountPoint
-	Setup  http.Handler
-	Create http.Handler
-	List   http.Handler
-	Update http.Handler
-	Delete http.Handler
+	Mounts                []*MountPoint
+	Setup                 http.Handler
+	Create                http.Handler
+	List                  http.Handler
+	Update                http.Handler
+	Delete                http.Handler
+	CalendarToken         http.Handler
+	Calendar              http.Handler
+	Timesheet             http.Handler
+	Estimates             http.Handler
+	Lifecycle             http.Handler
+	Sessions              http.Handler
+	AddSession            http.Handler
+	UpdateSession         http.Handler
+	DeleteSession         http.Handler
+	TrackProject          http.Handler
+	UntrackProject        http.Handler
+	Burndown              http.Handler
+	Recompute             http.Handler
+	Stream                http.Handler
+	RebuildProjections    http.Handler
+	GetWorkflow           http.Handler
+	SetWorkflow           http.Handler
+	GetAutoStop           http.Handler
+	CreateWebhook         http.Handler
+	ListWebhooks          http.Handler
+	DeleteWebhook         http.Handler
+	ListWebhookDeliveries http.Handler
+	RedeliverWebhook      http.Handler
+	SetAutoStop           http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -58,12 +84,60 @@ func New(
 			{"List", "GET", "/focus/tasks"},
 			{"Update", "PUT", "/focus/tasks/{task_id}"},
 			{"Delete", "DELETE", "/focus/tasks/{task_id}"},
+			{"CalendarToken", "POST", "/focus/tasks/calendar/token"},
+			{"Calendar", "GET", "/focus/tasks/calendar.ics"},
+			{"Timesheet", "GET", "/focus/tasks/timesheet"},
+			{"Estimates", "GET", "/focus/tasks/analytics/estimates"},
+			{"Lifecycle", "GET", "/focus/tasks/analytics/lifecycle"},
+			{"Sessions", "GET", "/focus/tasks/sessions"},
+			{"AddSession", "POST", "/focus/tasks/{task_id}/sessions"},
+			{"UpdateSession", "PUT", "/focus/tasks/sessions/{session_id}"},
+			{"DeleteSession", "DELETE", "/focus/tasks/sessions/{session_id}"},
+			{"TrackProject", "PUT", "/focus/tasks/{task_id}/tracking"},
+			{"UntrackProject", "DELETE", "/focus/tasks/{task_id}/tracking"},
+			{"Burndown", "GET", "/focus/tasks/{task_id}/burndown"},
+			{"Recompute", "POST", "/focus/tasks/recompute"},
+			{"Stream", "GET", "/focus/tasks/stream"},
+			{"RebuildProjections", "POST", "/focus/tasks/rebuild-projections"},
+			{"GetWorkflow", "GET", "/focus/tasks/settings/workflow"},
+			{"SetWorkflow", "PUT", "/focus/tasks/settings/workflow"},
+			{"GetAutoStop", "GET", "/focus/tasks/settings/auto-stop"},
+			{"CreateWebhook", "POST", "/focus/tasks/webhooks"},
+			{"ListWebhooks", "GET", "/focus/tasks/webhooks"},
+			{"DeleteWebhook", "DELETE", "/focus/tasks/webhooks/{webhook_id}"},
+			{"ListWebhookDeliveries", "GET", "/focus/tasks/webhooks/{webhook_id}/deliveries"},
+			{"RedeliverWebhook", "POST", "/focus/tasks/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver"},
+			{"SetAutoStop", "PUT", "/focus/tasks/settings/auto-stop"},
 		},
-		Setup:  NewSetupHandler(e.Setup, mux, decoder, encoder, errhandler, formatter),
-		Create: NewCreateHandler(e.Create, mux, decoder, encoder, errhandler, formatter),
-		List:   NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
-		Update: NewUpdateHandler(e.Update, mux, decoder, encoder, errhandler, formatter),
-		Delete: NewDeleteHandler(e.Delete, mux, decoder, encoder, errhandler, formatter),
+		Setup:                 NewSetupHandler(e.Setup, mux, decoder, encoder, errhandler, formatter),
+		Create:                NewCreateHandler(e.Create, mux, decoder, encoder, errhandler, formatter),
+		List:                  NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
+		Update:                NewUpdateHandler(e.Update, mux, decoder, encoder, errhandler, formatter),
+		Delete:                NewDeleteHandler(e.Delete, mux, decoder, encoder, errhandler, formatter),
+		CalendarToken:         NewCalendarTokenHandler(e.CalendarToken, mux, decoder, encoder, errhandler, formatter),
+		Calendar:              NewCalendarHandler(e.Calendar, mux, decoder, encoder, errhandler, formatter),
+		Timesheet:             NewTimesheetHandler(e.Timesheet, mux, decoder, encoder, errhandler, formatter),
+		Estimates:             NewEstimatesHandler(e.Estimates, mux, decoder, encoder, errhandler, formatter),
+		Lifecycle:             NewLifecycleHandler(e.Lifecycle, mux, decoder, encoder, errhandler, formatter),
+		Sessions:              NewSessionsHandler(e.Sessions, mux, decoder, encoder, errhandler, formatter),
+		AddSession:            NewAddSessionHandler(e.AddSession, mux, decoder, encoder, errhandler, formatter),
+		UpdateSession:         NewUpdateSessionHandler(e.UpdateSession, mux, decoder, encoder, errhandler, formatter),
+		DeleteSession:         NewDeleteSessionHandler(e.DeleteSession, mux, decoder, encoder, errhandler, formatter),
+		TrackProject:          NewTrackProjectHandler(e.TrackProject, mux, decoder, encoder, errhandler, formatter),
+		UntrackProject:        NewUntrackProjectHandler(e.UntrackProject, mux, decoder, encoder, errhandler, formatter),
+		Burndown:              NewBurndownHandler(e.Burndown, mux, decoder, encoder, errhandler, formatter),
+		Recompute:             NewRecomputeHandler(e.Recompute, mux, decoder, encoder, errhandler, formatter),
+		Stream:                NewStreamHandler(e.Stream, mux, decoder, encoder, errhandler, formatter),
+		RebuildProjections:    NewRebuildProjectionsHandler(e.RebuildProjections, mux, decoder, encoder, errhandler, formatter),
+		GetWorkflow:           NewGetWorkflowHandler(e.GetWorkflow, mux, decoder, encoder, errhandler, formatter),
+		SetWorkflow:           NewSetWorkflowHandler(e.SetWorkflow, mux, decoder, encoder, errhandler, formatter),
+		GetAutoStop:           NewGetAutoStopHandler(e.GetAutoStop, mux, decoder, encoder, errhandler, formatter),
+		CreateWebhook:         NewCreateWebhookHandler(e.CreateWebhook, mux, decoder, encoder, errhandler, formatter),
+		ListWebhooks:          NewListWebhooksHandler(e.ListWebhooks, mux, decoder, encoder, errhandler, formatter),
+		DeleteWebhook:         NewDeleteWebhookHandler(e.DeleteWebhook, mux, decoder, encoder, errhandler, formatter),
+		ListWebhookDeliveries: NewListWebhookDeliveriesHandler(e.ListWebhookDeliveries, mux, decoder, encoder, errhandler, formatter),
+		RedeliverWebhook:      NewRedeliverWebhookHandler(e.RedeliverWebhook, mux, decoder, encoder, errhandler, formatter),
+		SetAutoStop:           NewSetAutoStopHandler(e.SetAutoStop, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -77,6 +151,30 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.List = m(s.List)
 	s.Update = m(s.Update)
 	s.Delete = m(s.Delete)
+	s.CalendarToken = m(s.CalendarToken)
+	s.Calendar = m(s.Calendar)
+	s.Timesheet = m(s.Timesheet)
+	s.Estimates = m(s.Estimates)
+	s.Lifecycle = m(s.Lifecycle)
+	s.Sessions = m(s.Sessions)
+	s.AddSession = m(s.AddSession)
+	s.UpdateSession = m(s.UpdateSession)
+	s.DeleteSession = m(s.DeleteSession)
+	s.TrackProject = m(s.TrackProject)
+	s.UntrackProject = m(s.UntrackProject)
+	s.Burndown = m(s.Burndown)
+	s.Recompute = m(s.Recompute)
+	s.Stream = m(s.Stream)
+	s.RebuildProjections = m(s.RebuildProjections)
+	s.GetWorkflow = m(s.GetWorkflow)
+	s.SetWorkflow = m(s.SetWorkflow)
+	s.GetAutoStop = m(s.GetAutoStop)
+	s.CreateWebhook = m(s.CreateWebhook)
+	s.ListWebhooks = m(s.ListWebhooks)
+	s.DeleteWebhook = m(s.DeleteWebhook)
+	s.ListWebhookDeliveries = m(s.ListWebhookDeliveries)
+	s.RedeliverWebhook = m(s.RedeliverWebhook)
+	s.SetAutoStop = m(s.SetAutoStop)
 }
 
 // MethodNames returns the methods served.
@@ -89,6 +187,30 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountListHandler(mux, h.List)
 	MountUpdateHandler(mux, h.Update)
 	MountDeleteHandler(mux, h.Delete)
+	MountCalendarTokenHandler(mux, h.CalendarToken)
+	MountCalendarHandler(mux, h.Calendar)
+	MountTimesheetHandler(mux, h.Timesheet)
+	MountEstimatesHandler(mux, h.Estimates)
+	MountLifecycleHandler(mux, h.Lifecycle)
+	MountSessionsHandler(mux, h.Sessions)
+	MountAddSessionHandler(mux, h.AddSession)
+	MountUpdateSessionHandler(mux, h.UpdateSession)
+	MountDeleteSessionHandler(mux, h.DeleteSession)
+	MountTrackProjectHandler(mux, h.TrackProject)
+	MountUntrackProjectHandler(mux, h.UntrackProject)
+	MountBurndownHandler(mux, h.Burndown)
+	MountRecomputeHandler(mux, h.Recompute)
+	MountStreamHandler(mux, h.Stream)
+	MountRebuildProjectionsHandler(mux, h.RebuildProjections)
+	MountGetWorkflowHandler(mux, h.GetWorkflow)
+	MountSetWorkflowHandler(mux, h.SetWorkflow)
+	MountGetAutoStopHandler(mux, h.GetAutoStop)
+	MountCreateWebhookHandler(mux, h.CreateWebhook)
+	MountListWebhooksHandler(mux, h.ListWebhooks)
+	MountDeleteWebhookHandler(mux, h.DeleteWebhook)
+	MountListWebhookDeliveriesHandler(mux, h.ListWebhookDeliveries)
+	MountRedeliverWebhookHandler(mux, h.RedeliverWebhook)
+	MountSetAutoStopHandler(mux, h.SetAutoStop)
 }
 
 // Mount configures the mux to serve the task endpoints.
@@ -360,3 +482,1346 @@ func NewDeleteHandler(
 		}
 	})
 }
+
+// MountCalendarTokenHandler configures the mux to serve the "task" service
+// "calendar_token" endpoint.
+func MountCalendarTokenHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/focus/tasks/calendar/token", f)
+}
+
+// NewCalendarTokenHandler creates a HTTP handler which loads the HTTP request
+// and calls the "task" service "calendar_token" endpoint.
+func NewCalendarTokenHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCalendarTokenRequest(mux, decoder)
+		encodeResponse = EncodeCalendarTokenResponse(encoder)
+		encodeError    = EncodeCalendarTokenError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "calendar_token")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCalendarHandler configures the mux to serve the "task" service
+// "calendar" endpoint.
+func MountCalendarHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/focus/tasks/calendar.ics", f)
+}
+
+// NewCalendarHandler creates a HTTP handler which loads the HTTP request and
+// calls the "task" service "calendar" endpoint.
+func NewCalendarHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCalendarRequest(mux, decoder)
+		encodeResponse = EncodeCalendarResponse(encoder)
+		encodeError    = EncodeCalendarError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "calendar")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*task.CalendarResponseData)
+		defer o.Body.Close()
+		if wt, ok := o.Body.(io.WriterTo); ok {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
+				if errhandler != nil {
+					errhandler(ctx, w, err)
+				}
+				return
+			}
+			n, err := wt.WriteTo(w)
+			if err != nil {
+				if n == 0 {
+					if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+						errhandler(ctx, w, err)
+					}
+				} else {
+					http.NewResponseController(w).Flush()
+					panic(http.ErrAbortHandler) // too late to write an error
+				}
+			}
+			return
+		}
+		// handle immediate read error like a returned error
+		buf := bufio.NewReader(o.Body)
+		if _, err := buf.Peek(1); err != nil && err != io.EOF {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if _, err := io.Copy(w, buf); err != nil {
+			http.NewResponseController(w).Flush()
+			panic(http.ErrAbortHandler) // too late to write an error
+		}
+	})
+}
+
+// MountTimesheetHandler configures the mux to serve the "task" service
+// "timesheet" endpoint.
+func MountTimesheetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/focus/tasks/timesheet", f)
+}
+
+// NewTimesheetHandler creates a HTTP handler which loads the HTTP request and
+// calls the "task" service "timesheet" endpoint.
+func NewTimesheetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeTimesheetRequest(mux, decoder)
+		encodeResponse = EncodeTimesheetResponse(encoder)
+		encodeError    = EncodeTimesheetError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "timesheet")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*task.TimesheetResponseData)
+		defer o.Body.Close()
+		if wt, ok := o.Body.(io.WriterTo); ok {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
+				if errhandler != nil {
+					errhandler(ctx, w, err)
+				}
+				return
+			}
+			n, err := wt.WriteTo(w)
+			if err != nil {
+				if n == 0 {
+					if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+						errhandler(ctx, w, err)
+					}
+				} else {
+					http.NewResponseController(w).Flush()
+					panic(http.ErrAbortHandler) // too late to write an error
+				}
+			}
+			return
+		}
+		// handle immediate read error like a returned error
+		buf := bufio.NewReader(o.Body)
+		if _, err := buf.Peek(1); err != nil && err != io.EOF {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if _, err := io.Copy(w, buf); err != nil {
+			http.NewResponseController(w).Flush()
+			panic(http.ErrAbortHandler) // too late to write an error
+		}
+	})
+}
+
+// MountEstimatesHandler configures the mux to serve the "task" service
+// "estimates" endpoint.
+func MountEstimatesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/focus/tasks/analytics/estimates", f)
+}
+
+// NewEstimatesHandler creates a HTTP handler which loads the HTTP request and
+// calls the "task" service "estimates" endpoint.
+func NewEstimatesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeEstimatesRequest(mux, decoder)
+		encodeResponse = EncodeEstimatesResponse(encoder)
+		encodeError    = EncodeEstimatesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "estimates")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountLifecycleHandler configures the mux to serve the "task" service
+// "lifecycle" endpoint.
+func MountLifecycleHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/focus/tasks/analytics/lifecycle", f)
+}
+
+// NewLifecycleHandler creates a HTTP handler which loads the HTTP request and
+// calls the "task" service "lifecycle" endpoint.
+func NewLifecycleHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeLifecycleRequest(mux, decoder)
+		encodeResponse = EncodeLifecycleResponse(encoder)
+		encodeError    = EncodeLifecycleError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "lifecycle")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSessionsHandler configures the mux to serve the "task" service
+// "sessions" endpoint.
+func MountSessionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/focus/tasks/sessions", f)
+}
+
+// NewSessionsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "task" service "sessions" endpoint.
+func NewSessionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSessionsRequest(mux, decoder)
+		encodeResponse = EncodeSessionsResponse(encoder)
+		encodeError    = EncodeSessionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "sessions")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountAddSessionHandler configures the mux to serve the "task" service
+// "add_session" endpoint.
+func MountAddSessionHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/focus/tasks/{task_id}/sessions", f)
+}
+
+// NewAddSessionHandler creates a HTTP handler which loads the HTTP request and
+// calls the "task" service "add_session" endpoint.
+func NewAddSessionHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAddSessionRequest(mux, decoder)
+		encodeResponse = EncodeAddSessionResponse(encoder)
+		encodeError    = EncodeAddSessionError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "add_session")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateSessionHandler configures the mux to serve the "task" service
+// "update_session" endpoint.
+func MountUpdateSessionHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/focus/tasks/sessions/{session_id}", f)
+}
+
+// NewUpdateSessionHandler creates a HTTP handler which loads the HTTP request
+// and calls the "task" service "update_session" endpoint.
+func NewUpdateSessionHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateSessionRequest(mux, decoder)
+		encodeResponse = EncodeUpdateSessionResponse(encoder)
+		encodeError    = EncodeUpdateSessionError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update_session")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteSessionHandler configures the mux to serve the "task" service
+// "delete_session" endpoint.
+func MountDeleteSessionHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/focus/tasks/sessions/{session_id}", f)
+}
+
+// NewDeleteSessionHandler creates a HTTP handler which loads the HTTP request
+// and calls the "task" service "delete_session" endpoint.
+func NewDeleteSessionHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteSessionRequest(mux, decoder)
+		encodeResponse = EncodeDeleteSessionResponse(encoder)
+		encodeError    = EncodeDeleteSessionError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete_session")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountTrackProjectHandler configures the mux to serve the "task" service
+// "track_project" endpoint.
+func MountTrackProjectHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/focus/tasks/{task_id}/tracking", f)
+}
+
+// NewTrackProjectHandler creates a HTTP handler which loads the HTTP request
+// and calls the "task" service "track_project" endpoint.
+func NewTrackProjectHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeTrackProjectRequest(mux, decoder)
+		encodeResponse = EncodeTrackProjectResponse(encoder)
+		encodeError    = EncodeTrackProjectError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "track_project")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUntrackProjectHandler configures the mux to serve the "task" service
+// "untrack_project" endpoint.
+func MountUntrackProjectHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/focus/tasks/{task_id}/tracking", f)
+}
+
+// NewUntrackProjectHandler creates a HTTP handler which loads the HTTP request
+// and calls the "task" service "untrack_project" endpoint.
+func NewUntrackProjectHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUntrackProjectRequest(mux, decoder)
+		encodeResponse = EncodeUntrackProjectResponse(encoder)
+		encodeError    = EncodeUntrackProjectError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "untrack_project")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountBurndownHandler configures the mux to serve the "task" service
+// "burndown" endpoint.
+func MountBurndownHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/focus/tasks/{task_id}/burndown", f)
+}
+
+// NewBurndownHandler creates a HTTP handler which loads the HTTP request and
+// calls the "task" service "burndown" endpoint.
+func NewBurndownHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBurndownRequest(mux, decoder)
+		encodeResponse = EncodeBurndownResponse(encoder)
+		encodeError    = EncodeBurndownError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "burndown")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRecomputeHandler configures the mux to serve the "task" service
+// "recompute" endpoint.
+func MountRecomputeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/focus/tasks/recompute", f)
+}
+
+// NewRecomputeHandler creates a HTTP handler which loads the HTTP request and
+// calls the "task" service "recompute" endpoint.
+func NewRecomputeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRecomputeRequest(mux, decoder)
+		encodeResponse = EncodeRecomputeResponse(encoder)
+		encodeError    = EncodeRecomputeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "recompute")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountStreamHandler configures the mux to serve the "task" service "stream"
+// endpoint.
+func MountStreamHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/focus/tasks/stream", f)
+}
+
+// NewStreamHandler creates a HTTP handler which loads the HTTP request and
+// calls the "task" service "stream" endpoint.
+func NewStreamHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest = DecodeStreamRequest(mux, decoder)
+		encodeError   = EncodeStreamError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "stream")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		v := &task.StreamEndpointInput{
+			Stream: &StreamServerStream{
+				w: w,
+				r: r,
+			},
+			Payload: payload,
+		}
+		_, err = endpoint(ctx, v)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+	})
+}
+
+// MountRebuildProjectionsHandler configures the mux to serve the "task"
+// service "rebuild_projections" endpoint.
+func MountRebuildProjectionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/focus/tasks/rebuild-projections", f)
+}
+
+// NewRebuildProjectionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "task" service "rebuild_projections" endpoint.
+func NewRebuildProjectionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRebuildProjectionsRequest(mux, decoder)
+		encodeResponse = EncodeRebuildProjectionsResponse(encoder)
+		encodeError    = EncodeRebuildProjectionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "rebuild_projections")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetWorkflowHandler configures the mux to serve the "task" service
+// "get_workflow" endpoint.
+func MountGetWorkflowHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/focus/tasks/settings/workflow", f)
+}
+
+// NewGetWorkflowHandler creates a HTTP handler which loads the HTTP request
+// and calls the "task" service "get_workflow" endpoint.
+func NewGetWorkflowHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetWorkflowRequest(mux, decoder)
+		encodeResponse = EncodeGetWorkflowResponse(encoder)
+		encodeError    = EncodeGetWorkflowError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_workflow")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSetWorkflowHandler configures the mux to serve the "task" service
+// "set_workflow" endpoint.
+func MountSetWorkflowHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/focus/tasks/settings/workflow", f)
+}
+
+// NewSetWorkflowHandler creates a HTTP handler which loads the HTTP request
+// and calls the "task" service "set_workflow" endpoint.
+func NewSetWorkflowHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSetWorkflowRequest(mux, decoder)
+		encodeResponse = EncodeSetWorkflowResponse(encoder)
+		encodeError    = EncodeSetWorkflowError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "set_workflow")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetAutoStopHandler configures the mux to serve the "task" service
+// "get_auto_stop" endpoint.
+func MountGetAutoStopHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/focus/tasks/settings/auto-stop", f)
+}
+
+// NewGetAutoStopHandler creates a HTTP handler which loads the HTTP request
+// and calls the "task" service "get_auto_stop" endpoint.
+func NewGetAutoStopHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetAutoStopRequest(mux, decoder)
+		encodeResponse = EncodeGetAutoStopResponse(encoder)
+		encodeError    = EncodeGetAutoStopError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_auto_stop")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCreateWebhookHandler configures the mux to serve the "task" service
+// "create_webhook" endpoint.
+func MountCreateWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/focus/tasks/webhooks", f)
+}
+
+// NewCreateWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "task" service "create_webhook" endpoint.
+func NewCreateWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateWebhookRequest(mux, decoder)
+		encodeResponse = EncodeCreateWebhookResponse(encoder)
+		encodeError    = EncodeCreateWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create_webhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListWebhooksHandler configures the mux to serve the "task" service
+// "list_webhooks" endpoint.
+func MountListWebhooksHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/focus/tasks/webhooks", f)
+}
+
+// NewListWebhooksHandler creates a HTTP handler which loads the HTTP request
+// and calls the "task" service "list_webhooks" endpoint.
+func NewListWebhooksHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListWebhooksRequest(mux, decoder)
+		encodeResponse = EncodeListWebhooksResponse(encoder)
+		encodeError    = EncodeListWebhooksError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_webhooks")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteWebhookHandler configures the mux to serve the "task" service
+// "delete_webhook" endpoint.
+func MountDeleteWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/focus/tasks/webhooks/{webhook_id}", f)
+}
+
+// NewDeleteWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "task" service "delete_webhook" endpoint.
+func NewDeleteWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteWebhookRequest(mux, decoder)
+		encodeResponse = EncodeDeleteWebhookResponse(encoder)
+		encodeError    = EncodeDeleteWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete_webhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListWebhookDeliveriesHandler configures the mux to serve the "task"
+// service "list_webhook_deliveries" endpoint.
+func MountListWebhookDeliveriesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/focus/tasks/webhooks/{webhook_id}/deliveries", f)
+}
+
+// NewListWebhookDeliveriesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "task" service "list_webhook_deliveries" endpoint.
+func NewListWebhookDeliveriesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListWebhookDeliveriesRequest(mux, decoder)
+		encodeResponse = EncodeListWebhookDeliveriesResponse(encoder)
+		encodeError    = EncodeListWebhookDeliveriesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_webhook_deliveries")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRedeliverWebhookHandler configures the mux to serve the "task" service
+// "redeliver_webhook" endpoint.
+func MountRedeliverWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/focus/tasks/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver", f)
+}
+
+// NewRedeliverWebhookHandler creates a HTTP handler which loads the HTTP
+// request and calls the "task" service "redeliver_webhook" endpoint.
+func NewRedeliverWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRedeliverWebhookRequest(mux, decoder)
+		encodeResponse = EncodeRedeliverWebhookResponse(encoder)
+		encodeError    = EncodeRedeliverWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "redeliver_webhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSetAutoStopHandler configures the mux to serve the "task" service
+// "set_auto_stop" endpoint.
+func MountSetAutoStopHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/focus/tasks/settings/auto-stop", f)
+}
+
+// NewSetAutoStopHandler creates a HTTP handler which loads the HTTP request
+// and calls the "task" service "set_auto_stop" endpoint.
+func NewSetAutoStopHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSetAutoStopRequest(mux, decoder)
+		encodeResponse = EncodeSetAutoStopResponse(encoder)
+		encodeError    = EncodeSetAutoStopError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "set_auto_stop")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "task")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,78 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// sse
+//
+// Command:
+// $ goa gen github.com/neatflowcv/focus/design
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	task "github.com/neatflowcv/focus/gen/task"
+)
+
+// StreamServerStream implements the task.StreamServerStream interface using
+// Server-Sent Events.
+type StreamServerStream struct {
+	// once ensures the headers are written once.
+	once sync.Once
+	// w is the HTTP response writer used to send the SSE events.
+	w http.ResponseWriter
+	// r is the HTTP request.
+	r *http.Request
+}
+
+// Send Send streams instances of "task.StreamEvent" to the "stream" endpoint
+// SSE connection.
+func (s *StreamServerStream) Send(v *task.StreamEvent) error {
+	return s.SendWithContext(context.Background(), v)
+}
+
+// SendWithContext SendWithContext streams instances of "task.StreamEvent" to
+// the "stream" endpoint SSE connection with context.
+func (s *StreamServerStream) SendWithContext(ctx context.Context, v *task.StreamEvent) error {
+	s.once.Do(func() {
+		header := s.w.Header()
+		if header.Get("Content-Type") == "" {
+			header.Set("Content-Type", "text/event-stream")
+		}
+		if header.Get("Cache-Control") == "" {
+			header.Set("Cache-Control", "no-cache")
+		}
+		if header.Get("Connection") == "" {
+			header.Set("Connection", "keep-alive")
+		}
+		s.w.WriteHeader(http.StatusOK)
+	})
+	res := v
+
+	if id := res.ID; id != "" {
+		fmt.Fprintf(s.w, "id: %s\n", id)
+	}
+	if event := res.Kind; event != "" {
+		fmt.Fprintf(s.w, "event: %s\n", event)
+	}
+
+	var data string
+	byts, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	data = string(byts)
+	fmt.Fprintf(s.w, "data: %s\n\n", data)
+
+	http.NewResponseController(s.w).Flush()
+	return nil
+}
+
+// Close is a no-op for SSE. We keep the method for compatibility with other
+// stream types.
+func (s *StreamServerStream) Close() error {
+	return nil
+}
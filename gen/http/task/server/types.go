@@ -35,6 +35,70 @@ type UpdateRequestBody struct {
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 	// The estimated time of the task
 	EstimatedTime *int64 `form:"estimated_time,omitempty" json:"estimated_time,omitempty" xml:"estimated_time,omitempty"`
+	// The timestamp when the task is due, 0 to clear
+	DueAt *int64 `form:"due_at,omitempty" json:"due_at,omitempty" xml:"due_at,omitempty"`
+	// Whether to derive the status from the subtasks
+	Rollup *bool `form:"rollup,omitempty" json:"rollup,omitempty" xml:"rollup,omitempty"`
+}
+
+// AddSessionRequestBody is the type of the "task" service "add_session"
+// endpoint HTTP request body.
+type AddSessionRequestBody struct {
+	// The timestamp when the session was started
+	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// The timestamp when the session was ended
+	EndedAt *int64 `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// The note of the session
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// UpdateSessionRequestBody is the type of the "task" service "update_session"
+// endpoint HTTP request body.
+type UpdateSessionRequestBody struct {
+	// The timestamp when the session was started
+	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// The timestamp when the session was ended
+	EndedAt *int64 `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// The note of the session
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// TrackProjectRequestBody is the type of the "task" service "track_project"
+// endpoint HTTP request body.
+type TrackProjectRequestBody struct {
+	// The IANA time zone where a day ends
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+}
+
+// SetWorkflowRequestBody is the type of the "task" service "set_workflow"
+// endpoint HTTP request body.
+type SetWorkflowRequestBody struct {
+	// The statuses, todo, doing and done are always included
+	Statuses []*WorkflowStatusRequestBody `form:"statuses,omitempty" json:"statuses,omitempty" xml:"statuses,omitempty"`
+	// The allowed transitions, empty to allow all
+	Transitions []*WorkflowTransitionRequestBody `form:"transitions,omitempty" json:"transitions,omitempty" xml:"transitions,omitempty"`
+}
+
+// CreateWebhookRequestBody is the type of the "task" service "create_webhook"
+// endpoint HTTP request body.
+type CreateWebhookRequestBody struct {
+	// The http or https URL that receives the events
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// The events to send
+	Events []string `form:"events,omitempty" json:"events,omitempty" xml:"events,omitempty"`
+}
+
+// SetAutoStopRequestBody is the type of the "task" service "set_auto_stop"
+// endpoint HTTP request body.
+type SetAutoStopRequestBody struct {
+	// Stop tasks running longer than this many seconds, 0 to disable
+	Threshold *int64 `form:"threshold,omitempty" json:"threshold,omitempty" xml:"threshold,omitempty"`
+	// Record at most this many seconds for a stopped task, 0 for no cap
+	Cap *int64 `form:"cap,omitempty" json:"cap,omitempty" xml:"cap,omitempty"`
+	// Stop tasks still running past midnight
+	Midnight *bool `form:"midnight,omitempty" json:"midnight,omitempty" xml:"midnight,omitempty"`
+	// The IANA time zone used to find midnight
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
 }
 
 // CreateResponseBody is the type of the "task" service "create" endpoint HTTP
@@ -54,10 +118,20 @@ type CreateResponseBody struct {
 	ActualTime *int64 `form:"actual_time,omitempty" json:"actual_time,omitempty" xml:"actual_time,omitempty"`
 	// The timestamp when the task was started
 	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// The timestamp when the task is due
+	DueAt *int64 `form:"due_at,omitempty" json:"due_at,omitempty" xml:"due_at,omitempty"`
 	// Whether the task is a leaf task
 	IsLeaf *bool `form:"is_leaf,omitempty" json:"is_leaf,omitempty" xml:"is_leaf,omitempty"`
 	// The status of the task
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Whether the status is derived from the subtasks
+	Rollup *bool `form:"rollup,omitempty" json:"rollup,omitempty" xml:"rollup,omitempty"`
+	// The timestamp when the task was first started
+	FirstStartedAt *int64 `form:"first_started_at,omitempty" json:"first_started_at,omitempty" xml:"first_started_at,omitempty"`
+	// The timestamp when the task was completed
+	CompletedAt *int64 `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+	// The number of times the task was reopened after completion
+	Reopens *int `form:"reopens,omitempty" json:"reopens,omitempty" xml:"reopens,omitempty"`
 }
 
 // CreatetaskoutputResponseCollection is the type of the "task" service "list"
@@ -81,10 +155,244 @@ type UpdateResponseBody struct {
 	ActualTime *int64 `form:"actual_time,omitempty" json:"actual_time,omitempty" xml:"actual_time,omitempty"`
 	// The timestamp when the task was started
 	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// The timestamp when the task is due
+	DueAt *int64 `form:"due_at,omitempty" json:"due_at,omitempty" xml:"due_at,omitempty"`
 	// Whether the task is a leaf task
 	IsLeaf *bool `form:"is_leaf,omitempty" json:"is_leaf,omitempty" xml:"is_leaf,omitempty"`
 	// The status of the task
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Whether the status is derived from the subtasks
+	Rollup *bool `form:"rollup,omitempty" json:"rollup,omitempty" xml:"rollup,omitempty"`
+	// The timestamp when the task was first started
+	FirstStartedAt *int64 `form:"first_started_at,omitempty" json:"first_started_at,omitempty" xml:"first_started_at,omitempty"`
+	// The timestamp when the task was completed
+	CompletedAt *int64 `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+	// The number of times the task was reopened after completion
+	Reopens *int `form:"reopens,omitempty" json:"reopens,omitempty" xml:"reopens,omitempty"`
+}
+
+// CalendarTokenResponseBody is the type of the "task" service "calendar_token"
+// endpoint HTTP response body.
+type CalendarTokenResponseBody struct {
+	// The token for the calendar feed
+	Token string `form:"token" json:"token" xml:"token"`
+	// The timestamp when the token expires
+	ExpiresAt int64 `form:"expires_at" json:"expires_at" xml:"expires_at"`
+}
+
+// EstimatesResponseBody is the type of the "task" service "estimates" endpoint
+// HTTP response body.
+type EstimatesResponseBody struct {
+	// The statistics of all completed leaf tasks
+	Summary *EstimateStatsResponseBody `form:"summary" json:"summary" xml:"summary"`
+	// The statistics per period of completion
+	Timeline []*EstimateGroupResponseBody `form:"timeline" json:"timeline" xml:"timeline"`
+	// The statistics per depth
+	ByDepth []*EstimateGroupResponseBody `form:"by_depth" json:"by_depth" xml:"by_depth"`
+	// The statistics per +project and @context tag
+	ByTag []*EstimateGroupResponseBody `form:"by_tag" json:"by_tag" xml:"by_tag"`
+	// The statistics per top-level task ID
+	BySubtree []*EstimateGroupResponseBody `form:"by_subtree" json:"by_subtree" xml:"by_subtree"`
+}
+
+// LifecycleResponseBody is the type of the "task" service "lifecycle" endpoint
+// HTTP response body.
+type LifecycleResponseBody struct {
+	// The completed tasks in order of completion
+	Tasks []*LifecycleTaskResponseBody `form:"tasks" json:"tasks" xml:"tasks"`
+	// The statistics of all completed leaf tasks
+	Summary *FlowStatsResponseBody `form:"summary" json:"summary" xml:"summary"`
+	// The statistics per top-level task ID
+	BySubtree []*FlowGroupResponseBody `form:"by_subtree" json:"by_subtree" xml:"by_subtree"`
+}
+
+// SessionsResponseBody is the type of the "task" service "sessions" endpoint
+// HTTP response body.
+type SessionsResponseBody []*SessionOutputResponse
+
+// AddSessionResponseBody is the type of the "task" service "add_session"
+// endpoint HTTP response body.
+type AddSessionResponseBody struct {
+	// The ID of the session
+	ID string `form:"id" json:"id" xml:"id"`
+	// The ID of the task
+	TaskID string `form:"task_id" json:"task_id" xml:"task_id"`
+	// The timestamp when the session was started
+	StartedAt int64 `form:"started_at" json:"started_at" xml:"started_at"`
+	// The timestamp when the session was ended
+	EndedAt int64 `form:"ended_at" json:"ended_at" xml:"ended_at"`
+	// Whether the session was stopped automatically
+	Auto bool `form:"auto" json:"auto" xml:"auto"`
+	// The note of the session
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// UpdateSessionResponseBody is the type of the "task" service "update_session"
+// endpoint HTTP response body.
+type UpdateSessionResponseBody struct {
+	// The ID of the session
+	ID string `form:"id" json:"id" xml:"id"`
+	// The ID of the task
+	TaskID string `form:"task_id" json:"task_id" xml:"task_id"`
+	// The timestamp when the session was started
+	StartedAt int64 `form:"started_at" json:"started_at" xml:"started_at"`
+	// The timestamp when the session was ended
+	EndedAt int64 `form:"ended_at" json:"ended_at" xml:"ended_at"`
+	// Whether the session was stopped automatically
+	Auto bool `form:"auto" json:"auto" xml:"auto"`
+	// The note of the session
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// BurndownResponseBody is the type of the "task" service "burndown" endpoint
+// HTTP response body.
+type BurndownResponseBody struct {
+	// The time zone where a day ends
+	Timezone string `form:"timezone" json:"timezone" xml:"timezone"`
+	// The snapshots in date order, the last one is live
+	Points []*BurndownPointResponseBody `form:"points" json:"points" xml:"points"`
+}
+
+// RecomputeResponseBody is the type of the "task" service "recompute" endpoint
+// HTTP response body.
+type RecomputeResponseBody struct {
+	// The number of missing records that were created
+	Created int `form:"created" json:"created" xml:"created"`
+	// The number of records that were fixed
+	Updated int `form:"updated" json:"updated" xml:"updated"`
+	// The number of records without a task that were deleted
+	Deleted int `form:"deleted" json:"deleted" xml:"deleted"`
+}
+
+// StreamResponseBody is the type of the "task" service "stream" endpoint HTTP
+// response body.
+type StreamResponseBody struct {
+	// The ID to resume from, empty for timer ticks
+	ID string `form:"id" json:"id" xml:"id"`
+	// The kind of the event
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// The ID of the task
+	TaskID *string `form:"task_id,omitempty" json:"task_id,omitempty" xml:"task_id,omitempty"`
+	// The parent ID of the task
+	ParentID *string `form:"parent_id,omitempty" json:"parent_id,omitempty" xml:"parent_id,omitempty"`
+	// The next ID of the task
+	NextID *string `form:"next_id,omitempty" json:"next_id,omitempty" xml:"next_id,omitempty"`
+	// The status of the task
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The actual time of the running task
+	ActualTime *int64 `form:"actual_time,omitempty" json:"actual_time,omitempty" xml:"actual_time,omitempty"`
+	// The timestamp when the running task was started
+	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// The timestamp of the event
+	At int64 `form:"at" json:"at" xml:"at"`
+}
+
+// RebuildProjectionsResponseBody is the type of the "task" service
+// "rebuild_projections" endpoint HTTP response body.
+type RebuildProjectionsResponseBody struct {
+	// Whether the changes were only reported
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// The changed extras
+	Extras *RecomputeOutputResponseBody `form:"extras" json:"extras" xml:"extras"`
+	// The changed traces
+	Traces *RecomputeOutputResponseBody `form:"traces" json:"traces" xml:"traces"`
+	// The changes in the order they are applied
+	Changes []*ProjectionChangeResponseBody `form:"changes" json:"changes" xml:"changes"`
+}
+
+// GetWorkflowResponseBody is the type of the "task" service "get_workflow"
+// endpoint HTTP response body.
+type GetWorkflowResponseBody struct {
+	// The statuses, todo, doing and done are always included
+	Statuses []*WorkflowStatusResponseBody `form:"statuses" json:"statuses" xml:"statuses"`
+	// The allowed transitions, empty to allow all
+	Transitions []*WorkflowTransitionResponseBody `form:"transitions" json:"transitions" xml:"transitions"`
+}
+
+// SetWorkflowResponseBody is the type of the "task" service "set_workflow"
+// endpoint HTTP response body.
+type SetWorkflowResponseBody struct {
+	// The statuses, todo, doing and done are always included
+	Statuses []*WorkflowStatusResponseBody `form:"statuses" json:"statuses" xml:"statuses"`
+	// The allowed transitions, empty to allow all
+	Transitions []*WorkflowTransitionResponseBody `form:"transitions" json:"transitions" xml:"transitions"`
+}
+
+// GetAutoStopResponseBody is the type of the "task" service "get_auto_stop"
+// endpoint HTTP response body.
+type GetAutoStopResponseBody struct {
+	// Stop tasks running longer than this many seconds, 0 to disable
+	Threshold int64 `form:"threshold" json:"threshold" xml:"threshold"`
+	// Record at most this many seconds for a stopped task, 0 for no cap
+	Cap int64 `form:"cap" json:"cap" xml:"cap"`
+	// Stop tasks still running past midnight
+	Midnight bool `form:"midnight" json:"midnight" xml:"midnight"`
+	// The IANA time zone used to find midnight
+	Timezone string `form:"timezone" json:"timezone" xml:"timezone"`
+}
+
+// CreateWebhookResponseBody is the type of the "task" service "create_webhook"
+// endpoint HTTP response body.
+type CreateWebhookResponseBody struct {
+	// The ID of the webhook
+	ID string `form:"id" json:"id" xml:"id"`
+	// The URL that receives the events
+	URL string `form:"url" json:"url" xml:"url"`
+	// The events to send
+	Events []string `form:"events" json:"events" xml:"events"`
+	// The key of the HMAC-SHA256 signature in the X-Focus-Signature-256 header,
+	// returned only on creation
+	Secret *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	// The timestamp when the webhook was created
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// ListWebhooksResponseBody is the type of the "task" service "list_webhooks"
+// endpoint HTTP response body.
+type ListWebhooksResponseBody []*WebhookOutputResponse
+
+// ListWebhookDeliveriesResponseBody is the type of the "task" service
+// "list_webhook_deliveries" endpoint HTTP response body.
+type ListWebhookDeliveriesResponseBody []*WebhookDeliveryResponse
+
+// RedeliverWebhookResponseBody is the type of the "task" service
+// "redeliver_webhook" endpoint HTTP response body.
+type RedeliverWebhookResponseBody struct {
+	// The ID of the delivery
+	ID string `form:"id" json:"id" xml:"id"`
+	// The ID of the webhook
+	WebhookID string `form:"webhook_id" json:"webhook_id" xml:"webhook_id"`
+	// The event that was sent
+	Event string `form:"event" json:"event" xml:"event"`
+	// The JSON body that was sent
+	Payload string `form:"payload" json:"payload" xml:"payload"`
+	// The state of the delivery
+	Status string `form:"status" json:"status" xml:"status"`
+	// The number of attempts so far
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// The timestamp of the next attempt while pending
+	NextAttemptAt *int64 `form:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty" xml:"next_attempt_at,omitempty"`
+	// The status code of the last response
+	LastStatusCode *int `form:"last_status_code,omitempty" json:"last_status_code,omitempty" xml:"last_status_code,omitempty"`
+	// The error of the last attempt
+	LastError *string `form:"last_error,omitempty" json:"last_error,omitempty" xml:"last_error,omitempty"`
+	// The timestamp when the delivery was created
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// The timestamp when the delivery succeeded
+	DeliveredAt *int64 `form:"delivered_at,omitempty" json:"delivered_at,omitempty" xml:"delivered_at,omitempty"`
+}
+
+// SetAutoStopResponseBody is the type of the "task" service "set_auto_stop"
+// endpoint HTTP response body.
+type SetAutoStopResponseBody struct {
+	// Stop tasks running longer than this many seconds, 0 to disable
+	Threshold int64 `form:"threshold" json:"threshold" xml:"threshold"`
+	// Record at most this many seconds for a stopped task, 0 for no cap
+	Cap int64 `form:"cap" json:"cap" xml:"cap"`
+	// Stop tasks still running past midnight
+	Midnight bool `form:"midnight" json:"midnight" xml:"midnight"`
+	// The IANA time zone used to find midnight
+	Timezone string `form:"timezone" json:"timezone" xml:"timezone"`
 }
 
 // SetupUnauthorizedResponseBody is the type of the "task" service "setup"
@@ -195,6 +503,24 @@ type ListInternalServerErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// UpdateBadRequestResponseBody is the type of the "task" service "update"
+// endpoint HTTP response body for the "BadRequest" error.
+type UpdateBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // UpdateUnauthorizedResponseBody is the type of the "task" service "update"
 // endpoint HTTP response body for the "Unauthorized" error.
 type UpdateUnauthorizedResponseBody struct {
@@ -231,6 +557,24 @@ type UpdateTaskNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// UpdateConflictResponseBody is the type of the "task" service "update"
+// endpoint HTTP response body for the "Conflict" error.
+type UpdateConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // UpdateInternalServerErrorResponseBody is the type of the "task" service
 // "update" endpoint HTTP response body for the "InternalServerError" error.
 type UpdateInternalServerErrorResponseBody struct {
@@ -303,118 +647,2929 @@ type DeleteInternalServerErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// CreatetaskoutputResponse is used to define fields on response body types.
-type CreatetaskoutputResponse struct {
-	// The ID of the task
+// CalendarTokenUnauthorizedResponseBody is the type of the "task" service
+// "calendar_token" endpoint HTTP response body for the "Unauthorized" error.
+type CalendarTokenUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
 	ID string `form:"id" json:"id" xml:"id"`
-	// The parent ID of the task
-	ParentID *string `form:"parent_id,omitempty" json:"parent_id,omitempty" xml:"parent_id,omitempty"`
-	// The title of the task
-	Title string `form:"title" json:"title" xml:"title"`
-	// The timestamp when the task was created
-	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
-	// The estimated time of the task
-	EstimatedTime *int64 `form:"estimated_time,omitempty" json:"estimated_time,omitempty" xml:"estimated_time,omitempty"`
-	// The actual time of the task
-	ActualTime *int64 `form:"actual_time,omitempty" json:"actual_time,omitempty" xml:"actual_time,omitempty"`
-	// The timestamp when the task was started
-	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
-	// Whether the task is a leaf task
-	IsLeaf *bool `form:"is_leaf,omitempty" json:"is_leaf,omitempty" xml:"is_leaf,omitempty"`
-	// The status of the task
-	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCreateResponseBody builds the HTTP response body from the result of the
-// "create" endpoint of the "task" service.
-func NewCreateResponseBody(res *taskviews.CreatetaskoutputView) *CreateResponseBody {
-	body := &CreateResponseBody{
-		ID:            *res.ID,
-		ParentID:      res.ParentID,
-		Title:         *res.Title,
-		CreatedAt:     *res.CreatedAt,
-		EstimatedTime: res.EstimatedTime,
-		ActualTime:    res.ActualTime,
-		StartedAt:     res.StartedAt,
-		IsLeaf:        res.IsLeaf,
-		Status:        res.Status,
-	}
-	return body
+// CalendarTokenInternalServerErrorResponseBody is the type of the "task"
+// service "calendar_token" endpoint HTTP response body for the
+// "InternalServerError" error.
+type CalendarTokenInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCreatetaskoutputResponseCollection builds the HTTP response body from the
-// result of the "list" endpoint of the "task" service.
-func NewCreatetaskoutputResponseCollection(res taskviews.CreatetaskoutputCollectionView) CreatetaskoutputResponseCollection {
-	body := make([]*CreatetaskoutputResponse, len(res))
-	for i, val := range res {
-		body[i] = marshalTaskviewsCreatetaskoutputViewToCreatetaskoutputResponse(val)
-	}
-	return body
+// CalendarUnauthorizedResponseBody is the type of the "task" service
+// "calendar" endpoint HTTP response body for the "Unauthorized" error.
+type CalendarUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewUpdateResponseBody builds the HTTP response body from the result of the
-// "update" endpoint of the "task" service.
-func NewUpdateResponseBody(res *taskviews.CreatetaskoutputView) *UpdateResponseBody {
-	body := &UpdateResponseBody{
-		ID:            *res.ID,
-		ParentID:      res.ParentID,
-		Title:         *res.Title,
-		CreatedAt:     *res.CreatedAt,
-		EstimatedTime: res.EstimatedTime,
-		ActualTime:    res.ActualTime,
-		StartedAt:     res.StartedAt,
-		IsLeaf:        res.IsLeaf,
-		Status:        res.Status,
-	}
-	return body
+// CalendarInternalServerErrorResponseBody is the type of the "task" service
+// "calendar" endpoint HTTP response body for the "InternalServerError" error.
+type CalendarInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewSetupUnauthorizedResponseBody builds the HTTP response body from the
-// result of the "setup" endpoint of the "task" service.
-func NewSetupUnauthorizedResponseBody(res *goa.ServiceError) *SetupUnauthorizedResponseBody {
-	body := &SetupUnauthorizedResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// TimesheetBadRequestResponseBody is the type of the "task" service
+// "timesheet" endpoint HTTP response body for the "BadRequest" error.
+type TimesheetBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewSetupInternalServerErrorResponseBody builds the HTTP response body from
-// the result of the "setup" endpoint of the "task" service.
-func NewSetupInternalServerErrorResponseBody(res *goa.ServiceError) *SetupInternalServerErrorResponseBody {
-	body := &SetupInternalServerErrorResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// TimesheetUnauthorizedResponseBody is the type of the "task" service
+// "timesheet" endpoint HTTP response body for the "Unauthorized" error.
+type TimesheetUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCreateUnauthorizedResponseBody builds the HTTP response body from the
-// result of the "create" endpoint of the "task" service.
-func NewCreateUnauthorizedResponseBody(res *goa.ServiceError) *CreateUnauthorizedResponseBody {
-	body := &CreateUnauthorizedResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
+// TimesheetTaskNotFoundResponseBody is the type of the "task" service
+// "timesheet" endpoint HTTP response body for the "TaskNotFound" error.
+type TimesheetTaskNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// TimesheetInternalServerErrorResponseBody is the type of the "task" service
+// "timesheet" endpoint HTTP response body for the "InternalServerError" error.
+type TimesheetInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// EstimatesUnauthorizedResponseBody is the type of the "task" service
+// "estimates" endpoint HTTP response body for the "Unauthorized" error.
+type EstimatesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// EstimatesTaskNotFoundResponseBody is the type of the "task" service
+// "estimates" endpoint HTTP response body for the "TaskNotFound" error.
+type EstimatesTaskNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// EstimatesInternalServerErrorResponseBody is the type of the "task" service
+// "estimates" endpoint HTTP response body for the "InternalServerError" error.
+type EstimatesInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// LifecycleBadRequestResponseBody is the type of the "task" service
+// "lifecycle" endpoint HTTP response body for the "BadRequest" error.
+type LifecycleBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// LifecycleUnauthorizedResponseBody is the type of the "task" service
+// "lifecycle" endpoint HTTP response body for the "Unauthorized" error.
+type LifecycleUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// LifecycleTaskNotFoundResponseBody is the type of the "task" service
+// "lifecycle" endpoint HTTP response body for the "TaskNotFound" error.
+type LifecycleTaskNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// LifecycleInternalServerErrorResponseBody is the type of the "task" service
+// "lifecycle" endpoint HTTP response body for the "InternalServerError" error.
+type LifecycleInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SessionsUnauthorizedResponseBody is the type of the "task" service
+// "sessions" endpoint HTTP response body for the "Unauthorized" error.
+type SessionsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SessionsTaskNotFoundResponseBody is the type of the "task" service
+// "sessions" endpoint HTTP response body for the "TaskNotFound" error.
+type SessionsTaskNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SessionsInternalServerErrorResponseBody is the type of the "task" service
+// "sessions" endpoint HTTP response body for the "InternalServerError" error.
+type SessionsInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddSessionBadRequestResponseBody is the type of the "task" service
+// "add_session" endpoint HTTP response body for the "BadRequest" error.
+type AddSessionBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddSessionUnauthorizedResponseBody is the type of the "task" service
+// "add_session" endpoint HTTP response body for the "Unauthorized" error.
+type AddSessionUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddSessionTaskNotFoundResponseBody is the type of the "task" service
+// "add_session" endpoint HTTP response body for the "TaskNotFound" error.
+type AddSessionTaskNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddSessionConflictResponseBody is the type of the "task" service
+// "add_session" endpoint HTTP response body for the "Conflict" error.
+type AddSessionConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddSessionInternalServerErrorResponseBody is the type of the "task" service
+// "add_session" endpoint HTTP response body for the "InternalServerError"
+// error.
+type AddSessionInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateSessionBadRequestResponseBody is the type of the "task" service
+// "update_session" endpoint HTTP response body for the "BadRequest" error.
+type UpdateSessionBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateSessionUnauthorizedResponseBody is the type of the "task" service
+// "update_session" endpoint HTTP response body for the "Unauthorized" error.
+type UpdateSessionUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateSessionSessionNotFoundResponseBody is the type of the "task" service
+// "update_session" endpoint HTTP response body for the "SessionNotFound" error.
+type UpdateSessionSessionNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateSessionConflictResponseBody is the type of the "task" service
+// "update_session" endpoint HTTP response body for the "Conflict" error.
+type UpdateSessionConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateSessionInternalServerErrorResponseBody is the type of the "task"
+// service "update_session" endpoint HTTP response body for the
+// "InternalServerError" error.
+type UpdateSessionInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteSessionUnauthorizedResponseBody is the type of the "task" service
+// "delete_session" endpoint HTTP response body for the "Unauthorized" error.
+type DeleteSessionUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteSessionSessionNotFoundResponseBody is the type of the "task" service
+// "delete_session" endpoint HTTP response body for the "SessionNotFound" error.
+type DeleteSessionSessionNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteSessionInternalServerErrorResponseBody is the type of the "task"
+// service "delete_session" endpoint HTTP response body for the
+// "InternalServerError" error.
+type DeleteSessionInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// TrackProjectBadRequestResponseBody is the type of the "task" service
+// "track_project" endpoint HTTP response body for the "BadRequest" error.
+type TrackProjectBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// TrackProjectUnauthorizedResponseBody is the type of the "task" service
+// "track_project" endpoint HTTP response body for the "Unauthorized" error.
+type TrackProjectUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// TrackProjectTaskNotFoundResponseBody is the type of the "task" service
+// "track_project" endpoint HTTP response body for the "TaskNotFound" error.
+type TrackProjectTaskNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// TrackProjectInternalServerErrorResponseBody is the type of the "task"
+// service "track_project" endpoint HTTP response body for the
+// "InternalServerError" error.
+type TrackProjectInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UntrackProjectUnauthorizedResponseBody is the type of the "task" service
+// "untrack_project" endpoint HTTP response body for the "Unauthorized" error.
+type UntrackProjectUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UntrackProjectProjectNotFoundResponseBody is the type of the "task" service
+// "untrack_project" endpoint HTTP response body for the "ProjectNotFound"
+// error.
+type UntrackProjectProjectNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UntrackProjectInternalServerErrorResponseBody is the type of the "task"
+// service "untrack_project" endpoint HTTP response body for the
+// "InternalServerError" error.
+type UntrackProjectInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BurndownUnauthorizedResponseBody is the type of the "task" service
+// "burndown" endpoint HTTP response body for the "Unauthorized" error.
+type BurndownUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BurndownProjectNotFoundResponseBody is the type of the "task" service
+// "burndown" endpoint HTTP response body for the "ProjectNotFound" error.
+type BurndownProjectNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BurndownInternalServerErrorResponseBody is the type of the "task" service
+// "burndown" endpoint HTTP response body for the "InternalServerError" error.
+type BurndownInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RecomputeUnauthorizedResponseBody is the type of the "task" service
+// "recompute" endpoint HTTP response body for the "Unauthorized" error.
+type RecomputeUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RecomputeInternalServerErrorResponseBody is the type of the "task" service
+// "recompute" endpoint HTTP response body for the "InternalServerError" error.
+type RecomputeInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// StreamUnauthorizedResponseBody is the type of the "task" service "stream"
+// endpoint HTTP response body for the "Unauthorized" error.
+type StreamUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// StreamInternalServerErrorResponseBody is the type of the "task" service
+// "stream" endpoint HTTP response body for the "InternalServerError" error.
+type StreamInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RebuildProjectionsUnauthorizedResponseBody is the type of the "task" service
+// "rebuild_projections" endpoint HTTP response body for the "Unauthorized"
+// error.
+type RebuildProjectionsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RebuildProjectionsInternalServerErrorResponseBody is the type of the "task"
+// service "rebuild_projections" endpoint HTTP response body for the
+// "InternalServerError" error.
+type RebuildProjectionsInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetWorkflowUnauthorizedResponseBody is the type of the "task" service
+// "get_workflow" endpoint HTTP response body for the "Unauthorized" error.
+type GetWorkflowUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetWorkflowInternalServerErrorResponseBody is the type of the "task" service
+// "get_workflow" endpoint HTTP response body for the "InternalServerError"
+// error.
+type GetWorkflowInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetWorkflowBadRequestResponseBody is the type of the "task" service
+// "set_workflow" endpoint HTTP response body for the "BadRequest" error.
+type SetWorkflowBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetWorkflowUnauthorizedResponseBody is the type of the "task" service
+// "set_workflow" endpoint HTTP response body for the "Unauthorized" error.
+type SetWorkflowUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetWorkflowInternalServerErrorResponseBody is the type of the "task" service
+// "set_workflow" endpoint HTTP response body for the "InternalServerError"
+// error.
+type SetWorkflowInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetAutoStopUnauthorizedResponseBody is the type of the "task" service
+// "get_auto_stop" endpoint HTTP response body for the "Unauthorized" error.
+type GetAutoStopUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetAutoStopInternalServerErrorResponseBody is the type of the "task" service
+// "get_auto_stop" endpoint HTTP response body for the "InternalServerError"
+// error.
+type GetAutoStopInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWebhookBadRequestResponseBody is the type of the "task" service
+// "create_webhook" endpoint HTTP response body for the "BadRequest" error.
+type CreateWebhookBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWebhookUnauthorizedResponseBody is the type of the "task" service
+// "create_webhook" endpoint HTTP response body for the "Unauthorized" error.
+type CreateWebhookUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWebhookInternalServerErrorResponseBody is the type of the "task"
+// service "create_webhook" endpoint HTTP response body for the
+// "InternalServerError" error.
+type CreateWebhookInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWebhooksUnauthorizedResponseBody is the type of the "task" service
+// "list_webhooks" endpoint HTTP response body for the "Unauthorized" error.
+type ListWebhooksUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWebhooksInternalServerErrorResponseBody is the type of the "task"
+// service "list_webhooks" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListWebhooksInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteWebhookUnauthorizedResponseBody is the type of the "task" service
+// "delete_webhook" endpoint HTTP response body for the "Unauthorized" error.
+type DeleteWebhookUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteWebhookWebhookNotFoundResponseBody is the type of the "task" service
+// "delete_webhook" endpoint HTTP response body for the "WebhookNotFound" error.
+type DeleteWebhookWebhookNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteWebhookInternalServerErrorResponseBody is the type of the "task"
+// service "delete_webhook" endpoint HTTP response body for the
+// "InternalServerError" error.
+type DeleteWebhookInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWebhookDeliveriesUnauthorizedResponseBody is the type of the "task"
+// service "list_webhook_deliveries" endpoint HTTP response body for the
+// "Unauthorized" error.
+type ListWebhookDeliveriesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWebhookDeliveriesWebhookNotFoundResponseBody is the type of the "task"
+// service "list_webhook_deliveries" endpoint HTTP response body for the
+// "WebhookNotFound" error.
+type ListWebhookDeliveriesWebhookNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWebhookDeliveriesInternalServerErrorResponseBody is the type of the
+// "task" service "list_webhook_deliveries" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListWebhookDeliveriesInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RedeliverWebhookUnauthorizedResponseBody is the type of the "task" service
+// "redeliver_webhook" endpoint HTTP response body for the "Unauthorized" error.
+type RedeliverWebhookUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RedeliverWebhookDeliveryNotFoundResponseBody is the type of the "task"
+// service "redeliver_webhook" endpoint HTTP response body for the
+// "DeliveryNotFound" error.
+type RedeliverWebhookDeliveryNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RedeliverWebhookInternalServerErrorResponseBody is the type of the "task"
+// service "redeliver_webhook" endpoint HTTP response body for the
+// "InternalServerError" error.
+type RedeliverWebhookInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetAutoStopBadRequestResponseBody is the type of the "task" service
+// "set_auto_stop" endpoint HTTP response body for the "BadRequest" error.
+type SetAutoStopBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetAutoStopUnauthorizedResponseBody is the type of the "task" service
+// "set_auto_stop" endpoint HTTP response body for the "Unauthorized" error.
+type SetAutoStopUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetAutoStopInternalServerErrorResponseBody is the type of the "task" service
+// "set_auto_stop" endpoint HTTP response body for the "InternalServerError"
+// error.
+type SetAutoStopInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreatetaskoutputResponse is used to define fields on response body types.
+type CreatetaskoutputResponse struct {
+	// The ID of the task
+	ID string `form:"id" json:"id" xml:"id"`
+	// The parent ID of the task
+	ParentID *string `form:"parent_id,omitempty" json:"parent_id,omitempty" xml:"parent_id,omitempty"`
+	// The title of the task
+	Title string `form:"title" json:"title" xml:"title"`
+	// The timestamp when the task was created
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// The estimated time of the task
+	EstimatedTime *int64 `form:"estimated_time,omitempty" json:"estimated_time,omitempty" xml:"estimated_time,omitempty"`
+	// The actual time of the task
+	ActualTime *int64 `form:"actual_time,omitempty" json:"actual_time,omitempty" xml:"actual_time,omitempty"`
+	// The timestamp when the task was started
+	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// The timestamp when the task is due
+	DueAt *int64 `form:"due_at,omitempty" json:"due_at,omitempty" xml:"due_at,omitempty"`
+	// Whether the task is a leaf task
+	IsLeaf *bool `form:"is_leaf,omitempty" json:"is_leaf,omitempty" xml:"is_leaf,omitempty"`
+	// The status of the task
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Whether the status is derived from the subtasks
+	Rollup *bool `form:"rollup,omitempty" json:"rollup,omitempty" xml:"rollup,omitempty"`
+	// The timestamp when the task was first started
+	FirstStartedAt *int64 `form:"first_started_at,omitempty" json:"first_started_at,omitempty" xml:"first_started_at,omitempty"`
+	// The timestamp when the task was completed
+	CompletedAt *int64 `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+	// The number of times the task was reopened after completion
+	Reopens *int `form:"reopens,omitempty" json:"reopens,omitempty" xml:"reopens,omitempty"`
+}
+
+// EstimateStatsResponseBody is used to define fields on response body types.
+type EstimateStatsResponseBody struct {
+	// The number of completed leaf tasks
+	Count int `form:"count" json:"count" xml:"count"`
+	// The median of actual time divided by estimated time
+	MedianRatio float64 `form:"median_ratio" json:"median_ratio" xml:"median_ratio"`
+	// The mean of the smaller time divided by the larger time
+	MeanAccuracy float64 `form:"mean_accuracy" json:"mean_accuracy" xml:"mean_accuracy"`
+	// The median of actual time minus estimated time in seconds
+	MedianOverrun int64 `form:"median_overrun" json:"median_overrun" xml:"median_overrun"`
+}
+
+// EstimateGroupResponseBody is used to define fields on response body types.
+type EstimateGroupResponseBody struct {
+	// The key of the group
+	Key string `form:"key" json:"key" xml:"key"`
+	// The statistics of the group
+	Stats *EstimateStatsResponseBody `form:"stats" json:"stats" xml:"stats"`
+}
+
+// LifecycleTaskResponseBody is used to define fields on response body types.
+type LifecycleTaskResponseBody struct {
+	// The ID of the task
+	ID string `form:"id" json:"id" xml:"id"`
+	// The title of the task
+	Title string `form:"title" json:"title" xml:"title"`
+	// Whether the task is a leaf task
+	IsLeaf bool `form:"is_leaf" json:"is_leaf" xml:"is_leaf"`
+	// The timestamp when the task was created
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// The timestamp when the task was first started
+	FirstStartedAt *int64 `form:"first_started_at,omitempty" json:"first_started_at,omitempty" xml:"first_started_at,omitempty"`
+	// The timestamp when the task was completed
+	CompletedAt int64 `form:"completed_at" json:"completed_at" xml:"completed_at"`
+	// The number of times the task was reopened after completion
+	Reopens int `form:"reopens" json:"reopens" xml:"reopens"`
+	// The seconds from the first start to completion
+	CycleTime *int64 `form:"cycle_time,omitempty" json:"cycle_time,omitempty" xml:"cycle_time,omitempty"`
+	// The seconds from creation to completion
+	LeadTime int64 `form:"lead_time" json:"lead_time" xml:"lead_time"`
+}
+
+// FlowStatsResponseBody is used to define fields on response body types.
+type FlowStatsResponseBody struct {
+	// The number of completed leaf tasks
+	Count int `form:"count" json:"count" xml:"count"`
+	// The number of completed leaf tasks that were started
+	Started int `form:"started" json:"started" xml:"started"`
+	// The total number of reopens
+	Reopens int `form:"reopens" json:"reopens" xml:"reopens"`
+	// The median cycle time in seconds
+	MedianCycleTime int64 `form:"median_cycle_time" json:"median_cycle_time" xml:"median_cycle_time"`
+	// The mean cycle time in seconds
+	MeanCycleTime int64 `form:"mean_cycle_time" json:"mean_cycle_time" xml:"mean_cycle_time"`
+	// The median lead time in seconds
+	MedianLeadTime int64 `form:"median_lead_time" json:"median_lead_time" xml:"median_lead_time"`
+	// The mean lead time in seconds
+	MeanLeadTime int64 `form:"mean_lead_time" json:"mean_lead_time" xml:"mean_lead_time"`
+}
+
+// FlowGroupResponseBody is used to define fields on response body types.
+type FlowGroupResponseBody struct {
+	// The key of the group
+	Key string `form:"key" json:"key" xml:"key"`
+	// The statistics of the group
+	Stats *FlowStatsResponseBody `form:"stats" json:"stats" xml:"stats"`
+}
+
+// SessionOutputResponse is used to define fields on response body types.
+type SessionOutputResponse struct {
+	// The ID of the session
+	ID string `form:"id" json:"id" xml:"id"`
+	// The ID of the task
+	TaskID string `form:"task_id" json:"task_id" xml:"task_id"`
+	// The timestamp when the session was started
+	StartedAt int64 `form:"started_at" json:"started_at" xml:"started_at"`
+	// The timestamp when the session was ended
+	EndedAt int64 `form:"ended_at" json:"ended_at" xml:"ended_at"`
+	// Whether the session was stopped automatically
+	Auto bool `form:"auto" json:"auto" xml:"auto"`
+	// The note of the session
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// BurndownPointResponseBody is used to define fields on response body types.
+type BurndownPointResponseBody struct {
+	// The date of the snapshot
+	Date string `form:"date" json:"date" xml:"date"`
+	// The estimated time of open leaf tasks in seconds
+	RemainingTime int64 `form:"remaining_time" json:"remaining_time" xml:"remaining_time"`
+	// The estimated time of done leaf tasks in seconds
+	CompletedTime int64 `form:"completed_time" json:"completed_time" xml:"completed_time"`
+	// The actual time of the project in seconds
+	ActualTime int64 `form:"actual_time" json:"actual_time" xml:"actual_time"`
+	// The number of open leaf tasks
+	Open int `form:"open" json:"open" xml:"open"`
+	// The number of done leaf tasks
+	Done int `form:"done" json:"done" xml:"done"`
+}
+
+// RecomputeOutputResponseBody is used to define fields on response body types.
+type RecomputeOutputResponseBody struct {
+	// The number of missing records that were created
+	Created int `form:"created" json:"created" xml:"created"`
+	// The number of records that were fixed
+	Updated int `form:"updated" json:"updated" xml:"updated"`
+	// The number of records without a task that were deleted
+	Deleted int `form:"deleted" json:"deleted" xml:"deleted"`
+}
+
+// ProjectionChangeResponseBody is used to define fields on response body types.
+type ProjectionChangeResponseBody struct {
+	// The projection that is changed
+	Projection string `form:"projection" json:"projection" xml:"projection"`
+	// The ID of the task
+	ID string `form:"id" json:"id" xml:"id"`
+	// The kind of the change, delete_session removes the session in from
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// The stored value
+	From string `form:"from" json:"from" xml:"from"`
+	// The rebuilt value
+	To string `form:"to" json:"to" xml:"to"`
+}
+
+// WorkflowStatusResponseBody is used to define fields on response body types.
+type WorkflowStatusResponseBody struct {
+	// The name of the status
+	Name string `form:"name" json:"name" xml:"name"`
+	// The core status that decides whether time is being spent
+	Category string `form:"category" json:"category" xml:"category"`
+}
+
+// WorkflowTransitionResponseBody is used to define fields on response body
+// types.
+type WorkflowTransitionResponseBody struct {
+	// The status before the transition
+	From string `form:"from" json:"from" xml:"from"`
+	// The status after the transition
+	To string `form:"to" json:"to" xml:"to"`
+}
+
+// WebhookOutputResponse is used to define fields on response body types.
+type WebhookOutputResponse struct {
+	// The ID of the webhook
+	ID string `form:"id" json:"id" xml:"id"`
+	// The URL that receives the events
+	URL string `form:"url" json:"url" xml:"url"`
+	// The events to send
+	Events []string `form:"events" json:"events" xml:"events"`
+	// The key of the HMAC-SHA256 signature in the X-Focus-Signature-256 header,
+	// returned only on creation
+	Secret *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	// The timestamp when the webhook was created
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// WebhookDeliveryResponse is used to define fields on response body types.
+type WebhookDeliveryResponse struct {
+	// The ID of the delivery
+	ID string `form:"id" json:"id" xml:"id"`
+	// The ID of the webhook
+	WebhookID string `form:"webhook_id" json:"webhook_id" xml:"webhook_id"`
+	// The event that was sent
+	Event string `form:"event" json:"event" xml:"event"`
+	// The JSON body that was sent
+	Payload string `form:"payload" json:"payload" xml:"payload"`
+	// The state of the delivery
+	Status string `form:"status" json:"status" xml:"status"`
+	// The number of attempts so far
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// The timestamp of the next attempt while pending
+	NextAttemptAt *int64 `form:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty" xml:"next_attempt_at,omitempty"`
+	// The status code of the last response
+	LastStatusCode *int `form:"last_status_code,omitempty" json:"last_status_code,omitempty" xml:"last_status_code,omitempty"`
+	// The error of the last attempt
+	LastError *string `form:"last_error,omitempty" json:"last_error,omitempty" xml:"last_error,omitempty"`
+	// The timestamp when the delivery was created
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// The timestamp when the delivery succeeded
+	DeliveredAt *int64 `form:"delivered_at,omitempty" json:"delivered_at,omitempty" xml:"delivered_at,omitempty"`
+}
+
+// WorkflowStatusRequestBody is used to define fields on request body types.
+type WorkflowStatusRequestBody struct {
+	// The name of the status
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// The core status that decides whether time is being spent
+	Category *string `form:"category,omitempty" json:"category,omitempty" xml:"category,omitempty"`
+}
+
+// WorkflowTransitionRequestBody is used to define fields on request body types.
+type WorkflowTransitionRequestBody struct {
+	// The status before the transition
+	From *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// The status after the transition
+	To *string `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+}
+
+// NewCreateResponseBody builds the HTTP response body from the result of the
+// "create" endpoint of the "task" service.
+func NewCreateResponseBody(res *taskviews.CreatetaskoutputView) *CreateResponseBody {
+	body := &CreateResponseBody{
+		ID:             *res.ID,
+		ParentID:       res.ParentID,
+		Title:          *res.Title,
+		CreatedAt:      *res.CreatedAt,
+		EstimatedTime:  res.EstimatedTime,
+		ActualTime:     res.ActualTime,
+		StartedAt:      res.StartedAt,
+		DueAt:          res.DueAt,
+		IsLeaf:         res.IsLeaf,
+		Status:         res.Status,
+		Rollup:         res.Rollup,
+		FirstStartedAt: res.FirstStartedAt,
+		CompletedAt:    res.CompletedAt,
+		Reopens:        res.Reopens,
+	}
+	return body
+}
+
+// NewCreatetaskoutputResponseCollection builds the HTTP response body from the
+// result of the "list" endpoint of the "task" service.
+func NewCreatetaskoutputResponseCollection(res taskviews.CreatetaskoutputCollectionView) CreatetaskoutputResponseCollection {
+	body := make([]*CreatetaskoutputResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalTaskviewsCreatetaskoutputViewToCreatetaskoutputResponse(val)
+	}
+	return body
+}
+
+// NewUpdateResponseBody builds the HTTP response body from the result of the
+// "update" endpoint of the "task" service.
+func NewUpdateResponseBody(res *taskviews.CreatetaskoutputView) *UpdateResponseBody {
+	body := &UpdateResponseBody{
+		ID:             *res.ID,
+		ParentID:       res.ParentID,
+		Title:          *res.Title,
+		CreatedAt:      *res.CreatedAt,
+		EstimatedTime:  res.EstimatedTime,
+		ActualTime:     res.ActualTime,
+		StartedAt:      res.StartedAt,
+		DueAt:          res.DueAt,
+		IsLeaf:         res.IsLeaf,
+		Status:         res.Status,
+		Rollup:         res.Rollup,
+		FirstStartedAt: res.FirstStartedAt,
+		CompletedAt:    res.CompletedAt,
+		Reopens:        res.Reopens,
+	}
+	return body
+}
+
+// NewCalendarTokenResponseBody builds the HTTP response body from the result
+// of the "calendar_token" endpoint of the "task" service.
+func NewCalendarTokenResponseBody(res *task.CalendarTokenOutput) *CalendarTokenResponseBody {
+	body := &CalendarTokenResponseBody{
+		Token:     res.Token,
+		ExpiresAt: res.ExpiresAt,
+	}
+	return body
+}
+
+// NewEstimatesResponseBody builds the HTTP response body from the result of
+// the "estimates" endpoint of the "task" service.
+func NewEstimatesResponseBody(res *task.EstimatesOutput) *EstimatesResponseBody {
+	body := &EstimatesResponseBody{}
+	if res.Summary != nil {
+		body.Summary = marshalTaskEstimateStatsToEstimateStatsResponseBody(res.Summary)
+	}
+	if res.Timeline != nil {
+		body.Timeline = make([]*EstimateGroupResponseBody, len(res.Timeline))
+		for i, val := range res.Timeline {
+			body.Timeline[i] = marshalTaskEstimateGroupToEstimateGroupResponseBody(val)
+		}
+	} else {
+		body.Timeline = []*EstimateGroupResponseBody{}
+	}
+	if res.ByDepth != nil {
+		body.ByDepth = make([]*EstimateGroupResponseBody, len(res.ByDepth))
+		for i, val := range res.ByDepth {
+			body.ByDepth[i] = marshalTaskEstimateGroupToEstimateGroupResponseBody(val)
+		}
+	} else {
+		body.ByDepth = []*EstimateGroupResponseBody{}
+	}
+	if res.ByTag != nil {
+		body.ByTag = make([]*EstimateGroupResponseBody, len(res.ByTag))
+		for i, val := range res.ByTag {
+			body.ByTag[i] = marshalTaskEstimateGroupToEstimateGroupResponseBody(val)
+		}
+	} else {
+		body.ByTag = []*EstimateGroupResponseBody{}
+	}
+	if res.BySubtree != nil {
+		body.BySubtree = make([]*EstimateGroupResponseBody, len(res.BySubtree))
+		for i, val := range res.BySubtree {
+			body.BySubtree[i] = marshalTaskEstimateGroupToEstimateGroupResponseBody(val)
+		}
+	} else {
+		body.BySubtree = []*EstimateGroupResponseBody{}
+	}
+	return body
+}
+
+// NewLifecycleResponseBody builds the HTTP response body from the result of
+// the "lifecycle" endpoint of the "task" service.
+func NewLifecycleResponseBody(res *task.LifecycleOutput) *LifecycleResponseBody {
+	body := &LifecycleResponseBody{}
+	if res.Tasks != nil {
+		body.Tasks = make([]*LifecycleTaskResponseBody, len(res.Tasks))
+		for i, val := range res.Tasks {
+			body.Tasks[i] = marshalTaskLifecycleTaskToLifecycleTaskResponseBody(val)
+		}
+	} else {
+		body.Tasks = []*LifecycleTaskResponseBody{}
+	}
+	if res.Summary != nil {
+		body.Summary = marshalTaskFlowStatsToFlowStatsResponseBody(res.Summary)
+	}
+	if res.BySubtree != nil {
+		body.BySubtree = make([]*FlowGroupResponseBody, len(res.BySubtree))
+		for i, val := range res.BySubtree {
+			body.BySubtree[i] = marshalTaskFlowGroupToFlowGroupResponseBody(val)
+		}
+	} else {
+		body.BySubtree = []*FlowGroupResponseBody{}
+	}
+	return body
+}
+
+// NewSessionsResponseBody builds the HTTP response body from the result of the
+// "sessions" endpoint of the "task" service.
+func NewSessionsResponseBody(res []*task.SessionOutput) SessionsResponseBody {
+	body := make([]*SessionOutputResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalTaskSessionOutputToSessionOutputResponse(val)
+	}
+	return body
+}
+
+// NewAddSessionResponseBody builds the HTTP response body from the result of
+// the "add_session" endpoint of the "task" service.
+func NewAddSessionResponseBody(res *task.SessionOutput) *AddSessionResponseBody {
+	body := &AddSessionResponseBody{
+		ID:        res.ID,
+		TaskID:    res.TaskID,
+		StartedAt: res.StartedAt,
+		EndedAt:   res.EndedAt,
+		Auto:      res.Auto,
+		Note:      res.Note,
+	}
+	return body
+}
+
+// NewUpdateSessionResponseBody builds the HTTP response body from the result
+// of the "update_session" endpoint of the "task" service.
+func NewUpdateSessionResponseBody(res *task.SessionOutput) *UpdateSessionResponseBody {
+	body := &UpdateSessionResponseBody{
+		ID:        res.ID,
+		TaskID:    res.TaskID,
+		StartedAt: res.StartedAt,
+		EndedAt:   res.EndedAt,
+		Auto:      res.Auto,
+		Note:      res.Note,
+	}
+	return body
+}
+
+// NewBurndownResponseBody builds the HTTP response body from the result of the
+// "burndown" endpoint of the "task" service.
+func NewBurndownResponseBody(res *task.BurndownOutput) *BurndownResponseBody {
+	body := &BurndownResponseBody{
+		Timezone: res.Timezone,
+	}
+	if res.Points != nil {
+		body.Points = make([]*BurndownPointResponseBody, len(res.Points))
+		for i, val := range res.Points {
+			body.Points[i] = marshalTaskBurndownPointToBurndownPointResponseBody(val)
+		}
+	} else {
+		body.Points = []*BurndownPointResponseBody{}
+	}
+	return body
+}
+
+// NewRecomputeResponseBody builds the HTTP response body from the result of
+// the "recompute" endpoint of the "task" service.
+func NewRecomputeResponseBody(res *task.RecomputeOutput) *RecomputeResponseBody {
+	body := &RecomputeResponseBody{
+		Created: res.Created,
+		Updated: res.Updated,
+		Deleted: res.Deleted,
+	}
+	return body
+}
+
+// NewStreamResponseBody builds the HTTP response body from the result of the
+// "stream" endpoint of the "task" service.
+func NewStreamResponseBody(res *task.StreamEvent) *StreamResponseBody {
+	body := &StreamResponseBody{
+		ID:         res.ID,
+		Kind:       res.Kind,
+		TaskID:     res.TaskID,
+		ParentID:   res.ParentID,
+		NextID:     res.NextID,
+		Status:     res.Status,
+		ActualTime: res.ActualTime,
+		StartedAt:  res.StartedAt,
+		At:         res.At,
+	}
+	return body
+}
+
+// NewRebuildProjectionsResponseBody builds the HTTP response body from the
+// result of the "rebuild_projections" endpoint of the "task" service.
+func NewRebuildProjectionsResponseBody(res *task.RebuildProjectionsOutput) *RebuildProjectionsResponseBody {
+	body := &RebuildProjectionsResponseBody{
+		DryRun: res.DryRun,
+	}
+	if res.Extras != nil {
+		body.Extras = marshalTaskRecomputeOutputToRecomputeOutputResponseBody(res.Extras)
+	}
+	if res.Traces != nil {
+		body.Traces = marshalTaskRecomputeOutputToRecomputeOutputResponseBody(res.Traces)
+	}
+	if res.Changes != nil {
+		body.Changes = make([]*ProjectionChangeResponseBody, len(res.Changes))
+		for i, val := range res.Changes {
+			body.Changes[i] = marshalTaskProjectionChangeToProjectionChangeResponseBody(val)
+		}
+	} else {
+		body.Changes = []*ProjectionChangeResponseBody{}
+	}
+	return body
+}
+
+// NewGetWorkflowResponseBody builds the HTTP response body from the result of
+// the "get_workflow" endpoint of the "task" service.
+func NewGetWorkflowResponseBody(res *task.Workflow) *GetWorkflowResponseBody {
+	body := &GetWorkflowResponseBody{}
+	if res.Statuses != nil {
+		body.Statuses = make([]*WorkflowStatusResponseBody, len(res.Statuses))
+		for i, val := range res.Statuses {
+			body.Statuses[i] = marshalTaskWorkflowStatusToWorkflowStatusResponseBody(val)
+		}
+	} else {
+		body.Statuses = []*WorkflowStatusResponseBody{}
+	}
+	if res.Transitions != nil {
+		body.Transitions = make([]*WorkflowTransitionResponseBody, len(res.Transitions))
+		for i, val := range res.Transitions {
+			body.Transitions[i] = marshalTaskWorkflowTransitionToWorkflowTransitionResponseBody(val)
+		}
+	} else {
+		body.Transitions = []*WorkflowTransitionResponseBody{}
+	}
+	return body
+}
+
+// NewSetWorkflowResponseBody builds the HTTP response body from the result of
+// the "set_workflow" endpoint of the "task" service.
+func NewSetWorkflowResponseBody(res *task.Workflow) *SetWorkflowResponseBody {
+	body := &SetWorkflowResponseBody{}
+	if res.Statuses != nil {
+		body.Statuses = make([]*WorkflowStatusResponseBody, len(res.Statuses))
+		for i, val := range res.Statuses {
+			body.Statuses[i] = marshalTaskWorkflowStatusToWorkflowStatusResponseBody(val)
+		}
+	} else {
+		body.Statuses = []*WorkflowStatusResponseBody{}
+	}
+	if res.Transitions != nil {
+		body.Transitions = make([]*WorkflowTransitionResponseBody, len(res.Transitions))
+		for i, val := range res.Transitions {
+			body.Transitions[i] = marshalTaskWorkflowTransitionToWorkflowTransitionResponseBody(val)
+		}
+	} else {
+		body.Transitions = []*WorkflowTransitionResponseBody{}
+	}
+	return body
+}
+
+// NewGetAutoStopResponseBody builds the HTTP response body from the result of
+// the "get_auto_stop" endpoint of the "task" service.
+func NewGetAutoStopResponseBody(res *task.AutoStopPolicy) *GetAutoStopResponseBody {
+	body := &GetAutoStopResponseBody{
+		Threshold: res.Threshold,
+		Cap:       res.Cap,
+		Midnight:  res.Midnight,
+		Timezone:  res.Timezone,
+	}
+	return body
+}
+
+// NewCreateWebhookResponseBody builds the HTTP response body from the result
+// of the "create_webhook" endpoint of the "task" service.
+func NewCreateWebhookResponseBody(res *task.WebhookOutput) *CreateWebhookResponseBody {
+	body := &CreateWebhookResponseBody{
+		ID:        res.ID,
+		URL:       res.URL,
+		Secret:    res.Secret,
+		CreatedAt: res.CreatedAt,
+	}
+	if res.Events != nil {
+		body.Events = make([]string, len(res.Events))
+		for i, val := range res.Events {
+			body.Events[i] = val
+		}
+	} else {
+		body.Events = []string{}
+	}
+	return body
+}
+
+// NewListWebhooksResponseBody builds the HTTP response body from the result of
+// the "list_webhooks" endpoint of the "task" service.
+func NewListWebhooksResponseBody(res []*task.WebhookOutput) ListWebhooksResponseBody {
+	body := make([]*WebhookOutputResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalTaskWebhookOutputToWebhookOutputResponse(val)
+	}
+	return body
+}
+
+// NewListWebhookDeliveriesResponseBody builds the HTTP response body from the
+// result of the "list_webhook_deliveries" endpoint of the "task" service.
+func NewListWebhookDeliveriesResponseBody(res []*task.WebhookDelivery) ListWebhookDeliveriesResponseBody {
+	body := make([]*WebhookDeliveryResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalTaskWebhookDeliveryToWebhookDeliveryResponse(val)
+	}
+	return body
+}
+
+// NewRedeliverWebhookResponseBody builds the HTTP response body from the
+// result of the "redeliver_webhook" endpoint of the "task" service.
+func NewRedeliverWebhookResponseBody(res *task.WebhookDelivery) *RedeliverWebhookResponseBody {
+	body := &RedeliverWebhookResponseBody{
+		ID:             res.ID,
+		WebhookID:      res.WebhookID,
+		Event:          res.Event,
+		Payload:        res.Payload,
+		Status:         res.Status,
+		Attempts:       res.Attempts,
+		NextAttemptAt:  res.NextAttemptAt,
+		LastStatusCode: res.LastStatusCode,
+		LastError:      res.LastError,
+		CreatedAt:      res.CreatedAt,
+		DeliveredAt:    res.DeliveredAt,
+	}
+	return body
+}
+
+// NewSetAutoStopResponseBody builds the HTTP response body from the result of
+// the "set_auto_stop" endpoint of the "task" service.
+func NewSetAutoStopResponseBody(res *task.AutoStopPolicy) *SetAutoStopResponseBody {
+	body := &SetAutoStopResponseBody{
+		Threshold: res.Threshold,
+		Cap:       res.Cap,
+		Midnight:  res.Midnight,
+		Timezone:  res.Timezone,
+	}
+	return body
+}
+
+// NewSetupUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "setup" endpoint of the "task" service.
+func NewSetupUnauthorizedResponseBody(res *goa.ServiceError) *SetupUnauthorizedResponseBody {
+	body := &SetupUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetupInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "setup" endpoint of the "task" service.
+func NewSetupInternalServerErrorResponseBody(res *goa.ServiceError) *SetupInternalServerErrorResponseBody {
+	body := &SetupInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "task" service.
+func NewCreateUnauthorizedResponseBody(res *goa.ServiceError) *CreateUnauthorizedResponseBody {
+	body := &CreateUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "create" endpoint of the "task" service.
+func NewCreateInternalServerErrorResponseBody(res *goa.ServiceError) *CreateInternalServerErrorResponseBody {
+	body := &CreateInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "task" service.
+func NewListUnauthorizedResponseBody(res *goa.ServiceError) *ListUnauthorizedResponseBody {
+	body := &ListUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "list" endpoint of the "task" service.
+func NewListInternalServerErrorResponseBody(res *goa.ServiceError) *ListInternalServerErrorResponseBody {
+	body := &ListInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateBadRequestResponseBody builds the HTTP response body from the
+// result of the "update" endpoint of the "task" service.
+func NewUpdateBadRequestResponseBody(res *goa.ServiceError) *UpdateBadRequestResponseBody {
+	body := &UpdateBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "update" endpoint of the "task" service.
+func NewUpdateUnauthorizedResponseBody(res *goa.ServiceError) *UpdateUnauthorizedResponseBody {
+	body := &UpdateUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateTaskNotFoundResponseBody builds the HTTP response body from the
+// result of the "update" endpoint of the "task" service.
+func NewUpdateTaskNotFoundResponseBody(res *goa.ServiceError) *UpdateTaskNotFoundResponseBody {
+	body := &UpdateTaskNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateConflictResponseBody builds the HTTP response body from the result
+// of the "update" endpoint of the "task" service.
+func NewUpdateConflictResponseBody(res *goa.ServiceError) *UpdateConflictResponseBody {
+	body := &UpdateConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "update" endpoint of the "task" service.
+func NewUpdateInternalServerErrorResponseBody(res *goa.ServiceError) *UpdateInternalServerErrorResponseBody {
+	body := &UpdateInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "delete" endpoint of the "task" service.
+func NewDeleteUnauthorizedResponseBody(res *goa.ServiceError) *DeleteUnauthorizedResponseBody {
+	body := &DeleteUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteTaskNotFoundResponseBody builds the HTTP response body from the
+// result of the "delete" endpoint of the "task" service.
+func NewDeleteTaskNotFoundResponseBody(res *goa.ServiceError) *DeleteTaskNotFoundResponseBody {
+	body := &DeleteTaskNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "delete" endpoint of the "task" service.
+func NewDeleteInternalServerErrorResponseBody(res *goa.ServiceError) *DeleteInternalServerErrorResponseBody {
+	body := &DeleteInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCalendarTokenUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "calendar_token" endpoint of the "task" service.
+func NewCalendarTokenUnauthorizedResponseBody(res *goa.ServiceError) *CalendarTokenUnauthorizedResponseBody {
+	body := &CalendarTokenUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCalendarTokenInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "calendar_token" endpoint of the "task" service.
+func NewCalendarTokenInternalServerErrorResponseBody(res *goa.ServiceError) *CalendarTokenInternalServerErrorResponseBody {
+	body := &CalendarTokenInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCalendarUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "calendar" endpoint of the "task" service.
+func NewCalendarUnauthorizedResponseBody(res *goa.ServiceError) *CalendarUnauthorizedResponseBody {
+	body := &CalendarUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCalendarInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "calendar" endpoint of the "task" service.
+func NewCalendarInternalServerErrorResponseBody(res *goa.ServiceError) *CalendarInternalServerErrorResponseBody {
+	body := &CalendarInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewTimesheetBadRequestResponseBody builds the HTTP response body from the
+// result of the "timesheet" endpoint of the "task" service.
+func NewTimesheetBadRequestResponseBody(res *goa.ServiceError) *TimesheetBadRequestResponseBody {
+	body := &TimesheetBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewTimesheetUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "timesheet" endpoint of the "task" service.
+func NewTimesheetUnauthorizedResponseBody(res *goa.ServiceError) *TimesheetUnauthorizedResponseBody {
+	body := &TimesheetUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewTimesheetTaskNotFoundResponseBody builds the HTTP response body from the
+// result of the "timesheet" endpoint of the "task" service.
+func NewTimesheetTaskNotFoundResponseBody(res *goa.ServiceError) *TimesheetTaskNotFoundResponseBody {
+	body := &TimesheetTaskNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewTimesheetInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "timesheet" endpoint of the "task" service.
+func NewTimesheetInternalServerErrorResponseBody(res *goa.ServiceError) *TimesheetInternalServerErrorResponseBody {
+	body := &TimesheetInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewEstimatesUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "estimates" endpoint of the "task" service.
+func NewEstimatesUnauthorizedResponseBody(res *goa.ServiceError) *EstimatesUnauthorizedResponseBody {
+	body := &EstimatesUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewEstimatesTaskNotFoundResponseBody builds the HTTP response body from the
+// result of the "estimates" endpoint of the "task" service.
+func NewEstimatesTaskNotFoundResponseBody(res *goa.ServiceError) *EstimatesTaskNotFoundResponseBody {
+	body := &EstimatesTaskNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewEstimatesInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "estimates" endpoint of the "task" service.
+func NewEstimatesInternalServerErrorResponseBody(res *goa.ServiceError) *EstimatesInternalServerErrorResponseBody {
+	body := &EstimatesInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewLifecycleBadRequestResponseBody builds the HTTP response body from the
+// result of the "lifecycle" endpoint of the "task" service.
+func NewLifecycleBadRequestResponseBody(res *goa.ServiceError) *LifecycleBadRequestResponseBody {
+	body := &LifecycleBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewLifecycleUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "lifecycle" endpoint of the "task" service.
+func NewLifecycleUnauthorizedResponseBody(res *goa.ServiceError) *LifecycleUnauthorizedResponseBody {
+	body := &LifecycleUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewLifecycleTaskNotFoundResponseBody builds the HTTP response body from the
+// result of the "lifecycle" endpoint of the "task" service.
+func NewLifecycleTaskNotFoundResponseBody(res *goa.ServiceError) *LifecycleTaskNotFoundResponseBody {
+	body := &LifecycleTaskNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewLifecycleInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "lifecycle" endpoint of the "task" service.
+func NewLifecycleInternalServerErrorResponseBody(res *goa.ServiceError) *LifecycleInternalServerErrorResponseBody {
+	body := &LifecycleInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSessionsUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "sessions" endpoint of the "task" service.
+func NewSessionsUnauthorizedResponseBody(res *goa.ServiceError) *SessionsUnauthorizedResponseBody {
+	body := &SessionsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSessionsTaskNotFoundResponseBody builds the HTTP response body from the
+// result of the "sessions" endpoint of the "task" service.
+func NewSessionsTaskNotFoundResponseBody(res *goa.ServiceError) *SessionsTaskNotFoundResponseBody {
+	body := &SessionsTaskNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSessionsInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "sessions" endpoint of the "task" service.
+func NewSessionsInternalServerErrorResponseBody(res *goa.ServiceError) *SessionsInternalServerErrorResponseBody {
+	body := &SessionsInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddSessionBadRequestResponseBody builds the HTTP response body from the
+// result of the "add_session" endpoint of the "task" service.
+func NewAddSessionBadRequestResponseBody(res *goa.ServiceError) *AddSessionBadRequestResponseBody {
+	body := &AddSessionBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddSessionUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "add_session" endpoint of the "task" service.
+func NewAddSessionUnauthorizedResponseBody(res *goa.ServiceError) *AddSessionUnauthorizedResponseBody {
+	body := &AddSessionUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddSessionTaskNotFoundResponseBody builds the HTTP response body from the
+// result of the "add_session" endpoint of the "task" service.
+func NewAddSessionTaskNotFoundResponseBody(res *goa.ServiceError) *AddSessionTaskNotFoundResponseBody {
+	body := &AddSessionTaskNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddSessionConflictResponseBody builds the HTTP response body from the
+// result of the "add_session" endpoint of the "task" service.
+func NewAddSessionConflictResponseBody(res *goa.ServiceError) *AddSessionConflictResponseBody {
+	body := &AddSessionConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddSessionInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "add_session" endpoint of the "task" service.
+func NewAddSessionInternalServerErrorResponseBody(res *goa.ServiceError) *AddSessionInternalServerErrorResponseBody {
+	body := &AddSessionInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateSessionBadRequestResponseBody builds the HTTP response body from
+// the result of the "update_session" endpoint of the "task" service.
+func NewUpdateSessionBadRequestResponseBody(res *goa.ServiceError) *UpdateSessionBadRequestResponseBody {
+	body := &UpdateSessionBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateSessionUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "update_session" endpoint of the "task" service.
+func NewUpdateSessionUnauthorizedResponseBody(res *goa.ServiceError) *UpdateSessionUnauthorizedResponseBody {
+	body := &UpdateSessionUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateSessionSessionNotFoundResponseBody builds the HTTP response body
+// from the result of the "update_session" endpoint of the "task" service.
+func NewUpdateSessionSessionNotFoundResponseBody(res *goa.ServiceError) *UpdateSessionSessionNotFoundResponseBody {
+	body := &UpdateSessionSessionNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateSessionConflictResponseBody builds the HTTP response body from the
+// result of the "update_session" endpoint of the "task" service.
+func NewUpdateSessionConflictResponseBody(res *goa.ServiceError) *UpdateSessionConflictResponseBody {
+	body := &UpdateSessionConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateSessionInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "update_session" endpoint of the "task" service.
+func NewUpdateSessionInternalServerErrorResponseBody(res *goa.ServiceError) *UpdateSessionInternalServerErrorResponseBody {
+	body := &UpdateSessionInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteSessionUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "delete_session" endpoint of the "task" service.
+func NewDeleteSessionUnauthorizedResponseBody(res *goa.ServiceError) *DeleteSessionUnauthorizedResponseBody {
+	body := &DeleteSessionUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteSessionSessionNotFoundResponseBody builds the HTTP response body
+// from the result of the "delete_session" endpoint of the "task" service.
+func NewDeleteSessionSessionNotFoundResponseBody(res *goa.ServiceError) *DeleteSessionSessionNotFoundResponseBody {
+	body := &DeleteSessionSessionNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteSessionInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "delete_session" endpoint of the "task" service.
+func NewDeleteSessionInternalServerErrorResponseBody(res *goa.ServiceError) *DeleteSessionInternalServerErrorResponseBody {
+	body := &DeleteSessionInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewTrackProjectBadRequestResponseBody builds the HTTP response body from the
+// result of the "track_project" endpoint of the "task" service.
+func NewTrackProjectBadRequestResponseBody(res *goa.ServiceError) *TrackProjectBadRequestResponseBody {
+	body := &TrackProjectBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewTrackProjectUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "track_project" endpoint of the "task" service.
+func NewTrackProjectUnauthorizedResponseBody(res *goa.ServiceError) *TrackProjectUnauthorizedResponseBody {
+	body := &TrackProjectUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewTrackProjectTaskNotFoundResponseBody builds the HTTP response body from
+// the result of the "track_project" endpoint of the "task" service.
+func NewTrackProjectTaskNotFoundResponseBody(res *goa.ServiceError) *TrackProjectTaskNotFoundResponseBody {
+	body := &TrackProjectTaskNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewTrackProjectInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "track_project" endpoint of the "task" service.
+func NewTrackProjectInternalServerErrorResponseBody(res *goa.ServiceError) *TrackProjectInternalServerErrorResponseBody {
+	body := &TrackProjectInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUntrackProjectUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "untrack_project" endpoint of the "task" service.
+func NewUntrackProjectUnauthorizedResponseBody(res *goa.ServiceError) *UntrackProjectUnauthorizedResponseBody {
+	body := &UntrackProjectUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUntrackProjectProjectNotFoundResponseBody builds the HTTP response body
+// from the result of the "untrack_project" endpoint of the "task" service.
+func NewUntrackProjectProjectNotFoundResponseBody(res *goa.ServiceError) *UntrackProjectProjectNotFoundResponseBody {
+	body := &UntrackProjectProjectNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUntrackProjectInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "untrack_project" endpoint of the "task" service.
+func NewUntrackProjectInternalServerErrorResponseBody(res *goa.ServiceError) *UntrackProjectInternalServerErrorResponseBody {
+	body := &UntrackProjectInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBurndownUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "burndown" endpoint of the "task" service.
+func NewBurndownUnauthorizedResponseBody(res *goa.ServiceError) *BurndownUnauthorizedResponseBody {
+	body := &BurndownUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBurndownProjectNotFoundResponseBody builds the HTTP response body from
+// the result of the "burndown" endpoint of the "task" service.
+func NewBurndownProjectNotFoundResponseBody(res *goa.ServiceError) *BurndownProjectNotFoundResponseBody {
+	body := &BurndownProjectNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBurndownInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "burndown" endpoint of the "task" service.
+func NewBurndownInternalServerErrorResponseBody(res *goa.ServiceError) *BurndownInternalServerErrorResponseBody {
+	body := &BurndownInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRecomputeUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "recompute" endpoint of the "task" service.
+func NewRecomputeUnauthorizedResponseBody(res *goa.ServiceError) *RecomputeUnauthorizedResponseBody {
+	body := &RecomputeUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRecomputeInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "recompute" endpoint of the "task" service.
+func NewRecomputeInternalServerErrorResponseBody(res *goa.ServiceError) *RecomputeInternalServerErrorResponseBody {
+	body := &RecomputeInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewStreamUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "stream" endpoint of the "task" service.
+func NewStreamUnauthorizedResponseBody(res *goa.ServiceError) *StreamUnauthorizedResponseBody {
+	body := &StreamUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewStreamInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "stream" endpoint of the "task" service.
+func NewStreamInternalServerErrorResponseBody(res *goa.ServiceError) *StreamInternalServerErrorResponseBody {
+	body := &StreamInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRebuildProjectionsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "rebuild_projections" endpoint of the "task" service.
+func NewRebuildProjectionsUnauthorizedResponseBody(res *goa.ServiceError) *RebuildProjectionsUnauthorizedResponseBody {
+	body := &RebuildProjectionsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRebuildProjectionsInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "rebuild_projections" endpoint of the
+// "task" service.
+func NewRebuildProjectionsInternalServerErrorResponseBody(res *goa.ServiceError) *RebuildProjectionsInternalServerErrorResponseBody {
+	body := &RebuildProjectionsInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetWorkflowUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "get_workflow" endpoint of the "task" service.
+func NewGetWorkflowUnauthorizedResponseBody(res *goa.ServiceError) *GetWorkflowUnauthorizedResponseBody {
+	body := &GetWorkflowUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetWorkflowInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "get_workflow" endpoint of the "task" service.
+func NewGetWorkflowInternalServerErrorResponseBody(res *goa.ServiceError) *GetWorkflowInternalServerErrorResponseBody {
+	body := &GetWorkflowInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetWorkflowBadRequestResponseBody builds the HTTP response body from the
+// result of the "set_workflow" endpoint of the "task" service.
+func NewSetWorkflowBadRequestResponseBody(res *goa.ServiceError) *SetWorkflowBadRequestResponseBody {
+	body := &SetWorkflowBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetWorkflowUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "set_workflow" endpoint of the "task" service.
+func NewSetWorkflowUnauthorizedResponseBody(res *goa.ServiceError) *SetWorkflowUnauthorizedResponseBody {
+	body := &SetWorkflowUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetWorkflowInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "set_workflow" endpoint of the "task" service.
+func NewSetWorkflowInternalServerErrorResponseBody(res *goa.ServiceError) *SetWorkflowInternalServerErrorResponseBody {
+	body := &SetWorkflowInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetAutoStopUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "get_auto_stop" endpoint of the "task" service.
+func NewGetAutoStopUnauthorizedResponseBody(res *goa.ServiceError) *GetAutoStopUnauthorizedResponseBody {
+	body := &GetAutoStopUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetAutoStopInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "get_auto_stop" endpoint of the "task" service.
+func NewGetAutoStopInternalServerErrorResponseBody(res *goa.ServiceError) *GetAutoStopInternalServerErrorResponseBody {
+	body := &GetAutoStopInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWebhookBadRequestResponseBody builds the HTTP response body from
+// the result of the "create_webhook" endpoint of the "task" service.
+func NewCreateWebhookBadRequestResponseBody(res *goa.ServiceError) *CreateWebhookBadRequestResponseBody {
+	body := &CreateWebhookBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWebhookUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "create_webhook" endpoint of the "task" service.
+func NewCreateWebhookUnauthorizedResponseBody(res *goa.ServiceError) *CreateWebhookUnauthorizedResponseBody {
+	body := &CreateWebhookUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWebhookInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "create_webhook" endpoint of the "task" service.
+func NewCreateWebhookInternalServerErrorResponseBody(res *goa.ServiceError) *CreateWebhookInternalServerErrorResponseBody {
+	body := &CreateWebhookInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWebhooksUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "list_webhooks" endpoint of the "task" service.
+func NewListWebhooksUnauthorizedResponseBody(res *goa.ServiceError) *ListWebhooksUnauthorizedResponseBody {
+	body := &ListWebhooksUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWebhooksInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "list_webhooks" endpoint of the "task" service.
+func NewListWebhooksInternalServerErrorResponseBody(res *goa.ServiceError) *ListWebhooksInternalServerErrorResponseBody {
+	body := &ListWebhooksInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
 	return body
 }
 
-// NewCreateInternalServerErrorResponseBody builds the HTTP response body from
-// the result of the "create" endpoint of the "task" service.
-func NewCreateInternalServerErrorResponseBody(res *goa.ServiceError) *CreateInternalServerErrorResponseBody {
-	body := &CreateInternalServerErrorResponseBody{
+// NewDeleteWebhookUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "delete_webhook" endpoint of the "task" service.
+func NewDeleteWebhookUnauthorizedResponseBody(res *goa.ServiceError) *DeleteWebhookUnauthorizedResponseBody {
+	body := &DeleteWebhookUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -425,10 +3580,10 @@ func NewCreateInternalServerErrorResponseBody(res *goa.ServiceError) *CreateInte
 	return body
 }
 
-// NewListUnauthorizedResponseBody builds the HTTP response body from the
-// result of the "list" endpoint of the "task" service.
-func NewListUnauthorizedResponseBody(res *goa.ServiceError) *ListUnauthorizedResponseBody {
-	body := &ListUnauthorizedResponseBody{
+// NewDeleteWebhookWebhookNotFoundResponseBody builds the HTTP response body
+// from the result of the "delete_webhook" endpoint of the "task" service.
+func NewDeleteWebhookWebhookNotFoundResponseBody(res *goa.ServiceError) *DeleteWebhookWebhookNotFoundResponseBody {
+	body := &DeleteWebhookWebhookNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -439,10 +3594,10 @@ func NewListUnauthorizedResponseBody(res *goa.ServiceError) *ListUnauthorizedRes
 	return body
 }
 
-// NewListInternalServerErrorResponseBody builds the HTTP response body from
-// the result of the "list" endpoint of the "task" service.
-func NewListInternalServerErrorResponseBody(res *goa.ServiceError) *ListInternalServerErrorResponseBody {
-	body := &ListInternalServerErrorResponseBody{
+// NewDeleteWebhookInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "delete_webhook" endpoint of the "task" service.
+func NewDeleteWebhookInternalServerErrorResponseBody(res *goa.ServiceError) *DeleteWebhookInternalServerErrorResponseBody {
+	body := &DeleteWebhookInternalServerErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -453,10 +3608,11 @@ func NewListInternalServerErrorResponseBody(res *goa.ServiceError) *ListInternal
 	return body
 }
 
-// NewUpdateUnauthorizedResponseBody builds the HTTP response body from the
-// result of the "update" endpoint of the "task" service.
-func NewUpdateUnauthorizedResponseBody(res *goa.ServiceError) *UpdateUnauthorizedResponseBody {
-	body := &UpdateUnauthorizedResponseBody{
+// NewListWebhookDeliveriesUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "list_webhook_deliveries" endpoint of the "task"
+// service.
+func NewListWebhookDeliveriesUnauthorizedResponseBody(res *goa.ServiceError) *ListWebhookDeliveriesUnauthorizedResponseBody {
+	body := &ListWebhookDeliveriesUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -467,10 +3623,11 @@ func NewUpdateUnauthorizedResponseBody(res *goa.ServiceError) *UpdateUnauthorize
 	return body
 }
 
-// NewUpdateTaskNotFoundResponseBody builds the HTTP response body from the
-// result of the "update" endpoint of the "task" service.
-func NewUpdateTaskNotFoundResponseBody(res *goa.ServiceError) *UpdateTaskNotFoundResponseBody {
-	body := &UpdateTaskNotFoundResponseBody{
+// NewListWebhookDeliveriesWebhookNotFoundResponseBody builds the HTTP response
+// body from the result of the "list_webhook_deliveries" endpoint of the "task"
+// service.
+func NewListWebhookDeliveriesWebhookNotFoundResponseBody(res *goa.ServiceError) *ListWebhookDeliveriesWebhookNotFoundResponseBody {
+	body := &ListWebhookDeliveriesWebhookNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -481,10 +3638,11 @@ func NewUpdateTaskNotFoundResponseBody(res *goa.ServiceError) *UpdateTaskNotFoun
 	return body
 }
 
-// NewUpdateInternalServerErrorResponseBody builds the HTTP response body from
-// the result of the "update" endpoint of the "task" service.
-func NewUpdateInternalServerErrorResponseBody(res *goa.ServiceError) *UpdateInternalServerErrorResponseBody {
-	body := &UpdateInternalServerErrorResponseBody{
+// NewListWebhookDeliveriesInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "list_webhook_deliveries" endpoint of
+// the "task" service.
+func NewListWebhookDeliveriesInternalServerErrorResponseBody(res *goa.ServiceError) *ListWebhookDeliveriesInternalServerErrorResponseBody {
+	body := &ListWebhookDeliveriesInternalServerErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -495,10 +3653,10 @@ func NewUpdateInternalServerErrorResponseBody(res *goa.ServiceError) *UpdateInte
 	return body
 }
 
-// NewDeleteUnauthorizedResponseBody builds the HTTP response body from the
-// result of the "delete" endpoint of the "task" service.
-func NewDeleteUnauthorizedResponseBody(res *goa.ServiceError) *DeleteUnauthorizedResponseBody {
-	body := &DeleteUnauthorizedResponseBody{
+// NewRedeliverWebhookUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "redeliver_webhook" endpoint of the "task" service.
+func NewRedeliverWebhookUnauthorizedResponseBody(res *goa.ServiceError) *RedeliverWebhookUnauthorizedResponseBody {
+	body := &RedeliverWebhookUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -509,10 +3667,11 @@ func NewDeleteUnauthorizedResponseBody(res *goa.ServiceError) *DeleteUnauthorize
 	return body
 }
 
-// NewDeleteTaskNotFoundResponseBody builds the HTTP response body from the
-// result of the "delete" endpoint of the "task" service.
-func NewDeleteTaskNotFoundResponseBody(res *goa.ServiceError) *DeleteTaskNotFoundResponseBody {
-	body := &DeleteTaskNotFoundResponseBody{
+// NewRedeliverWebhookDeliveryNotFoundResponseBody builds the HTTP response
+// body from the result of the "redeliver_webhook" endpoint of the "task"
+// service.
+func NewRedeliverWebhookDeliveryNotFoundResponseBody(res *goa.ServiceError) *RedeliverWebhookDeliveryNotFoundResponseBody {
+	body := &RedeliverWebhookDeliveryNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -523,10 +3682,53 @@ func NewDeleteTaskNotFoundResponseBody(res *goa.ServiceError) *DeleteTaskNotFoun
 	return body
 }
 
-// NewDeleteInternalServerErrorResponseBody builds the HTTP response body from
-// the result of the "delete" endpoint of the "task" service.
-func NewDeleteInternalServerErrorResponseBody(res *goa.ServiceError) *DeleteInternalServerErrorResponseBody {
-	body := &DeleteInternalServerErrorResponseBody{
+// NewRedeliverWebhookInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "redeliver_webhook" endpoint of the "task"
+// service.
+func NewRedeliverWebhookInternalServerErrorResponseBody(res *goa.ServiceError) *RedeliverWebhookInternalServerErrorResponseBody {
+	body := &RedeliverWebhookInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetAutoStopBadRequestResponseBody builds the HTTP response body from the
+// result of the "set_auto_stop" endpoint of the "task" service.
+func NewSetAutoStopBadRequestResponseBody(res *goa.ServiceError) *SetAutoStopBadRequestResponseBody {
+	body := &SetAutoStopBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetAutoStopUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "set_auto_stop" endpoint of the "task" service.
+func NewSetAutoStopUnauthorizedResponseBody(res *goa.ServiceError) *SetAutoStopUnauthorizedResponseBody {
+	body := &SetAutoStopUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetAutoStopInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "set_auto_stop" endpoint of the "task" service.
+func NewSetAutoStopInternalServerErrorResponseBody(res *goa.ServiceError) *SetAutoStopInternalServerErrorResponseBody {
+	body := &SetAutoStopInternalServerErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -574,6 +3776,8 @@ func NewUpdateTaskUpdateInput(body *UpdateRequestBody, taskID string, authorizat
 		NextID:        body.NextID,
 		Status:        *body.Status,
 		EstimatedTime: body.EstimatedTime,
+		DueAt:         body.DueAt,
+		Rollup:        body.Rollup,
 	}
 	v.TaskID = taskID
 	v.Authorization = authorization
@@ -590,6 +3794,267 @@ func NewDeleteTaskDeleteInput(taskID string, authorization string) *task.TaskDel
 	return v
 }
 
+// NewCalendarTokenPayload builds a task service calendar_token endpoint
+// payload.
+func NewCalendarTokenPayload(authorization string) *task.CalendarTokenPayload {
+	v := &task.CalendarTokenPayload{}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewCalendarPayload builds a task service calendar endpoint payload.
+func NewCalendarPayload(token string, dueAsEvent *bool) *task.CalendarPayload {
+	v := &task.CalendarPayload{}
+	v.Token = token
+	v.DueAsEvent = dueAsEvent
+
+	return v
+}
+
+// NewTimesheetPayload builds a task service timesheet endpoint payload.
+func NewTimesheetPayload(from *string, to *string, timezone string, parentID *string, tag *string, round int, format string, authorization string) *task.TimesheetPayload {
+	v := &task.TimesheetPayload{}
+	v.From = from
+	v.To = to
+	v.Timezone = timezone
+	v.ParentID = parentID
+	v.Tag = tag
+	v.Round = round
+	v.Format = format
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewEstimatesPayload builds a task service estimates endpoint payload.
+func NewEstimatesPayload(parentID *string, period string, authorization string) *task.EstimatesPayload {
+	v := &task.EstimatesPayload{}
+	v.ParentID = parentID
+	v.Period = period
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewLifecyclePayload builds a task service lifecycle endpoint payload.
+func NewLifecyclePayload(parentID *string, from *string, to *string, timezone string, authorization string) *task.LifecyclePayload {
+	v := &task.LifecyclePayload{}
+	v.ParentID = parentID
+	v.From = from
+	v.To = to
+	v.Timezone = timezone
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewSessionsPayload builds a task service sessions endpoint payload.
+func NewSessionsPayload(taskID *string, authorization string) *task.SessionsPayload {
+	v := &task.SessionsPayload{}
+	v.TaskID = taskID
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewAddSessionPayload builds a task service add_session endpoint payload.
+func NewAddSessionPayload(body *AddSessionRequestBody, taskID string, authorization string) *task.AddSessionPayload {
+	v := &task.AddSessionPayload{
+		StartedAt: *body.StartedAt,
+		EndedAt:   *body.EndedAt,
+		Note:      body.Note,
+	}
+	v.TaskID = taskID
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewUpdateSessionPayload builds a task service update_session endpoint
+// payload.
+func NewUpdateSessionPayload(body *UpdateSessionRequestBody, sessionID string, authorization string) *task.UpdateSessionPayload {
+	v := &task.UpdateSessionPayload{
+		StartedAt: *body.StartedAt,
+		EndedAt:   *body.EndedAt,
+		Note:      body.Note,
+	}
+	v.SessionID = sessionID
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewDeleteSessionPayload builds a task service delete_session endpoint
+// payload.
+func NewDeleteSessionPayload(sessionID string, authorization string) *task.DeleteSessionPayload {
+	v := &task.DeleteSessionPayload{}
+	v.SessionID = sessionID
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewTrackProjectPayload builds a task service track_project endpoint payload.
+func NewTrackProjectPayload(body *TrackProjectRequestBody, taskID string, authorization string) *task.TrackProjectPayload {
+	v := &task.TrackProjectPayload{}
+	if body.Timezone != nil {
+		v.Timezone = *body.Timezone
+	}
+	if body.Timezone == nil {
+		v.Timezone = "UTC"
+	}
+	v.TaskID = taskID
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewUntrackProjectPayload builds a task service untrack_project endpoint
+// payload.
+func NewUntrackProjectPayload(taskID string, authorization string) *task.UntrackProjectPayload {
+	v := &task.UntrackProjectPayload{}
+	v.TaskID = taskID
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewBurndownPayload builds a task service burndown endpoint payload.
+func NewBurndownPayload(taskID string, authorization string) *task.BurndownPayload {
+	v := &task.BurndownPayload{}
+	v.TaskID = taskID
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewRecomputePayload builds a task service recompute endpoint payload.
+func NewRecomputePayload(authorization string) *task.RecomputePayload {
+	v := &task.RecomputePayload{}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewStreamPayload builds a task service stream endpoint payload.
+func NewStreamPayload(authorization string, lastEventID *string) *task.StreamPayload {
+	v := &task.StreamPayload{}
+	v.Authorization = authorization
+	v.LastEventID = lastEventID
+
+	return v
+}
+
+// NewRebuildProjectionsPayload builds a task service rebuild_projections
+// endpoint payload.
+func NewRebuildProjectionsPayload(dryRun bool, authorization string) *task.RebuildProjectionsPayload {
+	v := &task.RebuildProjectionsPayload{}
+	v.DryRun = dryRun
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewGetWorkflowPayload builds a task service get_workflow endpoint payload.
+func NewGetWorkflowPayload(authorization string) *task.GetWorkflowPayload {
+	v := &task.GetWorkflowPayload{}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewSetWorkflowPayload builds a task service set_workflow endpoint payload.
+func NewSetWorkflowPayload(body *SetWorkflowRequestBody, authorization string) *task.SetWorkflowPayload {
+	v := &task.SetWorkflowPayload{}
+	v.Statuses = make([]*task.WorkflowStatus, len(body.Statuses))
+	for i, val := range body.Statuses {
+		v.Statuses[i] = unmarshalWorkflowStatusRequestBodyToTaskWorkflowStatus(val)
+	}
+	v.Transitions = make([]*task.WorkflowTransition, len(body.Transitions))
+	for i, val := range body.Transitions {
+		v.Transitions[i] = unmarshalWorkflowTransitionRequestBodyToTaskWorkflowTransition(val)
+	}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewGetAutoStopPayload builds a task service get_auto_stop endpoint payload.
+func NewGetAutoStopPayload(authorization string) *task.GetAutoStopPayload {
+	v := &task.GetAutoStopPayload{}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewCreateWebhookPayload builds a task service create_webhook endpoint
+// payload.
+func NewCreateWebhookPayload(body *CreateWebhookRequestBody, authorization string) *task.CreateWebhookPayload {
+	v := &task.CreateWebhookPayload{
+		URL: *body.URL,
+	}
+	v.Events = make([]string, len(body.Events))
+	for i, val := range body.Events {
+		v.Events[i] = val
+	}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewListWebhooksPayload builds a task service list_webhooks endpoint payload.
+func NewListWebhooksPayload(authorization string) *task.ListWebhooksPayload {
+	v := &task.ListWebhooksPayload{}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewDeleteWebhookPayload builds a task service delete_webhook endpoint
+// payload.
+func NewDeleteWebhookPayload(webhookID string, authorization string) *task.DeleteWebhookPayload {
+	v := &task.DeleteWebhookPayload{}
+	v.WebhookID = webhookID
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewListWebhookDeliveriesPayload builds a task service
+// list_webhook_deliveries endpoint payload.
+func NewListWebhookDeliveriesPayload(webhookID string, authorization string) *task.ListWebhookDeliveriesPayload {
+	v := &task.ListWebhookDeliveriesPayload{}
+	v.WebhookID = webhookID
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewRedeliverWebhookPayload builds a task service redeliver_webhook endpoint
+// payload.
+func NewRedeliverWebhookPayload(webhookID string, deliveryID string, authorization string) *task.RedeliverWebhookPayload {
+	v := &task.RedeliverWebhookPayload{}
+	v.WebhookID = webhookID
+	v.DeliveryID = deliveryID
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewSetAutoStopPayload builds a task service set_auto_stop endpoint payload.
+func NewSetAutoStopPayload(body *SetAutoStopRequestBody, authorization string) *task.SetAutoStopPayload {
+	v := &task.SetAutoStopPayload{
+		Threshold: *body.Threshold,
+		Cap:       *body.Cap,
+		Midnight:  *body.Midnight,
+		Timezone:  *body.Timezone,
+	}
+	v.Authorization = authorization
+
+	return v
+}
+
 // ValidateCreateRequestBody runs the validations defined on CreateRequestBody
 func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
 	if body.Title == nil {
@@ -608,3 +4073,133 @@ func ValidateUpdateRequestBody(body *UpdateRequestBody) (err error) {
 	}
 	return
 }
+
+// ValidateAddSessionRequestBody runs the validations defined on
+// add_session_request_body
+func ValidateAddSessionRequestBody(body *AddSessionRequestBody) (err error) {
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.EndedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ended_at", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSessionRequestBody runs the validations defined on
+// update_session_request_body
+func ValidateUpdateSessionRequestBody(body *UpdateSessionRequestBody) (err error) {
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.EndedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ended_at", "body"))
+	}
+	return
+}
+
+// ValidateSetWorkflowRequestBody runs the validations defined on
+// set_workflow_request_body
+func ValidateSetWorkflowRequestBody(body *SetWorkflowRequestBody) (err error) {
+	if body.Statuses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("statuses", "body"))
+	}
+	if body.Transitions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transitions", "body"))
+	}
+	for _, e := range body.Statuses {
+		if e != nil {
+			if err2 := ValidateWorkflowStatusRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Transitions {
+		if e != nil {
+			if err2 := ValidateWorkflowTransitionRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateWebhookRequestBody runs the validations defined on
+// create_webhook_request_body
+func ValidateCreateWebhookRequestBody(body *CreateWebhookRequestBody) (err error) {
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.Events == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("events", "body"))
+	}
+	if len(body.Events) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.events", body.Events, len(body.Events), 1, true))
+	}
+	for _, e := range body.Events {
+		if !(e == "task.created" || e == "task.completed" || e == "task.moved" || e == "task.deleted" || e == "timer.started" || e == "timer.stopped") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.events[*]", e, []any{"task.created", "task.completed", "task.moved", "task.deleted", "timer.started", "timer.stopped"}))
+		}
+	}
+	return
+}
+
+// ValidateSetAutoStopRequestBody runs the validations defined on
+// set_auto_stop_request_body
+func ValidateSetAutoStopRequestBody(body *SetAutoStopRequestBody) (err error) {
+	if body.Threshold == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("threshold", "body"))
+	}
+	if body.Cap == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cap", "body"))
+	}
+	if body.Midnight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("midnight", "body"))
+	}
+	if body.Timezone == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timezone", "body"))
+	}
+	if body.Threshold != nil {
+		if *body.Threshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.threshold", *body.Threshold, 0, true))
+		}
+	}
+	if body.Cap != nil {
+		if *body.Cap < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cap", *body.Cap, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateWorkflowStatusRequestBody runs the validations defined on
+// WorkflowStatusRequestBody
+func ValidateWorkflowStatusRequestBody(body *WorkflowStatusRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Category == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("category", "body"))
+	}
+	if body.Name != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.name", *body.Name, "^[a-z][a-z0-9_-]{0,31}$"))
+	}
+	if body.Category != nil {
+		if !(*body.Category == "todo" || *body.Category == "doing" || *body.Category == "done") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.category", *body.Category, []any{"todo", "doing", "done"}))
+		}
+	}
+	return
+}
+
+// ValidateWorkflowTransitionRequestBody runs the validations defined on
+// WorkflowTransitionRequestBody
+func ValidateWorkflowTransitionRequestBody(body *WorkflowTransitionRequestBody) (err error) {
+	if body.From == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from", "body"))
+	}
+	if body.To == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("to", "body"))
+	}
+	return
+}
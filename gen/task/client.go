@@ -9,27 +9,76 @@ package task
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
 
 // Client is the "task" service client.
 type Client struct {
-	SetupEndpoint  goa.Endpoint
-	CreateEndpoint goa.Endpoint
-	ListEndpoint   goa.Endpoint
-	UpdateEndpoint goa.Endpoint
-	DeleteEndpoint goa.Endpoint
+	SetupEndpoint                 goa.Endpoint
+	CreateEndpoint                goa.Endpoint
+	ListEndpoint                  goa.Endpoint
+	UpdateEndpoint                goa.Endpoint
+	DeleteEndpoint                goa.Endpoint
+	CalendarTokenEndpoint         goa.Endpoint
+	CalendarEndpoint              goa.Endpoint
+	TimesheetEndpoint             goa.Endpoint
+	EstimatesEndpoint             goa.Endpoint
+	LifecycleEndpoint             goa.Endpoint
+	SessionsEndpoint              goa.Endpoint
+	AddSessionEndpoint            goa.Endpoint
+	UpdateSessionEndpoint         goa.Endpoint
+	DeleteSessionEndpoint         goa.Endpoint
+	TrackProjectEndpoint          goa.Endpoint
+	UntrackProjectEndpoint        goa.Endpoint
+	BurndownEndpoint              goa.Endpoint
+	RecomputeEndpoint             goa.Endpoint
+	StreamEndpoint                goa.Endpoint
+	RebuildProjectionsEndpoint    goa.Endpoint
+	GetWorkflowEndpoint           goa.Endpoint
+	SetWorkflowEndpoint           goa.Endpoint
+	GetAutoStopEndpoint           goa.Endpoint
+	CreateWebhookEndpoint         goa.Endpoint
+	ListWebhooksEndpoint          goa.Endpoint
+	DeleteWebhookEndpoint         goa.Endpoint
+	ListWebhookDeliveriesEndpoint goa.Endpoint
+	RedeliverWebhookEndpoint      goa.Endpoint
+	SetAutoStopEndpoint           goa.Endpoint
 }
 
 // NewClient initializes a "task" service client given the endpoints.
-func NewClient(setup, create, list, update, delete_ goa.Endpoint) *Client {
+func NewClient(setup, create, list, update, delete_, calendarToken, calendar, timesheet, estimates, lifecycle, sessions, addSession, updateSession, deleteSession, trackProject, untrackProject, burndown, recompute, stream, rebuildProjections, getWorkflow, setWorkflow, getAutoStop, createWebhook, listWebhooks, deleteWebhook, listWebhookDeliveries, redeliverWebhook, setAutoStop goa.Endpoint) *Client {
 	return &Client{
-		SetupEndpoint:  setup,
-		CreateEndpoint: create,
-		ListEndpoint:   list,
-		UpdateEndpoint: update,
-		DeleteEndpoint: delete_,
+		SetupEndpoint:                 setup,
+		CreateEndpoint:                create,
+		ListEndpoint:                  list,
+		UpdateEndpoint:                update,
+		DeleteEndpoint:                delete_,
+		CalendarTokenEndpoint:         calendarToken,
+		CalendarEndpoint:              calendar,
+		TimesheetEndpoint:             timesheet,
+		EstimatesEndpoint:             estimates,
+		LifecycleEndpoint:             lifecycle,
+		SessionsEndpoint:              sessions,
+		AddSessionEndpoint:            addSession,
+		UpdateSessionEndpoint:         updateSession,
+		DeleteSessionEndpoint:         deleteSession,
+		TrackProjectEndpoint:          trackProject,
+		UntrackProjectEndpoint:        untrackProject,
+		BurndownEndpoint:              burndown,
+		RecomputeEndpoint:             recompute,
+		StreamEndpoint:                stream,
+		RebuildProjectionsEndpoint:    rebuildProjections,
+		GetWorkflowEndpoint:           getWorkflow,
+		SetWorkflowEndpoint:           setWorkflow,
+		GetAutoStopEndpoint:           getAutoStop,
+		CreateWebhookEndpoint:         createWebhook,
+		ListWebhooksEndpoint:          listWebhooks,
+		DeleteWebhookEndpoint:         deleteWebhook,
+		ListWebhookDeliveriesEndpoint: listWebhookDeliveries,
+		RedeliverWebhookEndpoint:      redeliverWebhook,
+		SetAutoStopEndpoint:           setAutoStop,
 	}
 }
 
@@ -38,6 +87,12 @@ func NewClient(setup, create, list, update, delete_ goa.Endpoint) *Client {
 //   - "Unauthorized" (type *goa.ServiceError): Unauthorized
 //   - "InternalServerError" (type *goa.ServiceError): Internal server error
 //   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
 //   - error: internal error
 func (c *Client) Setup(ctx context.Context, p *SetupTaskInput) (err error) {
 	_, err = c.SetupEndpoint(ctx, p)
@@ -49,6 +104,12 @@ func (c *Client) Setup(ctx context.Context, p *SetupTaskInput) (err error) {
 //   - "Unauthorized" (type *goa.ServiceError): Unauthorized
 //   - "InternalServerError" (type *goa.ServiceError): Internal server error
 //   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
 //   - error: internal error
 func (c *Client) Create(ctx context.Context, p *CreateTaskInput) (res *Createtaskoutput, err error) {
 	var ires any
@@ -64,6 +125,12 @@ func (c *Client) Create(ctx context.Context, p *CreateTaskInput) (res *Createtas
 //   - "Unauthorized" (type *goa.ServiceError): Unauthorized
 //   - "InternalServerError" (type *goa.ServiceError): Internal server error
 //   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
 //   - error: internal error
 func (c *Client) List(ctx context.Context, p *ListPayload) (res CreatetaskoutputCollection, err error) {
 	var ires any
@@ -79,6 +146,12 @@ func (c *Client) List(ctx context.Context, p *ListPayload) (res Createtaskoutput
 //   - "Unauthorized" (type *goa.ServiceError): Unauthorized
 //   - "InternalServerError" (type *goa.ServiceError): Internal server error
 //   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
 //   - error: internal error
 func (c *Client) Update(ctx context.Context, p *TaskUpdateInput) (res *Createtaskoutput, err error) {
 	var ires any
@@ -94,8 +167,507 @@ func (c *Client) Update(ctx context.Context, p *TaskUpdateInput) (res *Createtas
 //   - "Unauthorized" (type *goa.ServiceError): Unauthorized
 //   - "InternalServerError" (type *goa.ServiceError): Internal server error
 //   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
 //   - error: internal error
 func (c *Client) Delete(ctx context.Context, p *TaskDeleteInput) (err error) {
 	_, err = c.DeleteEndpoint(ctx, p)
 	return
 }
+
+// CalendarToken calls the "calendar_token" endpoint of the "task" service.
+// CalendarToken may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) CalendarToken(ctx context.Context, p *CalendarTokenPayload) (res *CalendarTokenOutput, err error) {
+	var ires any
+	ires, err = c.CalendarTokenEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CalendarTokenOutput), nil
+}
+
+// Calendar calls the "calendar" endpoint of the "task" service.
+// Calendar may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) Calendar(ctx context.Context, p *CalendarPayload) (res *CalendarResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.CalendarEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*CalendarResponseData)
+	return o.Result, o.Body, nil
+}
+
+// Timesheet calls the "timesheet" endpoint of the "task" service.
+// Timesheet may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) Timesheet(ctx context.Context, p *TimesheetPayload) (res *TimesheetResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.TimesheetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*TimesheetResponseData)
+	return o.Result, o.Body, nil
+}
+
+// Estimates calls the "estimates" endpoint of the "task" service.
+// Estimates may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) Estimates(ctx context.Context, p *EstimatesPayload) (res *EstimatesOutput, err error) {
+	var ires any
+	ires, err = c.EstimatesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EstimatesOutput), nil
+}
+
+// Lifecycle calls the "lifecycle" endpoint of the "task" service.
+// Lifecycle may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) Lifecycle(ctx context.Context, p *LifecyclePayload) (res *LifecycleOutput, err error) {
+	var ires any
+	ires, err = c.LifecycleEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*LifecycleOutput), nil
+}
+
+// Sessions calls the "sessions" endpoint of the "task" service.
+// Sessions may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) Sessions(ctx context.Context, p *SessionsPayload) (res []*SessionOutput, err error) {
+	var ires any
+	ires, err = c.SessionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*SessionOutput), nil
+}
+
+// AddSession calls the "add_session" endpoint of the "task" service.
+// AddSession may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) AddSession(ctx context.Context, p *AddSessionPayload) (res *SessionOutput, err error) {
+	var ires any
+	ires, err = c.AddSessionEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SessionOutput), nil
+}
+
+// UpdateSession calls the "update_session" endpoint of the "task" service.
+// UpdateSession may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) UpdateSession(ctx context.Context, p *UpdateSessionPayload) (res *SessionOutput, err error) {
+	var ires any
+	ires, err = c.UpdateSessionEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SessionOutput), nil
+}
+
+// DeleteSession calls the "delete_session" endpoint of the "task" service.
+// DeleteSession may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) DeleteSession(ctx context.Context, p *DeleteSessionPayload) (err error) {
+	_, err = c.DeleteSessionEndpoint(ctx, p)
+	return
+}
+
+// TrackProject calls the "track_project" endpoint of the "task" service.
+// TrackProject may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) TrackProject(ctx context.Context, p *TrackProjectPayload) (err error) {
+	_, err = c.TrackProjectEndpoint(ctx, p)
+	return
+}
+
+// UntrackProject calls the "untrack_project" endpoint of the "task" service.
+// UntrackProject may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) UntrackProject(ctx context.Context, p *UntrackProjectPayload) (err error) {
+	_, err = c.UntrackProjectEndpoint(ctx, p)
+	return
+}
+
+// Burndown calls the "burndown" endpoint of the "task" service.
+// Burndown may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) Burndown(ctx context.Context, p *BurndownPayload) (res *BurndownOutput, err error) {
+	var ires any
+	ires, err = c.BurndownEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BurndownOutput), nil
+}
+
+// Recompute calls the "recompute" endpoint of the "task" service.
+// Recompute may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) Recompute(ctx context.Context, p *RecomputePayload) (res *RecomputeOutput, err error) {
+	var ires any
+	ires, err = c.RecomputeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RecomputeOutput), nil
+}
+
+// Stream calls the "stream" endpoint of the "task" service.
+// Stream may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) Stream(ctx context.Context, p *StreamPayload) (res StreamClientStream, err error) {
+	var ires any
+	ires, err = c.StreamEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(StreamClientStream), nil
+}
+
+// RebuildProjections calls the "rebuild_projections" endpoint of the "task"
+// service.
+// RebuildProjections may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) RebuildProjections(ctx context.Context, p *RebuildProjectionsPayload) (res *RebuildProjectionsOutput, err error) {
+	var ires any
+	ires, err = c.RebuildProjectionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RebuildProjectionsOutput), nil
+}
+
+// GetWorkflow calls the "get_workflow" endpoint of the "task" service.
+// GetWorkflow may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) GetWorkflow(ctx context.Context, p *GetWorkflowPayload) (res *Workflow, err error) {
+	var ires any
+	ires, err = c.GetWorkflowEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Workflow), nil
+}
+
+// SetWorkflow calls the "set_workflow" endpoint of the "task" service.
+// SetWorkflow may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) SetWorkflow(ctx context.Context, p *SetWorkflowPayload) (res *Workflow, err error) {
+	var ires any
+	ires, err = c.SetWorkflowEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Workflow), nil
+}
+
+// GetAutoStop calls the "get_auto_stop" endpoint of the "task" service.
+// GetAutoStop may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) GetAutoStop(ctx context.Context, p *GetAutoStopPayload) (res *AutoStopPolicy, err error) {
+	var ires any
+	ires, err = c.GetAutoStopEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AutoStopPolicy), nil
+}
+
+// CreateWebhook calls the "create_webhook" endpoint of the "task" service.
+// CreateWebhook may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) CreateWebhook(ctx context.Context, p *CreateWebhookPayload) (res *WebhookOutput, err error) {
+	var ires any
+	ires, err = c.CreateWebhookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WebhookOutput), nil
+}
+
+// ListWebhooks calls the "list_webhooks" endpoint of the "task" service.
+// ListWebhooks may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) ListWebhooks(ctx context.Context, p *ListWebhooksPayload) (res []*WebhookOutput, err error) {
+	var ires any
+	ires, err = c.ListWebhooksEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*WebhookOutput), nil
+}
+
+// DeleteWebhook calls the "delete_webhook" endpoint of the "task" service.
+// DeleteWebhook may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) DeleteWebhook(ctx context.Context, p *DeleteWebhookPayload) (err error) {
+	_, err = c.DeleteWebhookEndpoint(ctx, p)
+	return
+}
+
+// ListWebhookDeliveries calls the "list_webhook_deliveries" endpoint of the
+// "task" service.
+// ListWebhookDeliveries may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) ListWebhookDeliveries(ctx context.Context, p *ListWebhookDeliveriesPayload) (res []*WebhookDelivery, err error) {
+	var ires any
+	ires, err = c.ListWebhookDeliveriesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*WebhookDelivery), nil
+}
+
+// RedeliverWebhook calls the "redeliver_webhook" endpoint of the "task"
+// service.
+// RedeliverWebhook may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) RedeliverWebhook(ctx context.Context, p *RedeliverWebhookPayload) (res *WebhookDelivery, err error) {
+	var ires any
+	ires, err = c.RedeliverWebhookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WebhookDelivery), nil
+}
+
+// SetAutoStop calls the "set_auto_stop" endpoint of the "task" service.
+// SetAutoStop may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "TaskNotFound" (type *goa.ServiceError): Task not found
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "SessionNotFound" (type *goa.ServiceError): Session not found
+//   - "Conflict" (type *goa.ServiceError): Conflict
+//   - "ProjectNotFound" (type *goa.ServiceError): Project not found
+//   - "WebhookNotFound" (type *goa.ServiceError): Webhook not found
+//   - "DeliveryNotFound" (type *goa.ServiceError): Webhook delivery not found
+//   - error: internal error
+func (c *Client) SetAutoStop(ctx context.Context, p *SetAutoStopPayload) (res *AutoStopPolicy, err error) {
+	var ires any
+	ires, err = c.SetAutoStopEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AutoStopPolicy), nil
+}
@@ -9,27 +9,103 @@ package task
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
 
 // Endpoints wraps the "task" service endpoints.
 type Endpoints struct {
-	Setup  goa.Endpoint
-	Create goa.Endpoint
-	List   goa.Endpoint
-	Update goa.Endpoint
-	Delete goa.Endpoint
+	Setup                 goa.Endpoint
+	Create                goa.Endpoint
+	List                  goa.Endpoint
+	Update                goa.Endpoint
+	Delete                goa.Endpoint
+	CalendarToken         goa.Endpoint
+	Calendar              goa.Endpoint
+	Timesheet             goa.Endpoint
+	Estimates             goa.Endpoint
+	Lifecycle             goa.Endpoint
+	Sessions              goa.Endpoint
+	AddSession            goa.Endpoint
+	UpdateSession         goa.Endpoint
+	DeleteSession         goa.Endpoint
+	TrackProject          goa.Endpoint
+	UntrackProject        goa.Endpoint
+	Burndown              goa.Endpoint
+	Recompute             goa.Endpoint
+	Stream                goa.Endpoint
+	RebuildProjections    goa.Endpoint
+	GetWorkflow           goa.Endpoint
+	SetWorkflow           goa.Endpoint
+	GetAutoStop           goa.Endpoint
+	CreateWebhook         goa.Endpoint
+	ListWebhooks          goa.Endpoint
+	DeleteWebhook         goa.Endpoint
+	ListWebhookDeliveries goa.Endpoint
+	RedeliverWebhook      goa.Endpoint
+	SetAutoStop           goa.Endpoint
+}
+
+// CalendarResponseData holds both the result and the HTTP response body reader
+// of the "calendar" method.
+type CalendarResponseData struct {
+	// Result is the method result.
+	Result *CalendarResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
+// TimesheetResponseData holds both the result and the HTTP response body
+// reader of the "timesheet" method.
+type TimesheetResponseData struct {
+	// Result is the method result.
+	Result *TimesheetResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
+// StreamEndpointInput holds both the payload and the server stream of the
+// "stream" method.
+type StreamEndpointInput struct {
+	// Payload is the method payload.
+	Payload *StreamPayload
+	// Stream is the server stream used by the "stream" method to send data.
+	Stream StreamServerStream
 }
 
 // NewEndpoints wraps the methods of the "task" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		Setup:  NewSetupEndpoint(s),
-		Create: NewCreateEndpoint(s),
-		List:   NewListEndpoint(s),
-		Update: NewUpdateEndpoint(s),
-		Delete: NewDeleteEndpoint(s),
+		Setup:                 NewSetupEndpoint(s),
+		Create:                NewCreateEndpoint(s),
+		List:                  NewListEndpoint(s),
+		Update:                NewUpdateEndpoint(s),
+		Delete:                NewDeleteEndpoint(s),
+		CalendarToken:         NewCalendarTokenEndpoint(s),
+		Calendar:              NewCalendarEndpoint(s),
+		Timesheet:             NewTimesheetEndpoint(s),
+		Estimates:             NewEstimatesEndpoint(s),
+		Lifecycle:             NewLifecycleEndpoint(s),
+		Sessions:              NewSessionsEndpoint(s),
+		AddSession:            NewAddSessionEndpoint(s),
+		UpdateSession:         NewUpdateSessionEndpoint(s),
+		DeleteSession:         NewDeleteSessionEndpoint(s),
+		TrackProject:          NewTrackProjectEndpoint(s),
+		UntrackProject:        NewUntrackProjectEndpoint(s),
+		Burndown:              NewBurndownEndpoint(s),
+		Recompute:             NewRecomputeEndpoint(s),
+		Stream:                NewStreamEndpoint(s),
+		RebuildProjections:    NewRebuildProjectionsEndpoint(s),
+		GetWorkflow:           NewGetWorkflowEndpoint(s),
+		SetWorkflow:           NewSetWorkflowEndpoint(s),
+		GetAutoStop:           NewGetAutoStopEndpoint(s),
+		CreateWebhook:         NewCreateWebhookEndpoint(s),
+		ListWebhooks:          NewListWebhooksEndpoint(s),
+		DeleteWebhook:         NewDeleteWebhookEndpoint(s),
+		ListWebhookDeliveries: NewListWebhookDeliveriesEndpoint(s),
+		RedeliverWebhook:      NewRedeliverWebhookEndpoint(s),
+		SetAutoStop:           NewSetAutoStopEndpoint(s),
 	}
 }
 
@@ -40,6 +116,30 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.List = m(e.List)
 	e.Update = m(e.Update)
 	e.Delete = m(e.Delete)
+	e.CalendarToken = m(e.CalendarToken)
+	e.Calendar = m(e.Calendar)
+	e.Timesheet = m(e.Timesheet)
+	e.Estimates = m(e.Estimates)
+	e.Lifecycle = m(e.Lifecycle)
+	e.Sessions = m(e.Sessions)
+	e.AddSession = m(e.AddSession)
+	e.UpdateSession = m(e.UpdateSession)
+	e.DeleteSession = m(e.DeleteSession)
+	e.TrackProject = m(e.TrackProject)
+	e.UntrackProject = m(e.UntrackProject)
+	e.Burndown = m(e.Burndown)
+	e.Recompute = m(e.Recompute)
+	e.Stream = m(e.Stream)
+	e.RebuildProjections = m(e.RebuildProjections)
+	e.GetWorkflow = m(e.GetWorkflow)
+	e.SetWorkflow = m(e.SetWorkflow)
+	e.GetAutoStop = m(e.GetAutoStop)
+	e.CreateWebhook = m(e.CreateWebhook)
+	e.ListWebhooks = m(e.ListWebhooks)
+	e.DeleteWebhook = m(e.DeleteWebhook)
+	e.ListWebhookDeliveries = m(e.ListWebhookDeliveries)
+	e.RedeliverWebhook = m(e.RedeliverWebhook)
+	e.SetAutoStop = m(e.SetAutoStop)
 }
 
 // NewSetupEndpoint returns an endpoint function that calls the method "setup"
@@ -101,3 +201,227 @@ func NewDeleteEndpoint(s Service) goa.Endpoint {
 		return nil, s.Delete(ctx, p)
 	}
 }
+
+// NewCalendarTokenEndpoint returns an endpoint function that calls the method
+// "calendar_token" of service "task".
+func NewCalendarTokenEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CalendarTokenPayload)
+		return s.CalendarToken(ctx, p)
+	}
+}
+
+// NewCalendarEndpoint returns an endpoint function that calls the method
+// "calendar" of service "task".
+func NewCalendarEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CalendarPayload)
+		res, body, err := s.Calendar(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &CalendarResponseData{Result: res, Body: body}, nil
+	}
+}
+
+// NewTimesheetEndpoint returns an endpoint function that calls the method
+// "timesheet" of service "task".
+func NewTimesheetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TimesheetPayload)
+		res, body, err := s.Timesheet(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &TimesheetResponseData{Result: res, Body: body}, nil
+	}
+}
+
+// NewEstimatesEndpoint returns an endpoint function that calls the method
+// "estimates" of service "task".
+func NewEstimatesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*EstimatesPayload)
+		return s.Estimates(ctx, p)
+	}
+}
+
+// NewLifecycleEndpoint returns an endpoint function that calls the method
+// "lifecycle" of service "task".
+func NewLifecycleEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*LifecyclePayload)
+		return s.Lifecycle(ctx, p)
+	}
+}
+
+// NewSessionsEndpoint returns an endpoint function that calls the method
+// "sessions" of service "task".
+func NewSessionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SessionsPayload)
+		return s.Sessions(ctx, p)
+	}
+}
+
+// NewAddSessionEndpoint returns an endpoint function that calls the method
+// "add_session" of service "task".
+func NewAddSessionEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AddSessionPayload)
+		return s.AddSession(ctx, p)
+	}
+}
+
+// NewUpdateSessionEndpoint returns an endpoint function that calls the method
+// "update_session" of service "task".
+func NewUpdateSessionEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateSessionPayload)
+		return s.UpdateSession(ctx, p)
+	}
+}
+
+// NewDeleteSessionEndpoint returns an endpoint function that calls the method
+// "delete_session" of service "task".
+func NewDeleteSessionEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteSessionPayload)
+		return nil, s.DeleteSession(ctx, p)
+	}
+}
+
+// NewTrackProjectEndpoint returns an endpoint function that calls the method
+// "track_project" of service "task".
+func NewTrackProjectEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TrackProjectPayload)
+		return nil, s.TrackProject(ctx, p)
+	}
+}
+
+// NewUntrackProjectEndpoint returns an endpoint function that calls the method
+// "untrack_project" of service "task".
+func NewUntrackProjectEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UntrackProjectPayload)
+		return nil, s.UntrackProject(ctx, p)
+	}
+}
+
+// NewBurndownEndpoint returns an endpoint function that calls the method
+// "burndown" of service "task".
+func NewBurndownEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BurndownPayload)
+		return s.Burndown(ctx, p)
+	}
+}
+
+// NewRecomputeEndpoint returns an endpoint function that calls the method
+// "recompute" of service "task".
+func NewRecomputeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RecomputePayload)
+		return s.Recompute(ctx, p)
+	}
+}
+
+// NewStreamEndpoint returns an endpoint function that calls the method
+// "stream" of service "task".
+func NewStreamEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*StreamEndpointInput)
+		return nil, s.Stream(ctx, ep.Payload, ep.Stream)
+	}
+}
+
+// NewRebuildProjectionsEndpoint returns an endpoint function that calls the
+// method "rebuild_projections" of service "task".
+func NewRebuildProjectionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RebuildProjectionsPayload)
+		return s.RebuildProjections(ctx, p)
+	}
+}
+
+// NewGetWorkflowEndpoint returns an endpoint function that calls the method
+// "get_workflow" of service "task".
+func NewGetWorkflowEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetWorkflowPayload)
+		return s.GetWorkflow(ctx, p)
+	}
+}
+
+// NewSetWorkflowEndpoint returns an endpoint function that calls the method
+// "set_workflow" of service "task".
+func NewSetWorkflowEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetWorkflowPayload)
+		return s.SetWorkflow(ctx, p)
+	}
+}
+
+// NewGetAutoStopEndpoint returns an endpoint function that calls the method
+// "get_auto_stop" of service "task".
+func NewGetAutoStopEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetAutoStopPayload)
+		return s.GetAutoStop(ctx, p)
+	}
+}
+
+// NewCreateWebhookEndpoint returns an endpoint function that calls the method
+// "create_webhook" of service "task".
+func NewCreateWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateWebhookPayload)
+		return s.CreateWebhook(ctx, p)
+	}
+}
+
+// NewListWebhooksEndpoint returns an endpoint function that calls the method
+// "list_webhooks" of service "task".
+func NewListWebhooksEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListWebhooksPayload)
+		return s.ListWebhooks(ctx, p)
+	}
+}
+
+// NewDeleteWebhookEndpoint returns an endpoint function that calls the method
+// "delete_webhook" of service "task".
+func NewDeleteWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteWebhookPayload)
+		return nil, s.DeleteWebhook(ctx, p)
+	}
+}
+
+// NewListWebhookDeliveriesEndpoint returns an endpoint function that calls the
+// method "list_webhook_deliveries" of service "task".
+func NewListWebhookDeliveriesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListWebhookDeliveriesPayload)
+		return s.ListWebhookDeliveries(ctx, p)
+	}
+}
+
+// NewRedeliverWebhookEndpoint returns an endpoint function that calls the
+// method "redeliver_webhook" of service "task".
+func NewRedeliverWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RedeliverWebhookPayload)
+		return s.RedeliverWebhook(ctx, p)
+	}
+}
+
+// NewSetAutoStopEndpoint returns an endpoint function that calls the method
+// "set_auto_stop" of service "task".
+func NewSetAutoStopEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetAutoStopPayload)
+		return s.SetAutoStop(ctx, p)
+	}
+}
@@ -9,6 +9,7 @@ package task
 
 import (
 	"context"
+	"io"
 
 	taskviews "github.com/neatflowcv/focus/gen/task/views"
 	goa "goa.design/goa/v3/pkg"
@@ -26,6 +27,64 @@ type Service interface {
 	Update(context.Context, *TaskUpdateInput) (res *Createtaskoutput, err error)
 	// Delete a task.
 	Delete(context.Context, *TaskDeleteInput) (err error)
+	// Issue a token for subscribing to the calendar feed.
+	CalendarToken(context.Context, *CalendarTokenPayload) (res *CalendarTokenOutput, err error)
+	// Get the iCalendar feed of due tasks and recorded work sessions.
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	Calendar(context.Context, *CalendarPayload) (res *CalendarResult, body io.ReadCloser, err error)
+	// Export the time spent per task per day.
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	Timesheet(context.Context, *TimesheetPayload) (res *TimesheetResult, body io.ReadCloser, err error)
+	// Compare estimated and actual time of completed leaf tasks.
+	Estimates(context.Context, *EstimatesPayload) (res *EstimatesOutput, err error)
+	// List tasks completed in a range of days with their cycle and lead time.
+	Lifecycle(context.Context, *LifecyclePayload) (res *LifecycleOutput, err error)
+	// List recorded work sessions.
+	Sessions(context.Context, *SessionsPayload) (res []*SessionOutput, err error)
+	// Log time spent on a task afterward.
+	AddSession(context.Context, *AddSessionPayload) (res *SessionOutput, err error)
+	// Correct a recorded work session.
+	UpdateSession(context.Context, *UpdateSessionPayload) (res *SessionOutput, err error)
+	// Delete a recorded work session.
+	DeleteSession(context.Context, *DeleteSessionPayload) (err error)
+	// Record a daily burndown snapshot of the task and its subtasks.
+	TrackProject(context.Context, *TrackProjectPayload) (err error)
+	// Stop tracking the project and delete its snapshots.
+	UntrackProject(context.Context, *UntrackProjectPayload) (err error)
+	// List the daily snapshots of a tracked project for burndown and burnup charts.
+	Burndown(context.Context, *BurndownPayload) (res *BurndownOutput, err error)
+	// Recompute the leaf flag and status of the caller's tasks from their task
+	// tree.
+	Recompute(context.Context, *RecomputePayload) (res *RecomputeOutput, err error)
+	// Stream changes of the tasks as Server-Sent Events.
+	Stream(context.Context, *StreamPayload, StreamServerStream) (err error)
+	// Rebuild the caller's extras and traces from their task tree and report what
+	// was changed.
+	RebuildProjections(context.Context, *RebuildProjectionsPayload) (res *RebuildProjectionsOutput, err error)
+	// Get the statuses and allowed transitions of tasks.
+	GetWorkflow(context.Context, *GetWorkflowPayload) (res *Workflow, err error)
+	// Set the statuses and allowed transitions of tasks.
+	SetWorkflow(context.Context, *SetWorkflowPayload) (res *Workflow, err error)
+	// Get the policy for stopping forgotten running tasks.
+	GetAutoStop(context.Context, *GetAutoStopPayload) (res *AutoStopPolicy, err error)
+	// Register a URL that receives signed events of the tasks.
+	CreateWebhook(context.Context, *CreateWebhookPayload) (res *WebhookOutput, err error)
+	// List the registered webhooks.
+	ListWebhooks(context.Context, *ListWebhooksPayload) (res []*WebhookOutput, err error)
+	// Delete a webhook and its delivery log.
+	DeleteWebhook(context.Context, *DeleteWebhookPayload) (err error)
+	// List the deliveries of a webhook in the order they were created.
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesPayload) (res []*WebhookDelivery, err error)
+	// Send the payload of a delivery again as a new delivery.
+	RedeliverWebhook(context.Context, *RedeliverWebhookPayload) (res *WebhookDelivery, err error)
+	// Set the policy for stopping forgotten running tasks.
+	SetAutoStop(context.Context, *SetAutoStopPayload) (res *AutoStopPolicy, err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -42,7 +101,113 @@ const ServiceName = "task"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [5]string{"setup", "create", "list", "update", "delete"}
+var MethodNames = [29]string{"setup", "create", "list", "update", "delete", "calendar_token", "calendar", "timesheet", "estimates", "lifecycle", "sessions", "add_session", "update_session", "delete_session", "track_project", "untrack_project", "burndown", "recompute", "stream", "rebuild_projections", "get_workflow", "set_workflow", "get_auto_stop", "create_webhook", "list_webhooks", "delete_webhook", "list_webhook_deliveries", "redeliver_webhook", "set_auto_stop"}
+
+// StreamServerStream allows streaming instances of *StreamEvent to the client.
+type StreamServerStream interface {
+	// Send streams instances of "StreamEvent".
+	Send(*StreamEvent) error
+	// SendWithContext streams instances of "StreamEvent" with context.
+	SendWithContext(context.Context, *StreamEvent) error
+	// Close closes the stream.
+	Close() error
+}
+
+// StreamClientStream allows streaming instances of *StreamEvent to the client.
+type StreamClientStream interface {
+	// Recv reads instances of "StreamEvent" from the stream.
+	Recv() (*StreamEvent, error)
+	// RecvWithContext reads instances of "StreamEvent" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*StreamEvent, error)
+}
+
+// AddSessionPayload is the payload type of the task service add_session method.
+type AddSessionPayload struct {
+	// The authorization header
+	Authorization string
+	// The ID of the task
+	TaskID string
+	// The timestamp when the session was started
+	StartedAt int64
+	// The timestamp when the session was ended
+	EndedAt int64
+	// The note of the session
+	Note *string
+}
+
+// AutoStopPolicy is the result type of the task service get_auto_stop method.
+type AutoStopPolicy struct {
+	// Stop tasks running longer than this many seconds, 0 to disable
+	Threshold int64
+	// Record at most this many seconds for a stopped task, 0 for no cap
+	Cap int64
+	// Stop tasks still running past midnight
+	Midnight bool
+	// The IANA time zone used to find midnight
+	Timezone string
+}
+
+// BurndownOutput is the result type of the task service burndown method.
+type BurndownOutput struct {
+	// The time zone where a day ends
+	Timezone string
+	// The snapshots in date order, the last one is live
+	Points []*BurndownPoint
+}
+
+// BurndownPayload is the payload type of the task service burndown method.
+type BurndownPayload struct {
+	// The authorization header
+	Authorization string
+	// The ID of the root task of the project
+	TaskID string
+}
+
+type BurndownPoint struct {
+	// The date of the snapshot
+	Date string
+	// The estimated time of open leaf tasks in seconds
+	RemainingTime int64
+	// The estimated time of done leaf tasks in seconds
+	CompletedTime int64
+	// The actual time of the project in seconds
+	ActualTime int64
+	// The number of open leaf tasks
+	Open int
+	// The number of done leaf tasks
+	Done int
+}
+
+// CalendarPayload is the payload type of the task service calendar method.
+type CalendarPayload struct {
+	// The calendar token
+	Token string
+	// Whether to list due tasks as VEVENT instead of VTODO
+	DueAsEvent *bool
+}
+
+// CalendarResult is the result type of the task service calendar method.
+type CalendarResult struct {
+	// The content type of the feed
+	ContentType string
+}
+
+// CalendarTokenOutput is the result type of the task service calendar_token
+// method.
+type CalendarTokenOutput struct {
+	// The token for the calendar feed
+	Token string
+	// The timestamp when the token expires
+	ExpiresAt int64
+}
+
+// CalendarTokenPayload is the payload type of the task service calendar_token
+// method.
+type CalendarTokenPayload struct {
+	// The authorization header
+	Authorization string
+}
 
 // CreateTaskInput is the payload type of the task service create method.
 type CreateTaskInput struct {
@@ -54,6 +219,17 @@ type CreateTaskInput struct {
 	Title string
 }
 
+// CreateWebhookPayload is the payload type of the task service create_webhook
+// method.
+type CreateWebhookPayload struct {
+	// The authorization header
+	Authorization string
+	// The http or https URL that receives the events
+	URL string
+	// The events to send
+	Events []string
+}
+
 // Createtaskoutput is the result type of the task service create method.
 type Createtaskoutput struct {
 	// The ID of the task
@@ -70,16 +246,169 @@ type Createtaskoutput struct {
 	ActualTime *int64
 	// The timestamp when the task was started
 	StartedAt *int64
+	// The timestamp when the task is due
+	DueAt *int64
 	// Whether the task is a leaf task
 	IsLeaf *bool
 	// The status of the task
 	Status *string
+	// Whether the status is derived from the subtasks
+	Rollup *bool
+	// The timestamp when the task was first started
+	FirstStartedAt *int64
+	// The timestamp when the task was completed
+	CompletedAt *int64
+	// The number of times the task was reopened after completion
+	Reopens *int
 }
 
 // CreatetaskoutputCollection is the result type of the task service list
 // method.
 type CreatetaskoutputCollection []*Createtaskoutput
 
+// DeleteSessionPayload is the payload type of the task service delete_session
+// method.
+type DeleteSessionPayload struct {
+	// The authorization header
+	Authorization string
+	// The ID of the session
+	SessionID string
+}
+
+// DeleteWebhookPayload is the payload type of the task service delete_webhook
+// method.
+type DeleteWebhookPayload struct {
+	// The authorization header
+	Authorization string
+	// The ID of the webhook
+	WebhookID string
+}
+
+type EstimateGroup struct {
+	// The key of the group
+	Key string
+	// The statistics of the group
+	Stats *EstimateStats
+}
+
+type EstimateStats struct {
+	// The number of completed leaf tasks
+	Count int
+	// The median of actual time divided by estimated time
+	MedianRatio float64
+	// The mean of the smaller time divided by the larger time
+	MeanAccuracy float64
+	// The median of actual time minus estimated time in seconds
+	MedianOverrun int64
+}
+
+// EstimatesOutput is the result type of the task service estimates method.
+type EstimatesOutput struct {
+	// The statistics of all completed leaf tasks
+	Summary *EstimateStats
+	// The statistics per period of completion
+	Timeline []*EstimateGroup
+	// The statistics per depth
+	ByDepth []*EstimateGroup
+	// The statistics per +project and @context tag
+	ByTag []*EstimateGroup
+	// The statistics per top-level task ID
+	BySubtree []*EstimateGroup
+}
+
+// EstimatesPayload is the payload type of the task service estimates method.
+type EstimatesPayload struct {
+	// The authorization header
+	Authorization string
+	// Only analyze the subtasks of this task
+	ParentID *string
+	// The period of the timeline
+	Period string
+}
+
+type FlowGroup struct {
+	// The key of the group
+	Key string
+	// The statistics of the group
+	Stats *FlowStats
+}
+
+type FlowStats struct {
+	// The number of completed leaf tasks
+	Count int
+	// The number of completed leaf tasks that were started
+	Started int
+	// The total number of reopens
+	Reopens int
+	// The median cycle time in seconds
+	MedianCycleTime int64
+	// The mean cycle time in seconds
+	MeanCycleTime int64
+	// The median lead time in seconds
+	MedianLeadTime int64
+	// The mean lead time in seconds
+	MeanLeadTime int64
+}
+
+// GetAutoStopPayload is the payload type of the task service get_auto_stop
+// method.
+type GetAutoStopPayload struct {
+	// The authorization header
+	Authorization string
+}
+
+// GetWorkflowPayload is the payload type of the task service get_workflow
+// method.
+type GetWorkflowPayload struct {
+	// The authorization header
+	Authorization string
+}
+
+// LifecycleOutput is the result type of the task service lifecycle method.
+type LifecycleOutput struct {
+	// The completed tasks in order of completion
+	Tasks []*LifecycleTask
+	// The statistics of all completed leaf tasks
+	Summary *FlowStats
+	// The statistics per top-level task ID
+	BySubtree []*FlowGroup
+}
+
+// LifecyclePayload is the payload type of the task service lifecycle method.
+type LifecyclePayload struct {
+	// The authorization header
+	Authorization string
+	// Only analyze the subtasks of this task
+	ParentID *string
+	// The first day of completion to include
+	From *string
+	// The last day of completion to include
+	To *string
+	// The IANA time zone used to split days
+	Timezone string
+}
+
+type LifecycleTask struct {
+	// The ID of the task
+	ID string
+	// The title of the task
+	Title string
+	// Whether the task is a leaf task
+	IsLeaf bool
+	// The timestamp when the task was created
+	CreatedAt int64
+	// The timestamp when the task was first started
+	FirstStartedAt *int64
+	// The timestamp when the task was completed
+	CompletedAt int64
+	// The number of times the task was reopened after completion
+	Reopens int
+	// The seconds from the first start to completion
+	CycleTime *int64
+	// The seconds from creation to completion
+	LeadTime int64
+}
+
 // ListPayload is the payload type of the task service list method.
 type ListPayload struct {
 	// The authorization header
@@ -90,12 +419,170 @@ type ListPayload struct {
 	Recursive *bool
 }
 
+// ListWebhookDeliveriesPayload is the payload type of the task service
+// list_webhook_deliveries method.
+type ListWebhookDeliveriesPayload struct {
+	// The authorization header
+	Authorization string
+	// The ID of the webhook
+	WebhookID string
+}
+
+// ListWebhooksPayload is the payload type of the task service list_webhooks
+// method.
+type ListWebhooksPayload struct {
+	// The authorization header
+	Authorization string
+}
+
+type ProjectionChange struct {
+	// The projection that is changed
+	Projection string
+	// The ID of the task
+	ID string
+	// The kind of the change, delete_session removes the session in from
+	Kind string
+	// The stored value
+	From string
+	// The rebuilt value
+	To string
+}
+
+// RebuildProjectionsOutput is the result type of the task service
+// rebuild_projections method.
+type RebuildProjectionsOutput struct {
+	// Whether the changes were only reported
+	DryRun bool
+	// The changed extras
+	Extras *RecomputeOutput
+	// The changed traces
+	Traces *RecomputeOutput
+	// The changes in the order they are applied
+	Changes []*ProjectionChange
+}
+
+// RebuildProjectionsPayload is the payload type of the task service
+// rebuild_projections method.
+type RebuildProjectionsPayload struct {
+	// The authorization header
+	Authorization string
+	// Report the changes without saving them
+	DryRun bool
+}
+
+// RecomputeOutput is the result type of the task service recompute method.
+type RecomputeOutput struct {
+	// The number of missing records that were created
+	Created int
+	// The number of records that were fixed
+	Updated int
+	// The number of records without a task that were deleted
+	Deleted int
+}
+
+// RecomputePayload is the payload type of the task service recompute method.
+type RecomputePayload struct {
+	// The authorization header
+	Authorization string
+}
+
+// RedeliverWebhookPayload is the payload type of the task service
+// redeliver_webhook method.
+type RedeliverWebhookPayload struct {
+	// The authorization header
+	Authorization string
+	// The ID of the webhook
+	WebhookID string
+	// The ID of the delivery to send again
+	DeliveryID string
+}
+
+// SessionOutput is the result type of the task service add_session method.
+type SessionOutput struct {
+	// The ID of the session
+	ID string
+	// The ID of the task
+	TaskID string
+	// The timestamp when the session was started
+	StartedAt int64
+	// The timestamp when the session was ended
+	EndedAt int64
+	// Whether the session was stopped automatically
+	Auto bool
+	// The note of the session
+	Note *string
+}
+
+// SessionsPayload is the payload type of the task service sessions method.
+type SessionsPayload struct {
+	// The authorization header
+	Authorization string
+	// Only list the sessions of this task
+	TaskID *string
+}
+
+// SetAutoStopPayload is the payload type of the task service set_auto_stop
+// method.
+type SetAutoStopPayload struct {
+	// The authorization header
+	Authorization string
+	// Stop tasks running longer than this many seconds, 0 to disable
+	Threshold int64
+	// Record at most this many seconds for a stopped task, 0 for no cap
+	Cap int64
+	// Stop tasks still running past midnight
+	Midnight bool
+	// The IANA time zone used to find midnight
+	Timezone string
+}
+
+// SetWorkflowPayload is the payload type of the task service set_workflow
+// method.
+type SetWorkflowPayload struct {
+	// The authorization header
+	Authorization string
+	// The statuses, todo, doing and done are always included
+	Statuses []*WorkflowStatus
+	// The allowed transitions, empty to allow all
+	Transitions []*WorkflowTransition
+}
+
 // SetupTaskInput is the payload type of the task service setup method.
 type SetupTaskInput struct {
 	// The authorization header
 	Authorization string
 }
 
+// StreamEvent is the result type of the task service stream method.
+type StreamEvent struct {
+	// The ID to resume from, empty for timer ticks
+	ID string
+	// The kind of the event
+	Kind string
+	// The ID of the task
+	TaskID *string
+	// The parent ID of the task
+	ParentID *string
+	// The next ID of the task
+	NextID *string
+	// The status of the task
+	Status *string
+	// The actual time of the running task
+	ActualTime *int64
+	// The timestamp when the running task was started
+	StartedAt *int64
+	// The timestamp of the event
+	At int64
+}
+
+// StreamPayload is the payload type of the task service stream method.
+type StreamPayload struct {
+	// The authorization header
+	Authorization string
+	// Resume after this event ID
+	LastEventID *string
+}
+
 // TaskDeleteInput is the payload type of the task service delete method.
 type TaskDeleteInput struct {
 	// The authorization header
@@ -120,6 +607,135 @@ type TaskUpdateInput struct {
 	Status string
 	// The estimated time of the task
 	EstimatedTime *int64
+	// The timestamp when the task is due, 0 to clear
+	DueAt *int64
+	// Whether to derive the status from the subtasks
+	Rollup *bool
+}
+
+// TimesheetPayload is the payload type of the task service timesheet method.
+type TimesheetPayload struct {
+	// The authorization header
+	Authorization string
+	// The first day to include
+	From *string
+	// The last day to include
+	To *string
+	// The IANA time zone used to split days
+	Timezone string
+	// Only include this task and its subtasks
+	ParentID *string
+	// Only include tasks whose title contains this word
+	Tag *string
+	// Round each row to this many minutes
+	Round int
+	// The format of the timesheet
+	Format string
+}
+
+// TimesheetResult is the result type of the task service timesheet method.
+type TimesheetResult struct {
+	// The content type of the timesheet
+	ContentType string
+}
+
+// TrackProjectPayload is the payload type of the task service track_project
+// method.
+type TrackProjectPayload struct {
+	// The authorization header
+	Authorization string
+	// The ID of the root task of the project
+	TaskID string
+	// The IANA time zone where a day ends
+	Timezone string
+}
+
+// UntrackProjectPayload is the payload type of the task service
+// untrack_project method.
+type UntrackProjectPayload struct {
+	// The authorization header
+	Authorization string
+	// The ID of the root task of the project
+	TaskID string
+}
+
+// UpdateSessionPayload is the payload type of the task service update_session
+// method.
+type UpdateSessionPayload struct {
+	// The authorization header
+	Authorization string
+	// The ID of the session
+	SessionID string
+	// The timestamp when the session was started
+	StartedAt int64
+	// The timestamp when the session was ended
+	EndedAt int64
+	// The note of the session
+	Note *string
+}
+
+// WebhookDelivery is the result type of the task service redeliver_webhook
+// method.
+type WebhookDelivery struct {
+	// The ID of the delivery
+	ID string
+	// The ID of the webhook
+	WebhookID string
+	// The event that was sent
+	Event string
+	// The JSON body that was sent
+	Payload string
+	// The state of the delivery
+	Status string
+	// The number of attempts so far
+	Attempts int
+	// The timestamp of the next attempt while pending
+	NextAttemptAt *int64
+	// The status code of the last response
+	LastStatusCode *int
+	// The error of the last attempt
+	LastError *string
+	// The timestamp when the delivery was created
+	CreatedAt int64
+	// The timestamp when the delivery succeeded
+	DeliveredAt *int64
+}
+
+// WebhookOutput is the result type of the task service create_webhook method.
+type WebhookOutput struct {
+	// The ID of the webhook
+	ID string
+	// The URL that receives the events
+	URL string
+	// The events to send
+	Events []string
+	// The key of the HMAC-SHA256 signature in the X-Focus-Signature-256 header,
+	// returned only on creation
+	Secret *string
+	// The timestamp when the webhook was created
+	CreatedAt int64
+}
+
+// Workflow is the result type of the task service get_workflow method.
+type Workflow struct {
+	// The statuses, todo, doing and done are always included
+	Statuses []*WorkflowStatus
+	// The allowed transitions, empty to allow all
+	Transitions []*WorkflowTransition
+}
+
+type WorkflowStatus struct {
+	// The name of the status
+	Name string
+	// The core status that decides whether time is being spent
+	Category string
+}
+
+type WorkflowTransition struct {
+	// The status before the transition
+	From string
+	// The status after the transition
+	To string
 }
 
 // MakeUnauthorized builds a goa.ServiceError from an error.
@@ -137,6 +753,36 @@ func MakeTaskNotFound(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "TaskNotFound", false, false, false)
 }
 
+// MakeBadRequest builds a goa.ServiceError from an error.
+func MakeBadRequest(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "BadRequest", false, false, false)
+}
+
+// MakeSessionNotFound builds a goa.ServiceError from an error.
+func MakeSessionNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "SessionNotFound", false, false, false)
+}
+
+// MakeConflict builds a goa.ServiceError from an error.
+func MakeConflict(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "Conflict", false, false, false)
+}
+
+// MakeProjectNotFound builds a goa.ServiceError from an error.
+func MakeProjectNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "ProjectNotFound", false, false, false)
+}
+
+// MakeWebhookNotFound builds a goa.ServiceError from an error.
+func MakeWebhookNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "WebhookNotFound", false, false, false)
+}
+
+// MakeDeliveryNotFound builds a goa.ServiceError from an error.
+func MakeDeliveryNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "DeliveryNotFound", false, false, false)
+}
+
 // NewCreatetaskoutput initializes result type Createtaskoutput from viewed
 // result type Createtaskoutput.
 func NewCreatetaskoutput(vres *taskviews.Createtaskoutput) *Createtaskoutput {
@@ -169,12 +815,17 @@ func NewViewedCreatetaskoutputCollection(res CreatetaskoutputCollection, view st
 // Createtaskoutput.
 func newCreatetaskoutput(vres *taskviews.CreatetaskoutputView) *Createtaskoutput {
 	res := &Createtaskoutput{
-		ParentID:      vres.ParentID,
-		EstimatedTime: vres.EstimatedTime,
-		ActualTime:    vres.ActualTime,
-		StartedAt:     vres.StartedAt,
-		IsLeaf:        vres.IsLeaf,
-		Status:        vres.Status,
+		ParentID:       vres.ParentID,
+		EstimatedTime:  vres.EstimatedTime,
+		ActualTime:     vres.ActualTime,
+		StartedAt:      vres.StartedAt,
+		DueAt:          vres.DueAt,
+		IsLeaf:         vres.IsLeaf,
+		Status:         vres.Status,
+		Rollup:         vres.Rollup,
+		FirstStartedAt: vres.FirstStartedAt,
+		CompletedAt:    vres.CompletedAt,
+		Reopens:        vres.Reopens,
 	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
@@ -192,15 +843,20 @@ func newCreatetaskoutput(vres *taskviews.CreatetaskoutputView) *Createtaskoutput
 // type CreatetaskoutputView using the "default" view.
 func newCreatetaskoutputView(res *Createtaskoutput) *taskviews.CreatetaskoutputView {
 	vres := &taskviews.CreatetaskoutputView{
-		ID:            &res.ID,
-		ParentID:      res.ParentID,
-		Title:         &res.Title,
-		CreatedAt:     &res.CreatedAt,
-		EstimatedTime: res.EstimatedTime,
-		ActualTime:    res.ActualTime,
-		StartedAt:     res.StartedAt,
-		IsLeaf:        res.IsLeaf,
-		Status:        res.Status,
+		ID:             &res.ID,
+		ParentID:       res.ParentID,
+		Title:          &res.Title,
+		CreatedAt:      &res.CreatedAt,
+		EstimatedTime:  res.EstimatedTime,
+		ActualTime:     res.ActualTime,
+		StartedAt:      res.StartedAt,
+		DueAt:          res.DueAt,
+		IsLeaf:         res.IsLeaf,
+		Status:         res.Status,
+		Rollup:         res.Rollup,
+		FirstStartedAt: res.FirstStartedAt,
+		CompletedAt:    res.CompletedAt,
+		Reopens:        res.Reopens,
 	}
 	return vres
 }
@@ -44,10 +44,20 @@ type CreatetaskoutputView struct {
 	ActualTime *int64
 	// The timestamp when the task was started
 	StartedAt *int64
+	// The timestamp when the task is due
+	DueAt *int64
 	// Whether the task is a leaf task
 	IsLeaf *bool
 	// The status of the task
 	Status *string
+	// Whether the status is derived from the subtasks
+	Rollup *bool
+	// The timestamp when the task was first started
+	FirstStartedAt *int64
+	// The timestamp when the task was completed
+	CompletedAt *int64
+	// The number of times the task was reopened after completion
+	Reopens *int
 }
 
 // CreatetaskoutputCollectionView is a type that runs validations on a
@@ -66,8 +76,13 @@ var (
 			"estimated_time",
 			"actual_time",
 			"started_at",
+			"due_at",
 			"is_leaf",
 			"status",
+			"rollup",
+			"first_started_at",
+			"completed_at",
+			"reopens",
 		},
 	}
 	// CreatetaskoutputCollectionMap is a map indexing the attribute names of
@@ -81,8 +96,13 @@ var (
 			"estimated_time",
 			"actual_time",
 			"started_at",
+			"due_at",
 			"is_leaf",
 			"status",
+			"rollup",
+			"first_started_at",
+			"completed_at",
+			"reopens",
 		},
 	}
 )
@@ -0,0 +1,77 @@
+package analytics
+
+import "time"
+
+const (
+	PeriodWeek  = "week"
+	PeriodMonth = "month"
+)
+
+type EstimatesInput struct {
+	Username string
+	RootID   string // 비어있지 않으면 해당 task의 하위 task만 분석한다
+	Period   string // PeriodWeek 또는 PeriodMonth
+}
+
+// Stats는 완료된 leaf task들의 예상 시간 대비 실제 시간을 요약한다.
+type Stats struct {
+	Count         int
+	MedianRatio   float64       // actual / estimated의 중앙값. 1보다 크면 예상보다 오래 걸렸다
+	MeanAccuracy  float64       // min(actual, estimated) / max(actual, estimated)의 평균
+	MedianOverrun time.Duration // actual - estimated의 중앙값. 음수이면 예상보다 빨리 끝났다
+}
+
+type Group struct {
+	Key   string
+	Stats Stats
+}
+
+type EstimatesOutput struct {
+	Summary   Stats
+	Timeline  []*Group // 완료 시점의 기간별. 완료 시점을 알 수 없는 task는 제외한다
+	ByDepth   []*Group // 분석 기준 task로부터의 깊이별. 바로 아래가 1이다
+	ByTag     []*Group // 제목의 +project, @context 단어별
+	BySubtree []*Group // 분석 기준 task 바로 아래 task별
+}
+
+type LifecycleInput struct {
+	Username string
+	RootID   string    // 비어있지 않으면 해당 task의 하위 task만 분석한다
+	From     time.Time // 이 시각 이후에 완료된 task만 포함한다. zero이면 제한하지 않는다
+	To       time.Time // 이 시각 이전에 완료된 task만 포함한다. zero이면 제한하지 않는다
+}
+
+// LifecycleTask는 완료된 task 하나의 시작, 완료 기록이다.
+type LifecycleTask struct {
+	ID             string
+	Title          string
+	Leaf           bool
+	CreatedAt      time.Time
+	FirstStartedAt time.Time // 시작한 적이 없으면 zero
+	CompletedAt    time.Time
+	Reopens        int
+	CycleTime      time.Duration // 처음 시작부터 완료까지. 시작한 적이 없으면 0
+	LeadTime       time.Duration // 생성부터 완료까지
+}
+
+// FlowStats는 완료된 leaf task들의 cycle time과 lead time을 요약한다.
+type FlowStats struct {
+	Count           int
+	Started         int // cycle time을 구할 수 있는, 시작 기록이 있는 task의 수
+	Reopens         int // 다시 열린 횟수의 합
+	MedianCycleTime time.Duration
+	MeanCycleTime   time.Duration
+	MedianLeadTime  time.Duration
+	MeanLeadTime    time.Duration
+}
+
+type FlowGroup struct {
+	Key   string
+	Stats FlowStats
+}
+
+type LifecycleOutput struct {
+	Tasks     []*LifecycleTask // 완료 시각 순
+	Summary   FlowStats
+	BySubtree []*FlowGroup // 분석 기준 task 바로 아래 task별
+}
@@ -0,0 +1,8 @@
+package analytics
+
+import "errors"
+
+var (
+	ErrTaskNotFound  = errors.New("task not found")
+	ErrInvalidPeriod = errors.New("invalid period")
+)
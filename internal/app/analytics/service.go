@@ -0,0 +1,309 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+type Service struct {
+	flowService  *flow.Service
+	extraService *extra.Service
+	traceService *trace.Service
+}
+
+func NewService(flowService *flow.Service, extraService *extra.Service, traceService *trace.Service) *Service {
+	return &Service{
+		flowService:  flowService,
+		extraService: extraService,
+		traceService: traceService,
+	}
+}
+
+// Lifecycle은 기간 안에 완료된 task의 cycle time과 lead time을 구한다. 요약은 leaf task만 센다.
+func (s *Service) Lifecycle(ctx context.Context, input *LifecycleInput) (*LifecycleOutput, error) {
+	err := s.checkRoot(ctx, input.Username, input.RootID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskOut, err := s.flowService.ListTasks(ctx, &flow.ListTasksInput{
+		Username:  input.Username,
+		ParentID:  input.RootID,
+		Recursive: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	var ids []string
+
+	subtrees := make(map[string]string)
+
+	for _, task := range taskOut.Tasks {
+		ids = append(ids, task.ID)
+
+		if task.ParentID == input.RootID {
+			subtrees[task.ID] = task.ID
+		} else {
+			subtrees[task.ID] = subtrees[task.ParentID]
+		}
+	}
+
+	extraOut, err := s.extraService.ListExtras(ctx, &extra.ListExtrasInput{Username: input.Username, IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list extras: %w", err)
+	}
+
+	extras := make(map[string]*extra.Extra)
+	for _, item := range extraOut.Extras {
+		extras[item.ID] = item
+	}
+
+	var (
+		tasks  []*LifecycleTask
+		leaves []*LifecycleTask
+	)
+
+	bySubtree := make(map[string][]*LifecycleTask)
+
+	for _, task := range taskOut.Tasks {
+		item, ok := extras[task.ID]
+		if !ok || !isCompletedBetween(item, input.From, input.To) {
+			continue
+		}
+
+		value := newLifecycleTask(task, item)
+		tasks = append(tasks, value)
+
+		if !item.Leaf {
+			continue
+		}
+
+		leaves = append(leaves, value)
+		bySubtree[subtrees[task.ID]] = append(bySubtree[subtrees[task.ID]], value)
+	}
+
+	slices.SortStableFunc(tasks, func(a, b *LifecycleTask) int {
+		return a.CompletedAt.Compare(b.CompletedAt)
+	})
+
+	return &LifecycleOutput{
+		Tasks:     tasks,
+		Summary:   newFlowStats(leaves),
+		BySubtree: flowGroups(bySubtree),
+	}, nil
+}
+
+func (s *Service) checkRoot(ctx context.Context, username string, rootID string) error {
+	if rootID == "" {
+		return nil
+	}
+
+	_, err := s.flowService.GetTask(ctx, &flow.GetTaskInput{
+		Username: username,
+		TaskID:   rootID,
+	})
+	if err != nil {
+		if errors.Is(err, flow.ErrTaskNotFound) {
+			return ErrTaskNotFound
+		}
+
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+
+	return nil
+}
+
+// isCompletedBetween은 [from, to) 구간에 완료되었는지 확인한다.
+func isCompletedBetween(item *extra.Extra, from, to time.Time) bool {
+	if item.Category != string(domain.TaskStatusDone) || item.CompletedAt.IsZero() {
+		return false
+	}
+
+	if !from.IsZero() && item.CompletedAt.Before(from) {
+		return false
+	}
+
+	return to.IsZero() || item.CompletedAt.Before(to)
+}
+
+func newLifecycleTask(task *flow.Task, item *extra.Extra) *LifecycleTask {
+	var cycleTime time.Duration
+	if !item.FirstStartedAt.IsZero() {
+		cycleTime = item.CompletedAt.Sub(item.FirstStartedAt)
+	}
+
+	return &LifecycleTask{
+		ID:             task.ID,
+		Title:          task.Title,
+		Leaf:           item.Leaf,
+		CreatedAt:      task.CreatedAt,
+		FirstStartedAt: item.FirstStartedAt,
+		CompletedAt:    item.CompletedAt,
+		Reopens:        item.Reopens,
+		CycleTime:      cycleTime,
+		LeadTime:       item.CompletedAt.Sub(task.CreatedAt),
+	}
+}
+
+func (s *Service) Estimates(ctx context.Context, input *EstimatesInput) (*EstimatesOutput, error) { //nolint:cyclop,funlen
+	if input.Period != PeriodWeek && input.Period != PeriodMonth {
+		return nil, ErrInvalidPeriod
+	}
+
+	err := s.checkRoot(ctx, input.Username, input.RootID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskOut, err := s.flowService.ListTasks(ctx, &flow.ListTasksInput{
+		Username:  input.Username,
+		ParentID:  input.RootID,
+		Recursive: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	var ids []string
+
+	depths := make(map[string]int)
+	subtrees := make(map[string]string)
+
+	// 전위 순회 순서이므로 부모가 항상 먼저 나온다.
+	for _, task := range taskOut.Tasks {
+		ids = append(ids, task.ID)
+
+		if task.ParentID == input.RootID {
+			depths[task.ID] = 1
+			subtrees[task.ID] = task.ID
+
+			continue
+		}
+
+		depths[task.ID] = depths[task.ParentID] + 1
+		subtrees[task.ID] = subtrees[task.ParentID]
+	}
+
+	extraOut, err := s.extraService.ListExtras(ctx, &extra.ListExtrasInput{Username: input.Username, IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list extras: %w", err)
+	}
+
+	completed := make(map[string]bool)
+	completedAt := make(map[string]time.Time)
+
+	for _, item := range extraOut.Extras {
+		completed[item.ID] = item.Leaf && item.Category == string(domain.TaskStatusDone)
+
+		if !item.CompletedAt.IsZero() {
+			completedAt[item.ID] = item.CompletedAt
+		}
+	}
+
+	traceOut, err := s.traceService.ListTraces(ctx, &trace.ListTracesInput{Username: input.Username, IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list traces: %w", err)
+	}
+
+	sessionOut, err := s.traceService.ListSessions(ctx, &trace.ListSessionsInput{Username: input.Username, IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	// 완료 기록이 없으면 마지막 session이 끝난 시점을 완료 시점으로 본다.
+	lastEndedAt := make(map[string]time.Time)
+	for _, session := range sessionOut.Sessions {
+		if session.EndedAt.After(lastEndedAt[session.TraceID]) {
+			lastEndedAt[session.TraceID] = session.EndedAt
+		}
+	}
+
+	for id, at := range lastEndedAt {
+		if _, ok := completedAt[id]; !ok {
+			completedAt[id] = at
+		}
+	}
+
+	titles := make(map[string]string)
+	for _, task := range taskOut.Tasks {
+		titles[task.ID] = task.Title
+	}
+
+	var all []sample
+
+	timeline := make(map[string][]sample)
+	byDepth := make(map[string][]sample)
+	byTag := make(map[string][]sample)
+	bySubtree := make(map[string][]sample)
+
+	for _, item := range traceOut.Traces {
+		// 예상 시간이 없거나 실제 시간이 없으면 비교할 수 없다.
+		if !completed[item.ID] || item.Estimated <= 0 || item.Actual <= 0 {
+			continue
+		}
+
+		value := sample{estimated: item.Estimated, actual: item.Actual}
+		all = append(all, value)
+
+		if at, ok := completedAt[item.ID]; ok {
+			key := periodKey(at, input.Period)
+			timeline[key] = append(timeline[key], value)
+		}
+
+		depth := strconv.Itoa(depths[item.ID])
+		byDepth[depth] = append(byDepth[depth], value)
+
+		for _, tag := range tagsOf(titles[item.ID]) {
+			byTag[tag] = append(byTag[tag], value)
+		}
+
+		subtree := subtrees[item.ID]
+		bySubtree[subtree] = append(bySubtree[subtree], value)
+	}
+
+	return &EstimatesOutput{
+		Summary:   newStats(all),
+		Timeline:  groups(timeline, strings.Compare),
+		ByDepth:   groups(byDepth, compareNumber),
+		ByTag:     groups(byTag, strings.Compare),
+		BySubtree: groups(bySubtree, strings.Compare),
+	}, nil
+}
+
+// periodKey는 주 단위이면 해당 주 월요일의 날짜를, 월 단위이면 YYYY-MM을 반환한다.
+func periodKey(at time.Time, period string) string {
+	at = at.UTC()
+
+	if period == PeriodMonth {
+		return at.Format("2006-01")
+	}
+
+	const daysInWeek = 7
+
+	offset := (int(at.Weekday()) + daysInWeek - 1) % daysInWeek
+
+	return at.AddDate(0, 0, -offset).Format(time.DateOnly)
+}
+
+func tagsOf(title string) []string {
+	var tags []string
+
+	for _, word := range strings.Fields(title) {
+		if len(word) > 1 && (word[0] == '+' || word[0] == '@') {
+			tags = append(tags, word)
+		}
+	}
+
+	return tags
+}
@@ -0,0 +1,262 @@
+package analytics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/analytics"
+	"github.com/neatflowcv/focus/internal/app/apptest"
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/stretchr/testify/require"
+)
+
+const username = "test"
+
+func newService(t *testing.T, repo apptest.Repository) (*analytics.Service, *apptest.Services) {
+	t.Helper()
+
+	data := apptest.NewServices(t, repo, username)
+
+	return analytics.NewService(data.Flow, data.Extra, data.Trace), data
+}
+
+func createTask(t *testing.T, data *apptest.Services, title string, parentID string) string {
+	t.Helper()
+
+	out, _ := data.Flow.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: username,
+		Title:    title,
+		ParentID: parentID,
+		NextID:   "",
+		Now:      time.Now(),
+	})
+
+	return out.ID
+}
+
+func complete(
+	t *testing.T,
+	data *apptest.Services,
+	id string,
+	estimated time.Duration,
+	start time.Time,
+	actual time.Duration,
+) {
+	t.Helper()
+
+	_ = data.Trace.SetEstimated(t.Context(), &trace.SetEstimatedInput{Username: username, ID: id, Estimated: estimated})
+	_ = data.Extra.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+		Username: username,
+		ID:       id,
+		Status:   string(domain.TaskStatusDoing),
+		Now:      start,
+		Force:    false,
+	})
+	_ = data.Extra.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+		Username: username,
+		ID:       id,
+		Status:   string(domain.TaskStatusDone),
+		Now:      start.Add(actual),
+		Force:    false,
+	})
+}
+
+func TestServiceEstimates(t *testing.T) {
+	t.Parallel()
+
+	for _, backend := range apptest.Backends() {
+		t.Run(backend.Name, func(t *testing.T) {
+			t.Parallel()
+
+			service, data := newService(t, backend.New(t))
+			monday := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+			project := createTask(t, data, "release +app", "")
+			build := createTask(t, data, "build +app", project)
+			docs := createTask(t, data, "docs @desk", project)
+			chore := createTask(t, data, "chore", "")
+			pending := createTask(t, data, "pending", "")
+			complete(t, data, build, time.Hour, monday, 2*time.Hour)
+			complete(t, data, docs, time.Hour, monday.Add(7*24*time.Hour), 30*time.Minute)
+			complete(t, data, chore, 2*time.Hour, monday.Add(8*24*time.Hour), 2*time.Hour)
+			_ = data.Trace.SetEstimated(t.Context(), &trace.SetEstimatedInput{
+				Username:  username,
+				ID:        pending,
+				Estimated: time.Hour,
+			})
+
+			out, err := service.Estimates(t.Context(), &analytics.EstimatesInput{
+				Username: username,
+				RootID:   "",
+				Period:   analytics.PeriodWeek,
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, 3, out.Summary.Count)
+			require.InDelta(t, 1.0, out.Summary.MedianRatio, 1e-9)
+			require.InDelta(t, (0.5+0.5+1.0)/3, out.Summary.MeanAccuracy, 1e-9)
+			require.Equal(t, time.Duration(0), out.Summary.MedianOverrun)
+
+			require.Len(t, out.Timeline, 2)
+			require.Equal(t, "2025-06-02", out.Timeline[0].Key)
+			require.Equal(t, 1, out.Timeline[0].Stats.Count)
+			require.Equal(t, "2025-06-09", out.Timeline[1].Key)
+			require.Equal(t, 2, out.Timeline[1].Stats.Count)
+
+			require.Len(t, out.ByDepth, 2)
+			require.Equal(t, "1", out.ByDepth[0].Key)
+			require.Equal(t, 1, out.ByDepth[0].Stats.Count)
+			require.Equal(t, "2", out.ByDepth[1].Key)
+			require.Equal(t, 2, out.ByDepth[1].Stats.Count)
+
+			require.Len(t, out.ByTag, 2)
+			require.Equal(t, "+app", out.ByTag[0].Key)
+			require.InDelta(t, 2.0, out.ByTag[0].Stats.MedianRatio, 1e-9)
+			require.Equal(t, time.Hour, out.ByTag[0].Stats.MedianOverrun)
+
+			require.Len(t, out.BySubtree, 2)
+		})
+	}
+}
+
+func TestServiceEstimates_Subtree(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t, apptest.NewMemory(t))
+	now := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	project := createTask(t, data, "release", "")
+	feature := createTask(t, data, "feature", project)
+	step := createTask(t, data, "step", feature)
+	other := createTask(t, data, "other", "")
+	complete(t, data, step, time.Hour, now, 90*time.Minute)
+	complete(t, data, other, time.Hour, now, time.Hour)
+
+	out, err := service.Estimates(t.Context(), &analytics.EstimatesInput{
+		Username: username,
+		RootID:   project,
+		Period:   analytics.PeriodMonth,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 1, out.Summary.Count)
+	require.Equal(t, "2025-06", out.Timeline[0].Key)
+	require.Equal(t, "2", out.ByDepth[0].Key)
+	require.Equal(t, feature, out.BySubtree[0].Key)
+	require.Equal(t, 30*time.Minute, out.BySubtree[0].Stats.MedianOverrun)
+}
+
+func TestServiceEstimates_Error(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown root", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newService(t, apptest.NewMemory(t))
+
+		_, err := service.Estimates(t.Context(), &analytics.EstimatesInput{
+			Username: username,
+			RootID:   "unknown",
+			Period:   analytics.PeriodWeek,
+		})
+
+		require.ErrorIs(t, err, analytics.ErrTaskNotFound)
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newService(t, apptest.NewMemory(t))
+
+		_, err := service.Estimates(t.Context(), &analytics.EstimatesInput{
+			Username: username,
+			RootID:   "",
+			Period:   "day",
+		})
+
+		require.ErrorIs(t, err, analytics.ErrInvalidPeriod)
+	})
+}
+
+func TestServiceLifecycle(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t, apptest.NewMemory(t))
+	monday := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	project := createTask(t, data, "release", "")
+	build := createTask(t, data, "build", project)
+	docs := createTask(t, data, "docs", project)
+	chore := createTask(t, data, "chore", "")
+	createdAt := map[string]time.Time{}
+
+	for _, id := range []string{project, build, docs, chore} {
+		out, _ := data.Flow.GetTask(t.Context(), &flow.GetTaskInput{Username: username, TaskID: id})
+		createdAt[id] = out.Task.CreatedAt
+	}
+
+	complete(t, data, build, time.Hour, monday, 2*time.Hour)
+	complete(t, data, docs, time.Hour, monday.Add(24*time.Hour), time.Hour)
+	complete(t, data, chore, time.Hour, monday.Add(48*time.Hour), 4*time.Hour)
+	_ = data.Extra.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+		Username: username,
+		ID:       project,
+		Status:   string(domain.TaskStatusDone),
+		Now:      monday.Add(26 * time.Hour),
+		Force:    false,
+	})
+
+	out, err := service.Lifecycle(t.Context(), &analytics.LifecycleInput{
+		Username: username,
+		RootID:   "",
+		From:     time.Time{},
+		To:       time.Time{},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, out.Tasks, 4)
+	require.Equal(t, build, out.Tasks[0].ID)
+	require.Equal(t, 2*time.Hour, out.Tasks[0].CycleTime)
+	require.Equal(t, monday.Add(2*time.Hour).Sub(createdAt[build]), out.Tasks[0].LeadTime)
+	require.Equal(t, project, out.Tasks[2].ID)
+	require.False(t, out.Tasks[2].Leaf)
+	require.Equal(t, time.Duration(0), out.Tasks[2].CycleTime)
+
+	require.Equal(t, 3, out.Summary.Count)
+	require.Equal(t, 3, out.Summary.Started)
+	require.Equal(t, 2*time.Hour, out.Summary.MedianCycleTime)
+	require.Equal(t, (2*time.Hour+time.Hour+4*time.Hour)/3, out.Summary.MeanCycleTime)
+
+	require.Len(t, out.BySubtree, 2)
+
+	t.Run("completed between", func(t *testing.T) {
+		t.Parallel()
+
+		out, err := service.Lifecycle(t.Context(), &analytics.LifecycleInput{
+			Username: username,
+			RootID:   project,
+			From:     monday.Add(24 * time.Hour),
+			To:       monday.Add(48 * time.Hour),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out.Tasks, 1)
+		require.Equal(t, docs, out.Tasks[0].ID)
+		require.Len(t, out.BySubtree, 1)
+		require.Equal(t, docs, out.BySubtree[0].Key)
+		require.Equal(t, time.Hour, out.BySubtree[0].Stats.MeanCycleTime)
+	})
+
+	t.Run("unknown root", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := service.Lifecycle(t.Context(), &analytics.LifecycleInput{
+			Username: username,
+			RootID:   "unknown",
+			From:     time.Time{},
+			To:       time.Time{},
+		})
+
+		require.ErrorIs(t, err, analytics.ErrTaskNotFound)
+	})
+}
@@ -0,0 +1,149 @@
+package analytics
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+type sample struct {
+	estimated time.Duration
+	actual    time.Duration
+}
+
+func (s sample) ratio() float64 {
+	return float64(s.actual) / float64(s.estimated)
+}
+
+func (s sample) accuracy() float64 {
+	return float64(min(s.actual, s.estimated)) / float64(max(s.actual, s.estimated))
+}
+
+func newStats(samples []sample) Stats {
+	if len(samples) == 0 {
+		return Stats{
+			Count:         0,
+			MedianRatio:   0,
+			MeanAccuracy:  0,
+			MedianOverrun: 0,
+		}
+	}
+
+	ratios := make([]float64, 0, len(samples))
+	overruns := make([]time.Duration, 0, len(samples))
+	accuracy := 0.0
+
+	for _, item := range samples {
+		ratios = append(ratios, item.ratio())
+		overruns = append(overruns, item.actual-item.estimated)
+		accuracy += item.accuracy()
+	}
+
+	return Stats{
+		Count:         len(samples),
+		MedianRatio:   median(ratios),
+		MeanAccuracy:  accuracy / float64(len(samples)),
+		MedianOverrun: median(overruns),
+	}
+}
+
+func median[T float64 | time.Duration](values []T) T {
+	slices.Sort(values)
+
+	mid := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[mid]
+	}
+
+	return (values[mid-1] + values[mid]) / 2 //nolint:mnd
+}
+
+// groups는 compare 순서대로 정렬된 Group을 만든다.
+func groups(samples map[string][]sample, compare func(a, b string) int) []*Group {
+	var ret []*Group
+	for key, items := range samples {
+		ret = append(ret, &Group{
+			Key:   key,
+			Stats: newStats(items),
+		})
+	}
+
+	slices.SortFunc(ret, func(a, b *Group) int {
+		return compare(a.Key, b.Key)
+	})
+
+	return ret
+}
+
+// compareNumber는 음수가 아닌 정수 문자열을 수의 크기로 비교한다.
+func compareNumber(a, b string) int {
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+
+	return strings.Compare(a, b)
+}
+
+func newFlowStats(tasks []*LifecycleTask) FlowStats {
+	var (
+		cycles  []time.Duration
+		leads   []time.Duration
+		reopens int
+	)
+
+	for _, item := range tasks {
+		if !item.FirstStartedAt.IsZero() {
+			cycles = append(cycles, item.CycleTime)
+		}
+
+		leads = append(leads, item.LeadTime)
+		reopens += item.Reopens
+	}
+
+	return FlowStats{
+		Count:           len(tasks),
+		Started:         len(cycles),
+		Reopens:         reopens,
+		MedianCycleTime: medianOrZero(cycles),
+		MeanCycleTime:   mean(cycles),
+		MedianLeadTime:  medianOrZero(leads),
+		MeanLeadTime:    mean(leads),
+	}
+}
+
+func medianOrZero(values []time.Duration) time.Duration {
+	if len(values) == 0 {
+		return 0
+	}
+
+	return median(values)
+}
+
+func mean(values []time.Duration) time.Duration {
+	if len(values) == 0 {
+		return 0
+	}
+
+	var sum time.Duration
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / time.Duration(len(values))
+}
+
+func flowGroups(tasks map[string][]*LifecycleTask) []*FlowGroup {
+	var ret []*FlowGroup
+	for key, items := range tasks {
+		ret = append(ret, &FlowGroup{
+			Key:   key,
+			Stats: newFlowStats(items),
+		})
+	}
+
+	slices.SortFunc(ret, func(a, b *FlowGroup) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return ret
+}
@@ -0,0 +1,121 @@
+// Package apptest는 서비스 테스트가 함께 쓰는 준비 코드이다.
+package apptest
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/outbox"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/clock/system"
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/neatflowcv/focus/internal/pkg/idmaker/ulid"
+	"github.com/neatflowcv/focus/internal/pkg/repository"
+	"github.com/neatflowcv/focus/internal/pkg/repository/memory"
+	"github.com/neatflowcv/focus/internal/pkg/repository/sqlite"
+	"github.com/stretchr/testify/require"
+)
+
+type Repository interface {
+	repository.Repository
+	repository.ExtraRepository
+	repository.TraceRepository
+	repository.BackupRepository
+	repository.AutoStopRepository
+	repository.BurndownRepository
+	repository.WorkflowRepository
+	repository.OutboxRepository
+	repository.WebhookRepository
+	repository.DeadLetterRepository
+}
+
+type Backend struct {
+	Name string
+	New  func(t *testing.T) Repository
+}
+
+// Backends는 같은 테스트를 메모리와 SQLite 저장소에서 돌린다.
+func Backends() []Backend {
+	return []Backend{
+		{Name: "memory", New: NewMemory},
+		{Name: "sqlite", New: NewSQLite},
+	}
+}
+
+func NewMemory(*testing.T) Repository {
+	return memory.NewRepository()
+}
+
+func NewSQLite(t *testing.T) Repository {
+	t.Helper()
+
+	repo, err := sqlite.NewRepository(filepath.Join(t.TempDir(), "focus.db"))
+	require.NoError(t, err)
+
+	return repo
+}
+
+type Clock struct {
+	Time time.Time
+}
+
+func (c *Clock) Now() time.Time {
+	return c.Time
+}
+
+type Services struct {
+	Repo  Repository
+	Bus   *eventbus.Bus
+	Flow  *flow.Service
+	Extra *extra.Service
+	Trace *trace.Service
+}
+
+// NewServices는 task가 만들어지면 extra와 trace를, 상태가 바뀌면 trace를 맞추도록 이어 둔다.
+func NewServices(t *testing.T, repo Repository, username string) *Services {
+	t.Helper()
+
+	bus := eventbus.NewBus()
+	flowService := flow.NewService(outbox.NewService(system.NewClock(), repo, bus), ulid.NewIDMaker(), repo)
+	extraService := extra.NewService(bus, repo, repo)
+	traceService := trace.NewService(bus, ulid.NewIDMaker(), repo)
+
+	bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		_ = extraService.CreateExtra(ctx, &extra.CreateExtraInput{
+			Username: event.Username,
+			ID:       event.TaskID,
+			ParentID: event.ParentID,
+			Now:      event.Now,
+		})
+		_ = traceService.CreateTrace(ctx, &trace.CreateTraceInput{
+			Username: event.Username,
+			ID:       event.TaskID,
+			ParentID: event.ParentID,
+		})
+
+		return nil
+	})
+	bus.ExtraStatusUpdated.Subscribe(func(ctx context.Context, event *eventbus.ExtraStatusUpdatedEvent) error {
+		return traceService.UpdateStatus(ctx, &trace.UpdateStatusInput{
+			Username: event.Username,
+			ID:       event.ExtraID,
+			Status:   event.Category,
+			Now:      event.Now,
+		})
+	})
+
+	err := flowService.CreateRootDummy(t.Context(), &flow.CreateRootDummyInput{Username: username})
+	require.NoError(t, err)
+
+	return &Services{
+		Repo:  repo,
+		Bus:   bus,
+		Flow:  flowService,
+		Extra: extraService,
+		Trace: traceService,
+	}
+}
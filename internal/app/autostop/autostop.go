@@ -0,0 +1,34 @@
+package autostop
+
+import "time"
+
+type Policy struct {
+	Threshold time.Duration // 0이면 실행 시간으로 멈추지 않는다
+	Cap       time.Duration // 0이면 멈춘 시점까지의 시간을 모두 기록한다
+	Midnight  bool
+	Timezone  string
+}
+
+type SetPolicyInput struct {
+	Username string
+	Policy   Policy
+}
+
+type GetPolicyInput struct {
+	Username string
+}
+
+type GetPolicyOutput struct {
+	Policy Policy
+}
+
+type Stopped struct {
+	Username  string
+	TaskID    string
+	StartedAt time.Time
+	StoppedAt time.Time
+}
+
+type RunOutput struct {
+	Stopped []*Stopped
+}
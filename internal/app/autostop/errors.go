@@ -0,0 +1,8 @@
+package autostop
+
+import "errors"
+
+var (
+	ErrInvalidPolicy   = errors.New("invalid policy")
+	ErrInvalidTimezone = errors.New("invalid timezone")
+)
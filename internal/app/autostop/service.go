@@ -0,0 +1,186 @@
+package autostop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/clock"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/repository"
+)
+
+type Service struct {
+	clock        clock.Clock
+	repo         repository.AutoStopRepository
+	flowService  *flow.Service
+	extraService *extra.Service
+	traceService *trace.Service
+}
+
+func NewService(
+	clock clock.Clock,
+	repo repository.AutoStopRepository,
+	flowService *flow.Service,
+	extraService *extra.Service,
+	traceService *trace.Service,
+) *Service {
+	return &Service{
+		clock:        clock,
+		repo:         repo,
+		flowService:  flowService,
+		extraService: extraService,
+		traceService: traceService,
+	}
+}
+
+func (s *Service) SetPolicy(ctx context.Context, input *SetPolicyInput) error {
+	if input.Policy.Threshold < 0 || input.Policy.Cap < 0 {
+		return ErrInvalidPolicy
+	}
+
+	location, err := time.LoadLocation(input.Policy.Timezone)
+	if err != nil {
+		return ErrInvalidTimezone
+	}
+
+	policy := domain.NewAutoStopPolicy(
+		input.Username,
+		input.Policy.Threshold,
+		input.Policy.Cap,
+		input.Policy.Midnight,
+		location,
+	)
+
+	err = s.repo.SaveAutoStopPolicy(ctx, policy)
+	if err != nil {
+		return fmt.Errorf("failed to save auto stop policy: %w", err)
+	}
+
+	return nil
+}
+
+// GetPolicy는 사용자의 정책을 반환한다. 정책이 없으면 아무것도 멈추지 않는 정책을 반환한다.
+func (s *Service) GetPolicy(ctx context.Context, input *GetPolicyInput) (*GetPolicyOutput, error) {
+	policy, err := s.repo.GetAutoStopPolicy(ctx, input.Username)
+	if err != nil {
+		if errors.Is(err, repository.ErrAutoStopPolicyNotFound) {
+			return &GetPolicyOutput{
+				Policy: Policy{
+					Threshold: 0,
+					Cap:       0,
+					Midnight:  false,
+					Timezone:  time.UTC.String(),
+				},
+			}, nil
+		}
+
+		return nil, fmt.Errorf("failed to get auto stop policy: %w", err)
+	}
+
+	return &GetPolicyOutput{
+		Policy: Policy{
+			Threshold: policy.Threshold(),
+			Cap:       policy.Cap(),
+			Midnight:  policy.Midnight(),
+			Timezone:  policy.Location().String(),
+		},
+	}, nil
+}
+
+// Run은 정책이 있는 모든 사용자의 잊힌 trace를 멈춘다.
+func (s *Service) Run(ctx context.Context) (*RunOutput, error) {
+	policies, err := s.repo.ListAutoStopPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list auto stop policies: %w", err)
+	}
+
+	now := s.clock.Now()
+
+	var (
+		stopped []*Stopped
+		errs    []error
+	)
+
+	for _, policy := range policies {
+		items, err := s.stopUser(ctx, policy, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to auto stop traces of %s: %w", policy.Username(), err))
+		}
+
+		stopped = append(stopped, items...)
+	}
+
+	return &RunOutput{
+		Stopped: stopped,
+	}, errors.Join(errs...)
+}
+
+func (s *Service) stopUser(ctx context.Context, policy *domain.AutoStopPolicy, now time.Time) ([]*Stopped, error) {
+	taskOut, err := s.flowService.ListTasks(ctx, &flow.ListTasksInput{
+		Username:  policy.Username(),
+		ParentID:  "",
+		Recursive: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	var ids []string
+	for _, task := range taskOut.Tasks {
+		ids = append(ids, task.ID)
+	}
+
+	traceOut, err := s.traceService.ListTraces(ctx, &trace.ListTracesInput{Username: policy.Username(), IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list traces: %w", err)
+	}
+
+	var stopped []*Stopped
+
+	for _, item := range traceOut.Traces {
+		if item.StartedAt.IsZero() {
+			continue
+		}
+
+		stopAt, ok := policy.StopAt(item.StartedAt, now)
+		if !ok {
+			continue
+		}
+
+		// trace를 먼저 멈춰야 status 변경으로 now까지의 시간이 기록되지 않는다.
+		err := s.traceService.StopTrace(ctx, &trace.StopTraceInput{
+			Username: policy.Username(),
+			ID:       item.ID,
+			At:       stopAt,
+			Auto:     true,
+		})
+		if err != nil {
+			return stopped, fmt.Errorf("failed to stop trace: %w", err)
+		}
+
+		err = s.extraService.UpdateStatus(ctx, &extra.UpdateStatusInput{
+			Username: policy.Username(),
+			ID:       item.ID,
+			Status:   string(domain.TaskStatusTodo),
+			Now:      now,
+			Force:    true,
+		})
+		if err != nil {
+			return stopped, fmt.Errorf("failed to update status: %w", err)
+		}
+
+		stopped = append(stopped, &Stopped{
+			Username:  policy.Username(),
+			TaskID:    item.ID,
+			StartedAt: item.StartedAt,
+			StoppedAt: stopAt,
+		})
+	}
+
+	return stopped, nil
+}
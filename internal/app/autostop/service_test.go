@@ -0,0 +1,197 @@
+package autostop_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/apptest"
+	"github.com/neatflowcv/focus/internal/app/autostop"
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/repository/memory"
+	"github.com/stretchr/testify/require"
+)
+
+const username = "test"
+
+type ServiceData struct {
+	*apptest.Services
+
+	clock *apptest.Clock
+}
+
+func newService(t *testing.T, repo apptest.Repository) (*autostop.Service, *ServiceData) {
+	t.Helper()
+
+	services := apptest.NewServices(t, repo, username)
+	clock := &apptest.Clock{Time: time.Time{}}
+	service := autostop.NewService(clock, repo, services.Flow, services.Extra, services.Trace)
+
+	return service, &ServiceData{Services: services, clock: clock}
+}
+
+func startTask(t *testing.T, data *ServiceData, parentID string, at time.Time) string {
+	t.Helper()
+
+	out, _ := data.Flow.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: username,
+		Title:    "task",
+		ParentID: parentID,
+		NextID:   "",
+		Now:      at,
+	})
+	_ = data.Extra.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+		Username: username,
+		ID:       out.ID,
+		Status:   string(domain.TaskStatusDoing),
+		Now:      at,
+		Force:    false,
+	})
+
+	return out.ID
+}
+
+func TestServiceRun(t *testing.T) {
+	t.Parallel()
+
+	repo := memory.NewRepository()
+	service, data := newService(t, repo)
+	friday := time.Date(2025, 7, 4, 17, 0, 0, 0, time.UTC)
+	parent, _ := data.Flow.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: username,
+		Title:    "project",
+		ParentID: "",
+		NextID:   "",
+		Now:      friday,
+	})
+	forgotten := startTask(t, data, parent.ID, friday)
+	recent := startTask(t, data, "", friday.Add(62*time.Hour))
+	_ = service.SetPolicy(t.Context(), &autostop.SetPolicyInput{
+		Username: username,
+		Policy: autostop.Policy{
+			Threshold: 4 * time.Hour,
+			Cap:       time.Hour,
+			Midnight:  false,
+			Timezone:  "UTC",
+		},
+	})
+	data.clock.Time = friday.Add(63 * time.Hour)
+
+	out, err := service.Run(t.Context())
+
+	require.NoError(t, err)
+	require.Len(t, out.Stopped, 1)
+	require.Equal(t, forgotten, out.Stopped[0].TaskID)
+	require.Equal(t, friday.Add(time.Hour), out.Stopped[0].StoppedAt)
+	require.Equal(t, domain.TaskStatusTodo, repo.Extras[username][domain.ExtraID(forgotten)].Status())
+	require.Equal(t, time.Hour, repo.Traces[username][domain.TraceID(parent.ID)].Actual())
+	require.True(t, repo.Traces[username][domain.TraceID(forgotten)].StartedAt().IsZero())
+	require.Equal(t, domain.TaskStatusDoing, repo.Extras[username][domain.ExtraID(recent)].Status())
+
+	for _, session := range repo.Sessions[username] {
+		require.True(t, session.Auto())
+	}
+}
+
+func TestServiceRun_Midnight(t *testing.T) {
+	t.Parallel()
+
+	repo := memory.NewRepository()
+	service, data := newService(t, repo)
+	seoul, _ := time.LoadLocation("Asia/Seoul")
+	evening := time.Date(2025, 7, 4, 22, 0, 0, 0, seoul)
+	task := startTask(t, data, "", evening)
+	_ = service.SetPolicy(t.Context(), &autostop.SetPolicyInput{
+		Username: username,
+		Policy: autostop.Policy{
+			Threshold: 0,
+			Cap:       0,
+			Midnight:  true,
+			Timezone:  "Asia/Seoul",
+		},
+	})
+
+	data.clock.Time = evening.Add(time.Hour)
+	out, _ := service.Run(t.Context())
+	require.Empty(t, out.Stopped)
+
+	data.clock.Time = evening.Add(3 * time.Hour)
+	out, err := service.Run(t.Context())
+
+	require.NoError(t, err)
+	require.Len(t, out.Stopped, 1)
+	require.Equal(t, 2*time.Hour, repo.Traces[username][domain.TraceID(task)].Actual())
+}
+
+func TestServiceRun_AlreadyStopped(t *testing.T) {
+	t.Parallel()
+
+	for _, backend := range apptest.Backends() {
+		t.Run(backend.Name, func(t *testing.T) {
+			t.Parallel()
+
+			service, data := newService(t, backend.New(t))
+			start := time.Date(2025, 7, 4, 9, 0, 0, 0, time.UTC)
+			task := startTask(t, data, "", start)
+			err := data.Extra.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+				Username: username,
+				ID:       task,
+				Status:   string(domain.TaskStatusDone),
+				Now:      start.Add(time.Hour),
+				Force:    false,
+			})
+			require.NoError(t, err)
+			_ = service.SetPolicy(t.Context(), &autostop.SetPolicyInput{
+				Username: username,
+				Policy: autostop.Policy{
+					Threshold: 4 * time.Hour,
+					Cap:       time.Hour,
+					Midnight:  false,
+					Timezone:  "UTC",
+				},
+			})
+			data.clock.Time = start.Add(10 * time.Hour)
+
+			out, err := service.Run(t.Context())
+
+			require.NoError(t, err)
+			require.Empty(t, out.Stopped)
+
+			sessions, err := data.Trace.ListSessions(t.Context(), &trace.ListSessionsInput{
+				Username: username,
+				IDs:      []string{task},
+			})
+			require.NoError(t, err)
+			require.Len(t, sessions.Sessions, 1)
+			require.False(t, sessions.Sessions[0].Auto)
+
+			extras, err := data.Extra.ListExtras(t.Context(), &extra.ListExtrasInput{
+				Username: username,
+				IDs:      []string{task},
+			})
+			require.NoError(t, err)
+			require.Equal(t, string(domain.TaskStatusDone), extras.Extras[0].Status)
+			require.Zero(t, extras.Extras[0].Reopens)
+		})
+	}
+}
+
+func TestServiceSetPolicy_Error(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newService(t, apptest.NewMemory(t))
+
+	err := service.SetPolicy(t.Context(), &autostop.SetPolicyInput{
+		Username: username,
+		Policy: autostop.Policy{
+			Threshold: time.Hour,
+			Cap:       0,
+			Midnight:  false,
+			Timezone:  "Mars/Olympus",
+		},
+	})
+
+	require.ErrorIs(t, err, autostop.ErrInvalidTimezone)
+}
@@ -0,0 +1,16 @@
+package backup
+
+import "time"
+
+type BackupInput struct {
+	Usernames []string // 비어 있으면 모든 사용자
+	Now       time.Time
+}
+
+type BackupOutput struct {
+	Dump *Dump
+}
+
+type RestoreInput struct {
+	Dump *Dump
+}
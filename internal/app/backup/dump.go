@@ -0,0 +1,125 @@
+package backup
+
+import (
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+// FormatVersion은 Dump의 현재 포맷 버전이다. 포맷이 바뀌면 올려야 한다.
+const FormatVersion = 7
+
+type Dump struct {
+	Version   int         `json:"version"`
+	CreatedAt time.Time   `json:"created_at"`
+	Users     []*UserDump `json:"users"`
+}
+
+type UserDump struct {
+	Username string           `json:"username"`
+	Tasks    []*TaskRecord    `json:"tasks"`
+	Extras   []*ExtraRecord   `json:"extras"`
+	Traces   []*TraceRecord   `json:"traces"`
+	Sessions []*SessionRecord `json:"sessions"` // version 2부터
+
+	// version 7부터
+	Workflow *WorkflowRecord  `json:"workflow,omitempty"` // 없으면 기본 workflow
+	AutoStop *AutoStopRecord  `json:"auto_stop,omitempty"`
+	Projects []*ProjectRecord `json:"projects,omitempty"`
+	Webhooks []*WebhookRecord `json:"webhooks,omitempty"`
+}
+
+type TaskRecord struct {
+	ID        string    `json:"id"`
+	ParentID  string    `json:"parent_id"`
+	NextID    string    `json:"next_id"`
+	Title     string    `json:"title"`
+	CreatedAt time.Time `json:"created_at"`
+	Version   uint64    `json:"version"`
+}
+
+type ExtraRecord struct {
+	ID       string `json:"id"`
+	ParentID string `json:"parent_id"`
+	Leaf     bool   `json:"leaf"`
+	Status   string `json:"status"`
+	Category string `json:"category,omitempty"` // version 4부터, 없으면 Status가 기본 상태이다
+	Rollup   bool   `json:"rollup,omitempty"`   // version 5부터
+
+	// version 6부터
+	FirstStartedAt *time.Time `json:"first_started_at,omitempty"`
+	CompletedAt    *time.Time `json:"completed_at,omitempty"`
+	Reopens        int        `json:"reopens,omitempty"`
+}
+
+func (r *ExtraRecord) category() domain.TaskStatus {
+	if r.Category == "" {
+		return domain.TaskStatus(r.Status)
+	}
+
+	return domain.TaskStatus(r.Category)
+}
+
+type TraceRecord struct {
+	ID        string         `json:"id"`
+	ParentID  string         `json:"parent_id"`
+	Estimated time.Duration  `json:"estimated"`      // nanoseconds
+	Self      *time.Duration `json:"self,omitempty"` // nanoseconds, version 3부터
+	Actual    time.Duration  `json:"actual"`         // nanoseconds
+	StartedAt *time.Time     `json:"started_at,omitempty"`
+	DueAt     *time.Time     `json:"due_at,omitempty"` // version 2부터
+}
+
+type SessionRecord struct {
+	ID        string    `json:"id"`
+	TraceID   string    `json:"trace_id"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+	Auto      bool      `json:"auto,omitempty"`
+	Note      string    `json:"note,omitempty"`
+}
+
+type WorkflowRecord struct {
+	Statuses    []*WorkflowStatusRecord `json:"statuses"`
+	Transitions []*TransitionRecord     `json:"transitions,omitempty"`
+}
+
+type WorkflowStatusRecord struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+}
+
+type TransitionRecord struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type AutoStopRecord struct {
+	Threshold time.Duration `json:"threshold"` // nanoseconds
+	Cap       time.Duration `json:"cap"`       // nanoseconds
+	Midnight  bool          `json:"midnight"`
+	Timezone  string        `json:"timezone"`
+}
+
+type ProjectRecord struct {
+	RootID    string            `json:"root_id"`
+	Timezone  string            `json:"timezone"`
+	Snapshots []*SnapshotRecord `json:"snapshots"`
+}
+
+type SnapshotRecord struct {
+	Date      time.Time     `json:"date"`
+	Remaining time.Duration `json:"remaining"` // nanoseconds
+	Completed time.Duration `json:"completed"` // nanoseconds
+	Actual    time.Duration `json:"actual"`    // nanoseconds
+	Open      int           `json:"open"`
+	Done      int           `json:"done"`
+}
+
+type WebhookRecord struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"secret"`
+	Events    []string  `json:"events"`
+	CreatedAt time.Time `json:"created_at"`
+}
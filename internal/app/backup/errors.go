@@ -0,0 +1,8 @@
+package backup
+
+import "errors"
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported backup version")
+	ErrInvalidDump        = errors.New("invalid backup dump")
+)
@@ -0,0 +1,485 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/repository"
+)
+
+type Service struct {
+	repo         repository.BackupRepository
+	extraRepo    repository.ExtraRepository
+	traceRepo    repository.TraceRepository
+	workflowRepo repository.WorkflowRepository
+	autoStopRepo repository.AutoStopRepository
+	burndownRepo repository.BurndownRepository
+	webhookRepo  repository.WebhookRepository
+}
+
+func NewService(
+	repo repository.BackupRepository,
+	extraRepo repository.ExtraRepository,
+	traceRepo repository.TraceRepository,
+	workflowRepo repository.WorkflowRepository,
+	autoStopRepo repository.AutoStopRepository,
+	burndownRepo repository.BurndownRepository,
+	webhookRepo repository.WebhookRepository,
+) *Service {
+	return &Service{
+		repo:         repo,
+		extraRepo:    extraRepo,
+		traceRepo:    traceRepo,
+		workflowRepo: workflowRepo,
+		autoStopRepo: autoStopRepo,
+		burndownRepo: burndownRepo,
+		webhookRepo:  webhookRepo,
+	}
+}
+
+func (s *Service) Backup(ctx context.Context, input *BackupInput) (*BackupOutput, error) {
+	usernames := input.Usernames
+	if len(usernames) == 0 {
+		var err error
+
+		usernames, err = s.repo.ListUsernames(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list usernames: %w", err)
+		}
+	}
+
+	var users []*UserDump
+
+	for _, username := range usernames {
+		user, err := s.dumpUser(ctx, username)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.dumpSettings(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, user)
+	}
+
+	return &BackupOutput{
+		Dump: &Dump{
+			Version:   FormatVersion,
+			CreatedAt: input.Now,
+			Users:     users,
+		},
+	}, nil
+}
+
+func (s *Service) Restore(ctx context.Context, input *RestoreInput) error {
+	err := validateDump(input.Dump)
+	if err != nil {
+		return err
+	}
+
+	var data []*repository.UserData
+	for _, user := range input.Dump.Users {
+		data = append(data, toUserData(user))
+	}
+
+	err = s.repo.ReplaceUserData(ctx, data...)
+	if err != nil {
+		return fmt.Errorf("failed to replace user data: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) dumpUser(ctx context.Context, username string) (*UserDump, error) {
+	tasks, err := s.repo.ListAllTasks(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	slices.SortFunc(tasks, func(a, b *domain.Task) int {
+		return strings.Compare(string(a.ID()), string(b.ID()))
+	})
+
+	var (
+		taskRecords []*TaskRecord
+		extraIDs    []domain.ExtraID
+		traceIDs    []domain.TraceID
+	)
+
+	for _, task := range tasks {
+		taskRecords = append(taskRecords, &TaskRecord{
+			ID:        string(task.ID()),
+			ParentID:  string(task.ParentID()),
+			NextID:    string(task.NextID()),
+			Title:     task.Title(),
+			CreatedAt: task.CreatedAt(),
+			Version:   task.Version(),
+		})
+
+		if task.IsDummy() {
+			continue
+		}
+
+		extraIDs = append(extraIDs, domain.ExtraID(task.ID()))
+		traceIDs = append(traceIDs, domain.TraceID(task.ID()))
+	}
+
+	extras, err := s.extraRepo.ListExtras(ctx, username, extraIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list extras: %w", err)
+	}
+
+	var extraRecords []*ExtraRecord
+	for _, extra := range extras {
+		extraRecords = append(extraRecords, &ExtraRecord{
+			ID:       string(extra.ID()),
+			ParentID: string(extra.ParentID()),
+			Leaf:     extra.Leaf(),
+			Status:   string(extra.Status()),
+			Category: string(extra.Category()),
+			Rollup:   extra.Rollup(),
+
+			FirstStartedAt: timePointer(extra.FirstStartedAt()),
+			CompletedAt:    timePointer(extra.CompletedAt()),
+			Reopens:        extra.Reopens(),
+		})
+	}
+
+	traces, err := s.traceRepo.ListTraces(ctx, username, traceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list traces: %w", err)
+	}
+
+	var traceRecords []*TraceRecord
+	for _, trace := range traces {
+		traceRecords = append(traceRecords, &TraceRecord{
+			ID:        string(trace.ID()),
+			ParentID:  string(trace.ParentID()),
+			Estimated: trace.Estimated(),
+			Self:      pointer(trace.Self()),
+			Actual:    trace.Actual(),
+			StartedAt: timePointer(trace.StartedAt()),
+			DueAt:     timePointer(trace.DueAt()),
+		})
+	}
+
+	sessions, err := s.traceRepo.ListSessions(ctx, username, traceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	var sessionRecords []*SessionRecord
+	for _, session := range sessions {
+		sessionRecords = append(sessionRecords, &SessionRecord{
+			ID:        string(session.ID()),
+			TraceID:   string(session.TraceID()),
+			StartedAt: session.StartedAt(),
+			EndedAt:   session.EndedAt(),
+			Auto:      session.Auto(),
+			Note:      session.Note(),
+		})
+	}
+
+	return &UserDump{
+		Username: username,
+		Tasks:    taskRecords,
+		Extras:   extraRecords,
+		Traces:   traceRecords,
+		Sessions: sessionRecords,
+	}, nil
+}
+
+func (s *Service) dumpSettings(ctx context.Context, user *UserDump) error { //nolint:cyclop,funlen
+	workflow, err := s.workflowRepo.GetWorkflow(ctx, user.Username)
+	if err != nil && !errors.Is(err, repository.ErrWorkflowNotFound) {
+		return fmt.Errorf("failed to get workflow: %w", err)
+	}
+
+	if workflow != nil {
+		user.Workflow = toWorkflowRecord(workflow)
+	}
+
+	policy, err := s.autoStopRepo.GetAutoStopPolicy(ctx, user.Username)
+	if err != nil && !errors.Is(err, repository.ErrAutoStopPolicyNotFound) {
+		return fmt.Errorf("failed to get auto stop policy: %w", err)
+	}
+
+	if policy != nil {
+		user.AutoStop = &AutoStopRecord{
+			Threshold: policy.Threshold(),
+			Cap:       policy.Cap(),
+			Midnight:  policy.Midnight(),
+			Timezone:  policy.Location().String(),
+		}
+	}
+
+	projects, err := s.burndownRepo.ListTrackedProjects(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list tracked projects: %w", err)
+	}
+
+	for _, project := range projects {
+		if project.Username() != user.Username {
+			continue
+		}
+
+		snapshots, err := s.burndownRepo.ListBurndownSnapshots(ctx, user.Username, project.RootID())
+		if err != nil {
+			return fmt.Errorf("failed to list burndown snapshots: %w", err)
+		}
+
+		record := &ProjectRecord{
+			RootID:    string(project.RootID()),
+			Timezone:  project.Location().String(),
+			Snapshots: nil,
+		}
+		for _, snapshot := range snapshots {
+			record.Snapshots = append(record.Snapshots, &SnapshotRecord{
+				Date:      snapshot.Date(),
+				Remaining: snapshot.Remaining(),
+				Completed: snapshot.Completed(),
+				Actual:    snapshot.Actual(),
+				Open:      snapshot.Open(),
+				Done:      snapshot.Done(),
+			})
+		}
+
+		user.Projects = append(user.Projects, record)
+	}
+
+	webhooks, err := s.webhookRepo.ListWebhooks(ctx, user.Username)
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	for _, webhook := range webhooks {
+		var events []string
+		for _, event := range webhook.Events() {
+			events = append(events, string(event))
+		}
+
+		user.Webhooks = append(user.Webhooks, &WebhookRecord{
+			ID:        string(webhook.ID()),
+			URL:       webhook.URL(),
+			Secret:    webhook.Secret(),
+			Events:    events,
+			CreatedAt: webhook.CreatedAt(),
+		})
+	}
+
+	return nil
+}
+
+func toWorkflowRecord(workflow *domain.Workflow) *WorkflowRecord {
+	ret := &WorkflowRecord{
+		Statuses:    nil,
+		Transitions: nil,
+	}
+
+	for _, status := range workflow.Statuses() {
+		ret.Statuses = append(ret.Statuses, &WorkflowStatusRecord{
+			Name:     string(status.Name()),
+			Category: string(status.Category()),
+		})
+	}
+
+	for _, transition := range workflow.Transitions() {
+		ret.Transitions = append(ret.Transitions, &TransitionRecord{
+			From: string(transition.From()),
+			To:   string(transition.To()),
+		})
+	}
+
+	return ret
+}
+
+func toUserData(user *UserDump) *repository.UserData {
+	var tasks []*domain.Task
+	for _, record := range user.Tasks {
+		tasks = append(tasks, domain.NewTask(
+			domain.TaskID(record.ID),
+			domain.TaskID(record.ParentID),
+			domain.TaskID(record.NextID),
+			record.Title,
+			record.CreatedAt,
+			record.Version,
+		))
+	}
+
+	var extras []*domain.Extra
+	for _, record := range user.Extras {
+		extras = append(extras, domain.NewExtra(
+			domain.ExtraID(record.ID),
+			domain.ExtraID(record.ParentID),
+			record.Leaf,
+			domain.TaskStatus(record.Status),
+			record.category(),
+			record.Rollup,
+			timeValue(record.FirstStartedAt),
+			timeValue(record.CompletedAt),
+			record.Reopens,
+		))
+	}
+
+	// version 3 이전에는 self가 없으므로 actual에서 자식의 actual을 빼서 구한다.
+	childActual := make(map[string]time.Duration)
+	for _, record := range user.Traces {
+		childActual[record.ParentID] += record.Actual
+	}
+
+	var traces []*domain.Trace
+	for _, record := range user.Traces {
+		self := max(record.Actual-childActual[record.ID], 0)
+		if record.Self != nil {
+			self = *record.Self
+		}
+
+		traces = append(traces, domain.NewTrace(
+			domain.TraceID(record.ID),
+			domain.TraceID(record.ParentID),
+			record.Estimated,
+			self,
+			record.Actual,
+			timeValue(record.StartedAt),
+			timeValue(record.DueAt),
+		))
+	}
+
+	var sessions []*domain.Session
+	for _, record := range user.Sessions {
+		sessions = append(sessions, domain.NewSession(
+			domain.SessionID(record.ID),
+			domain.TraceID(record.TraceID),
+			record.StartedAt,
+			record.EndedAt,
+			record.Auto,
+			record.Note,
+		))
+	}
+
+	data := &repository.UserData{
+		Username: user.Username,
+		Tasks:    tasks,
+		Extras:   extras,
+		Traces:   traces,
+		Sessions: sessions,
+
+		Workflow:  nil,
+		Policy:    nil,
+		Projects:  nil,
+		Snapshots: nil,
+		Webhooks:  nil,
+	}
+	setSettings(data, user)
+
+	return data
+}
+
+// setSettings는 검증을 거친 user의 설정을 data에 옮긴다.
+func setSettings(data *repository.UserData, user *UserDump) {
+	if user.Workflow != nil {
+		var statuses []*domain.WorkflowStatus
+		for _, record := range user.Workflow.Statuses {
+			statuses = append(statuses, domain.NewWorkflowStatus(
+				domain.TaskStatus(record.Name),
+				domain.TaskStatus(record.Category),
+			))
+		}
+
+		var transitions []*domain.Transition
+		for _, record := range user.Workflow.Transitions {
+			transitions = append(transitions, domain.NewTransition(
+				domain.TaskStatus(record.From),
+				domain.TaskStatus(record.To),
+			))
+		}
+
+		data.Workflow = domain.NewWorkflow(user.Username, statuses, transitions)
+	}
+
+	if user.AutoStop != nil {
+		data.Policy = domain.NewAutoStopPolicy(
+			user.Username,
+			user.AutoStop.Threshold,
+			user.AutoStop.Cap,
+			user.AutoStop.Midnight,
+			location(user.AutoStop.Timezone),
+		)
+	}
+
+	for _, project := range user.Projects {
+		rootID := domain.TaskID(project.RootID)
+		data.Projects = append(data.Projects, domain.NewTrackedProject(user.Username, rootID, location(project.Timezone)))
+
+		snapshots := slices.Clone(project.Snapshots)
+		slices.SortFunc(snapshots, func(a, b *SnapshotRecord) int {
+			return a.Date.Compare(b.Date)
+		})
+
+		for _, record := range snapshots {
+			data.Snapshots = append(data.Snapshots, domain.NewBurndownSnapshot(
+				user.Username,
+				rootID,
+				record.Date.UTC(),
+				record.Remaining,
+				record.Completed,
+				record.Actual,
+				record.Open,
+				record.Done,
+			))
+		}
+	}
+
+	for _, record := range user.Webhooks {
+		var events []domain.WebhookEvent
+		for _, event := range record.Events {
+			events = append(events, domain.WebhookEvent(event))
+		}
+
+		data.Webhooks = append(data.Webhooks, domain.NewWebhook(
+			domain.WebhookID(record.ID),
+			user.Username,
+			record.URL,
+			record.Secret,
+			events,
+			record.CreatedAt,
+		))
+	}
+}
+
+// location은 검증을 거친 시간대 이름을 읽는다.
+func location(name string) *time.Location {
+	ret, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+
+	return ret
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return *t
+}
+
+func timePointer(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+func pointer[T any](v T) *T {
+	return &v
+}
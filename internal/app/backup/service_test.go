@@ -0,0 +1,392 @@
+package backup_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/apptest"
+	"github.com/neatflowcv/focus/internal/app/backup"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/repository/memory"
+	"github.com/stretchr/testify/require"
+)
+
+type ServiceData struct {
+	repo *memory.Repository
+}
+
+func newService(t *testing.T) (*backup.Service, *ServiceData) {
+	t.Helper()
+
+	repo := memory.NewRepository()
+
+	return backup.NewService(repo, repo, repo, repo, repo, repo, repo), &ServiceData{
+		repo: repo,
+	}
+}
+
+func seed(t *testing.T, repo *memory.Repository, username string) {
+	t.Helper()
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	root := domain.NewTask(domain.TaskDummyID(""), "", "parent", "root-dummy", now, 2)
+	parent := domain.NewTask("parent", "", "", "parent", now, 1)
+	child := domain.NewTask("child", "parent", "", "child", now, 1)
+	parentDummy := parent.Dummy().SetNextID("child")
+
+	_ = repo.CreateTasks(t.Context(), username, root, parent, parentDummy, child, child.Dummy())
+	_ = repo.CreateExtra(t.Context(), username, domain.NewExtra(
+		"parent", "", false, domain.TaskStatusTodo, domain.TaskStatusTodo, false,
+		time.Time{}, time.Time{}, 0,
+	))
+	_ = repo.CreateExtra(t.Context(), username, domain.NewExtra(
+		"child", "parent", true, domain.TaskStatusDoing, domain.TaskStatusDoing, false,
+		now, time.Time{}, 1,
+	))
+	_ = repo.CreateTrace(
+		t.Context(),
+		username,
+		domain.NewTrace("parent", "", 0, 0, 90*time.Second, time.Time{}, time.Time{}),
+	)
+	_ = repo.CreateTrace(
+		t.Context(),
+		username,
+		domain.NewTrace("child", "parent", time.Hour, 90*time.Second, 90*time.Second, now, now),
+	)
+	_ = repo.CreateSession(
+		t.Context(),
+		username,
+		domain.NewSession("session", "child", now.Add(-90*time.Second), now, false, ""),
+	)
+}
+
+func TestServiceBackup(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	seed(t, data.repo, "test")
+
+	out, err := service.Backup(t.Context(), &backup.BackupInput{
+		Usernames: nil,
+		Now:       time.Now(),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, backup.FormatVersion, out.Dump.Version)
+	require.Len(t, out.Dump.Users, 1)
+	require.Equal(t, "test", out.Dump.Users[0].Username)
+	require.Len(t, out.Dump.Users[0].Tasks, 5)
+	require.Len(t, out.Dump.Users[0].Extras, 2)
+	require.Len(t, out.Dump.Users[0].Traces, 2)
+}
+
+func TestServiceRestore(t *testing.T) {
+	t.Parallel()
+
+	source, sourceData := newService(t)
+	seed(t, sourceData.repo, "test")
+	out, _ := source.Backup(t.Context(), &backup.BackupInput{
+		Usernames: []string{"test"},
+		Now:       time.Now(),
+	})
+
+	service, data := newService(t)
+
+	err := service.Restore(t.Context(), &backup.RestoreInput{
+		Dump: out.Dump,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, data.repo.Tasks["test"], 5)
+	require.True(t, sourceData.repo.Tasks["test"]["child"].Equals(data.repo.Tasks["test"]["child"]))
+	require.Equal(t, domain.TaskID("child"), data.repo.Tasks["test"]["parent-dummy"].NextID())
+	require.Equal(t, domain.TaskStatusDoing, data.repo.Extras["test"]["child"].Status())
+	require.Equal(t, time.Hour, data.repo.Traces["test"]["child"].Estimated())
+	require.Equal(t, 90*time.Second, data.repo.Traces["test"]["parent"].Actual())
+	require.True(t, data.repo.Traces["test"]["parent"].StartedAt().IsZero())
+	require.Equal(t, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), data.repo.Traces["test"]["child"].DueAt())
+	require.Len(t, data.repo.Sessions["test"], 1)
+	require.Equal(t, 90*time.Second, data.repo.Sessions["test"]["session"].Duration())
+	require.Equal(t, 90*time.Second, data.repo.Traces["test"]["child"].Self())
+}
+
+func TestServiceRestore_Settings(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	for _, backend := range apptest.Backends() {
+		t.Run(backend.Name, func(t *testing.T) {
+			t.Parallel()
+
+			source, sourceData := newService(t)
+			seed(t, sourceData.repo, "test")
+
+			now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+			workflow := domain.NewWorkflow("test", []*domain.WorkflowStatus{
+				domain.NewWorkflowStatus(domain.TaskStatusTodo, domain.TaskStatusTodo),
+				domain.NewWorkflowStatus(domain.TaskStatusDoing, domain.TaskStatusDoing),
+				domain.NewWorkflowStatus("review", domain.TaskStatusDoing),
+				domain.NewWorkflowStatus(domain.TaskStatusDone, domain.TaskStatusDone),
+			}, []*domain.Transition{domain.NewTransition(domain.TaskStatusDoing, "review")})
+			_ = sourceData.repo.SaveWorkflow(t.Context(), workflow)
+			_ = sourceData.repo.UpdateExtra(t.Context(), "test", sourceData.repo.Extras["test"]["child"].
+				SetStatus("review", domain.TaskStatusDoing, now))
+			_ = sourceData.repo.SaveAutoStopPolicy(t.Context(), domain.NewAutoStopPolicy(
+				"test", time.Hour, 0, true, time.UTC,
+			))
+			_ = sourceData.repo.SaveTrackedProject(t.Context(), domain.NewTrackedProject("test", "parent", time.UTC))
+			_ = sourceData.repo.SaveBurndownSnapshot(t.Context(), domain.NewBurndownSnapshot(
+				"test", "parent", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Hour, 0, 0, 1, 0,
+			))
+			_ = sourceData.repo.CreateWebhook(t.Context(), domain.NewWebhook(
+				"webhook", "test", "https://example.com", "secret",
+				[]domain.WebhookEvent{domain.WebhookEventTaskCreated}, now,
+			))
+			out, _ := source.Backup(t.Context(), &backup.BackupInput{
+				Usernames: []string{"test"},
+				Now:       now,
+			})
+			repo := backend.New(t)
+			service := backup.NewService(repo, repo, repo, repo, repo, repo, repo)
+
+			err := service.Restore(t.Context(), &backup.RestoreInput{
+				Dump: out.Dump,
+			})
+
+			require.NoError(t, err)
+
+			got, err := repo.GetWorkflow(t.Context(), "test")
+			require.NoError(t, err)
+			category, ok := got.Category("review")
+			require.True(t, ok)
+			require.Equal(t, domain.TaskStatusDoing, category)
+			require.False(t, got.CanTransition(domain.TaskStatusTodo, "review"))
+
+			extras, err := repo.ListExtras(t.Context(), "test", []domain.ExtraID{"child"})
+			require.NoError(t, err)
+			require.Equal(t, domain.TaskStatus("review"), extras[0].Status())
+
+			policy, err := repo.GetAutoStopPolicy(t.Context(), "test")
+			require.NoError(t, err)
+			require.Equal(t, time.Hour, policy.Threshold())
+			require.True(t, policy.Midnight())
+
+			_, err = repo.GetTrackedProject(t.Context(), "test", "parent")
+			require.NoError(t, err)
+			snapshots, err := repo.ListBurndownSnapshots(t.Context(), "test", "parent")
+			require.NoError(t, err)
+			require.Len(t, snapshots, 1)
+			require.Equal(t, time.Hour, snapshots[0].Remaining())
+
+			webhook, err := repo.GetWebhook(t.Context(), "test", "webhook")
+			require.NoError(t, err)
+			require.Equal(t, "secret", webhook.Secret())
+		})
+	}
+}
+
+func TestServiceRestore_WithoutSelf(t *testing.T) {
+	t.Parallel()
+
+	source, sourceData := newService(t)
+	seed(t, sourceData.repo, "test")
+	out, _ := source.Backup(t.Context(), &backup.BackupInput{
+		Usernames: []string{"test"},
+		Now:       time.Now(),
+	})
+	out.Dump.Version = 2
+
+	for _, trace := range out.Dump.Users[0].Traces {
+		trace.Self = nil
+		if trace.ID == "parent" {
+			trace.Actual = 2 * time.Minute
+		}
+	}
+
+	service, data := newService(t)
+
+	err := service.Restore(t.Context(), &backup.RestoreInput{
+		Dump: out.Dump,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, data.repo.Traces["test"]["parent"].Self())
+	require.Equal(t, 90*time.Second, data.repo.Traces["test"]["child"].Self())
+}
+
+func TestServiceRestore_Error(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	newDump := func(t *testing.T) *backup.Dump {
+		t.Helper()
+
+		source, data := newService(t)
+		seed(t, data.repo, "test")
+		out, _ := source.Backup(t.Context(), &backup.BackupInput{
+			Usernames: []string{"test"},
+			Now:       time.Now(),
+		})
+
+		return out.Dump
+	}
+
+	t.Run("unsupported version", func(t *testing.T) {
+		t.Parallel()
+
+		dump := newDump(t)
+		dump.Version = backup.FormatVersion + 1
+		service, _ := newService(t)
+
+		err := service.Restore(t.Context(), &backup.RestoreInput{Dump: dump})
+
+		require.ErrorIs(t, err, backup.ErrUnsupportedVersion)
+	})
+
+	t.Run("unknown parent", func(t *testing.T) {
+		t.Parallel()
+
+		dump := newDump(t)
+		for _, task := range dump.Users[0].Tasks {
+			if task.ID == "child" {
+				task.ParentID = "unknown"
+			}
+		}
+
+		service, data := newService(t)
+
+		err := service.Restore(t.Context(), &backup.RestoreInput{Dump: dump})
+
+		require.ErrorIs(t, err, backup.ErrInvalidDump)
+		require.Empty(t, data.repo.Tasks)
+	})
+
+	t.Run("parent cycle", func(t *testing.T) {
+		t.Parallel()
+
+		dump := newDump(t)
+		for _, task := range dump.Users[0].Tasks {
+			switch task.ID {
+			case string(domain.TaskDummyID("")):
+				task.NextID = ""
+			case "parent":
+				task.ParentID = "child"
+			}
+		}
+
+		service, data := newService(t)
+
+		err := service.Restore(t.Context(), &backup.RestoreInput{Dump: dump})
+
+		require.ErrorIs(t, err, backup.ErrInvalidDump)
+		require.ErrorContains(t, err, "parent cycle")
+		require.Empty(t, data.repo.Tasks)
+	})
+
+	t.Run("next cycle", func(t *testing.T) {
+		t.Parallel()
+
+		dump := newDump(t)
+		for _, task := range dump.Users[0].Tasks {
+			if task.ID == "child" {
+				task.NextID = string(domain.TaskDummyID("parent"))
+			}
+		}
+
+		service, data := newService(t)
+
+		err := service.Restore(t.Context(), &backup.RestoreInput{Dump: dump})
+
+		require.ErrorIs(t, err, backup.ErrInvalidDump)
+		require.ErrorContains(t, err, "next cycle")
+		require.Empty(t, data.repo.Tasks)
+	})
+
+	t.Run("orphan extra", func(t *testing.T) {
+		t.Parallel()
+
+		dump := newDump(t)
+		dump.Users[0].Extras = append(dump.Users[0].Extras, &backup.ExtraRecord{
+			ID:       "unknown",
+			ParentID: "",
+			Leaf:     true,
+			Status:   string(domain.TaskStatusTodo),
+		})
+		service, _ := newService(t)
+
+		err := service.Restore(t.Context(), &backup.RestoreInput{Dump: dump})
+
+		require.ErrorIs(t, err, backup.ErrInvalidDump)
+	})
+
+	t.Run("custom status without workflow", func(t *testing.T) {
+		t.Parallel()
+
+		dump := newDump(t)
+		for _, extra := range dump.Users[0].Extras {
+			if extra.ID == "child" {
+				extra.Status = "review"
+			}
+		}
+
+		service, _ := newService(t)
+
+		err := service.Restore(t.Context(), &backup.RestoreInput{Dump: dump})
+
+		require.ErrorIs(t, err, backup.ErrInvalidDump)
+	})
+
+	t.Run("status in another category", func(t *testing.T) {
+		t.Parallel()
+
+		dump := newDump(t)
+		dump.Users[0].Workflow = &backup.WorkflowRecord{
+			Statuses: []*backup.WorkflowStatusRecord{
+				{Name: string(domain.TaskStatusTodo), Category: string(domain.TaskStatusTodo)},
+				{Name: string(domain.TaskStatusDoing), Category: string(domain.TaskStatusDoing)},
+				{Name: "review", Category: string(domain.TaskStatusDone)},
+				{Name: string(domain.TaskStatusDone), Category: string(domain.TaskStatusDone)},
+			},
+			Transitions: nil,
+		}
+
+		for _, extra := range dump.Users[0].Extras {
+			if extra.ID == "child" {
+				extra.Status = "review"
+			}
+		}
+
+		service, _ := newService(t)
+
+		err := service.Restore(t.Context(), &backup.RestoreInput{Dump: dump})
+
+		require.ErrorIs(t, err, backup.ErrInvalidDump)
+	})
+
+	t.Run("project of unknown task", func(t *testing.T) {
+		t.Parallel()
+
+		dump := newDump(t)
+		dump.Users[0].Projects = []*backup.ProjectRecord{{RootID: "unknown", Timezone: "UTC", Snapshots: nil}}
+		service, _ := newService(t)
+
+		err := service.Restore(t.Context(), &backup.RestoreInput{Dump: dump})
+
+		require.ErrorIs(t, err, backup.ErrInvalidDump)
+	})
+
+	t.Run("mismatched trace parent", func(t *testing.T) {
+		t.Parallel()
+
+		dump := newDump(t)
+		for _, trace := range dump.Users[0].Traces {
+			if trace.ID == "child" {
+				trace.ParentID = ""
+			}
+		}
+
+		service, _ := newService(t)
+
+		err := service.Restore(t.Context(), &backup.RestoreInput{Dump: dump})
+
+		require.ErrorIs(t, err, backup.ErrInvalidDump)
+	})
+}
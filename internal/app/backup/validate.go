@@ -0,0 +1,393 @@
+package backup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+func validateDump(dump *Dump) error {
+	if dump == nil {
+		return fmt.Errorf("%w: empty dump", ErrInvalidDump)
+	}
+
+	if dump.Version < 1 || dump.Version > FormatVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, dump.Version)
+	}
+
+	usernames := make(map[string]struct{})
+
+	for _, user := range dump.Users {
+		if user.Username == "" {
+			return fmt.Errorf("%w: username is required", ErrInvalidDump)
+		}
+
+		if _, ok := usernames[user.Username]; ok {
+			return fmt.Errorf("%w: duplicated user %q", ErrInvalidDump, user.Username)
+		}
+
+		usernames[user.Username] = struct{}{}
+
+		err := validateUser(user)
+		if err != nil {
+			return fmt.Errorf("user %q: %w", user.Username, err)
+		}
+	}
+
+	return nil
+}
+
+func validateUser(user *UserDump) error { //nolint:cyclop
+	tasks, err := validateTasks(user.Tasks)
+	if err != nil {
+		return err
+	}
+
+	statuses, err := validateWorkflow(user.Workflow)
+	if err != nil {
+		return err
+	}
+
+	err = validateExtras(user.Extras, tasks, statuses, user.Workflow != nil)
+	if err != nil {
+		return err
+	}
+
+	traces, err := validateTraces(user.Traces, tasks)
+	if err != nil {
+		return err
+	}
+
+	err = validateSessions(user.Sessions, traces)
+	if err != nil {
+		return err
+	}
+
+	err = validateAutoStop(user.AutoStop)
+	if err != nil {
+		return err
+	}
+
+	err = validateProjects(user.Projects, tasks)
+	if err != nil {
+		return err
+	}
+
+	return validateWebhooks(user.Webhooks)
+}
+
+func validateTasks(records []*TaskRecord) (map[string]*TaskRecord, error) { //nolint:cyclop
+	tasks := make(map[string]*TaskRecord)
+
+	for _, record := range records {
+		if record.ID == "" || record.Title == "" || record.Version == 0 {
+			return nil, fmt.Errorf("%w: task %q is incomplete", ErrInvalidDump, record.ID)
+		}
+
+		if _, ok := tasks[record.ID]; ok {
+			return nil, fmt.Errorf("%w: duplicated task %q", ErrInvalidDump, record.ID)
+		}
+
+		tasks[record.ID] = record
+	}
+
+	for _, record := range records {
+		if record.ParentID != "" {
+			if _, ok := tasks[record.ParentID]; !ok {
+				return nil, fmt.Errorf("%w: parent of task %q not found", ErrInvalidDump, record.ID)
+			}
+		}
+
+		if record.NextID != "" {
+			next, ok := tasks[record.NextID]
+			if !ok {
+				return nil, fmt.Errorf("%w: next of task %q not found", ErrInvalidDump, record.ID)
+			}
+
+			if next.ParentID != record.ParentID {
+				return nil, fmt.Errorf("%w: next of task %q has another parent", ErrInvalidDump, record.ID)
+			}
+		}
+
+		if isDummy(record) {
+			continue
+		}
+
+		if _, ok := tasks[string(domain.TaskDummyID(domain.TaskID(record.ID)))]; !ok {
+			return nil, fmt.Errorf("%w: dummy of task %q not found", ErrInvalidDump, record.ID)
+		}
+	}
+
+	if id, ok := findCycle(records, tasks, func(record *TaskRecord) string { return record.ParentID }); ok {
+		return nil, fmt.Errorf("%w: parent cycle at task %q", ErrInvalidDump, id)
+	}
+
+	if id, ok := findCycle(records, tasks, func(record *TaskRecord) string { return record.NextID }); ok {
+		return nil, fmt.Errorf("%w: next cycle at task %q", ErrInvalidDump, id)
+	}
+
+	return tasks, nil
+}
+
+// findCycle은 link를 따라가다 지나온 task로 돌아오면 그 task를 돌려준다. link는 모두 있는 task를 가리켜야 한다.
+func findCycle(
+	records []*TaskRecord,
+	tasks map[string]*TaskRecord,
+	link func(*TaskRecord) string,
+) (string, bool) {
+	checked := make(map[string]struct{}) // 끝까지 따라가 본 task
+
+	for _, record := range records {
+		path := make(map[string]struct{})
+
+		for id := record.ID; id != ""; id = link(tasks[id]) {
+			if _, ok := checked[id]; ok {
+				break
+			}
+
+			if _, ok := path[id]; ok {
+				return id, true
+			}
+
+			path[id] = struct{}{}
+		}
+
+		for id := range path {
+			checked[id] = struct{}{}
+		}
+	}
+
+	return "", false
+}
+
+// validateWorkflow는 상태마다 그 기본 상태를 돌려준다.
+func validateWorkflow(record *WorkflowRecord) (map[domain.TaskStatus]domain.TaskStatus, error) { //nolint:cyclop
+	statuses := make(map[domain.TaskStatus]domain.TaskStatus)
+
+	if record == nil {
+		for _, status := range []domain.TaskStatus{domain.TaskStatusTodo, domain.TaskStatusDoing, domain.TaskStatusDone} {
+			statuses[status] = status
+		}
+
+		return statuses, nil
+	}
+
+	for _, status := range record.Statuses {
+		name, category := domain.TaskStatus(status.Name), domain.TaskStatus(status.Category)
+		if !name.IsValidName() || !category.IsValid() || (name.IsValid() && name != category) {
+			return nil, fmt.Errorf("%w: workflow has invalid status %q", ErrInvalidDump, status.Name)
+		}
+
+		if _, ok := statuses[name]; ok {
+			return nil, fmt.Errorf("%w: duplicated workflow status %q", ErrInvalidDump, status.Name)
+		}
+
+		statuses[name] = category
+	}
+
+	for _, status := range []domain.TaskStatus{domain.TaskStatusTodo, domain.TaskStatusDoing, domain.TaskStatusDone} {
+		if _, ok := statuses[status]; !ok {
+			return nil, fmt.Errorf("%w: workflow misses status %q", ErrInvalidDump, status)
+		}
+	}
+
+	for _, transition := range record.Transitions {
+		_, from := statuses[domain.TaskStatus(transition.From)]
+		_, to := statuses[domain.TaskStatus(transition.To)]
+
+		if !from || !to {
+			return nil, fmt.Errorf("%w: workflow has transition with unknown status", ErrInvalidDump)
+		}
+	}
+
+	return statuses, nil
+}
+
+// validateExtras는 workflow가 없으면 사용자 정의 상태를 받지 않는다.
+func validateExtras( //nolint:cyclop
+	records []*ExtraRecord,
+	tasks map[string]*TaskRecord,
+	statuses map[domain.TaskStatus]domain.TaskStatus,
+	custom bool,
+) error {
+	seen := make(map[string]struct{})
+
+	for _, record := range records {
+		task, ok := tasks[record.ID]
+		if !ok || isDummy(task) {
+			return fmt.Errorf("%w: task of extra %q not found", ErrInvalidDump, record.ID)
+		}
+
+		if _, ok := seen[record.ID]; ok {
+			return fmt.Errorf("%w: duplicated extra %q", ErrInvalidDump, record.ID)
+		}
+
+		seen[record.ID] = struct{}{}
+
+		if record.ParentID != task.ParentID {
+			return fmt.Errorf("%w: parent of extra %q mismatches its task", ErrInvalidDump, record.ID)
+		}
+
+		if !domain.TaskStatus(record.Status).IsValidName() || !record.category().IsValid() {
+			return fmt.Errorf("%w: extra %q has invalid status %q", ErrInvalidDump, record.ID, record.Status)
+		}
+
+		category, ok := statuses[domain.TaskStatus(record.Status)]
+		if !ok && !custom {
+			return fmt.Errorf("%w: extra %q has status %q without workflow", ErrInvalidDump, record.ID, record.Status)
+		}
+
+		if ok && category != record.category() {
+			return fmt.Errorf("%w: extra %q has status %q in another category", ErrInvalidDump, record.ID, record.Status)
+		}
+
+		if record.Reopens < 0 {
+			return fmt.Errorf("%w: extra %q has negative reopens", ErrInvalidDump, record.ID)
+		}
+	}
+
+	return nil
+}
+
+func validateTraces(records []*TraceRecord, tasks map[string]*TaskRecord) (map[string]struct{}, error) {
+	seen := make(map[string]struct{})
+
+	for _, record := range records {
+		task, ok := tasks[record.ID]
+		if !ok || isDummy(task) {
+			return nil, fmt.Errorf("%w: task of trace %q not found", ErrInvalidDump, record.ID)
+		}
+
+		if _, ok := seen[record.ID]; ok {
+			return nil, fmt.Errorf("%w: duplicated trace %q", ErrInvalidDump, record.ID)
+		}
+
+		seen[record.ID] = struct{}{}
+
+		if record.ParentID != task.ParentID {
+			return nil, fmt.Errorf("%w: parent of trace %q mismatches its task", ErrInvalidDump, record.ID)
+		}
+
+		if record.Estimated < 0 {
+			return nil, fmt.Errorf("%w: trace %q has negative estimate", ErrInvalidDump, record.ID)
+		}
+	}
+
+	return seen, nil
+}
+
+func validateSessions(records []*SessionRecord, traces map[string]struct{}) error {
+	seen := make(map[string]struct{})
+
+	for _, record := range records {
+		if record.ID == "" {
+			return fmt.Errorf("%w: session id is required", ErrInvalidDump)
+		}
+
+		if _, ok := seen[record.ID]; ok {
+			return fmt.Errorf("%w: duplicated session %q", ErrInvalidDump, record.ID)
+		}
+
+		seen[record.ID] = struct{}{}
+
+		if _, ok := traces[record.TraceID]; !ok {
+			return fmt.Errorf("%w: trace of session %q not found", ErrInvalidDump, record.ID)
+		}
+
+		if record.EndedAt.Before(record.StartedAt) {
+			return fmt.Errorf("%w: session %q ends before it starts", ErrInvalidDump, record.ID)
+		}
+	}
+
+	return nil
+}
+
+func validateAutoStop(record *AutoStopRecord) error {
+	if record == nil {
+		return nil
+	}
+
+	if record.Threshold < 0 || record.Cap < 0 {
+		return fmt.Errorf("%w: auto stop policy has negative duration", ErrInvalidDump)
+	}
+
+	_, err := time.LoadLocation(record.Timezone)
+	if err != nil {
+		return fmt.Errorf("%w: auto stop policy has unknown timezone %q", ErrInvalidDump, record.Timezone)
+	}
+
+	return nil
+}
+
+func validateProjects(records []*ProjectRecord, tasks map[string]*TaskRecord) error { //nolint:cyclop
+	seen := make(map[string]struct{})
+
+	for _, record := range records {
+		task, ok := tasks[record.RootID]
+		if !ok || isDummy(task) {
+			return fmt.Errorf("%w: task of project %q not found", ErrInvalidDump, record.RootID)
+		}
+
+		if _, ok := seen[record.RootID]; ok {
+			return fmt.Errorf("%w: duplicated project %q", ErrInvalidDump, record.RootID)
+		}
+
+		seen[record.RootID] = struct{}{}
+
+		_, err := time.LoadLocation(record.Timezone)
+		if err != nil {
+			return fmt.Errorf("%w: project %q has unknown timezone %q", ErrInvalidDump, record.RootID, record.Timezone)
+		}
+
+		dates := make(map[time.Time]struct{})
+
+		for _, snapshot := range record.Snapshots {
+			date := snapshot.Date.UTC()
+			if !date.Equal(date.Truncate(24 * time.Hour)) {
+				return fmt.Errorf("%w: snapshot of project %q is not at midnight", ErrInvalidDump, record.RootID)
+			}
+
+			if _, ok := dates[date]; ok {
+				return fmt.Errorf("%w: duplicated snapshot of project %q", ErrInvalidDump, record.RootID)
+			}
+
+			dates[date] = struct{}{}
+
+			if snapshot.Remaining < 0 || snapshot.Completed < 0 || snapshot.Actual < 0 ||
+				snapshot.Open < 0 || snapshot.Done < 0 {
+				return fmt.Errorf("%w: snapshot of project %q has negative value", ErrInvalidDump, record.RootID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateWebhooks(records []*WebhookRecord) error {
+	seen := make(map[string]struct{})
+
+	for _, record := range records {
+		if record.ID == "" || record.URL == "" || record.Secret == "" || len(record.Events) == 0 {
+			return fmt.Errorf("%w: webhook %q is incomplete", ErrInvalidDump, record.ID)
+		}
+
+		if _, ok := seen[record.ID]; ok {
+			return fmt.Errorf("%w: duplicated webhook %q", ErrInvalidDump, record.ID)
+		}
+
+		seen[record.ID] = struct{}{}
+
+		for _, event := range record.Events {
+			if !domain.WebhookEvent(event).IsValid() {
+				return fmt.Errorf("%w: webhook %q has invalid event %q", ErrInvalidDump, record.ID, event)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isDummy(record *TaskRecord) bool {
+	return domain.TaskID(record.ID) == domain.TaskDummyID(domain.TaskID(record.ParentID))
+}
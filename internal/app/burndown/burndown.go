@@ -0,0 +1,33 @@
+package burndown
+
+import "time"
+
+type TrackInput struct {
+	Username string
+	RootID   string
+	Timezone string // 하루의 경계를 계산하는 기준 시간대
+}
+
+type UntrackInput struct {
+	Username string
+	RootID   string
+}
+
+type SeriesInput struct {
+	Username string
+	RootID   string
+}
+
+type Point struct {
+	Date      time.Time     // UTC 자정
+	Remaining time.Duration // 완료되지 않은 leaf의 예상 시간 합
+	Completed time.Duration // 완료된 leaf의 예상 시간 합
+	Actual    time.Duration
+	Open      int
+	Done      int
+}
+
+type SeriesOutput struct {
+	Timezone string
+	Points   []*Point
+}
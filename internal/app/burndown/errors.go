@@ -0,0 +1,9 @@
+package burndown
+
+import "errors"
+
+var (
+	ErrTaskNotFound    = errors.New("task not found")
+	ErrNotTracked      = errors.New("task is not tracked")
+	ErrInvalidTimezone = errors.New("invalid timezone")
+)
@@ -0,0 +1,283 @@
+package burndown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/clock"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/repository"
+)
+
+type Service struct {
+	clock        clock.Clock
+	repo         repository.BurndownRepository
+	flowService  *flow.Service
+	extraService *extra.Service
+	traceService *trace.Service
+}
+
+func NewService(
+	clock clock.Clock,
+	repo repository.BurndownRepository,
+	flowService *flow.Service,
+	extraService *extra.Service,
+	traceService *trace.Service,
+) *Service {
+	return &Service{
+		clock:        clock,
+		repo:         repo,
+		flowService:  flowService,
+		extraService: extraService,
+		traceService: traceService,
+	}
+}
+
+// Track은 subtree를 프로젝트로 지정하고 오늘의 snapshot을 남긴다. 이미 추적 중이면 시간대만 바꾼다.
+func (s *Service) Track(ctx context.Context, input *TrackInput) error {
+	location, err := time.LoadLocation(input.Timezone)
+	if err != nil {
+		return ErrInvalidTimezone
+	}
+
+	err = s.checkTask(ctx, input.Username, input.RootID)
+	if err != nil {
+		return err
+	}
+
+	project := domain.NewTrackedProject(input.Username, domain.TaskID(input.RootID), location)
+
+	err = s.repo.SaveTrackedProject(ctx, project)
+	if err != nil {
+		return fmt.Errorf("failed to save tracked project: %w", err)
+	}
+
+	snapshot, err := s.snapshot(ctx, project, s.clock.Now())
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.SaveBurndownSnapshot(ctx, snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to save burndown snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// Untrack은 프로젝트 지정을 해제하고 쌓인 snapshot을 삭제한다.
+func (s *Service) Untrack(ctx context.Context, input *UntrackInput) error {
+	project, err := s.getProject(ctx, input.Username, input.RootID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteTrackedProject(ctx, project)
+	if err != nil {
+		return fmt.Errorf("failed to delete tracked project: %w", err)
+	}
+
+	return nil
+}
+
+// Series는 날짜 순의 snapshot을 반환한다. 오늘은 저장된 값 대신 현재 값을 계산해서 반환한다.
+func (s *Service) Series(ctx context.Context, input *SeriesInput) (*SeriesOutput, error) {
+	project, err := s.getProject(ctx, input.Username, input.RootID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := s.repo.ListBurndownSnapshots(ctx, project.Username(), project.RootID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list burndown snapshots: %w", err)
+	}
+
+	today, err := s.snapshot(ctx, project, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var points []*Point
+
+	for _, snapshot := range snapshots {
+		if !snapshot.Date().Before(today.Date()) {
+			continue
+		}
+
+		points = append(points, toPoint(snapshot))
+	}
+
+	points = append(points, toPoint(today))
+
+	return &SeriesOutput{
+		Timezone: project.Location().String(),
+		Points:   points,
+	}, nil
+}
+
+// Run은 모든 프로젝트의 오늘 snapshot을 현재 값으로 갱신한다.
+func (s *Service) Run(ctx context.Context) error {
+	projects, err := s.repo.ListTrackedProjects(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list tracked projects: %w", err)
+	}
+
+	now := s.clock.Now()
+
+	var errs []error
+
+	for _, project := range projects {
+		err := s.record(ctx, project, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to record snapshot of %s/%s: %w",
+				project.Username(), project.RootID(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Service) record(ctx context.Context, project *domain.TrackedProject, now time.Time) error {
+	snapshot, err := s.snapshot(ctx, project, now)
+	if err != nil {
+		// 삭제된 subtree는 더 이상 기록하지 않고, 쌓인 snapshot은 남겨둔다.
+		if errors.Is(err, ErrTaskNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	err = s.repo.SaveBurndownSnapshot(ctx, snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to save burndown snapshot: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) snapshot(
+	ctx context.Context,
+	project *domain.TrackedProject,
+	now time.Time,
+) (*domain.BurndownSnapshot, error) {
+	rootID := string(project.RootID())
+
+	err := s.checkTask(ctx, project.Username(), rootID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskOut, err := s.flowService.ListTasks(ctx, &flow.ListTasksInput{
+		Username:  project.Username(),
+		ParentID:  rootID,
+		Recursive: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	ids := []string{rootID}
+	for _, task := range taskOut.Tasks {
+		ids = append(ids, task.ID)
+	}
+
+	extraOut, err := s.extraService.ListExtras(ctx, &extra.ListExtrasInput{Username: project.Username(), IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list extras: %w", err)
+	}
+
+	traceOut, err := s.traceService.ListTraces(ctx, &trace.ListTracesInput{Username: project.Username(), IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list traces: %w", err)
+	}
+
+	traces := make(map[string]*trace.Trace)
+	for _, item := range traceOut.Traces {
+		traces[item.ID] = item
+	}
+
+	var (
+		remaining, completed, actual time.Duration
+		open, done                   int
+	)
+
+	if root, ok := traces[rootID]; ok {
+		actual = root.Actual
+	}
+
+	for _, item := range extraOut.Extras {
+		if !item.Leaf {
+			continue
+		}
+
+		var estimated time.Duration
+		if leaf, ok := traces[item.ID]; ok {
+			estimated = leaf.Estimated
+		}
+
+		if item.Category == string(domain.TaskStatusDone) {
+			completed += estimated
+			done++
+		} else {
+			remaining += estimated
+			open++
+		}
+	}
+
+	return domain.NewBurndownSnapshot(
+		project.Username(),
+		project.RootID(),
+		project.Date(now),
+		remaining,
+		completed,
+		actual,
+		open,
+		done,
+	), nil
+}
+
+func (s *Service) getProject(ctx context.Context, username string, rootID string) (*domain.TrackedProject, error) {
+	project, err := s.repo.GetTrackedProject(ctx, username, domain.TaskID(rootID))
+	if err != nil {
+		if errors.Is(err, repository.ErrTrackedProjectNotFound) {
+			return nil, ErrNotTracked
+		}
+
+		return nil, fmt.Errorf("failed to get tracked project: %w", err)
+	}
+
+	return project, nil
+}
+
+func (s *Service) checkTask(ctx context.Context, username string, taskID string) error {
+	_, err := s.flowService.GetTask(ctx, &flow.GetTaskInput{
+		Username: username,
+		TaskID:   taskID,
+	})
+	if err != nil {
+		if errors.Is(err, flow.ErrTaskNotFound) {
+			return ErrTaskNotFound
+		}
+
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+
+	return nil
+}
+
+func toPoint(snapshot *domain.BurndownSnapshot) *Point {
+	return &Point{
+		Date:      snapshot.Date(),
+		Remaining: snapshot.Remaining(),
+		Completed: snapshot.Completed(),
+		Actual:    snapshot.Actual(),
+		Open:      snapshot.Open(),
+		Done:      snapshot.Done(),
+	}
+}
@@ -0,0 +1,197 @@
+package burndown_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/apptest"
+	"github.com/neatflowcv/focus/internal/app/burndown"
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/repository/memory"
+	"github.com/stretchr/testify/require"
+)
+
+const username = "test"
+
+type ServiceData struct {
+	*apptest.Services
+
+	clock *apptest.Clock
+}
+
+func newService(t *testing.T, repo apptest.Repository) (*burndown.Service, *ServiceData) {
+	t.Helper()
+
+	services := apptest.NewServices(t, repo, username)
+	clock := &apptest.Clock{Time: time.Time{}}
+	service := burndown.NewService(clock, repo, services.Flow, services.Extra, services.Trace)
+
+	return service, &ServiceData{Services: services, clock: clock}
+}
+
+func createTask(t *testing.T, data *ServiceData, parentID string, estimated time.Duration) string {
+	t.Helper()
+
+	out, _ := data.Flow.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: username,
+		Title:    "task",
+		ParentID: parentID,
+		NextID:   "",
+		Now:      data.clock.Time,
+	})
+	_ = data.Trace.SetEstimated(t.Context(), &trace.SetEstimatedInput{
+		Username:  username,
+		ID:        out.ID,
+		Estimated: estimated,
+	})
+
+	return out.ID
+}
+
+func TestServiceSeries(t *testing.T) {
+	t.Parallel()
+
+	for _, backend := range apptest.Backends() {
+		t.Run(backend.Name, func(t *testing.T) {
+			t.Parallel()
+
+			service, data := newService(t, backend.New(t))
+			monday := time.Date(2025, 7, 7, 9, 0, 0, 0, time.UTC)
+			data.clock.Time = monday
+			project := createTask(t, data, "", 0)
+			first := createTask(t, data, project, 3*time.Hour)
+			_ = createTask(t, data, project, 5*time.Hour)
+			_ = service.Track(t.Context(), &burndown.TrackInput{
+				Username: username,
+				RootID:   project,
+				Timezone: "Asia/Seoul",
+			})
+
+			// 서울 기준으로 화요일이 된 뒤 첫 작업을 완료한다.
+			data.clock.Time = monday.Add(20 * time.Hour)
+			_ = data.Trace.SetActual(t.Context(), &trace.SetActualInput{Username: username, ID: first, Actual: 4 * time.Hour})
+			_ = data.Extra.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+				Username: username,
+				ID:       first,
+				Status:   string(domain.TaskStatusDone),
+				Now:      data.clock.Time,
+				Force:    false,
+			})
+			_ = service.Run(t.Context())
+
+			out, err := service.Series(t.Context(), &burndown.SeriesInput{
+				Username: username,
+				RootID:   project,
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, "Asia/Seoul", out.Timezone)
+			require.Equal(t, []*burndown.Point{
+				{
+					Date:      time.Date(2025, 7, 7, 0, 0, 0, 0, time.UTC),
+					Remaining: 8 * time.Hour,
+					Completed: 0,
+					Actual:    0,
+					Open:      2,
+					Done:      0,
+				},
+				{
+					Date:      time.Date(2025, 7, 8, 0, 0, 0, 0, time.UTC),
+					Remaining: 5 * time.Hour,
+					Completed: 3 * time.Hour,
+					Actual:    4 * time.Hour,
+					Open:      1,
+					Done:      1,
+				},
+			}, out.Points)
+		})
+	}
+}
+
+func TestServiceSeries_Today(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t, apptest.NewMemory(t))
+	data.clock.Time = time.Date(2025, 7, 7, 9, 0, 0, 0, time.UTC)
+	project := createTask(t, data, "", 0)
+	_ = service.Track(t.Context(), &burndown.TrackInput{
+		Username: username,
+		RootID:   project,
+		Timezone: "UTC",
+	})
+	_ = createTask(t, data, project, time.Hour)
+
+	out, err := service.Series(t.Context(), &burndown.SeriesInput{
+		Username: username,
+		RootID:   project,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, out.Points, 1)
+	require.Equal(t, time.Hour, out.Points[0].Remaining)
+	require.Equal(t, 1, out.Points[0].Open)
+}
+
+func TestServiceUntrack(t *testing.T) {
+	t.Parallel()
+
+	repo := memory.NewRepository()
+	service, data := newService(t, repo)
+	project := createTask(t, data, "", 0)
+	_ = service.Track(t.Context(), &burndown.TrackInput{
+		Username: username,
+		RootID:   project,
+		Timezone: "UTC",
+	})
+
+	err := service.Untrack(t.Context(), &burndown.UntrackInput{
+		Username: username,
+		RootID:   project,
+	})
+
+	require.NoError(t, err)
+	require.Empty(t, repo.Snapshots[username])
+
+	_, err = service.Series(t.Context(), &burndown.SeriesInput{
+		Username: username,
+		RootID:   project,
+	})
+
+	require.ErrorIs(t, err, burndown.ErrNotTracked)
+}
+
+func TestServiceTrack_Error(t *testing.T) {
+	t.Parallel()
+
+	t.Run("task not found", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newService(t, apptest.NewMemory(t))
+
+		err := service.Track(t.Context(), &burndown.TrackInput{
+			Username: username,
+			RootID:   "missing",
+			Timezone: "UTC",
+		})
+
+		require.ErrorIs(t, err, burndown.ErrTaskNotFound)
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		t.Parallel()
+
+		service, data := newService(t, apptest.NewMemory(t))
+		project := createTask(t, data, "", 0)
+
+		err := service.Track(t.Context(), &burndown.TrackInput{
+			Username: username,
+			RootID:   project,
+			Timezone: "Mars/Olympus",
+		})
+
+		require.ErrorIs(t, err, burndown.ErrInvalidTimezone)
+	})
+}
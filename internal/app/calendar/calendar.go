@@ -0,0 +1,13 @@
+package calendar
+
+import "time"
+
+type FeedInput struct {
+	Username   string
+	DueAsEvent bool // true이면 마감일이 있는 task를 VTODO 대신 VEVENT로 내보낸다
+	Now        time.Time
+}
+
+type FeedOutput struct {
+	Calendar string
+}
@@ -0,0 +1,63 @@
+package calendar
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	ContentType = "text/calendar; charset=utf-8"
+
+	icsTimeLayout = "20060102T150405Z"
+	icsLineLimit  = 75
+)
+
+// icsWriter는 RFC 5545 형식으로 content line을 쓴다.
+type icsWriter struct {
+	builder strings.Builder
+}
+
+func (w *icsWriter) line(name string, value string) {
+	line := name + ":" + value
+
+	// 75 octet을 넘는 줄은 UTF-8 문자 단위로 접는다.
+	for len(line) > icsLineLimit {
+		cut := icsLineLimit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+
+		w.builder.WriteString(line[:cut] + "\r\n")
+		line = " " + line[cut:]
+	}
+
+	w.builder.WriteString(line + "\r\n")
+}
+
+func (w *icsWriter) text(name string, value string) {
+	w.line(name, escapeText(value))
+}
+
+func (w *icsWriter) time(name string, value time.Time) {
+	w.line(name, value.UTC().Format(icsTimeLayout))
+}
+
+func (w *icsWriter) String() string {
+	return w.builder.String()
+}
+
+func escapeText(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	)
+
+	return replacer.Replace(value)
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
@@ -0,0 +1,132 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+const uidDomain = "@focus"
+
+type Service struct {
+	flowService  *flow.Service
+	extraService *extra.Service
+	traceService *trace.Service
+}
+
+func NewService(flowService *flow.Service, extraService *extra.Service, traceService *trace.Service) *Service {
+	return &Service{
+		flowService:  flowService,
+		extraService: extraService,
+		traceService: traceService,
+	}
+}
+
+func (s *Service) Feed(ctx context.Context, input *FeedInput) (*FeedOutput, error) { //nolint:funlen
+	taskOut, err := s.flowService.ListTasks(ctx, &flow.ListTasksInput{
+		Username:  input.Username,
+		ParentID:  "",
+		Recursive: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	var ids []string
+
+	titles := make(map[string]string)
+	for _, task := range taskOut.Tasks {
+		ids = append(ids, task.ID)
+		titles[task.ID] = task.Title
+	}
+
+	extraOut, err := s.extraService.ListExtras(ctx, &extra.ListExtrasInput{Username: input.Username, IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list extras: %w", err)
+	}
+
+	statuses := make(map[string]string)
+	for _, item := range extraOut.Extras {
+		statuses[item.ID] = item.Category
+	}
+
+	traceOut, err := s.traceService.ListTraces(ctx, &trace.ListTracesInput{Username: input.Username, IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list traces: %w", err)
+	}
+
+	sessionOut, err := s.traceService.ListSessions(ctx, &trace.ListSessionsInput{Username: input.Username, IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	var writer icsWriter
+
+	writer.line("BEGIN", "VCALENDAR")
+	writer.line("VERSION", "2.0")
+	writer.line("PRODID", "-//neatflowcv//focus//EN")
+	writer.line("CALSCALE", "GREGORIAN")
+	writer.text("X-WR-CALNAME", "focus")
+
+	for _, item := range traceOut.Traces {
+		if item.DueAt.IsZero() {
+			continue
+		}
+
+		if input.DueAsEvent {
+			start := item.DueAt.Add(-item.Estimated)
+
+			writer.line("BEGIN", "VEVENT")
+			writer.line("UID", "due-"+item.ID+uidDomain)
+			writer.time("DTSTAMP", input.Now)
+			writer.time("DTSTART", start)
+			writer.time("DTEND", item.DueAt)
+			writer.text("SUMMARY", titles[item.ID])
+			writer.line("END", "VEVENT")
+
+			continue
+		}
+
+		writer.line("BEGIN", "VTODO")
+		writer.line("UID", "task-"+item.ID+uidDomain)
+		writer.time("DTSTAMP", input.Now)
+		writer.text("SUMMARY", titles[item.ID])
+		writer.time("DUE", item.DueAt)
+		writer.line("STATUS", todoStatus(statuses[item.ID]))
+		writer.line("END", "VTODO")
+	}
+
+	for _, session := range sessionOut.Sessions {
+		writer.line("BEGIN", "VEVENT")
+		writer.line("UID", "session-"+session.ID+uidDomain)
+		writer.time("DTSTAMP", input.Now)
+		writer.time("DTSTART", session.StartedAt)
+		writer.time("DTEND", session.EndedAt)
+		writer.text("SUMMARY", titles[session.TraceID])
+		writer.line("TRANSP", "OPAQUE")
+		writer.line("END", "VEVENT")
+	}
+
+	writer.line("END", "VCALENDAR")
+
+	return &FeedOutput{
+		Calendar: writer.String(),
+	}, nil
+}
+
+func todoStatus(status string) string {
+	switch domain.TaskStatus(status) {
+	case domain.TaskStatusDoing:
+		return "IN-PROCESS"
+	case domain.TaskStatusDone:
+		return "COMPLETED"
+	case domain.TaskStatusTodo:
+		return "NEEDS-ACTION"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
@@ -0,0 +1,162 @@
+package calendar_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/apptest"
+	"github.com/neatflowcv/focus/internal/app/calendar"
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/stretchr/testify/require"
+)
+
+const username = "test"
+
+func newService(t *testing.T, repo apptest.Repository) (*calendar.Service, *apptest.Services) {
+	t.Helper()
+
+	data := apptest.NewServices(t, repo, username)
+
+	return calendar.NewService(data.Flow, data.Extra, data.Trace), data
+}
+
+func TestServiceFeed(t *testing.T) {
+	t.Parallel()
+
+	for _, backend := range apptest.Backends() {
+		t.Run(backend.Name, func(t *testing.T) {
+			t.Parallel()
+
+			service, data := newService(t, backend.New(t))
+			now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+			task, _ := data.Flow.CreateTask(t.Context(), &flow.CreateTaskInput{
+				Username: username,
+				Title:    "review, then merge; quickly",
+				ParentID: "",
+				NextID:   "",
+				Now:      now,
+			})
+			_ = data.Trace.SetDueAt(t.Context(), &trace.SetDueAtInput{
+				Username: username,
+				ID:       task.ID,
+				DueAt:    now.Add(48 * time.Hour),
+			})
+			_ = data.Extra.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+				Username: username,
+				ID:       task.ID,
+				Status:   string(domain.TaskStatusDoing),
+				Now:      now,
+				Force:    false,
+			})
+			_ = data.Extra.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+				Username: username,
+				ID:       task.ID,
+				Status:   string(domain.TaskStatusTodo),
+				Now:      now.Add(90 * time.Minute),
+				Force:    false,
+			})
+
+			out, err := service.Feed(t.Context(), &calendar.FeedInput{
+				Username:   username,
+				DueAsEvent: false,
+				Now:        now,
+			})
+
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(out.Calendar, "BEGIN:VCALENDAR\r\n"))
+			require.True(t, strings.HasSuffix(out.Calendar, "END:VCALENDAR\r\n"))
+			require.Contains(t, out.Calendar, "BEGIN:VTODO\r\nUID:task-"+task.ID+"@focus\r\n")
+			require.Contains(t, out.Calendar, "SUMMARY:review\\, then merge\\; quickly\r\n")
+			require.Contains(t, out.Calendar, "DUE:20250403T090000Z\r\n")
+			require.Contains(t, out.Calendar, "STATUS:NEEDS-ACTION\r\n")
+			require.Contains(t, out.Calendar, "DTSTART:20250401T090000Z\r\nDTEND:20250401T103000Z\r\n")
+		})
+	}
+}
+
+func TestServiceFeed_DueAsEvent(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t, apptest.NewMemory(t))
+	now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	task, _ := data.Flow.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: username,
+		Title:    "write a very long title that certainly needs to be folded by the calendar writer",
+		ParentID: "",
+		NextID:   "",
+		Now:      now,
+	})
+	_ = data.Trace.SetDueAt(t.Context(), &trace.SetDueAtInput{
+		Username: username,
+		ID:       task.ID,
+		DueAt:    now.Add(2 * time.Hour),
+	})
+	_ = data.Trace.SetEstimated(t.Context(), &trace.SetEstimatedInput{
+		Username:  username,
+		ID:        task.ID,
+		Estimated: 30 * time.Minute,
+	})
+
+	out, err := service.Feed(t.Context(), &calendar.FeedInput{
+		Username:   username,
+		DueAsEvent: true,
+		Now:        now,
+	})
+
+	require.NoError(t, err)
+	require.NotContains(t, out.Calendar, "VTODO")
+	require.Contains(t, out.Calendar, "UID:due-"+task.ID+"@focus\r\n")
+	require.Contains(t, out.Calendar, "DTSTART:20250401T103000Z\r\nDTEND:20250401T110000Z\r\n")
+
+	for line := range strings.SplitSeq(out.Calendar, "\r\n") {
+		require.LessOrEqual(t, len(line), 75)
+	}
+}
+
+func TestServiceFeed_Restart(t *testing.T) {
+	t.Parallel()
+
+	for _, backend := range apptest.Backends() {
+		t.Run(backend.Name, func(t *testing.T) {
+			t.Parallel()
+
+			service, data := newService(t, backend.New(t))
+			now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+			task, _ := data.Flow.CreateTask(t.Context(), &flow.CreateTaskInput{
+				Username: username,
+				Title:    "task",
+				ParentID: "",
+				NextID:   "",
+				Now:      now,
+			})
+
+			for i, status := range []domain.TaskStatus{
+				domain.TaskStatusDoing, domain.TaskStatusTodo, domain.TaskStatusDoing, domain.TaskStatusTodo,
+			} {
+				err := data.Extra.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+					Username: username,
+					ID:       task.ID,
+					Status:   string(status),
+					Now:      now.Add(time.Duration(i) * time.Hour),
+					Force:    false,
+				})
+				require.NoError(t, err)
+			}
+
+			out, err := service.Feed(t.Context(), &calendar.FeedInput{
+				Username:   username,
+				DueAsEvent: false,
+				Now:        now,
+			})
+
+			require.NoError(t, err)
+			require.NotContains(t, out.Calendar, "DUE:")
+			require.Contains(t, out.Calendar, "DTSTART:20250401T090000Z\r\nDTEND:20250401T100000Z\r\n")
+			require.Contains(t, out.Calendar, "DTSTART:20250401T110000Z\r\nDTEND:20250401T120000Z\r\n")
+		})
+	}
+}
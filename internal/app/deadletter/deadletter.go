@@ -0,0 +1,21 @@
+package deadletter
+
+import "time"
+
+type DeadLetter struct {
+	ID         uint64
+	Topic      string
+	Subscriber int
+	Payload    []byte
+	Error      string
+	Attempts   int
+	FailedAt   time.Time
+}
+
+type ListDeadLettersOutput struct {
+	DeadLetters []*DeadLetter
+}
+
+type ReplayInput struct {
+	ID uint64
+}
@@ -0,0 +1,5 @@
+package deadletter
+
+import "errors"
+
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
@@ -0,0 +1,107 @@
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/neatflowcv/focus/internal/pkg/repository"
+)
+
+var _ eventbus.DeadLetterStore = (*Store)(nil)
+
+// Store는 bus가 끝내 전달하지 못한 이벤트를 저장소에 남긴다. Options.DeadLetters에 넘긴다.
+type Store struct {
+	repo repository.DeadLetterRepository
+}
+
+func NewStore(repo repository.DeadLetterRepository) *Store {
+	return &Store{
+		repo: repo,
+	}
+}
+
+func (s *Store) Save(ctx context.Context, letter *eventbus.DeadLetter) error {
+	payload, err := json.Marshal(letter.Message)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", letter.Topic, err)
+	}
+
+	err = s.repo.CreateDeadLetter(ctx, &repository.DeadLetter{
+		ID:         0,
+		Topic:      letter.Topic,
+		Subscriber: letter.Subscriber,
+		Payload:    payload,
+		Error:      letter.Error,
+		Attempts:   letter.Attempts,
+		FailedAt:   letter.FailedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create dead letter: %w", err)
+	}
+
+	return nil
+}
+
+// Service는 남은 dead letter를 보여 주고 실패한 subscriber에게 다시 전달한다.
+type Service struct {
+	repo repository.DeadLetterRepository
+	bus  *eventbus.Bus
+}
+
+func NewService(repo repository.DeadLetterRepository, bus *eventbus.Bus) *Service {
+	return &Service{
+		repo: repo,
+		bus:  bus,
+	}
+}
+
+func (s *Service) ListDeadLetters(ctx context.Context) (*ListDeadLettersOutput, error) {
+	letters, err := s.repo.ListDeadLetters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dead letters: %w", err)
+	}
+
+	var items []*DeadLetter
+	for _, letter := range letters {
+		items = append(items, &DeadLetter{
+			ID:         letter.ID,
+			Topic:      letter.Topic,
+			Subscriber: letter.Subscriber,
+			Payload:    letter.Payload,
+			Error:      letter.Error,
+			Attempts:   letter.Attempts,
+			FailedAt:   letter.FailedAt,
+		})
+	}
+
+	return &ListDeadLettersOutput{
+		DeadLetters: items,
+	}, nil
+}
+
+// Replay는 dead letter를 다시 전달하고, 성공하면 지운다. 실패하면 그대로 남는다.
+func (s *Service) Replay(ctx context.Context, input *ReplayInput) error {
+	letter, err := s.repo.GetDeadLetter(ctx, input.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrDeadLetterNotFound) {
+			return ErrDeadLetterNotFound
+		}
+
+		return fmt.Errorf("failed to get dead letter: %w", err)
+	}
+
+	err = s.bus.Replay(ctx, letter.Topic, letter.Payload, letter.Subscriber)
+	if err != nil {
+		return fmt.Errorf("failed to replay dead letter %d: %w", letter.ID, err)
+	}
+
+	err = s.repo.DeleteDeadLetter(ctx, letter.ID)
+	if err != nil {
+		return fmt.Errorf("failed to delete dead letter: %w", err)
+	}
+
+	return nil
+}
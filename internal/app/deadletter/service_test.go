@@ -0,0 +1,126 @@
+package deadletter_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/deadletter"
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/neatflowcv/focus/internal/pkg/repository/memory"
+	"github.com/stretchr/testify/require"
+)
+
+var errSubscriber = errors.New("subscriber failed")
+
+type ServiceData struct {
+	repo  *memory.Repository
+	bus   *eventbus.Bus
+	first []string
+	fails int // 두 번째 subscriber가 앞으로 실패할 횟수
+}
+
+func newService(t *testing.T) (*deadletter.Service, *ServiceData) {
+	t.Helper()
+
+	data := &ServiceData{
+		repo:  memory.NewRepository(),
+		bus:   nil,
+		first: nil,
+		fails: 1,
+	}
+
+	options := eventbus.SyncOptions()
+	options.DeadLetters = deadletter.NewStore(data.repo)
+	data.bus = eventbus.NewBusWithOptions(options)
+
+	data.bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		data.first = append(data.first, event.TaskID)
+
+		return nil
+	})
+	data.bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		if data.fails > 0 {
+			data.fails--
+
+			return errSubscriber
+		}
+
+		return nil
+	})
+
+	return deadletter.NewService(data.repo, data.bus), data
+}
+
+func publish(t *testing.T, data *ServiceData) {
+	t.Helper()
+
+	err := data.bus.TaskCreated.Publish(t.Context(), &eventbus.TaskCreatedEvent{
+		Version:  eventbus.TaskCreatedVersion,
+		Username: "test",
+		TaskID:   "1",
+		ParentID: "",
+		NextID:   "",
+		Title:    "",
+		Now:      time.Date(2025, 7, 4, 9, 0, 0, 0, time.UTC),
+	})
+	require.ErrorIs(t, err, errSubscriber)
+}
+
+func TestServiceListDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	publish(t, data)
+
+	out, err := service.ListDeadLetters(t.Context())
+
+	require.NoError(t, err)
+	require.Len(t, out.DeadLetters, 1)
+	require.Equal(t, eventbus.TopicTaskCreated, out.DeadLetters[0].Topic)
+	require.Equal(t, 1, out.DeadLetters[0].Subscriber)
+	require.Equal(t, 1, out.DeadLetters[0].Attempts)
+	require.Contains(t, out.DeadLetters[0].Error, errSubscriber.Error())
+	require.Contains(t, string(out.DeadLetters[0].Payload), `"TaskID":"1"`)
+}
+
+func TestServiceReplay(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	publish(t, data)
+
+	err := service.Replay(t.Context(), &deadletter.ReplayInput{ID: data.repo.DeadLetters[0].ID})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, data.first)
+	require.Empty(t, data.repo.DeadLetters)
+}
+
+func TestServiceReplay_Error(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newService(t)
+
+		err := service.Replay(t.Context(), &deadletter.ReplayInput{ID: 1})
+
+		require.ErrorIs(t, err, deadletter.ErrDeadLetterNotFound)
+	})
+
+	t.Run("fails again", func(t *testing.T) {
+		t.Parallel()
+
+		service, data := newService(t)
+		publish(t, data)
+		data.fails = 1
+
+		err := service.Replay(t.Context(), &deadletter.ReplayInput{ID: data.repo.DeadLetters[0].ID})
+
+		require.ErrorIs(t, err, errSubscriber)
+		require.Len(t, data.repo.DeadLetters, 1)
+	})
+}
@@ -3,6 +3,10 @@ package extra
 import "errors"
 
 var (
-	ErrPreconditionFailed = errors.New("precondition failed")
-	ErrExtraNotFound      = errors.New("extra not found")
+	ErrPreconditionFailed   = errors.New("precondition failed")
+	ErrExtraNotFound        = errors.New("extra not found")
+	ErrInvalidStatus        = errors.New("invalid status")
+	ErrInvalidWorkflow      = errors.New("invalid workflow")
+	ErrTransitionNotAllowed = errors.New("transition not allowed")
+	ErrStatusDerived        = errors.New("status is derived from children")
 )
@@ -1,8 +1,12 @@
 package extra
 
+import "time"
+
 type CreateExtraInput struct {
+	Username string
 	ID       string
 	ParentID string
+	Now      time.Time
 }
 
 type CreateExtraOutput struct {
@@ -10,11 +14,14 @@ type CreateExtraOutput struct {
 }
 
 type DeleteExtraInput struct {
-	ID string
+	Username string
+	ID       string
+	Now      time.Time
 }
 
 type ListExtrasInput struct {
-	IDs []string
+	Username string
+	IDs      []string
 }
 
 type ListExtrasOutput struct {
@@ -22,6 +29,48 @@ type ListExtrasOutput struct {
 }
 
 type Extra struct {
-	Leaf   bool
-	Status string
+	ID       string
+	Leaf     bool
+	Status   string
+	Category string // Status가 속한 기본 상태
+	Rollup   bool
+
+	FirstStartedAt time.Time // 시작한 적이 없으면 zero
+	CompletedAt    time.Time // 완료되지 않았으면 zero
+	Reopens        int
+}
+
+type Node struct {
+	ID       string
+	ParentID string
+}
+
+type RecomputeInput struct {
+	Username string
+	Nodes    []*Node // 부모가 자식보다 먼저 나오는 작업 트리
+	DryRun   bool    // true이면 바꿀 내용만 계산하고 저장하지 않는다
+	Now      time.Time
+}
+
+type RecomputeOutput struct {
+	Created int // 작업은 있는데 extra가 없어서 만든 수
+	Updated int // parent, leaf 또는 상태를 고친 수
+	Deleted int // 작업이 없는데 남아 있어서 지운 수
+	Changes []*Change
+}
+
+const (
+	ChangeCreate   = "create"
+	ChangeReparent = "reparent"
+	ChangeDelete   = "delete"
+	ChangeLeaf     = "leaf"
+	ChangeStatus   = "status"
+)
+
+// Change는 Recompute가 고치는 값 하나이다. create는 To에 부모를, delete는 From에 부모를 담는다.
+type Change struct {
+	ID   string
+	Kind string
+	From string
+	To   string
 }
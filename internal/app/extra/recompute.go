@@ -0,0 +1,126 @@
+package extra
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+// recomputePlan은 저장된 extra를 작업 트리에 맞추기 위해 저장할 변경이다.
+type recomputePlan struct {
+	created  []*domain.Extra
+	updated  []*domain.Extra
+	deleted  []*domain.Extra
+	previous map[domain.ExtraID]*domain.Extra // updated의 원래 모습
+	changes  []*Change
+}
+
+func newRecomputePlan(stored []*domain.Extra, nodes []*Node, now time.Time) *recomputePlan { //nolint:cyclop,funlen
+	plan := &recomputePlan{
+		created:  nil,
+		updated:  nil,
+		deleted:  nil,
+		previous: make(map[domain.ExtraID]*domain.Extra),
+		changes:  nil,
+	}
+
+	current := make(map[domain.ExtraID]*domain.Extra, len(stored))
+	for _, extra := range stored {
+		current[extra.ID()] = extra
+	}
+
+	work := make(map[domain.ExtraID]*domain.Extra, len(nodes))
+	children := make(map[domain.ExtraID][]domain.ExtraID)
+
+	for _, node := range nodes {
+		id := domain.ExtraID(node.ID)
+		parentID := domain.ExtraID(node.ParentID)
+		children[parentID] = append(children[parentID], id)
+
+		extra, ok := current[id]
+
+		switch {
+		case !ok:
+			extra = domain.NewExtra(
+				id, parentID, true, domain.TaskStatusTodo, domain.TaskStatusTodo, false, time.Time{}, time.Time{}, 0,
+			)
+			plan.add(id, ChangeCreate, "", string(parentID))
+
+		case extra.ParentID() != parentID:
+			plan.add(id, ChangeReparent, string(extra.ParentID()), string(parentID))
+			extra = extra.SetParentID(parentID)
+		}
+
+		work[id] = extra
+	}
+
+	orphans := slices.SortedFunc(slices.Values(stored), func(a, b *domain.Extra) int {
+		return strings.Compare(string(a.ID()), string(b.ID()))
+	})
+	for _, extra := range orphans {
+		if _, ok := work[extra.ID()]; ok {
+			continue
+		}
+
+		plan.deleted = append(plan.deleted, extra)
+		plan.add(extra.ID(), ChangeDelete, string(extra.ParentID()), "")
+	}
+
+	// 자식의 상태가 먼저 정해져야 하므로 역순으로 계산한다.
+	for i := len(nodes) - 1; i >= 0; i-- {
+		id := domain.ExtraID(nodes[i].ID)
+
+		var kids []*domain.Extra
+		for _, child := range children[id] {
+			kids = append(kids, work[child])
+		}
+
+		before := work[id]
+		after := recomputed(before, kids, false, now)
+
+		if after.Leaf() != before.Leaf() {
+			plan.add(id, ChangeLeaf, strconv.FormatBool(before.Leaf()), strconv.FormatBool(after.Leaf()))
+		}
+
+		if after.Status() != before.Status() {
+			plan.add(id, ChangeStatus, string(before.Status()), string(after.Status()))
+		}
+
+		work[id] = after
+	}
+
+	for _, node := range nodes {
+		id := domain.ExtraID(node.ID)
+		extra := work[id]
+
+		original, ok := current[id]
+		if !ok {
+			plan.created = append(plan.created, extra)
+
+			continue
+		}
+
+		if extra.ParentID() == original.ParentID() &&
+			extra.Leaf() == original.Leaf() &&
+			extra.Status() == original.Status() {
+			continue
+		}
+
+		plan.updated = append(plan.updated, extra)
+		plan.previous[id] = original
+	}
+
+	return plan
+}
+
+func (p *recomputePlan) add(id domain.ExtraID, kind string, from string, to string) {
+	p.changes = append(p.changes, &Change{
+		ID:   string(id),
+		Kind: kind,
+		From: from,
+		To:   to,
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/neatflowcv/focus/internal/pkg/domain"
 	"github.com/neatflowcv/focus/internal/pkg/eventbus"
@@ -11,59 +12,47 @@ import (
 )
 
 type Service struct {
-	bus  *eventbus.Bus
-	repo repository.ExtraRepository
+	bus          *eventbus.Bus
+	repo         repository.ExtraRepository
+	workflowRepo repository.WorkflowRepository
 }
 
-func NewService(bus *eventbus.Bus, repo repository.ExtraRepository) *Service {
+func NewService(
+	bus *eventbus.Bus,
+	repo repository.ExtraRepository,
+	workflowRepo repository.WorkflowRepository,
+) *Service {
 	return &Service{
-		bus:  bus,
-		repo: repo,
+		bus:          bus,
+		repo:         repo,
+		workflowRepo: workflowRepo,
 	}
 }
 
+// InitialExtra는 task가 만들어질 때 CreateExtra가 저장하는 extra이다.
+func InitialExtra(id string) *Extra {
+	return toExtra(newExtra(domain.ExtraID(id), ""))
+}
+
 func (s *Service) CreateExtra(ctx context.Context, input *CreateExtraInput) error {
-	extra := domain.NewExtra(
-		domain.ExtraID(input.ID),
-		domain.ExtraID(input.ParentID),
-		true,
-		domain.TaskStatusTodo,
-	)
+	extra := newExtra(domain.ExtraID(input.ID), domain.ExtraID(input.ParentID))
 
-	err := s.repo.CreateExtra(ctx, extra)
+	err := s.repo.CreateExtra(ctx, input.Username, extra)
 	if err != nil {
-		return fmt.Errorf("failed to create extra: %w", err)
-	}
-
-	searchID := domain.ExtraID(input.ParentID)
-	for searchID != "" {
-		searchedExtra, err := s.repo.GetExtra(ctx, searchID)
-		if err != nil {
-			return fmt.Errorf("failed to get extra: %w", err)
+		// 다시 전달된 이벤트이면 부모만 다시 계산한다.
+		if errors.Is(err, repository.ErrExtraAlreadyExists) {
+			return s.refresh(ctx, input.Username, domain.ExtraID(input.ParentID), false, input.Now)
 		}
 
-		if !searchedExtra.Leaf() && !searchedExtra.IsCompleted() {
-			// parent가 이미 leaf가 아니라면, 위에 있는 모든 extra도 leaf가 아니므로 종료
-			break
-		}
-
-		update := searchedExtra.
-			SetLeaf(false).
-			SetStatus(domain.TaskStatusTodo)
-
-		err = s.repo.UpdateExtra(ctx, update)
-		if err != nil {
-			return fmt.Errorf("failed to update parent extra: %w", err)
-		}
-
-		searchID = searchedExtra.ParentID()
+		return fmt.Errorf("failed to create extra: %w", err)
 	}
 
-	return nil
+	// 완료된 작업을 옮겨 온 것이면 새 부모를 다시 열 필요가 없다.
+	return s.refresh(ctx, input.Username, domain.ExtraID(input.ParentID), !extra.IsCompleted(), input.Now)
 }
 
 func (s *Service) DeleteExtra(ctx context.Context, input *DeleteExtraInput) error {
-	extra, err := s.repo.GetExtra(ctx, domain.ExtraID(input.ID))
+	extra, err := s.repo.GetExtra(ctx, input.Username, domain.ExtraID(input.ID))
 	if err != nil {
 		if errors.Is(err, repository.ErrExtraNotFound) {
 			return ErrExtraNotFound
@@ -72,12 +61,12 @@ func (s *Service) DeleteExtra(ctx context.Context, input *DeleteExtraInput) erro
 		return fmt.Errorf("failed to get extra: %w", err)
 	}
 
-	err = s.repo.DeleteExtra(ctx, extra)
+	err = s.repo.DeleteExtra(ctx, input.Username, extra)
 	if err != nil {
 		return fmt.Errorf("failed to delete extra: %w", err)
 	}
 
-	return nil
+	return s.refresh(ctx, input.Username, extra.ParentID(), false, input.Now)
 }
 
 func (s *Service) ListExtras(ctx context.Context, input *ListExtrasInput) (*ListExtrasOutput, error) {
@@ -86,17 +75,14 @@ func (s *Service) ListExtras(ctx context.Context, input *ListExtrasInput) (*List
 		ids = append(ids, domain.ExtraID(id))
 	}
 
-	extras, err := s.repo.ListExtras(ctx, ids)
+	extras, err := s.repo.ListExtras(ctx, input.Username, ids)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list extras: %w", err)
 	}
 
 	var ouputExtras []*Extra
 	for _, extra := range extras {
-		ouputExtras = append(ouputExtras, &Extra{
-			Leaf:   extra.Leaf(),
-			Status: string(extra.Status()),
-		})
+		ouputExtras = append(ouputExtras, toExtra(extra))
 	}
 
 	return &ListExtrasOutput{
@@ -105,65 +91,438 @@ func (s *Service) ListExtras(ctx context.Context, input *ListExtrasInput) (*List
 }
 
 func (s *Service) UpdateStatus(ctx context.Context, input *UpdateStatusInput) error {
-	extra, err := s.repo.GetExtra(ctx, domain.ExtraID(input.ID))
+	workflow, err := s.getWorkflow(ctx, input.Username)
+	if err != nil {
+		return err
+	}
+
+	status := domain.TaskStatus(input.Status)
+
+	category, ok := workflow.Category(status)
+	if !ok {
+		return ErrInvalidStatus
+	}
+
+	extra, err := s.repo.GetExtra(ctx, input.Username, domain.ExtraID(input.ID))
 	if err != nil {
+		if errors.Is(err, repository.ErrExtraNotFound) {
+			return ErrExtraNotFound
+		}
+
 		return fmt.Errorf("failed to get extra: %w", err)
 	}
 
-	if extra.Status() == domain.TaskStatus(input.Status) {
+	if extra.Status() == status {
 		return nil
 	}
 
-	err = s.repo.UpdateExtra(ctx, extra.SetStatus(domain.TaskStatus(input.Status)))
+	if !input.Force && extra.IsDerived() {
+		return ErrStatusDerived
+	}
+
+	if !input.Force && !workflow.CanTransition(extra.Status(), status) {
+		return ErrTransitionNotAllowed
+	}
+
+	err = s.repo.UpdateExtra(ctx, input.Username, extra.SetStatus(status, category, input.Now))
 	if err != nil {
-		return fmt.Errorf("failed to set todo: %w", err)
+		return fmt.Errorf("failed to update status: %w", err)
 	}
 
-	s.bus.ExtraStatusUpdated.Publish(ctx, &eventbus.ExtraStatusUpdatedEvent{
-		ExtraID: input.ID,
-		Status:  input.Status,
-		Now:     input.Now,
+	err = s.bus.ExtraStatusUpdated.Publish(ctx, &eventbus.ExtraStatusUpdatedEvent{
+		Version:     eventbus.ExtraStatusUpdatedVersion,
+		Username:    input.Username,
+		ExtraID:     input.ID,
+		OldStatus:   string(extra.Status()),
+		OldCategory: string(extra.Category()),
+		Status:      input.Status,
+		Category:    string(category),
+		Derived:     false,
+		Now:         input.Now,
 	})
+	if err != nil {
+		return fmt.Errorf("failed to publish status updated: %w", err)
+	}
 
-	return nil
+	return s.refresh(ctx, input.Username, extra.ParentID(), false, input.Now)
 }
 
-func (s *Service) UpdateParent(ctx context.Context, input *UpdateParentInput) error {
-	extra, err := s.repo.GetExtra(ctx, domain.ExtraID(input.ID))
+// SetRollup은 상태를 자식으로부터 계산할지 정한다. 켜면 바로 자식으로부터 상태를 다시 계산한다.
+func (s *Service) SetRollup(ctx context.Context, input *SetRollupInput) error {
+	extra, err := s.repo.GetExtra(ctx, input.Username, domain.ExtraID(input.ID))
 	if err != nil {
+		if errors.Is(err, repository.ErrExtraNotFound) {
+			return ErrExtraNotFound
+		}
+
 		return fmt.Errorf("failed to get extra: %w", err)
 	}
 
-	update := extra.SetParentID(domain.ExtraID(input.ParentID))
+	if extra.Rollup() == input.Rollup {
+		return nil
+	}
 
-	err = s.repo.UpdateExtra(ctx, update)
+	err = s.repo.UpdateExtra(ctx, input.Username, extra.SetRollup(input.Rollup))
 	if err != nil {
 		return fmt.Errorf("failed to update extra: %w", err)
 	}
 
-	searchID := domain.ExtraID(input.ParentID)
-	for searchID != "" {
-		searchedExtra, err := s.repo.GetExtra(ctx, searchID)
+	err = s.bus.ExtraRollupUpdated.Publish(ctx, &eventbus.ExtraRollupUpdatedEvent{
+		Version:   eventbus.ExtraRollupUpdatedVersion,
+		Username:  input.Username,
+		ExtraID:   input.ID,
+		OldRollup: extra.Rollup(),
+		NewRollup: input.Rollup,
+		Now:       input.Now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish rollup updated: %w", err)
+	}
+
+	if !input.Rollup {
+		return nil
+	}
+
+	return s.refresh(ctx, input.Username, extra.ID(), false, input.Now)
+}
+
+// Recompute는 작업 트리로부터 모든 extra의 parent, leaf와 상태를 다시 계산하고 트리에 없는 extra는 지운다.
+func (s *Service) Recompute(ctx context.Context, input *RecomputeInput) (*RecomputeOutput, error) {
+	stored, err := s.repo.ListAllExtras(ctx, input.Username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list all extras: %w", err)
+	}
+
+	plan := newRecomputePlan(stored, input.Nodes, input.Now)
+
+	if !input.DryRun {
+		err := s.applyPlan(ctx, input.Username, plan, input.Now)
 		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &RecomputeOutput{
+		Created: len(plan.created),
+		Updated: len(plan.updated),
+		Deleted: len(plan.deleted),
+		Changes: plan.changes,
+	}, nil
+}
+
+func (s *Service) applyPlan(ctx context.Context, username string, plan *recomputePlan, now time.Time) error {
+	for _, extra := range plan.created {
+		err := s.repo.CreateExtra(ctx, username, extra)
+		if err != nil {
+			return fmt.Errorf("failed to create extra: %w", err)
+		}
+	}
+
+	for _, extra := range plan.updated {
+		err := s.repo.UpdateExtra(ctx, username, extra)
+		if err != nil {
+			return fmt.Errorf("failed to update extra: %w", err)
+		}
+
+		if extra.Status() == plan.previous[extra.ID()].Status() {
+			continue
+		}
+
+		err = s.publishDerived(ctx, username, plan.previous[extra.ID()], extra, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, extra := range plan.deleted {
+		err := s.repo.DeleteExtra(ctx, username, extra)
+		if err != nil {
+			return fmt.Errorf("failed to delete extra: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// refresh는 id부터 위로 바뀌는 동안 leaf와 상태를 다시 계산한다. reopen이면 완료된 부모도 todo로 되돌린다.
+func (s *Service) refresh(
+	ctx context.Context,
+	username string,
+	id domain.ExtraID,
+	reopen bool,
+	now time.Time,
+) error {
+	for id != "" {
+		extra, err := s.repo.GetExtra(ctx, username, id)
+		if err != nil {
+			// 부모부터 삭제되는 중이면 더 올라갈 곳이 없다.
+			if errors.Is(err, repository.ErrExtraNotFound) {
+				return nil
+			}
+
 			return fmt.Errorf("failed to get extra: %w", err)
 		}
 
-		if !searchedExtra.Leaf() && !searchedExtra.IsCompleted() {
-			// parent가 이미 leaf가 아니라면, 위에 있는 모든 extra도 leaf가 아니므로 종료
-			break
+		children, err := s.repo.ListChildExtras(ctx, username, id)
+		if err != nil {
+			return fmt.Errorf("failed to list child extras: %w", err)
+		}
+
+		changed, err := s.recompute(ctx, username, extra, children, reopen, now)
+		if err != nil {
+			return err
+		}
+
+		if !changed {
+			return nil
 		}
 
-		update := searchedExtra.
-			SetLeaf(false).
-			SetStatus(domain.TaskStatusTodo)
+		id = extra.ParentID()
+	}
+
+	return nil
+}
+
+// recompute는 extra 하나의 leaf와 상태를 children으로부터 다시 저장하고 바뀌었는지 반환한다.
+func (s *Service) recompute(
+	ctx context.Context,
+	username string,
+	extra *domain.Extra,
+	children []*domain.Extra,
+	reopen bool,
+	now time.Time,
+) (bool, error) {
+	update := recomputed(extra, children, reopen, now)
+	if update.Leaf() == extra.Leaf() && update.Status() == extra.Status() {
+		return false, nil
+	}
 
-		err = s.repo.UpdateExtra(ctx, update)
+	err := s.repo.UpdateExtra(ctx, username, update)
+	if err != nil {
+		return false, fmt.Errorf("failed to update extra: %w", err)
+	}
+
+	if update.Status() != extra.Status() {
+		err := s.publishDerived(ctx, username, extra, update, now)
 		if err != nil {
-			return fmt.Errorf("failed to update parent extra: %w", err)
+			return true, err
 		}
+	}
 
-		searchID = searchedExtra.ParentID()
+	return true, nil
+}
+
+// recomputed는 extra 하나의 leaf와 상태를 children으로부터 계산한 결과를 반환한다.
+func recomputed(extra *domain.Extra, children []*domain.Extra, reopen bool, now time.Time) *domain.Extra {
+	update := extra.SetLeaf(len(children) == 0)
+
+	switch {
+	case update.IsDerived():
+		var categories []domain.TaskStatus
+		for _, child := range children {
+			categories = append(categories, child.Category())
+		}
+
+		status := domain.RollupStatus(categories)
+		update = update.SetStatus(status, status, now)
+
+	case !update.Leaf() && (extra.Leaf() || reopen && extra.IsCompleted()):
+		update = update.SetStatus(domain.TaskStatusTodo, domain.TaskStatusTodo, now)
+	}
+
+	return update
+}
+
+// publishDerived는 자식으로부터 다시 계산되어 바뀐 상태를 알린다.
+func (s *Service) publishDerived(
+	ctx context.Context,
+	username string,
+	old *domain.Extra,
+	extra *domain.Extra,
+	now time.Time,
+) error {
+	err := s.bus.ExtraStatusUpdated.Publish(ctx, &eventbus.ExtraStatusUpdatedEvent{
+		Version:     eventbus.ExtraStatusUpdatedVersion,
+		Username:    username,
+		ExtraID:     string(extra.ID()),
+		OldStatus:   string(old.Status()),
+		OldCategory: string(old.Category()),
+		Status:      string(extra.Status()),
+		Category:    string(extra.Category()),
+		Derived:     true,
+		Now:         now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish status updated: %w", err)
 	}
 
 	return nil
 }
+
+// SetWorkflow는 사용자의 workflow를 교체한다. 이미 삭제된 상태에 있는 작업은 그 상태와 분류를 유지한다.
+func (s *Service) SetWorkflow(ctx context.Context, input *SetWorkflowInput) (*SetWorkflowOutput, error) {
+	workflow, err := newWorkflow(input.Username, &input.Workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.workflowRepo.SaveWorkflow(ctx, workflow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save workflow: %w", err)
+	}
+
+	return &SetWorkflowOutput{
+		Workflow: toWorkflow(workflow),
+	}, nil
+}
+
+// GetWorkflow는 사용자의 workflow를 반환한다. workflow가 없으면 기본 상태만 있는 workflow를 반환한다.
+func (s *Service) GetWorkflow(ctx context.Context, input *GetWorkflowInput) (*GetWorkflowOutput, error) {
+	workflow, err := s.getWorkflow(ctx, input.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetWorkflowOutput{
+		Workflow: toWorkflow(workflow),
+	}, nil
+}
+
+func (s *Service) getWorkflow(ctx context.Context, username string) (*domain.Workflow, error) {
+	workflow, err := s.workflowRepo.GetWorkflow(ctx, username)
+	if err != nil {
+		if errors.Is(err, repository.ErrWorkflowNotFound) {
+			return domain.NewDefaultWorkflow(username), nil
+		}
+
+		return nil, fmt.Errorf("failed to get workflow: %w", err)
+	}
+
+	return workflow, nil
+}
+
+// newWorkflow는 입력을 검증하고 빠진 기본 상태를 채운다.
+func newWorkflow(username string, input *Workflow) (*domain.Workflow, error) { //nolint:cyclop
+	var statuses []*domain.WorkflowStatus
+
+	names := make(map[domain.TaskStatus]bool)
+
+	for _, item := range input.Statuses {
+		name := domain.TaskStatus(item.Name)
+		category := domain.TaskStatus(item.Category)
+
+		if !name.IsValidName() || !category.IsValid() || names[name] {
+			return nil, ErrInvalidWorkflow
+		}
+
+		if name.IsValid() && name != category {
+			return nil, ErrInvalidWorkflow
+		}
+
+		names[name] = true
+		statuses = append(statuses, domain.NewWorkflowStatus(name, category))
+	}
+
+	for _, core := range []domain.TaskStatus{domain.TaskStatusTodo, domain.TaskStatusDoing, domain.TaskStatusDone} {
+		if !names[core] {
+			names[core] = true
+			statuses = append(statuses, domain.NewWorkflowStatus(core, core))
+		}
+	}
+
+	var transitions []*domain.Transition
+
+	for _, item := range input.Transitions {
+		from := domain.TaskStatus(item.From)
+		to := domain.TaskStatus(item.To)
+
+		if !names[from] || !names[to] || from == to {
+			return nil, ErrInvalidWorkflow
+		}
+
+		transitions = append(transitions, domain.NewTransition(from, to))
+	}
+
+	return domain.NewWorkflow(username, statuses, transitions), nil
+}
+
+func toWorkflow(workflow *domain.Workflow) Workflow {
+	var statuses []*Status
+	for _, status := range workflow.Statuses() {
+		statuses = append(statuses, &Status{
+			Name:     string(status.Name()),
+			Category: string(status.Category()),
+		})
+	}
+
+	var transitions []*Transition
+	for _, transition := range workflow.Transitions() {
+		transitions = append(transitions, &Transition{
+			From: string(transition.From()),
+			To:   string(transition.To()),
+		})
+	}
+
+	return Workflow{
+		Statuses:    statuses,
+		Transitions: transitions,
+	}
+}
+
+func (s *Service) UpdateParent(ctx context.Context, input *UpdateParentInput) error {
+	extra, err := s.repo.GetExtra(ctx, input.Username, domain.ExtraID(input.ID))
+	if err != nil {
+		if errors.Is(err, repository.ErrExtraNotFound) {
+			return ErrExtraNotFound
+		}
+
+		return fmt.Errorf("failed to get extra: %w", err)
+	}
+
+	if extra.ParentID() == domain.ExtraID(input.ParentID) {
+		return nil
+	}
+
+	update := extra.SetParentID(domain.ExtraID(input.ParentID))
+
+	err = s.repo.UpdateExtra(ctx, input.Username, update)
+	if err != nil {
+		return fmt.Errorf("failed to update extra: %w", err)
+	}
+
+	err = s.refresh(ctx, input.Username, extra.ParentID(), false, input.Now)
+	if err != nil {
+		return err
+	}
+
+	// 완료된 작업을 옮겨 온 것이면 새 부모를 다시 열 필요가 없다.
+	return s.refresh(ctx, input.Username, domain.ExtraID(input.ParentID), !extra.IsCompleted(), input.Now)
+}
+
+func newExtra(id domain.ExtraID, parentID domain.ExtraID) *domain.Extra {
+	return domain.NewExtra(
+		id,
+		parentID,
+		true,
+		domain.TaskStatusTodo,
+		domain.TaskStatusTodo,
+		false,
+		time.Time{},
+		time.Time{},
+		0,
+	)
+}
+
+func toExtra(extra *domain.Extra) *Extra {
+	return &Extra{
+		ID:       string(extra.ID()),
+		Leaf:     extra.Leaf(),
+		Status:   string(extra.Status()),
+		Category: string(extra.Category()),
+		Rollup:   extra.Rollup(),
+
+		FirstStartedAt: extra.FirstStartedAt(),
+		CompletedAt:    extra.CompletedAt(),
+		Reopens:        extra.Reopens(),
+	}
+}
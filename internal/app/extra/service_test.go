@@ -1,6 +1,7 @@
 package extra_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -11,7 +12,10 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+const username = "test"
+
 type ServiceData struct {
+	bus  *eventbus.Bus
 	repo *memory.Repository
 }
 
@@ -20,9 +24,10 @@ func newService(t *testing.T) (*extra.Service, *ServiceData) {
 
 	repo := memory.NewRepository()
 	bus := eventbus.NewBus()
-	service := extra.NewService(bus, repo)
+	service := extra.NewService(bus, repo, repo)
 
 	return service, &ServiceData{
+		bus:  bus,
 		repo: repo,
 	}
 }
@@ -33,14 +38,45 @@ func TestServiceCreateExtra(t *testing.T) {
 	service, data := newService(t)
 
 	err := service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "test",
+		ParentID: "",
+		Now:      time.Now(),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, data.repo.Extras[username], 1)
+	require.True(t, data.repo.Extras[username]["test"].Leaf())
+	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras[username]["test"].Status())
+}
+
+func TestServiceCreateExtra_Redelivered(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "test",
+		ParentID: "",
+		Now:      time.Now(),
+	})
+	_ = service.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+		Username: username,
+		ID:       "test",
+		Status:   string(domain.TaskStatusDoing),
+		Now:      time.Now(),
+		Force:    false,
+	})
+
+	err := service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "test",
 		ParentID: "",
+		Now:      time.Now(),
 	})
 
 	require.NoError(t, err)
-	require.Len(t, data.repo.Extras, 1)
-	require.True(t, data.repo.Extras["test"].Leaf())
-	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras["test"].Status())
+	require.Equal(t, domain.TaskStatusDoing, data.repo.Extras[username]["test"].Status())
 }
 
 func TestServiceDeleteExtra(t *testing.T) {
@@ -48,12 +84,16 @@ func TestServiceDeleteExtra(t *testing.T) {
 
 	service, _ := newService(t)
 	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "test",
 		ParentID: "",
+		Now:      time.Now(),
 	})
 
 	err := service.DeleteExtra(t.Context(), &extra.DeleteExtraInput{
-		ID: "test",
+		Username: username,
+		ID:       "test",
+		Now:      time.Now(),
 	})
 
 	require.NoError(t, err)
@@ -65,7 +105,9 @@ func TestServiceDeleteExtra_Error(t *testing.T) {
 	service, _ := newService(t)
 
 	err := service.DeleteExtra(t.Context(), &extra.DeleteExtraInput{
-		ID: "test",
+		Username: username,
+		ID:       "test",
+		Now:      time.Now(),
 	})
 
 	require.ErrorIs(t, err, extra.ErrExtraNotFound)
@@ -77,7 +119,8 @@ func TestServiceListExtras0(t *testing.T) {
 	service, _ := newService(t)
 
 	ret, err := service.ListExtras(t.Context(), &extra.ListExtrasInput{
-		IDs: []string{"test"},
+		Username: username,
+		IDs:      []string{"test"},
 	})
 
 	require.NoError(t, err)
@@ -89,12 +132,15 @@ func TestServiceListExtras1(t *testing.T) {
 
 	service, _ := newService(t)
 	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "test",
 		ParentID: "",
+		Now:      time.Now(),
 	})
 
 	ret, err := service.ListExtras(t.Context(), &extra.ListExtrasInput{
-		IDs: []string{"test"},
+		Username: username,
+		IDs:      []string{"test"},
 	})
 
 	require.NoError(t, err)
@@ -106,16 +152,21 @@ func TestServiceListExtras2(t *testing.T) {
 
 	service, _ := newService(t)
 	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "test1",
 		ParentID: "",
+		Now:      time.Now(),
 	})
 	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "test2",
 		ParentID: "",
+		Now:      time.Now(),
 	})
 
 	ret, err := service.ListExtras(t.Context(), &extra.ListExtrasInput{
-		IDs: []string{"test1", "test2"},
+		Username: username,
+		IDs:      []string{"test1", "test2"},
 	})
 
 	require.NoError(t, err)
@@ -127,16 +178,20 @@ func TestServiceCheckLeaf(t *testing.T) {
 
 	service, data := newService(t)
 	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "parent",
 		ParentID: "",
+		Now:      time.Now(),
 	})
 	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "child",
 		ParentID: "parent",
+		Now:      time.Now(),
 	})
 
-	require.True(t, data.repo.Extras["child"].Leaf())
-	require.False(t, data.repo.Extras["parent"].Leaf())
+	require.True(t, data.repo.Extras[username]["child"].Leaf())
+	require.False(t, data.repo.Extras[username]["parent"].Leaf())
 }
 
 func TestServiceCheckStatus1(t *testing.T) {
@@ -144,16 +199,20 @@ func TestServiceCheckStatus1(t *testing.T) {
 
 	service, data := newService(t)
 	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "parent",
 		ParentID: "",
+		Now:      time.Now(),
 	})
 	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "child",
 		ParentID: "parent",
+		Now:      time.Now(),
 	})
 
-	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras["parent"].Status())
-	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras["child"].Status())
+	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras[username]["parent"].Status())
+	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras[username]["child"].Status())
 }
 
 func TestServiceCheckStatus2(t *testing.T) {
@@ -161,21 +220,27 @@ func TestServiceCheckStatus2(t *testing.T) {
 
 	service, data := newService(t)
 	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "parent",
 		ParentID: "",
+		Now:      time.Now(),
 	})
 	_ = service.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
-		ID:     "parent",
-		Status: string(domain.TaskStatusDone),
-		Now:    time.Now(),
+		Username: username,
+		ID:       "parent",
+		Status:   string(domain.TaskStatusDone),
+		Now:      time.Now(),
+		Force:    false,
 	})
 	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "child",
 		ParentID: "parent",
+		Now:      time.Now(),
 	})
 
-	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras["parent"].Status())
-	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras["child"].Status())
+	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras[username]["parent"].Status())
+	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras[username]["child"].Status())
 }
 
 func TestServiceCheckStatus3(t *testing.T) {
@@ -183,23 +248,591 @@ func TestServiceCheckStatus3(t *testing.T) {
 
 	service, data := newService(t)
 	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "parent",
 		ParentID: "",
+		Now:      time.Now(),
 	})
 	_ = service.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
-		ID:     "parent",
-		Status: string(domain.TaskStatusDone),
-		Now:    time.Now(),
+		Username: username,
+		ID:       "parent",
+		Status:   string(domain.TaskStatusDone),
+		Now:      time.Now(),
+		Force:    false,
 	})
 	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "child",
 		ParentID: "",
+		Now:      time.Now(),
 	})
 	_ = service.UpdateParent(t.Context(), &extra.UpdateParentInput{
+		Username: username,
+		ID:       "child",
+		ParentID: "parent",
+	})
+
+	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras[username]["parent"].Status())
+	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras[username]["child"].Status())
+}
+
+func TestServiceUpdateStatus_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	now := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "test",
+		ParentID: "",
+		Now:      now,
+	})
+
+	for i, status := range []domain.TaskStatus{
+		domain.TaskStatusDoing,
+		domain.TaskStatusDone,
+		domain.TaskStatusDoing,
+		domain.TaskStatusDone,
+	} {
+		err := service.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+			Username: username,
+			ID:       "test",
+			Status:   string(status),
+			Now:      now.Add(time.Duration(i+1) * time.Hour),
+			Force:    false,
+		})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, now.Add(time.Hour), data.repo.Extras[username]["test"].FirstStartedAt())
+	require.Equal(t, now.Add(4*time.Hour), data.repo.Extras[username]["test"].CompletedAt())
+	require.Equal(t, 1, data.repo.Extras[username]["test"].Reopens())
+
+	t.Run("reopen", func(t *testing.T) {
+		t.Parallel()
+
+		service, data := newService(t)
+		_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+			Username: username,
+			ID:       "test",
+			ParentID: "",
+			Now:      now,
+		})
+		_ = service.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+			Username: username,
+			ID:       "test",
+			Status:   string(domain.TaskStatusDone),
+			Now:      now,
+			Force:    false,
+		})
+
+		err := service.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+			Username: username,
+			ID:       "test",
+			Status:   string(domain.TaskStatusTodo),
+			Now:      now.Add(time.Hour),
+			Force:    false,
+		})
+
+		require.NoError(t, err)
+		require.True(t, data.repo.Extras[username]["test"].FirstStartedAt().IsZero())
+		require.True(t, data.repo.Extras[username]["test"].CompletedAt().IsZero())
+		require.Equal(t, 1, data.repo.Extras[username]["test"].Reopens())
+	})
+}
+
+func TestServiceUpdateStatus_Workflow(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "test",
+		ParentID: "",
+		Now:      time.Now(),
+	})
+	_, _ = service.SetWorkflow(t.Context(), &extra.SetWorkflowInput{
+		Username: username,
+		Workflow: extra.Workflow{
+			Statuses: []*extra.Status{
+				{Name: "review", Category: string(domain.TaskStatusDoing)},
+			},
+			Transitions: []*extra.Transition{
+				{From: "todo", To: "doing"},
+				{From: "doing", To: "review"},
+				{From: "review", To: "done"},
+			},
+		},
+	})
+	update := func(status string, force bool) error {
+		return service.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+			Username: username,
+			ID:       "test",
+			Status:   status,
+			Now:      time.Now(),
+			Force:    force,
+		})
+	}
+
+	require.ErrorIs(t, update("review", false), extra.ErrTransitionNotAllowed)
+	require.ErrorIs(t, update("blocked", false), extra.ErrInvalidStatus)
+	require.NoError(t, update("doing", false))
+	require.NoError(t, update("review", false))
+	require.Equal(t, domain.TaskStatus("review"), data.repo.Extras[username]["test"].Status())
+	require.Equal(t, domain.TaskStatusDoing, data.repo.Extras[username]["test"].Category())
+	require.NoError(t, update("todo", true))
+	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras[username]["test"].Category())
+}
+
+func TestServiceGetWorkflow(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newService(t)
+
+	out, err := service.GetWorkflow(t.Context(), &extra.GetWorkflowInput{
+		Username: username,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []*extra.Status{
+		{Name: "todo", Category: "todo"},
+		{Name: "doing", Category: "doing"},
+		{Name: "done", Category: "done"},
+	}, out.Workflow.Statuses)
+	require.Empty(t, out.Workflow.Transitions)
+}
+
+func TestServiceSetWorkflow_Error(t *testing.T) {
+	t.Parallel()
+
+	setWorkflow := func(t *testing.T, workflow extra.Workflow) error {
+		t.Helper()
+
+		service, _ := newService(t)
+		_, err := service.SetWorkflow(t.Context(), &extra.SetWorkflowInput{
+			Username: username,
+			Workflow: workflow,
+		})
+
+		return err
+	}
+
+	t.Run("unknown category", func(t *testing.T) {
+		t.Parallel()
+
+		err := setWorkflow(t, extra.Workflow{
+			Statuses:    []*extra.Status{{Name: "review", Category: "review"}},
+			Transitions: nil,
+		})
+
+		require.ErrorIs(t, err, extra.ErrInvalidWorkflow)
+	})
+
+	t.Run("core status in another category", func(t *testing.T) {
+		t.Parallel()
+
+		err := setWorkflow(t, extra.Workflow{
+			Statuses:    []*extra.Status{{Name: "done", Category: "todo"}},
+			Transitions: nil,
+		})
+
+		require.ErrorIs(t, err, extra.ErrInvalidWorkflow)
+	})
+
+	t.Run("duplicated status", func(t *testing.T) {
+		t.Parallel()
+
+		err := setWorkflow(t, extra.Workflow{
+			Statuses: []*extra.Status{
+				{Name: "review", Category: "doing"},
+				{Name: "review", Category: "todo"},
+			},
+			Transitions: nil,
+		})
+
+		require.ErrorIs(t, err, extra.ErrInvalidWorkflow)
+	})
+
+	t.Run("transition with unknown status", func(t *testing.T) {
+		t.Parallel()
+
+		err := setWorkflow(t, extra.Workflow{
+			Statuses:    nil,
+			Transitions: []*extra.Transition{{From: "todo", To: "review"}},
+		})
+
+		require.ErrorIs(t, err, extra.ErrInvalidWorkflow)
+	})
+}
+
+func TestServiceSetRollup(t *testing.T) {
+	t.Parallel()
+
+	repo := memory.NewRepository()
+	bus := eventbus.NewBus()
+	service := extra.NewService(bus, repo, repo)
+
+	var events []*eventbus.ExtraStatusUpdatedEvent
+
+	bus.ExtraStatusUpdated.Subscribe(func(ctx context.Context, event *eventbus.ExtraStatusUpdatedEvent) error {
+		events = append(events, event)
+
+		return nil
+	})
+
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "project",
+		ParentID: "",
+		Now:      time.Now(),
+	})
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "parent",
+		ParentID: "project",
+		Now:      time.Now(),
+	})
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "child1",
+		ParentID: "parent",
+		Now:      time.Now(),
+	})
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "child2",
+		ParentID: "parent",
+		Now:      time.Now(),
+	})
+	_ = service.SetRollup(t.Context(), &extra.SetRollupInput{
+		Username: username,
+		ID:       "project",
+		Rollup:   true,
+		Now:      time.Now(),
+	})
+	_ = service.SetRollup(t.Context(), &extra.SetRollupInput{
+		Username: username,
+		ID:       "parent",
+		Rollup:   true,
+		Now:      time.Now(),
+	})
+	update := func(id string, status domain.TaskStatus) error {
+		return service.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+			Username: username,
+			ID:       id,
+			Status:   string(status),
+			Now:      time.Now(),
+			Force:    false,
+		})
+	}
+
+	require.NoError(t, update("child1", domain.TaskStatusDoing))
+	require.Equal(t, domain.TaskStatusDoing, repo.Extras[username]["parent"].Status())
+	require.Equal(t, domain.TaskStatusDoing, repo.Extras[username]["project"].Status())
+
+	require.NoError(t, update("child1", domain.TaskStatusDone))
+	require.Equal(t, domain.TaskStatusTodo, repo.Extras[username]["parent"].Status())
+
+	require.NoError(t, update("child2", domain.TaskStatusDone))
+	require.Equal(t, domain.TaskStatusDone, repo.Extras[username]["parent"].Status())
+	require.Equal(t, domain.TaskStatusDone, repo.Extras[username]["project"].Status())
+	require.ErrorIs(t, update("parent", domain.TaskStatusTodo), extra.ErrStatusDerived)
+
+	last := events[len(events)-1]
+	require.Equal(t, "project", last.ExtraID)
+	require.True(t, last.Derived)
+}
+
+func TestServiceSetRollup_Off(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "parent",
+		ParentID: "",
+		Now:      time.Now(),
+	})
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "child",
+		ParentID: "parent",
+		Now:      time.Now(),
+	})
+
+	err := service.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+		Username: username,
+		ID:       "child",
+		Status:   string(domain.TaskStatusDone),
+		Now:      time.Now(),
+		Force:    false,
+	})
+
+	require.NoError(t, err)
+	require.False(t, data.repo.Extras[username]["parent"].Rollup())
+	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras[username]["parent"].Status())
+}
+
+func TestServiceDeleteExtra_LastChild(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "parent",
+		ParentID: "",
+		Now:      time.Now(),
+	})
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "child",
+		ParentID: "parent",
+		Now:      time.Now(),
+	})
+
+	err := service.DeleteExtra(t.Context(), &extra.DeleteExtraInput{Username: username, ID: "child", Now: time.Now()})
+
+	require.NoError(t, err)
+	require.True(t, data.repo.Extras[username]["parent"].Leaf())
+}
+
+func TestServiceUpdateParent_MoveAway(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "old",
+		ParentID: "",
+		Now:      time.Now(),
+	})
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "new",
+		ParentID: "",
+		Now:      time.Now(),
+	})
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "child",
+		ParentID: "old",
+		Now:      time.Now(),
+	})
+
+	err := service.UpdateParent(t.Context(), &extra.UpdateParentInput{
+		Username: username,
+		ID:       "child",
+		ParentID: "new",
+		Now:      time.Now(),
+	})
+
+	require.NoError(t, err)
+	require.True(t, data.repo.Extras[username]["old"].Leaf())
+	require.False(t, data.repo.Extras[username]["new"].Leaf())
+}
+
+func TestServiceDeleteExtra_Rollup(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "parent",
+		ParentID: "",
+		Now:      time.Now(),
+	})
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "done",
+		ParentID: "parent",
+		Now:      time.Now(),
+	})
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "open",
+		ParentID: "parent",
+		Now:      time.Now(),
+	})
+	_ = service.SetRollup(t.Context(), &extra.SetRollupInput{
+		Username: username,
+		ID:       "parent",
+		Rollup:   true,
+		Now:      time.Now(),
+	})
+	_ = service.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+		Username: username,
+		ID:       "done",
+		Status:   string(domain.TaskStatusDone),
+		Now:      time.Now(),
+		Force:    false,
+	})
+
+	err := service.DeleteExtra(t.Context(), &extra.DeleteExtraInput{Username: username, ID: "open", Now: time.Now()})
+
+	require.NoError(t, err)
+	require.Equal(t, domain.TaskStatusDone, data.repo.Extras[username]["parent"].Status())
+}
+
+func TestServiceRecompute(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = data.repo.CreateExtra(t.Context(), username, domain.NewExtra(
+		"parent", "", false, domain.TaskStatusDoing, domain.TaskStatusDoing, false,
+		time.Time{}, time.Time{}, 0,
+	))
+	_ = data.repo.CreateExtra(t.Context(), username, domain.NewExtra(
+		"moved", "parent", true, domain.TaskStatusTodo, domain.TaskStatusTodo, false,
+		time.Time{}, time.Time{}, 0,
+	))
+	_ = data.repo.CreateExtra(t.Context(), username, domain.NewExtra(
+		"orphan", "other", true, domain.TaskStatusTodo, domain.TaskStatusTodo, false,
+		time.Time{}, time.Time{}, 0,
+	))
+	_ = data.repo.CreateExtra(t.Context(), username, domain.NewExtra(
+		"other", "", true, domain.TaskStatusTodo, domain.TaskStatusTodo, false,
+		time.Time{}, time.Time{}, 0,
+	))
+
+	out, err := service.Recompute(t.Context(), &extra.RecomputeInput{
+		Username: username,
+		Nodes: []*extra.Node{
+			{ID: "parent", ParentID: ""},
+			{ID: "other", ParentID: ""},
+			{ID: "moved", ParentID: "other"},
+			{ID: "new", ParentID: "other"},
+		},
+		DryRun: false,
+		Now:    time.Now(),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 1, out.Created)
+	require.Equal(t, 3, out.Updated)
+	require.Equal(t, 1, out.Deleted)
+	require.Contains(t, out.Changes, &extra.Change{ID: "moved", Kind: extra.ChangeReparent, From: "parent", To: "other"})
+	require.Contains(t, out.Changes, &extra.Change{ID: "orphan", Kind: extra.ChangeDelete, From: "other", To: ""})
+	require.True(t, data.repo.Extras[username]["parent"].Leaf())
+	require.Equal(t, domain.TaskStatusDoing, data.repo.Extras[username]["parent"].Status())
+	require.False(t, data.repo.Extras[username]["other"].Leaf())
+	require.Equal(t, domain.ExtraID("other"), data.repo.Extras[username]["moved"].ParentID())
+	require.NotContains(t, data.repo.Extras[username], domain.ExtraID("orphan"))
+}
+
+func TestServiceRecompute_DryRun(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = data.repo.CreateExtra(t.Context(), username, domain.NewExtra(
+		"parent", "", true, domain.TaskStatusDoing, domain.TaskStatusDoing, false,
+		time.Time{}, time.Time{}, 0,
+	))
+	_ = data.repo.CreateExtra(t.Context(), username, domain.NewExtra(
+		"orphan", "", true, domain.TaskStatusTodo, domain.TaskStatusTodo, false,
+		time.Time{}, time.Time{}, 0,
+	))
+
+	out, err := service.Recompute(t.Context(), &extra.RecomputeInput{
+		Username: username,
+		Nodes: []*extra.Node{
+			{ID: "parent", ParentID: ""},
+			{ID: "child", ParentID: "parent"},
+		},
+		DryRun: true,
+		Now:    time.Now(),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []*extra.Change{
+		{ID: "child", Kind: extra.ChangeCreate, From: "", To: "parent"},
+		{ID: "orphan", Kind: extra.ChangeDelete, From: "", To: ""},
+		{ID: "parent", Kind: extra.ChangeLeaf, From: "true", To: "false"},
+		{ID: "parent", Kind: extra.ChangeStatus, From: "doing", To: "todo"},
+	}, out.Changes)
+	require.Len(t, data.repo.Extras[username], 2)
+	require.True(t, data.repo.Extras[username]["parent"].Leaf())
+	require.Contains(t, data.repo.Extras[username], domain.ExtraID("orphan"))
+}
+
+func TestServiceEvents(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	service, data := newService(t)
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
+		ID:       "parent",
+		ParentID: "",
+		Now:      now,
+	})
+	_ = service.CreateExtra(t.Context(), &extra.CreateExtraInput{
+		Username: username,
 		ID:       "child",
 		ParentID: "parent",
+		Now:      now,
 	})
 
-	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras["parent"].Status())
-	require.Equal(t, domain.TaskStatusTodo, data.repo.Extras["child"].Status())
+	var events []any
+
+	data.bus.ExtraStatusUpdated.Subscribe(func(_ context.Context, event *eventbus.ExtraStatusUpdatedEvent) error {
+		events = append(events, event)
+
+		return nil
+	})
+	data.bus.ExtraRollupUpdated.Subscribe(func(_ context.Context, event *eventbus.ExtraRollupUpdatedEvent) error {
+		events = append(events, event)
+
+		return nil
+	})
+
+	err := service.SetRollup(t.Context(), &extra.SetRollupInput{
+		Username: username,
+		ID:       "parent",
+		Rollup:   true,
+		Now:      now,
+	})
+	require.NoError(t, err)
+
+	err = service.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+		Username: username,
+		ID:       "child",
+		Status:   string(domain.TaskStatusDoing),
+		Now:      now,
+		Force:    false,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []any{
+		&eventbus.ExtraRollupUpdatedEvent{
+			Version:   eventbus.ExtraRollupUpdatedVersion,
+			Username:  username,
+			ExtraID:   "parent",
+			OldRollup: false,
+			NewRollup: true,
+			Now:       now,
+		},
+		&eventbus.ExtraStatusUpdatedEvent{
+			Version:     eventbus.ExtraStatusUpdatedVersion,
+			Username:    username,
+			ExtraID:     "child",
+			OldStatus:   string(domain.TaskStatusTodo),
+			OldCategory: string(domain.TaskStatusTodo),
+			Status:      string(domain.TaskStatusDoing),
+			Category:    string(domain.TaskStatusDoing),
+			Derived:     false,
+			Now:         now,
+		},
+		&eventbus.ExtraStatusUpdatedEvent{
+			Version:     eventbus.ExtraStatusUpdatedVersion,
+			Username:    username,
+			ExtraID:     "parent",
+			OldStatus:   string(domain.TaskStatusTodo),
+			OldCategory: string(domain.TaskStatusTodo),
+			Status:      string(domain.TaskStatusDoing),
+			Category:    string(domain.TaskStatusDoing),
+			Derived:     true,
+			Now:         now,
+		},
+	}, events)
 }
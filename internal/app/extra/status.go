@@ -15,12 +15,23 @@ type SetTodoInput struct {
 }
 
 type UpdateParentInput struct {
+	Username string
 	ID       string
 	ParentID string
+	Now      time.Time
 }
 
 type UpdateStatusInput struct {
-	ID     string
-	Status string
-	Now    time.Time
+	Username string
+	ID       string
+	Status   string
+	Now      time.Time
+	Force    bool // true이면 사용자가 아니라 시스템이 바꾸는 것이므로 전이 규칙을 검사하지 않는다
+}
+
+type SetRollupInput struct {
+	Username string
+	ID       string
+	Rollup   bool
+	Now      time.Time
 }
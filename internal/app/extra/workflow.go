@@ -0,0 +1,33 @@
+package extra
+
+type Status struct {
+	Name     string
+	Category string // todo, doing, done 중 하나
+}
+
+type Transition struct {
+	From string
+	To   string
+}
+
+type Workflow struct {
+	Statuses    []*Status     // 기본 상태는 없어도 항상 포함된다
+	Transitions []*Transition // 비어 있으면 모든 전이를 허용한다
+}
+
+type SetWorkflowInput struct {
+	Username string
+	Workflow Workflow
+}
+
+type SetWorkflowOutput struct {
+	Workflow Workflow
+}
+
+type GetWorkflowInput struct {
+	Username string
+}
+
+type GetWorkflowOutput struct {
+	Workflow Workflow
+}
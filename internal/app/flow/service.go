@@ -2,8 +2,11 @@ package flow
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/neatflowcv/focus/internal/pkg/domain"
 	"github.com/neatflowcv/focus/internal/pkg/eventbus"
@@ -11,15 +14,20 @@ import (
 	"github.com/neatflowcv/focus/internal/pkg/repository"
 )
 
+// Outbox는 task의 변경과 함께 commit된 이벤트를 전달한다.
+type Outbox interface {
+	Notify(ctx context.Context) error
+}
+
 type Service struct {
-	bus     *eventbus.Bus
+	outbox  Outbox
 	idmaker idmaker.IDMaker
 	repo    repository.Repository
 }
 
-func NewService(bus *eventbus.Bus, idmaker idmaker.IDMaker, repo repository.Repository) *Service {
+func NewService(outbox Outbox, idmaker idmaker.IDMaker, repo repository.Repository) *Service {
 	return &Service{
-		bus:     bus,
+		outbox:  outbox,
 		idmaker: idmaker,
 		repo:    repo,
 	}
@@ -84,21 +92,29 @@ func (s *Service) CreateTask( //nolint:funlen
 	)
 	dummy := task.Dummy()
 
-	err = s.repo.CreateTasks(ctx, input.Username, task, dummy)
+	event, err := newOutboxEvent(eventbus.TopicTaskCreated, &eventbus.TaskCreatedEvent{
+		Version:  eventbus.TaskCreatedVersion,
+		Username: input.Username,
+		TaskID:   string(task.ID()),
+		ParentID: string(task.ParentID()),
+		NextID:   string(task.NextID()),
+		Title:    task.Title(),
+		Now:      input.Now,
+	}, input.Now)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create task: %w", err)
+		return nil, err
 	}
 
-	err = s.repo.UpdateTasks(ctx, input.Username, previous)
+	err = s.commit(ctx, input.Username, &repository.TaskChange{
+		Create: []*domain.Task{task, dummy},
+		Update: []*domain.Task{previous},
+		Delete: nil,
+		Events: []*repository.OutboxEvent{event},
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update previous task: %w", err)
+		return nil, fmt.Errorf("failed to create task: %w", err)
 	}
 
-	s.bus.TaskCreated.Publish(ctx, &eventbus.TaskCreatedEvent{
-		TaskID:   string(task.ID()),
-		ParentID: string(task.ParentID()),
-	})
-
 	return &CreateTaskOutput{
 		ID:        string(task.ID()),
 		CreatedAt: task.CreatedAt(),
@@ -139,44 +155,45 @@ func (s *Service) DeleteTask(ctx context.Context, input *DeleteTaskInput) error
 		}
 	}
 
-	err = s.repo.DeleteTasks(ctx, input.Username, deleteTasks...)
-	if err != nil {
-		return fmt.Errorf("failed to delete task: %w", err)
-	}
+	var events []*repository.OutboxEvent
 
 	for _, task := range deleteTasks {
 		if task.IsDummy() {
 			continue
 		}
 
-		s.bus.TaskDeleted.Publish(ctx, &eventbus.TaskDeletedEvent{
-			TaskID: string(task.ID()),
-		})
+		event, err := newOutboxEvent(eventbus.TopicTaskDeleted, &eventbus.TaskDeletedEvent{
+			Version:  eventbus.TaskDeletedVersion,
+			Username: input.Username,
+			TaskID:   string(task.ID()),
+			ParentID: string(task.ParentID()),
+			Title:    task.Title(),
+			Now:      input.Now,
+		}, input.Now)
+		if err != nil {
+			return err
+		}
+
+		events = append(events, event)
+	}
+
+	err = s.commit(ctx, input.Username, &repository.TaskChange{
+		Create: nil,
+		Update: nil,
+		Delete: deleteTasks,
+		Events: events,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete task: %w", err)
 	}
 
 	return nil
 }
 
 func (s *Service) ListTasks(ctx context.Context, input *ListTasksInput) (*ListTasksOutput, error) {
-	tasks, err := s.repo.ListTasks(ctx, input.Username, domain.TaskID(input.ParentID))
+	items, err := s.listTasks(ctx, input.Username, domain.TaskID(input.ParentID), input.Recursive)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list tasks: %w", err)
-	}
-
-	taskMap := make(map[domain.TaskID]*domain.Task)
-	for _, task := range tasks {
-		taskMap[task.ID()] = task
-	}
-
-	sortedTasks := domain.SortTasks(tasks, domain.TaskID(input.ParentID))
-
-	var items []*Task
-	for _, task := range sortedTasks {
-		items = append(items, &Task{
-			ID:        string(task.ID()),
-			Title:     task.Title(),
-			CreatedAt: task.CreatedAt(),
-		})
+		return nil, err
 	}
 
 	return &ListTasksOutput{
@@ -197,13 +214,15 @@ func (s *Service) GetTask(ctx context.Context, input *GetTaskInput) (*GetTaskOut
 	return &GetTaskOutput{
 		Task: Task{
 			ID:        string(task.ID()),
+			ParentID:  string(task.ParentID()),
+			NextID:    string(task.NextID()),
 			Title:     task.Title(),
 			CreatedAt: task.CreatedAt(),
 		},
 	}, nil
 }
 
-func (s *Service) UpdateTask(ctx context.Context, input *UpdateTaskInput) error { //nolint:cyclop
+func (s *Service) UpdateTask(ctx context.Context, input *UpdateTaskInput) error { //nolint:cyclop,funlen
 	task, err := s.repo.GetTask(ctx, input.Username, domain.TaskID(input.TaskID))
 	if err != nil {
 		if errors.Is(err, repository.ErrTaskNotFound) {
@@ -246,23 +265,116 @@ func (s *Service) UpdateTask(ctx context.Context, input *UpdateTaskInput) error
 		parentID = parent.ParentID()
 	}
 
-	if task.ParentID() != domain.TaskID(input.ParentID) || task.NextID() != domain.TaskID(input.NextID) {
-		err := s.updateTaskRelation(ctx, input.Username, task, domain.TaskID(input.ParentID), domain.TaskID(input.NextID))
+	var (
+		updates []*domain.Task
+		events  []*repository.OutboxEvent
+	)
+
+	newParentID := domain.TaskID(input.ParentID)
+	newNextID := domain.TaskID(input.NextID)
+
+	if task.ParentID() != newParentID || task.NextID() != newNextID {
+		updates, err = s.updateTaskRelation(ctx, input.Username, task, newParentID, newNextID)
 		if err != nil {
 			return fmt.Errorf("failed to update task relation: %w", err)
 		}
+
+		event, err := newOutboxEvent(eventbus.TopicTaskRelationUpdated, &eventbus.TaskRelationUpdatedEvent{
+			Version:     eventbus.TaskRelationUpdatedVersion,
+			Username:    input.Username,
+			TaskID:      input.TaskID,
+			OldParentID: string(task.ParentID()),
+			NewParentID: input.ParentID,
+			OldNextID:   string(task.NextID()),
+			NewNextID:   input.NextID,
+			Now:         input.Now,
+		}, input.Now)
+		if err != nil {
+			return err
+		}
+
+		events = append(events, event)
 	}
 
 	if task.Title() != input.Title {
-		err = s.updateTaskTitle(ctx, input.Username, domain.TaskID(input.TaskID), input.Title)
+		if len(updates) == 0 {
+			updates = append(updates, task)
+		}
+
+		// 관계와 제목을 함께 바꾸면 옮긴 task에 제목을 이어서 바꾼다.
+		updates[0] = updates[0].SetTitle(input.Title)
+
+		event, err := newOutboxEvent(eventbus.TopicTaskTitleUpdated, &eventbus.TaskTitleUpdatedEvent{
+			Version:  eventbus.TaskTitleUpdatedVersion,
+			Username: input.Username,
+			TaskID:   input.TaskID,
+			OldTitle: task.Title(),
+			NewTitle: input.Title,
+			Now:      input.Now,
+		}, input.Now)
 		if err != nil {
-			return fmt.Errorf("failed to update task title: %w", err)
+			return err
 		}
+
+		events = append(events, event)
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	err = s.commit(ctx, input.Username, &repository.TaskChange{
+		Create: nil,
+		Update: updates,
+		Delete: nil,
+		Events: events,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
 	}
 
 	return nil
 }
 
+func (s *Service) listTasks(
+	ctx context.Context,
+	username string,
+	parentID domain.TaskID,
+	recursive bool,
+) ([]*Task, error) {
+	tasks, err := s.repo.ListTasks(ctx, username, parentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	sortedTasks := domain.SortTasks(tasks, parentID)
+
+	var items []*Task
+
+	for _, task := range sortedTasks {
+		items = append(items, &Task{
+			ID:        string(task.ID()),
+			ParentID:  string(task.ParentID()),
+			NextID:    string(task.NextID()),
+			Title:     task.Title(),
+			CreatedAt: task.CreatedAt(),
+		})
+
+		if !recursive {
+			continue
+		}
+
+		children, err := s.listTasks(ctx, username, task.ID(), recursive)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, children...)
+	}
+
+	return items, nil
+}
+
 func (s *Service) getPreviousTask(
 	ctx context.Context,
 	username string,
@@ -283,16 +395,17 @@ func (s *Service) getPreviousTask(
 	panic("logic error: previous task not found")
 }
 
+// updateTaskRelation은 옮긴 task부터 시작해서 바뀌는 task들을 반환한다.
 func (s *Service) updateTaskRelation(
 	ctx context.Context,
 	username string,
 	task *domain.Task,
 	parentID domain.TaskID,
 	nextID domain.TaskID,
-) error {
+) ([]*domain.Task, error) {
 	oldPreviousTask, err := s.getPreviousTask(ctx, username, task.ParentID(), task.ID())
 	if err != nil {
-		return fmt.Errorf("failed to get old previous task: %w", err)
+		return nil, fmt.Errorf("failed to get old previous task: %w", err)
 	}
 
 	oldPreviousTask = oldPreviousTask.SetNextID(task.NextID())
@@ -301,36 +414,52 @@ func (s *Service) updateTaskRelation(
 
 	newPreviousTask, err := s.getPreviousTask(ctx, username, newTask.ParentID(), newTask.NextID())
 	if err != nil {
-		return fmt.Errorf("failed to get new previous task: %w", err)
+		return nil, fmt.Errorf("failed to get new previous task: %w", err)
 	}
 
 	newPreviousTask = newPreviousTask.SetNextID(task.ID())
 
-	err = s.repo.UpdateTasks(ctx, username, newTask, oldPreviousTask, newPreviousTask)
-	if err != nil {
-		return fmt.Errorf("failed to update task: %w", err)
+	return []*domain.Task{newTask, oldPreviousTask, newPreviousTask}, nil
+}
+
+// commit은 변경과 이벤트를 함께 저장한 뒤 outbox에 알린다.
+func (s *Service) commit(ctx context.Context, username string, change *repository.TaskChange) error {
+	metadata := eventbus.MetadataFrom(ctx)
+	for _, event := range change.Events {
+		event.RequestID = metadata.RequestID
+		event.TraceID = metadata.TraceID
 	}
 
-	err = s.repo.UpdateTasks(ctx, username, newTask, oldPreviousTask, newPreviousTask)
+	err := s.repo.CommitTasks(ctx, username, change)
 	if err != nil {
-		return fmt.Errorf("failed to update task: %w", err)
+		return fmt.Errorf("failed to commit tasks: %w", err)
 	}
 
-	return nil
-}
-
-func (s *Service) updateTaskTitle(ctx context.Context, username string, id domain.TaskID, title string) error {
-	task, err := s.repo.GetTask(ctx, username, id)
+	// 이미 commit했으므로 남은 이벤트는 다음 Dispatch가 전달한다.
+	err = s.outbox.Notify(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get task: %w", err)
+		log.Printf("failed to notify outbox: %v", err)
 	}
 
-	task = task.SetTitle(title)
+	return nil
+}
 
-	err = s.repo.UpdateTasks(ctx, username, task)
+func newOutboxEvent(topic string, event any, now time.Time) (*repository.OutboxEvent, error) {
+	payload, err := json.Marshal(event)
 	if err != nil {
-		return fmt.Errorf("failed to update task: %w", err)
+		return nil, fmt.Errorf("failed to encode %s: %w", topic, err)
 	}
 
-	return nil
+	return &repository.OutboxEvent{
+		ID:          0,
+		Username:    "",
+		Topic:       topic,
+		Payload:     payload,
+		CreatedAt:   now,
+		Attempts:    0,
+		DeliveredAt: time.Time{},
+		Delivered:   nil,
+		RequestID:   "",
+		TraceID:     "",
+	}, nil
 }
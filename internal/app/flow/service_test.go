@@ -1,17 +1,25 @@
 package flow_test
 
 import (
+	"context"
+	"errors"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/outbox"
+	"github.com/neatflowcv/focus/internal/pkg/clock/system"
 	"github.com/neatflowcv/focus/internal/pkg/domain"
 	"github.com/neatflowcv/focus/internal/pkg/eventbus"
 	"github.com/neatflowcv/focus/internal/pkg/idmaker/ulid"
 	"github.com/neatflowcv/focus/internal/pkg/repository/memory"
+	"github.com/neatflowcv/focus/internal/pkg/repository/sqlite"
 	"github.com/stretchr/testify/require"
 )
 
+var errSubscriber = errors.New("subscriber failed")
+
 type ServiceData struct {
 	bus     *eventbus.Bus
 	idmaker *ulid.IDMaker
@@ -27,7 +35,7 @@ func newService(t *testing.T) (*flow.Service, *ServiceData) {
 		repo:    memory.NewRepository(),
 	}
 
-	return flow.NewService(data.bus, data.idmaker, data.repo), data
+	return flow.NewService(outbox.NewService(system.NewClock(), data.repo, data.bus), data.idmaker, data.repo), data
 }
 
 func TestServiceCreateTask(t *testing.T) {
@@ -105,6 +113,85 @@ func TestServiceCreateTask_Error(t *testing.T) {
 
 		require.ErrorIs(t, err, flow.ErrNextTaskNotFound)
 	})
+
+	t.Run("subscriber fails after commit", func(t *testing.T) {
+		t.Parallel()
+
+		service, data := newService(t)
+		_ = service.CreateRootDummy(t.Context(), &flow.CreateRootDummyInput{
+			Username: "test",
+		})
+
+		data.bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+			return errSubscriber
+		})
+
+		out, err := service.CreateTask(t.Context(), &flow.CreateTaskInput{
+			Username: "test",
+			Title:    "test",
+			Now:      time.Now(),
+			ParentID: "",
+			NextID:   "",
+		})
+
+		require.NoError(t, err)
+		require.Contains(t, data.repo.Tasks["test"], domain.TaskID(out.ID))
+		require.Len(t, data.repo.Outbox, 1)
+		require.True(t, data.repo.Outbox[0].DeliveredAt.IsZero())
+		require.Equal(t, 1, data.repo.Outbox[0].Attempts)
+	})
+}
+
+func TestServiceCreateTask_SkipsFailedEvent(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateRootDummy(t.Context(), &flow.CreateRootDummyInput{
+		Username: "test",
+	})
+
+	var (
+		created []string
+		failed  bool
+	)
+
+	data.bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		if !failed {
+			failed = true
+
+			return errSubscriber
+		}
+
+		created = append(created, event.TaskID)
+
+		return nil
+	})
+
+	_, err := service.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: "test",
+		Title:    "first",
+		Now:      time.Now(),
+		ParentID: "",
+		NextID:   "",
+	})
+	require.NoError(t, err)
+
+	out, err := service.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: "test",
+		Title:    "second",
+		Now:      time.Now(),
+		ParentID: "",
+		NextID:   "",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{out.ID}, created) // 실패한 이벤트는 다음 시도 때까지 뒤의 이벤트를 막지 않는다
+	require.Len(t, data.repo.Tasks["test"], 5)
+
+	outbox := data.repo.Outbox
+	require.True(t, outbox[len(outbox)-2].DeliveredAt.IsZero())
+	require.False(t, outbox[len(outbox)-2].NextAttemptAt.IsZero())
+	require.False(t, outbox[len(outbox)-1].DeliveredAt.IsZero())
 }
 
 func TestServiceListTasks(t *testing.T) { //nolint:funlen
@@ -226,6 +313,49 @@ func TestServiceListTasks(t *testing.T) { //nolint:funlen
 		require.Equal(t, secondTitle, ret.Tasks[0].Title)
 		require.Equal(t, firstTitle, ret.Tasks[1].Title)
 	})
+
+	t.Run("recursive", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newService(t)
+		_ = service.CreateRootDummy(t.Context(), &flow.CreateRootDummyInput{
+			Username: "test",
+		})
+		parent, _ := service.CreateTask(t.Context(), &flow.CreateTaskInput{
+			Username: "test",
+			Title:    "parent",
+			Now:      time.Now(),
+			ParentID: "",
+			NextID:   "",
+		})
+		_, _ = service.CreateTask(t.Context(), &flow.CreateTaskInput{
+			Username: "test",
+			Title:    "sibling",
+			Now:      time.Now(),
+			ParentID: "",
+			NextID:   "",
+		})
+		_, _ = service.CreateTask(t.Context(), &flow.CreateTaskInput{
+			Username: "test",
+			Title:    "child",
+			Now:      time.Now(),
+			ParentID: parent.ID,
+			NextID:   "",
+		})
+
+		ret, err := service.ListTasks(t.Context(), &flow.ListTasksInput{
+			Username:  "test",
+			ParentID:  "",
+			Recursive: true,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, ret.Tasks, 3)
+		require.Equal(t, "parent", ret.Tasks[0].Title)
+		require.Equal(t, "child", ret.Tasks[1].Title)
+		require.Equal(t, parent.ID, ret.Tasks[1].ParentID)
+		require.Equal(t, "sibling", ret.Tasks[2].Title)
+	})
 }
 
 func TestServiceDeleteTask(t *testing.T) {
@@ -251,6 +381,7 @@ func TestServiceDeleteTask(t *testing.T) {
 		err := service.DeleteTask(t.Context(), &flow.DeleteTaskInput{
 			Username: username,
 			TaskID:   task.ID,
+			Now:      time.Now(),
 		})
 
 		require.NoError(t, err)
@@ -284,6 +415,7 @@ func TestServiceDeleteTask(t *testing.T) {
 		err := service.DeleteTask(t.Context(), &flow.DeleteTaskInput{
 			Username: username,
 			TaskID:   task.ID,
+			Now:      time.Now(),
 		})
 
 		require.NoError(t, err)
@@ -302,6 +434,7 @@ func TestServiceDeleteTask_Error(t *testing.T) {
 	err := service.DeleteTask(t.Context(), &flow.DeleteTaskInput{
 		Username: "test",
 		TaskID:   "test",
+		Now:      time.Now(),
 	})
 
 	require.Error(t, err)
@@ -443,6 +576,240 @@ func TestServiceUpdateTask(t *testing.T) { //nolint:funlen
 	})
 }
 
+// record는 broker에 발행된 이벤트를 events에 순서대로 모은다.
+func record[T any](broker *eventbus.Broker[T], events *[]any) {
+	broker.Subscribe(func(_ context.Context, event T) error {
+		*events = append(*events, event)
+
+		return nil
+	})
+}
+
+func recordAll(bus *eventbus.Bus) *[]any {
+	var events []any
+
+	record(bus.TaskCreated, &events)
+	record(bus.TaskDeleted, &events)
+	record(bus.TaskRelationUpdated, &events)
+	record(bus.TaskTitleUpdated, &events)
+
+	return &events
+}
+
+func TestServiceEvents(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	const username = "test"
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	newTasks := func(t *testing.T) (*flow.Service, *ServiceData, string, string) {
+		t.Helper()
+
+		service, data := newService(t)
+		_ = service.CreateRootDummy(t.Context(), &flow.CreateRootDummyInput{
+			Username: username,
+		})
+		parent, _ := service.CreateTask(t.Context(), &flow.CreateTaskInput{
+			Username: username,
+			Title:    "parent",
+			ParentID: "",
+			NextID:   "",
+			Now:      now,
+		})
+		child, _ := service.CreateTask(t.Context(), &flow.CreateTaskInput{
+			Username: username,
+			Title:    "child",
+			ParentID: parent.ID,
+			NextID:   "",
+			Now:      now,
+		})
+
+		return service, data, parent.ID, child.ID
+	}
+
+	t.Run("create", func(t *testing.T) {
+		t.Parallel()
+
+		service, data := newService(t)
+		events := recordAll(data.bus)
+		_ = service.CreateRootDummy(t.Context(), &flow.CreateRootDummyInput{
+			Username: username,
+		})
+
+		out, err := service.CreateTask(t.Context(), &flow.CreateTaskInput{
+			Username: username,
+			Title:    "task",
+			ParentID: "",
+			NextID:   "",
+			Now:      now,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			&eventbus.TaskCreatedEvent{
+				Version:  eventbus.TaskCreatedVersion,
+				Username: username,
+				TaskID:   out.ID,
+				ParentID: "",
+				NextID:   "",
+				Title:    "task",
+				Now:      now,
+			},
+		}, *events)
+	})
+
+	t.Run("move", func(t *testing.T) {
+		t.Parallel()
+
+		service, data, parentID, childID := newTasks(t)
+		events := recordAll(data.bus)
+
+		err := service.UpdateTask(t.Context(), &flow.UpdateTaskInput{
+			Username: username,
+			TaskID:   childID,
+			ParentID: "",
+			NextID:   parentID,
+			Title:    "child",
+			Now:      now,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			&eventbus.TaskRelationUpdatedEvent{
+				Version:     eventbus.TaskRelationUpdatedVersion,
+				Username:    username,
+				TaskID:      childID,
+				OldParentID: parentID,
+				NewParentID: "",
+				OldNextID:   "",
+				NewNextID:   parentID,
+				Now:         now,
+			},
+		}, *events)
+	})
+
+	t.Run("title", func(t *testing.T) {
+		t.Parallel()
+
+		service, data, parentID, childID := newTasks(t)
+		events := recordAll(data.bus)
+
+		err := service.UpdateTask(t.Context(), &flow.UpdateTaskInput{
+			Username: username,
+			TaskID:   childID,
+			ParentID: parentID,
+			NextID:   "",
+			Title:    "renamed",
+			Now:      now,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			&eventbus.TaskTitleUpdatedEvent{
+				Version:  eventbus.TaskTitleUpdatedVersion,
+				Username: username,
+				TaskID:   childID,
+				OldTitle: "child",
+				NewTitle: "renamed",
+				Now:      now,
+			},
+		}, *events)
+	})
+
+	t.Run("move and title", func(t *testing.T) {
+		t.Parallel()
+
+		service, data, parentID, childID := newTasks(t)
+		events := recordAll(data.bus)
+
+		err := service.UpdateTask(t.Context(), &flow.UpdateTaskInput{
+			Username: username,
+			TaskID:   childID,
+			ParentID: "",
+			NextID:   "",
+			Title:    "renamed",
+			Now:      now,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "renamed", data.repo.Tasks[username][domain.TaskID(childID)].Title())
+		require.Equal(t, domain.TaskID(""), data.repo.Tasks[username][domain.TaskID(childID)].ParentID())
+		require.Equal(t, []any{
+			&eventbus.TaskRelationUpdatedEvent{
+				Version:     eventbus.TaskRelationUpdatedVersion,
+				Username:    username,
+				TaskID:      childID,
+				OldParentID: parentID,
+				NewParentID: "",
+				OldNextID:   "",
+				NewNextID:   "",
+				Now:         now,
+			},
+			&eventbus.TaskTitleUpdatedEvent{
+				Version:  eventbus.TaskTitleUpdatedVersion,
+				Username: username,
+				TaskID:   childID,
+				OldTitle: "child",
+				NewTitle: "renamed",
+				Now:      now,
+			},
+		}, *events)
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		service, data, parentID, childID := newTasks(t)
+		events := recordAll(data.bus)
+
+		err := service.UpdateTask(t.Context(), &flow.UpdateTaskInput{
+			Username: username,
+			TaskID:   childID,
+			ParentID: parentID,
+			NextID:   "",
+			Title:    "child",
+			Now:      now,
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, *events)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		t.Parallel()
+
+		service, data, parentID, childID := newTasks(t)
+		events := recordAll(data.bus)
+
+		err := service.DeleteTask(t.Context(), &flow.DeleteTaskInput{
+			Username: username,
+			TaskID:   parentID,
+			Now:      now,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			&eventbus.TaskDeletedEvent{
+				Version:  eventbus.TaskDeletedVersion,
+				Username: username,
+				TaskID:   parentID,
+				ParentID: "",
+				Title:    "parent",
+				Now:      now,
+			},
+			&eventbus.TaskDeletedEvent{
+				Version:  eventbus.TaskDeletedVersion,
+				Username: username,
+				TaskID:   childID,
+				ParentID: parentID,
+				Title:    "child",
+				Now:      now,
+			},
+		}, *events)
+	})
+}
+
 func TestServiceUpdateTask_Error(t *testing.T) { //nolint:funlen
 	t.Parallel()
 
@@ -557,3 +924,70 @@ func TestServiceUpdateTask_Error(t *testing.T) { //nolint:funlen
 		require.ErrorIs(t, err, flow.ErrSelfParent)
 	})
 }
+
+// TestServiceSQLite는 같은 흐름을 실제 데이터베이스에 저장하면서 확인한다.
+func TestServiceSQLite(t *testing.T) {
+	t.Parallel()
+
+	const username = "test"
+
+	repo, err := sqlite.NewRepository(filepath.Join(t.TempDir(), "focus.db"))
+	require.NoError(t, err)
+
+	bus := eventbus.NewBus()
+	service := flow.NewService(outbox.NewService(system.NewClock(), repo, bus), ulid.NewIDMaker(), repo)
+	events := recordAll(bus)
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	require.NoError(t, service.CreateRootDummy(t.Context(), &flow.CreateRootDummyInput{Username: username}))
+
+	parent, err := service.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: username,
+		Title:    "parent",
+		ParentID: "",
+		NextID:   "",
+		Now:      now,
+	})
+	require.NoError(t, err)
+
+	child, err := service.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: username,
+		Title:    "child",
+		ParentID: parent.ID,
+		NextID:   "",
+		Now:      now,
+	})
+	require.NoError(t, err)
+
+	err = service.UpdateTask(t.Context(), &flow.UpdateTaskInput{
+		Username: username,
+		TaskID:   child.ID,
+		ParentID: "",
+		NextID:   parent.ID,
+		Title:    "renamed",
+		Now:      now,
+	})
+	require.NoError(t, err)
+
+	out, err := service.ListTasks(t.Context(), &flow.ListTasksInput{
+		Username:  username,
+		ParentID:  "",
+		Recursive: false,
+	})
+	require.NoError(t, err)
+	require.Len(t, out.Tasks, 2)
+	require.Equal(t, child.ID, out.Tasks[0].ID)
+	require.Equal(t, "renamed", out.Tasks[0].Title)
+	require.Equal(t, parent.ID, out.Tasks[1].ID)
+
+	err = service.DeleteTask(t.Context(), &flow.DeleteTaskInput{
+		Username: username,
+		TaskID:   parent.ID,
+		Now:      now,
+	})
+	require.NoError(t, err)
+
+	_, err = service.GetTask(t.Context(), &flow.GetTaskInput{Username: username, TaskID: parent.ID})
+	require.ErrorIs(t, err, flow.ErrTaskNotFound)
+	require.Len(t, *events, 5)
+}
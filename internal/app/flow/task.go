@@ -19,12 +19,15 @@ type CreateTaskOutput struct {
 }
 
 type ListTasksInput struct {
-	Username string
-	ParentID string
+	Username  string
+	ParentID  string
+	Recursive bool // true이면 모든 하위 task를 전위 순회 순서로 포함한다
 }
 
 type Task struct {
 	ID        string
+	ParentID  string
+	NextID    string
 	Title     string
 	CreatedAt time.Time
 }
@@ -36,6 +39,7 @@ type ListTasksOutput struct {
 type DeleteTaskInput struct {
 	Username string
 	TaskID   string
+	Now      time.Time
 }
 
 type GetTaskInput struct {
@@ -57,4 +61,5 @@ type UpdateTaskInput struct {
 	ParentID string // Next을 가져와 ParentID를 가져올 수는 있으나, NextID가 "" 인 경우를 위해 필요함
 	NextID   string
 	Title    string
+	Now      time.Time
 }
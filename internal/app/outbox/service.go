@@ -0,0 +1,155 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/clock"
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/neatflowcv/focus/internal/pkg/repository"
+)
+
+const (
+	batchSize = 100
+
+	// 실패한 이벤트는 1초부터 두 배씩 늘려 최대 5분 간격으로 maxAttempts번까지 전달하고 dead letter로 남긴다.
+	maxAttempts = 10
+	baseBackoff = time.Second
+	maxBackoff  = 5 * time.Minute
+)
+
+// Service는 outbox에 저장된 이벤트를 저장된 순서대로 bus에 전달하고 전달된 것으로 표시한다.
+type Service struct {
+	clock clock.Clock
+	repo  repository.OutboxRepository
+	bus   *eventbus.Bus
+
+	mu       sync.Mutex // Dispatch가 동시에 돌면 순서가 섞인다
+	listened atomic.Bool
+	signal   chan struct{}
+}
+
+func NewService(clock clock.Clock, repo repository.OutboxRepository, bus *eventbus.Bus) *Service {
+	return &Service{
+		clock:    clock,
+		repo:     repo,
+		bus:      bus,
+		mu:       sync.Mutex{},
+		listened: atomic.Bool{},
+		signal:   make(chan struct{}, 1),
+	}
+}
+
+// Notify는 Listen한 쪽을 깨우고, 없으면 바로 전달한다.
+func (s *Service) Notify(ctx context.Context) error {
+	if !s.listened.Load() {
+		_, err := s.Dispatch(ctx)
+
+		return err
+	}
+
+	select {
+	case s.signal <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Listen은 이후의 Notify가 바로 전달하지 않고 돌려준 channel로 알리게 한다. 주기적으로 Dispatch하는 쪽에서 부른다.
+func (s *Service) Listen() <-chan struct{} {
+	s.listened.Store(true)
+
+	return s.signal
+}
+
+// Dispatch는 밀린 이벤트를 순서대로 전달하고 전달한 수를 돌려준다.
+// 실패한 이벤트는 다음 시도 때까지 미루고 뒤의 이벤트를 계속 전달하며, 실패는 모아서 돌려준다.
+func (s *Service) Dispatch(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		delivered int
+		errs      []error
+	)
+
+	for {
+		events, err := s.repo.ListPendingOutboxEvents(ctx, s.clock.Now(), batchSize)
+		if err != nil {
+			return delivered, errors.Join(append(errs, fmt.Errorf("failed to list outbox events: %w", err))...)
+		}
+
+		for _, event := range events {
+			deliverErr := s.deliver(ctx, event)
+
+			err := s.repo.UpdateOutboxEvent(ctx, event)
+			if err != nil {
+				return delivered, errors.Join(append(errs, fmt.Errorf("failed to update outbox event: %w", err))...)
+			}
+
+			if deliverErr != nil {
+				errs = append(errs, deliverErr)
+
+				continue
+			}
+
+			delivered++
+		}
+
+		if len(events) < batchSize {
+			return delivered, errors.Join(errs...)
+		}
+	}
+}
+
+// deliver는 이벤트를 한 번 전달하고 결과를 event에 적는다.
+func (s *Service) deliver(ctx context.Context, event *repository.OutboxEvent) error {
+	event.Attempts++
+
+	// 같은 batch에 다른 요청의 이벤트도 있으므로 이 이벤트를 일으킨 요청의 정보로 바꿔서 전달한다.
+	ctx = eventbus.WithMetadata(ctx, eventbus.Metadata{
+		RequestID: event.RequestID,
+		TraceID:   event.TraceID,
+	})
+
+	// 앞서 처리한 subscriber는 건너뛰므로 한 subscriber가 실패해도 다른 subscriber가 같은 이벤트를 다시 받지 않는다.
+	delivered, deliverErr := s.bus.Deliver(ctx, event.Topic, event.Payload, event.Delivered, event.Attempts)
+	event.Delivered = delivered
+
+	now := s.clock.Now()
+
+	switch {
+	case deliverErr == nil:
+		event.DeliveredAt = now
+	case event.Attempts >= maxAttempts:
+		event.DeliveredAt = now
+		deliverErr = errors.Join(deliverErr, s.bury(ctx, event, deliverErr))
+	default:
+		event.NextAttemptAt = now.Add(min(baseBackoff<<(event.Attempts-1), maxBackoff))
+	}
+
+	if deliverErr != nil {
+		return fmt.Errorf("failed to deliver outbox event %d: %w", event.ID, deliverErr)
+	}
+
+	return nil
+}
+
+// bury는 끝내 실패한 subscriber마다 dead letter를 하나씩 남긴다.
+func (s *Service) bury(ctx context.Context, event *repository.OutboxEvent, cause error) error {
+	var errs []error
+
+	for _, failure := range eventbus.SubscriberErrors(cause) {
+		err := s.bus.Bury(ctx, event.Topic, event.Payload, failure.Subscriber, event.Attempts, failure.Err)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,351 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/outbox"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/neatflowcv/focus/internal/pkg/repository"
+	"github.com/neatflowcv/focus/internal/pkg/repository/memory"
+	"github.com/stretchr/testify/require"
+)
+
+const username = "test"
+
+var errSubscriber = errors.New("subscriber failed")
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+type ServiceData struct {
+	repo    *memory.Repository
+	bus     *eventbus.Bus
+	clock   *fakeClock
+	letters *eventbus.MemoryDeadLetterStore
+}
+
+func newService(t *testing.T) (*outbox.Service, *ServiceData) {
+	t.Helper()
+
+	letters := eventbus.NewMemoryDeadLetterStore()
+	options := eventbus.SyncOptions()
+	options.DeadLetters = letters
+
+	data := &ServiceData{
+		repo:    memory.NewRepository(),
+		bus:     eventbus.NewBusWithOptions(options),
+		clock:   &fakeClock{now: time.Date(2025, 7, 4, 9, 0, 0, 0, time.UTC)},
+		letters: letters,
+	}
+
+	return outbox.NewService(data.clock, data.repo, data.bus), data
+}
+
+func commitCreated(t *testing.T, data *ServiceData, ids ...string) {
+	t.Helper()
+
+	var events []*repository.OutboxEvent
+
+	for _, id := range ids {
+		payload, err := json.Marshal(&eventbus.TaskCreatedEvent{
+			Version:  eventbus.TaskCreatedVersion,
+			Username: username,
+			TaskID:   id,
+			ParentID: "",
+			NextID:   "",
+			Title:    "",
+			Now:      data.clock.now,
+		})
+		require.NoError(t, err)
+
+		events = append(events, &repository.OutboxEvent{
+			ID:          0,
+			Username:    "",
+			Topic:       eventbus.TopicTaskCreated,
+			Payload:     payload,
+			CreatedAt:   data.clock.now,
+			Attempts:    0,
+			DeliveredAt: time.Time{},
+			Delivered:   nil,
+			RequestID:   "",
+			TraceID:     "",
+		})
+	}
+
+	err := data.repo.CommitTasks(t.Context(), username, &repository.TaskChange{
+		Create: []*domain.Task{domain.NewRootDummyTask()},
+		Update: nil,
+		Delete: nil,
+		Events: events,
+	})
+	require.NoError(t, err)
+}
+
+func TestServiceDispatch(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	commitCreated(t, data, "1", "2", "3")
+
+	var created []string
+
+	data.bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		created = append(created, event.TaskID)
+
+		return nil
+	})
+
+	count, err := service.Dispatch(t.Context())
+
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	require.Equal(t, []string{"1", "2", "3"}, created)
+
+	for _, event := range data.repo.Outbox {
+		require.Equal(t, username, event.Username)
+		require.Equal(t, data.clock.now, event.DeliveredAt)
+	}
+
+	count, err = service.Dispatch(t.Context())
+
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestServiceDispatch_Unversioned(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+
+	// 버전을 붙이기 전에 저장된 이벤트이다.
+	err := data.repo.CommitTasks(t.Context(), username, &repository.TaskChange{
+		Create: []*domain.Task{domain.NewRootDummyTask()},
+		Update: nil,
+		Delete: nil,
+		Events: []*repository.OutboxEvent{{
+			ID:          0,
+			Username:    "",
+			Topic:       eventbus.TopicTaskCreated,
+			Payload:     []byte(`{"Username":"test","TaskID":"1","ParentID":"","Now":"2025-07-04T09:00:00Z"}`),
+			CreatedAt:   data.clock.now,
+			Attempts:    0,
+			DeliveredAt: time.Time{},
+			Delivered:   nil,
+			RequestID:   "",
+			TraceID:     "",
+		}},
+	})
+	require.NoError(t, err)
+
+	var created []*eventbus.TaskCreatedEvent
+
+	data.bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		created = append(created, event)
+
+		return nil
+	})
+
+	_, err = service.Dispatch(t.Context())
+
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	require.Equal(t, 1, created[0].Version)
+	require.Equal(t, "1", created[0].TaskID)
+}
+
+func TestServiceDispatch_Error(t *testing.T) {
+	t.Parallel()
+
+	t.Run("skips failed event", func(t *testing.T) {
+		t.Parallel()
+
+		service, data := newService(t)
+		commitCreated(t, data, "1", "2")
+
+		var created []string
+
+		data.bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+			created = append(created, event.TaskID)
+			if event.TaskID == "1" {
+				return errSubscriber
+			}
+
+			return nil
+		})
+
+		count, err := service.Dispatch(t.Context())
+
+		require.ErrorIs(t, err, errSubscriber)
+		require.Equal(t, 1, count)
+		require.Equal(t, []string{"1", "2"}, created)
+		require.Equal(t, 1, data.repo.Outbox[0].Attempts)
+		require.Equal(t, data.clock.now.Add(time.Second), data.repo.Outbox[0].NextAttemptAt)
+		require.True(t, data.repo.Outbox[0].DeliveredAt.IsZero())
+		require.False(t, data.repo.Outbox[1].DeliveredAt.IsZero())
+
+		count, err = service.Dispatch(t.Context())
+
+		require.NoError(t, err)
+		require.Zero(t, count)
+		require.Len(t, created, 2)
+
+		data.clock.now = data.clock.now.Add(time.Second)
+		_, err = service.Dispatch(t.Context())
+
+		require.ErrorIs(t, err, errSubscriber)
+		require.Equal(t, []string{"1", "2", "1"}, created)
+		require.Equal(t, data.clock.now.Add(2*time.Second), data.repo.Outbox[0].NextAttemptAt)
+	})
+
+	t.Run("gives up", func(t *testing.T) {
+		t.Parallel()
+
+		service, data := newService(t)
+		commitCreated(t, data, "1")
+
+		calls := 0
+
+		data.bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+			return nil
+		})
+		data.bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+			calls++
+
+			return errSubscriber
+		})
+
+		for range 10 {
+			_, err := service.Dispatch(t.Context())
+			require.ErrorIs(t, err, errSubscriber)
+
+			data.clock.now = data.clock.now.Add(time.Hour)
+		}
+
+		count, err := service.Dispatch(t.Context())
+
+		require.NoError(t, err)
+		require.Zero(t, count)
+		require.Equal(t, 10, calls)
+		require.Equal(t, 10, data.repo.Outbox[0].Attempts)
+		require.False(t, data.repo.Outbox[0].DeliveredAt.IsZero())
+
+		letters := data.letters.List()
+		require.Len(t, letters, 1)
+		require.Equal(t, 1, letters[0].Subscriber)
+		require.Equal(t, 10, letters[0].Attempts)
+		require.Equal(t, errSubscriber.Error(), letters[0].Error)
+	})
+}
+
+func TestServiceDispatch_Subscribers(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	commitCreated(t, data, "1")
+
+	var (
+		first  []string
+		second []string
+	)
+
+	data.bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		first = append(first, event.TaskID)
+
+		return nil
+	})
+	data.bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		second = append(second, event.TaskID)
+		if len(second) == 1 {
+			return errSubscriber
+		}
+
+		return nil
+	})
+
+	_, err := service.Dispatch(t.Context())
+
+	require.ErrorIs(t, err, errSubscriber)
+	require.Equal(t, []int{0}, data.repo.Outbox[0].Delivered)
+
+	data.clock.now = data.clock.now.Add(time.Second)
+	count, err := service.Dispatch(t.Context())
+
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Equal(t, []string{"1"}, first)
+	require.Equal(t, []string{"1", "1"}, second)
+	require.Equal(t, []int{0, 1}, data.repo.Outbox[0].Delivered)
+	require.False(t, data.repo.Outbox[0].DeliveredAt.IsZero())
+}
+
+func TestServiceDispatch_Interceptors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		service, data := newService(t)
+		commitCreated(t, data, "1")
+		data.bus.Use(eventbus.Recover())
+
+		data.bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+			panic(errSubscriber)
+		})
+
+		count, err := service.Dispatch(t.Context())
+
+		require.ErrorIs(t, err, eventbus.ErrPanicked)
+		require.Zero(t, count)
+		require.True(t, data.repo.Outbox[0].DeliveredAt.IsZero())
+	})
+
+	t.Run("request id", func(t *testing.T) {
+		t.Parallel()
+
+		service, data := newService(t)
+		commitCreated(t, data, "1", "2")
+		data.repo.Outbox[0].RequestID = "first"
+		data.bus.Use(eventbus.Tracing())
+
+		var metadata []eventbus.Metadata
+
+		data.bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+			metadata = append(metadata, eventbus.MetadataFrom(ctx))
+
+			return nil
+		})
+
+		_, err := service.Dispatch(eventbus.WithRequestID(t.Context(), "dispatcher"))
+
+		require.NoError(t, err)
+		require.Len(t, metadata, 2)
+		require.Equal(t, "first", metadata[0].RequestID)
+		require.Empty(t, metadata[1].RequestID)
+		require.NotEmpty(t, metadata[0].TraceID)
+		require.NotEqual(t, metadata[0].TraceID, metadata[1].TraceID)
+	})
+}
+
+func TestServiceNotify(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	commitCreated(t, data, "1")
+
+	signal := service.Listen()
+	err := service.Notify(t.Context())
+
+	require.NoError(t, err)
+	require.Len(t, signal, 1)
+	require.True(t, data.repo.Outbox[0].DeliveredAt.IsZero())
+}
@@ -0,0 +1,37 @@
+package projection
+
+import (
+	"time"
+)
+
+const (
+	ProjectionExtra = "extra"
+	ProjectionTrace = "trace"
+)
+
+type RebuildInput struct {
+	Username string
+	DryRun   bool // true이면 바꿀 내용만 계산하고 저장하지 않는다
+	Now      time.Time
+}
+
+type Counts struct {
+	Created int
+	Updated int
+	Deleted int
+}
+
+// Change는 projection 하나에서 고치는 값 하나이다.
+type Change struct {
+	Projection string
+	ID         string
+	Kind       string
+	From       string
+	To         string
+}
+
+type RebuildOutput struct {
+	Extras  Counts
+	Traces  Counts
+	Changes []*Change // trace, extra 순서로 적용된다
+}
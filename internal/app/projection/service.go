@@ -0,0 +1,101 @@
+package projection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/trace"
+)
+
+type Service struct {
+	flowService  *flow.Service
+	extraService *extra.Service
+	traceService *trace.Service
+}
+
+func NewService(flowService *flow.Service, extraService *extra.Service, traceService *trace.Service) *Service {
+	return &Service{
+		flowService:  flowService,
+		extraService: extraService,
+		traceService: traceService,
+	}
+}
+
+// Rebuild는 작업 트리를 기준으로 trace와 extra를 차례로 다시 만든다.
+func (s *Service) Rebuild(ctx context.Context, input *RebuildInput) (*RebuildOutput, error) { //nolint:funlen
+	taskOut, err := s.flowService.ListTasks(ctx, &flow.ListTasksInput{
+		Username:  input.Username,
+		ParentID:  "",
+		Recursive: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	var (
+		traceNodes []*trace.Node
+		extraNodes []*extra.Node
+	)
+
+	for _, task := range taskOut.Tasks {
+		traceNodes = append(traceNodes, &trace.Node{ID: task.ID, ParentID: task.ParentID})
+		extraNodes = append(extraNodes, &extra.Node{ID: task.ID, ParentID: task.ParentID})
+	}
+
+	traceOut, err := s.traceService.Rebuild(ctx, &trace.RebuildInput{
+		Username: input.Username,
+		Nodes:    traceNodes,
+		DryRun:   input.DryRun,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild traces: %w", err)
+	}
+
+	extraOut, err := s.extraService.Recompute(ctx, &extra.RecomputeInput{
+		Username: input.Username,
+		Nodes:    extraNodes,
+		DryRun:   input.DryRun,
+		Now:      input.Now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to recompute extras: %w", err)
+	}
+
+	var changes []*Change
+
+	for _, change := range traceOut.Changes {
+		changes = append(changes, &Change{
+			Projection: ProjectionTrace,
+			ID:         change.ID,
+			Kind:       change.Kind,
+			From:       change.From,
+			To:         change.To,
+		})
+	}
+
+	for _, change := range extraOut.Changes {
+		changes = append(changes, &Change{
+			Projection: ProjectionExtra,
+			ID:         change.ID,
+			Kind:       change.Kind,
+			From:       change.From,
+			To:         change.To,
+		})
+	}
+
+	return &RebuildOutput{
+		Extras: Counts{
+			Created: extraOut.Created,
+			Updated: extraOut.Updated,
+			Deleted: extraOut.Deleted,
+		},
+		Traces: Counts{
+			Created: traceOut.Created,
+			Updated: traceOut.Updated,
+			Deleted: traceOut.Deleted,
+		},
+		Changes: changes,
+	}, nil
+}
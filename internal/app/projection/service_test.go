@@ -0,0 +1,109 @@
+package projection_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/outbox"
+	"github.com/neatflowcv/focus/internal/app/projection"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/clock/system"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/neatflowcv/focus/internal/pkg/idmaker/ulid"
+	"github.com/neatflowcv/focus/internal/pkg/repository/memory"
+	"github.com/stretchr/testify/require"
+)
+
+const username = "test"
+
+type ServiceData struct {
+	repo *memory.Repository
+	flow *flow.Service
+}
+
+func newService(t *testing.T) (*projection.Service, *ServiceData) {
+	t.Helper()
+
+	repo := memory.NewRepository()
+	bus := eventbus.NewBus()
+	flowService := flow.NewService(outbox.NewService(system.NewClock(), repo, bus), ulid.NewIDMaker(), repo)
+	extraService := extra.NewService(bus, repo, repo)
+	traceService := trace.NewService(bus, ulid.NewIDMaker(), repo)
+
+	bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		_ = extraService.CreateExtra(ctx, &extra.CreateExtraInput{
+			Username: event.Username,
+			ID:       event.TaskID,
+			ParentID: event.ParentID,
+			Now:      event.Now,
+		})
+		_ = traceService.CreateTrace(ctx, &trace.CreateTraceInput{
+			Username: event.Username,
+			ID:       event.TaskID,
+			ParentID: event.ParentID,
+		})
+
+		return nil
+	})
+
+	_ = flowService.CreateRootDummy(t.Context(), &flow.CreateRootDummyInput{Username: username})
+
+	return projection.NewService(flowService, extraService, traceService), &ServiceData{
+		repo: repo,
+		flow: flowService,
+	}
+}
+
+func createTask(t *testing.T, data *ServiceData, title string, parentID string) string {
+	t.Helper()
+
+	out, _ := data.flow.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: username,
+		Title:    title,
+		ParentID: parentID,
+		NextID:   "",
+		Now:      time.Now(),
+	})
+
+	return out.ID
+}
+
+func TestServiceRebuild(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	parent := createTask(t, data, "parent", "")
+	child := createTask(t, data, "child", parent)
+	// child의 생성 이벤트를 놓친 상황을 만든다.
+	delete(data.repo.Extras[username], domain.ExtraID(child))
+	delete(data.repo.Traces[username], domain.TraceID(child))
+
+	out, err := service.Rebuild(t.Context(), &projection.RebuildInput{
+		Username: username,
+		DryRun:   true,
+		Now:      time.Now(),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, projection.Counts{Created: 1, Updated: 0, Deleted: 0}, out.Traces)
+	require.Equal(t, projection.Counts{Created: 1, Updated: 0, Deleted: 0}, out.Extras)
+	require.Equal(t, []*projection.Change{
+		{Projection: projection.ProjectionTrace, ID: child, Kind: trace.ChangeCreate, From: "", To: parent},
+		{Projection: projection.ProjectionExtra, ID: child, Kind: extra.ChangeCreate, From: "", To: parent},
+	}, out.Changes)
+	require.NotContains(t, data.repo.Extras[username], domain.ExtraID(child))
+
+	_, err = service.Rebuild(t.Context(), &projection.RebuildInput{Username: username, DryRun: false, Now: time.Now()})
+
+	require.NoError(t, err)
+	require.Contains(t, data.repo.Extras[username], domain.ExtraID(child))
+	require.Contains(t, data.repo.Traces[username], domain.TraceID(child))
+	require.False(t, data.repo.Extras[username][domain.ExtraID(parent)].Leaf())
+
+	out, _ = service.Rebuild(t.Context(), &projection.RebuildInput{Username: username, DryRun: false, Now: time.Now()})
+	require.Empty(t, out.Changes)
+}
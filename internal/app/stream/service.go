@@ -0,0 +1,258 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/clock"
+)
+
+// replaySize는 사용자마다 다시 보내 줄 수 있도록 남겨 두는 이벤트 수이다.
+const replaySize = 256
+
+// Service는 사용자마다 최근 이벤트를 남겨 두고 구독한 연결에 보낸다.
+type Service struct {
+	clock        clock.Clock
+	flowService  *flow.Service
+	traceService *trace.Service
+
+	mu     sync.Mutex
+	lastID uint64
+	feeds  map[string]*feed
+}
+
+type feed struct {
+	since         uint64 // 이 ID 뒤의 이벤트는 모두 남아 있다
+	entries       []*entry
+	subscriptions map[*Subscription]struct{}
+}
+
+type entry struct {
+	seq   uint64
+	event *Event
+}
+
+func NewService(clock clock.Clock, flowService *flow.Service, traceService *trace.Service) *Service {
+	return &Service{
+		clock:        clock,
+		flowService:  flowService,
+		traceService: traceService,
+		mu:           sync.Mutex{},
+		lastID:       uint64(clock.Now().UnixMicro()), //nolint:gosec // 다시 시작해도 ID가 뒤로 가지 않게 한다
+		feeds:        make(map[string]*feed),
+	}
+}
+
+// Publish는 이벤트에 ID를 붙여 남겨 두고 구독한 연결에 보낸다.
+func (s *Service) Publish(ctx context.Context, input *PublishInput) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastID++
+
+	event := &Event{
+		ID:        strconv.FormatUint(s.lastID, 10),
+		Kind:      input.Kind,
+		TaskID:    input.TaskID,
+		ParentID:  input.ParentID,
+		NextID:    input.NextID,
+		Status:    input.Status,
+		Actual:    0,
+		StartedAt: time.Time{},
+		At:        input.At,
+	}
+
+	feed := s.getFeed(input.Username)
+
+	feed.entries = append(feed.entries, &entry{seq: s.lastID, event: event})
+	if len(feed.entries) > replaySize {
+		feed.since = feed.entries[len(feed.entries)-replaySize-1].seq
+		feed.entries = feed.entries[len(feed.entries)-replaySize:]
+	}
+
+	s.broadcast(feed, event)
+
+	return nil
+}
+
+// Subscribe는 LastEventID 뒤의 이벤트를, 이어 받을 수 없으면 reset 이벤트를 먼저 보내는 구독을 만든다.
+func (s *Service) Subscribe(ctx context.Context, input *SubscribeInput) (*SubscribeOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	feed := s.getFeed(input.Username)
+
+	var missed []*Event
+
+	if input.LastEventID != "" {
+		events, ok := feed.after(input.LastEventID, s.lastID)
+		if ok {
+			missed = events
+		} else {
+			missed = []*Event{s.newReset()}
+		}
+	}
+
+	subscription := &Subscription{
+		service:  s,
+		username: input.Username,
+		events:   make(chan *Event, subscriptionBuffer),
+		once:     sync.Once{},
+	}
+	feed.subscriptions[subscription] = struct{}{}
+
+	return &SubscribeOutput{
+		Missed:       missed,
+		Subscription: subscription,
+	}, nil
+}
+
+// Tick은 구독 중인 사용자마다 시간을 재고 있는 task의 지금까지의 시간을 보낸다.
+func (s *Service) Tick(ctx context.Context) error {
+	for _, username := range s.listSubscribed() {
+		events, err := s.listTicks(ctx, username)
+		if err != nil {
+			return fmt.Errorf("failed to list ticks of %s: %w", username, err)
+		}
+
+		s.mu.Lock()
+		for _, event := range events {
+			s.broadcast(s.getFeed(username), event)
+		}
+		s.mu.Unlock()
+	}
+
+	return nil
+}
+
+func (s *Service) listTicks(ctx context.Context, username string) ([]*Event, error) {
+	taskOut, err := s.flowService.ListTasks(ctx, &flow.ListTasksInput{
+		Username:  username,
+		ParentID:  "",
+		Recursive: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	var ids []string
+	for _, task := range taskOut.Tasks {
+		ids = append(ids, task.ID)
+	}
+
+	traceOut, err := s.traceService.ListTraces(ctx, &trace.ListTracesInput{Username: username, IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list traces: %w", err)
+	}
+
+	now := s.clock.Now()
+
+	var events []*Event
+
+	for _, item := range traceOut.Traces {
+		if item.StartedAt.IsZero() {
+			continue
+		}
+
+		events = append(events, &Event{
+			ID:        "",
+			Kind:      KindTimerTick,
+			TaskID:    item.ID,
+			ParentID:  "",
+			NextID:    "",
+			Status:    "",
+			Actual:    item.Actual + now.Sub(item.StartedAt),
+			StartedAt: item.StartedAt,
+			At:        now,
+		})
+	}
+
+	return events, nil
+}
+
+func (s *Service) listSubscribed() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var usernames []string
+
+	for username, feed := range s.feeds {
+		if len(feed.subscriptions) > 0 {
+			usernames = append(usernames, username)
+		}
+	}
+
+	return usernames
+}
+
+// broadcast는 lock 안에서 불리며 받지 못한 구독은 끊는다.
+func (s *Service) broadcast(feed *feed, event *Event) {
+	for subscription := range feed.subscriptions {
+		if subscription.send(event) {
+			continue
+		}
+
+		delete(feed.subscriptions, subscription)
+		subscription.close()
+	}
+}
+
+func (s *Service) unsubscribe(subscription *Subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	feed := s.getFeed(subscription.username)
+	delete(feed.subscriptions, subscription)
+	subscription.close()
+}
+
+func (s *Service) getFeed(username string) *feed {
+	ret, ok := s.feeds[username]
+	if !ok {
+		ret = &feed{
+			since:         s.lastID,
+			entries:       nil,
+			subscriptions: make(map[*Subscription]struct{}),
+		}
+		s.feeds[username] = ret
+	}
+
+	return ret
+}
+
+func (s *Service) newReset() *Event {
+	return &Event{
+		ID:        strconv.FormatUint(s.lastID, 10),
+		Kind:      KindReset,
+		TaskID:    "",
+		ParentID:  "",
+		NextID:    "",
+		Status:    "",
+		Actual:    0,
+		StartedAt: time.Time{},
+		At:        s.clock.Now(),
+	}
+}
+
+// after는 id 뒤의 이벤트를 돌려주며, 그 사이의 이벤트가 남아 있지 않으면 false이다.
+func (f *feed) after(id string, lastID uint64) ([]*Event, bool) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || seq < f.since || seq > lastID {
+		return nil, false
+	}
+
+	var events []*Event
+
+	for _, entry := range f.entries {
+		if entry.seq > seq {
+			events = append(events, entry.event)
+		}
+	}
+
+	return events, true
+}
@@ -0,0 +1,204 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/outbox"
+	"github.com/neatflowcv/focus/internal/app/stream"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/clock/system"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/neatflowcv/focus/internal/pkg/idmaker/ulid"
+	"github.com/neatflowcv/focus/internal/pkg/repository/memory"
+	"github.com/stretchr/testify/require"
+)
+
+const username = "test"
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+type ServiceData struct {
+	clock *fakeClock
+	flow  *flow.Service
+	extra *extra.Service
+}
+
+func newService(t *testing.T) (*stream.Service, *ServiceData) {
+	t.Helper()
+
+	repo := memory.NewRepository()
+	bus := eventbus.NewBus()
+	clock := &fakeClock{now: time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)}
+	flowService := flow.NewService(outbox.NewService(system.NewClock(), repo, bus), ulid.NewIDMaker(), repo)
+	extraService := extra.NewService(bus, repo, repo)
+	traceService := trace.NewService(bus, ulid.NewIDMaker(), repo)
+
+	bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		_ = extraService.CreateExtra(ctx, &extra.CreateExtraInput{
+			Username: event.Username,
+			ID:       event.TaskID,
+			ParentID: event.ParentID,
+			Now:      event.Now,
+		})
+		_ = traceService.CreateTrace(ctx, &trace.CreateTraceInput{
+			Username: event.Username,
+			ID:       event.TaskID,
+			ParentID: event.ParentID,
+		})
+
+		return nil
+	})
+	bus.ExtraStatusUpdated.Subscribe(func(ctx context.Context, event *eventbus.ExtraStatusUpdatedEvent) error {
+		return traceService.UpdateStatus(ctx, &trace.UpdateStatusInput{
+			Username: event.Username,
+			ID:       event.ExtraID,
+			Status:   event.Category,
+			Now:      event.Now,
+		})
+	})
+
+	_ = flowService.CreateRootDummy(t.Context(), &flow.CreateRootDummyInput{Username: username})
+
+	return stream.NewService(clock, flowService, traceService), &ServiceData{
+		clock: clock,
+		flow:  flowService,
+		extra: extraService,
+	}
+}
+
+func publish(t *testing.T, service *stream.Service, username string, taskID string) {
+	t.Helper()
+
+	err := service.Publish(t.Context(), &stream.PublishInput{
+		Username: username,
+		Kind:     stream.KindTaskCreated,
+		TaskID:   taskID,
+		ParentID: "",
+		NextID:   "",
+		Status:   "",
+		At:       time.Now(),
+	})
+	require.NoError(t, err)
+}
+
+func subscribe(t *testing.T, service *stream.Service, lastEventID string) *stream.SubscribeOutput {
+	t.Helper()
+
+	out, err := service.Subscribe(t.Context(), &stream.SubscribeInput{Username: username, LastEventID: lastEventID})
+	require.NoError(t, err)
+	t.Cleanup(out.Subscription.Close)
+
+	return out
+}
+
+func TestServicePublish(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newService(t)
+	out := subscribe(t, service, "")
+
+	publish(t, service, "other", "hidden")
+	publish(t, service, username, "a")
+
+	require.Empty(t, out.Missed)
+	event := <-out.Subscription.Events()
+	require.Equal(t, stream.KindTaskCreated, event.Kind)
+	require.Equal(t, "a", event.TaskID)
+	require.NotEmpty(t, event.ID)
+	require.Empty(t, out.Subscription.Events())
+}
+
+func TestServiceSubscribe_Resume(t *testing.T) {
+	t.Parallel()
+
+	t.Run("after last event", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newService(t)
+		first := subscribe(t, service, "")
+		publish(t, service, username, "a")
+		publish(t, service, username, "b")
+		seen := <-first.Subscription.Events()
+
+		out := subscribe(t, service, seen.ID)
+
+		require.Len(t, out.Missed, 1)
+		require.Equal(t, "b", out.Missed[0].TaskID)
+	})
+
+	t.Run("unknown event", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newService(t)
+		publish(t, service, username, "a")
+
+		out := subscribe(t, service, "1")
+
+		require.Len(t, out.Missed, 1)
+		require.Equal(t, stream.KindReset, out.Missed[0].Kind)
+
+		again := subscribe(t, service, out.Missed[0].ID)
+
+		require.Empty(t, again.Missed)
+	})
+}
+
+func TestServiceSubscribe_Slow(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newService(t)
+	out := subscribe(t, service, "")
+
+	for range 100 {
+		publish(t, service, username, "a")
+	}
+
+	count := 0
+	for range out.Subscription.Events() {
+		count++
+	}
+
+	require.Less(t, count, 100)
+}
+
+func TestServiceTick(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	task, _ := data.flow.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: username,
+		Title:    "task",
+		ParentID: "",
+		NextID:   "",
+		Now:      data.clock.now,
+	})
+	_ = data.extra.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+		Username: username,
+		ID:       task.ID,
+		Status:   string(domain.TaskStatusDoing),
+		Now:      data.clock.now,
+		Force:    false,
+	})
+	out := subscribe(t, service, "")
+	data.clock.now = data.clock.now.Add(90 * time.Second)
+
+	err := service.Tick(t.Context())
+
+	require.NoError(t, err)
+	event := <-out.Subscription.Events()
+	require.Equal(t, stream.KindTimerTick, event.Kind)
+	require.Equal(t, task.ID, event.TaskID)
+	require.Empty(t, event.ID)
+	require.Equal(t, 90*time.Second, event.Actual)
+}
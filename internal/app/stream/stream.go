@@ -0,0 +1,46 @@
+package stream
+
+import (
+	"time"
+)
+
+const (
+	KindReset         = "reset" // 놓친 이벤트를 이어 줄 수 없으니 처음부터 다시 읽어야 한다
+	KindTaskCreated   = "task.created"
+	KindTaskDeleted   = "task.deleted"
+	KindTaskMoved     = "task.moved"
+	KindStatusChanged = "status.changed"
+	KindTimerTick     = "timer.tick"
+)
+
+type PublishInput struct {
+	Username string
+	Kind     string
+	TaskID   string
+	ParentID string
+	NextID   string
+	Status   string
+	At       time.Time
+}
+
+type Event struct {
+	ID        string // 이어 받을 때 쓰는 ID이며 timer tick에는 없다
+	Kind      string
+	TaskID    string
+	ParentID  string
+	NextID    string
+	Status    string
+	Actual    time.Duration // timer tick에서 지금까지 잰 시간
+	StartedAt time.Time     // timer tick에서 시간을 재기 시작한 시각
+	At        time.Time
+}
+
+type SubscribeInput struct {
+	Username    string
+	LastEventID string // 비어 있으면 지금부터 받는다
+}
+
+type SubscribeOutput struct {
+	Missed       []*Event // LastEventID 뒤에 발생한 이벤트
+	Subscription *Subscription
+}
@@ -0,0 +1,40 @@
+package stream
+
+import (
+	"sync"
+)
+
+const subscriptionBuffer = 64
+
+// Subscription은 한 연결이 받는 이벤트이다. buffer가 차면 channel을 닫는다.
+type Subscription struct {
+	service  *Service
+	username string
+	events   chan *Event
+	once     sync.Once
+}
+
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// Close는 구독을 끝낸다. 여러 번 불러도 된다.
+func (s *Subscription) Close() {
+	s.service.unsubscribe(s)
+}
+
+// send는 Service의 lock 안에서 불린다.
+func (s *Subscription) send(event *Event) bool {
+	select {
+	case s.events <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Subscription) close() {
+	s.once.Do(func() {
+		close(s.events)
+	})
+}
@@ -0,0 +1,87 @@
+package timesheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	CSVContentType  = "text/csv; charset=utf-8"
+	JSONContentType = "application/json"
+
+	pathSeparator = " / "
+)
+
+type jsonRow struct {
+	Date   string  `json:"date"`
+	TaskID string  `json:"task_id"`
+	Path   string  `json:"path"`
+	Hours  float64 `json:"hours"`
+	Status string  `json:"status"`
+}
+
+// EncodeCSV는 header를 포함한 CSV로 행을 쓴다.
+func EncodeCSV(rows []*Row) (string, error) {
+	var buf bytes.Buffer
+
+	writer := csv.NewWriter(&buf)
+
+	err := writer.Write([]string{"date", "task_id", "path", "hours", "status"})
+	if err != nil {
+		return "", fmt.Errorf("failed to write csv: %w", err)
+	}
+
+	for _, row := range rows {
+		err := writer.Write([]string{
+			row.Date,
+			row.TaskID,
+			strings.Join(row.Path, pathSeparator),
+			strconv.FormatFloat(hours(row.Duration), 'f', 2, 64),
+			row.Status,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to write csv: %w", err)
+		}
+	}
+
+	writer.Flush()
+
+	err = writer.Error()
+	if err != nil {
+		return "", fmt.Errorf("failed to write csv: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+func EncodeJSON(rows []*Row) (string, error) {
+	items := make([]*jsonRow, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, &jsonRow{
+			Date:   row.Date,
+			TaskID: row.TaskID,
+			Path:   strings.Join(row.Path, pathSeparator),
+			Hours:  hours(row.Duration),
+			Status: row.Status,
+		})
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal json: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// hours는 시간을 소수점 둘째 자리까지 반올림한다.
+func hours(d time.Duration) float64 {
+	const precision = 100
+
+	return float64((d*precision+time.Hour/2)/time.Hour) / precision
+}
@@ -0,0 +1,5 @@
+package timesheet
+
+import "errors"
+
+var ErrTaskNotFound = errors.New("task not found")
@@ -0,0 +1,228 @@
+package timesheet
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/trace"
+)
+
+const dateLayout = "2006-01-02"
+
+type Service struct {
+	flowService  *flow.Service
+	extraService *extra.Service
+	traceService *trace.Service
+}
+
+func NewService(flowService *flow.Service, extraService *extra.Service, traceService *trace.Service) *Service {
+	return &Service{
+		flowService:  flowService,
+		extraService: extraService,
+		traceService: traceService,
+	}
+}
+
+type interval struct {
+	traceID string
+	start   time.Time
+	end     time.Time
+}
+
+type entry struct {
+	date   string
+	taskID string
+}
+
+func (s *Service) Export(ctx context.Context, input *ExportInput) (*ExportOutput, error) { //nolint:cyclop,funlen
+	taskOut, err := s.flowService.ListTasks(ctx, &flow.ListTasksInput{
+		Username:  input.Username,
+		ParentID:  "",
+		Recursive: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	tasks := make(map[string]*flow.Task)
+	for _, task := range taskOut.Tasks {
+		tasks[task.ID] = task
+	}
+
+	if input.RootID != "" && tasks[input.RootID] == nil {
+		return nil, ErrTaskNotFound
+	}
+
+	var (
+		ids   []string
+		order = make(map[string]int)
+		paths = make(map[string][]string)
+	)
+
+	for idx, task := range taskOut.Tasks {
+		path, inSubtree := pathOf(tasks, task, input.RootID)
+		if !inSubtree || !hasTag(task.Title, input.Tag) {
+			continue
+		}
+
+		ids = append(ids, task.ID)
+		order[task.ID] = idx
+		paths[task.ID] = path
+	}
+
+	if len(ids) == 0 {
+		return &ExportOutput{Rows: nil}, nil
+	}
+
+	extraOut, err := s.extraService.ListExtras(ctx, &extra.ListExtrasInput{Username: input.Username, IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list extras: %w", err)
+	}
+
+	statuses := make(map[string]string)
+	for _, item := range extraOut.Extras {
+		statuses[item.ID] = item.Status
+	}
+
+	sessionOut, err := s.traceService.ListSessions(ctx, &trace.ListSessionsInput{Username: input.Username, IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	var intervals []*interval
+	for _, session := range sessionOut.Sessions {
+		intervals = append(intervals, &interval{
+			traceID: session.TraceID,
+			start:   session.StartedAt,
+			end:     session.EndedAt,
+		})
+	}
+
+	traceOut, err := s.traceService.ListTraces(ctx, &trace.ListTracesInput{Username: input.Username, IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list traces: %w", err)
+	}
+
+	// 진행 중인 task는 아직 session이 없으므로 지금까지의 시간을 더한다.
+	for _, item := range traceOut.Traces {
+		if item.StartedAt.IsZero() || !item.StartedAt.Before(input.Now) {
+			continue
+		}
+
+		intervals = append(intervals, &interval{
+			traceID: item.ID,
+			start:   item.StartedAt,
+			end:     input.Now,
+		})
+	}
+
+	location := input.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	durations := make(map[entry]time.Duration)
+	for _, item := range intervals {
+		splitByDay(item, input.From, input.To, location, func(date string, duration time.Duration) {
+			durations[entry{date: date, taskID: item.traceID}] += duration
+		})
+	}
+
+	var rows []*Row
+
+	for key, duration := range durations {
+		if input.Round > 0 {
+			duration = duration.Round(input.Round)
+		}
+
+		if duration <= 0 {
+			continue
+		}
+
+		rows = append(rows, &Row{
+			Date:     key.date,
+			TaskID:   key.taskID,
+			Path:     paths[key.taskID],
+			Duration: duration,
+			Status:   statuses[key.taskID],
+		})
+	}
+
+	slices.SortFunc(rows, func(a, b *Row) int {
+		if a.Date != b.Date {
+			return strings.Compare(a.Date, b.Date)
+		}
+
+		return order[a.TaskID] - order[b.TaskID]
+	})
+
+	return &ExportOutput{
+		Rows: rows,
+	}, nil
+}
+
+// pathOf는 최상위 task부터 task까지의 제목이며, rootID가 경로에 없으면 false이다.
+func pathOf(tasks map[string]*flow.Task, task *flow.Task, rootID string) ([]string, bool) {
+	var path []string
+
+	inSubtree := rootID == ""
+
+	for current := task; current != nil; current = tasks[current.ParentID] {
+		path = append(path, current.Title)
+
+		if current.ID == rootID {
+			inSubtree = true
+		}
+	}
+
+	slices.Reverse(path)
+
+	return path, inSubtree
+}
+
+func hasTag(title string, tag string) bool {
+	if tag == "" {
+		return true
+	}
+
+	return slices.Contains(strings.Fields(title), tag)
+}
+
+// splitByDay는 [from, to) 범위로 잘라낸 구간을 location 기준 날짜 경계로 나누어 yield에 전달한다.
+func splitByDay(
+	item *interval,
+	from time.Time,
+	to time.Time,
+	location *time.Location,
+	yield func(date string, duration time.Duration),
+) {
+	start := item.start
+	if !from.IsZero() && start.Before(from) {
+		start = from
+	}
+
+	end := item.end
+	if !to.IsZero() && end.After(to) {
+		end = to
+	}
+
+	for start.Before(end) {
+		local := start.In(location)
+		year, month, day := local.Date()
+		nextDay := time.Date(year, month, day+1, 0, 0, 0, 0, location)
+
+		cut := end
+		if nextDay.Before(end) {
+			cut = nextDay
+		}
+
+		yield(local.Format(dateLayout), cut.Sub(start))
+
+		start = cut
+	}
+}
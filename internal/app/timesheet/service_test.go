@@ -0,0 +1,207 @@
+package timesheet_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/apptest"
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/timesheet"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/stretchr/testify/require"
+)
+
+const username = "test"
+
+func newService(t *testing.T, repo apptest.Repository) (*timesheet.Service, *apptest.Services) {
+	t.Helper()
+
+	data := apptest.NewServices(t, repo, username)
+
+	return timesheet.NewService(data.Flow, data.Extra, data.Trace), data
+}
+
+func createTask(t *testing.T, data *apptest.Services, title string, parentID string) string {
+	t.Helper()
+
+	out, _ := data.Flow.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: username,
+		Title:    title,
+		ParentID: parentID,
+		NextID:   "",
+		Now:      time.Now(),
+	})
+
+	return out.ID
+}
+
+func work(t *testing.T, data *apptest.Services, id string, start time.Time, end time.Time, status domain.TaskStatus) {
+	t.Helper()
+
+	_ = data.Extra.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+		Username: username,
+		ID:       id,
+		Status:   string(domain.TaskStatusDoing),
+		Now:      start,
+		Force:    false,
+	})
+	_ = data.Extra.UpdateStatus(t.Context(), &extra.UpdateStatusInput{
+		Username: username,
+		ID:       id,
+		Status:   string(status),
+		Now:      end,
+		Force:    false,
+	})
+}
+
+func TestServiceExport(t *testing.T) {
+	t.Parallel()
+
+	for _, backend := range apptest.Backends() {
+		t.Run(backend.Name, func(t *testing.T) {
+			t.Parallel()
+
+			service, data := newService(t, backend.New(t))
+			day := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+			project := createTask(t, data, "client +acme", "")
+			task := createTask(t, data, "api", project)
+			work(t, data, task, day.Add(22*time.Hour), day.Add(25*time.Hour), domain.TaskStatusTodo)
+			work(t, data, task, day.Add(26*time.Hour), day.Add(26*time.Hour+30*time.Minute), domain.TaskStatusDone)
+
+			out, err := service.Export(t.Context(), &timesheet.ExportInput{
+				Username: username,
+				From:     time.Time{},
+				To:       time.Time{},
+				RootID:   "",
+				Tag:      "",
+				Round:    0,
+				Location: nil,
+				Now:      day.Add(48 * time.Hour),
+			})
+
+			require.NoError(t, err)
+			require.Len(t, out.Rows, 2)
+			require.Equal(t, "2025-05-05", out.Rows[0].Date)
+			require.Equal(t, []string{"client +acme", "api"}, out.Rows[0].Path)
+			require.Equal(t, 2*time.Hour, out.Rows[0].Duration)
+			require.Equal(t, string(domain.TaskStatusDone), out.Rows[0].Status)
+			require.Equal(t, "2025-05-06", out.Rows[1].Date)
+			require.Equal(t, 90*time.Minute, out.Rows[1].Duration)
+
+			csv, err := timesheet.EncodeCSV(out.Rows)
+
+			require.NoError(t, err)
+			require.Equal(t, "date,task_id,path,hours,status\n"+
+				"2025-05-05,"+task+",client +acme / api,2.00,done\n"+
+				"2025-05-06,"+task+",client +acme / api,1.50,done\n", csv)
+		})
+	}
+}
+
+func TestServiceExport_Filter(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	day := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+	setup := func(t *testing.T) (*timesheet.Service, map[string]string) {
+		t.Helper()
+
+		service, data := newService(t, apptest.NewMemory(t))
+		ids := make(map[string]string)
+		ids["acme"] = createTask(t, data, "client +acme", "")
+		ids["api"] = createTask(t, data, "api", ids["acme"])
+		ids["home"] = createTask(t, data, "laundry", "")
+		work(t, data, ids["api"], day.Add(9*time.Hour), day.Add(9*time.Hour+8*time.Minute), domain.TaskStatusTodo)
+		work(t, data, ids["home"], day.Add(33*time.Hour), day.Add(34*time.Hour), domain.TaskStatusTodo)
+		work(t, data, ids["acme"], day.Add(35*time.Hour), day.Add(36*time.Hour), domain.TaskStatusDoing)
+
+		return service, ids
+	}
+
+	t.Run("date range", func(t *testing.T) {
+		t.Parallel()
+
+		service, ids := setup(t)
+
+		out, err := service.Export(t.Context(), &timesheet.ExportInput{
+			Username: username,
+			From:     day.Add(24 * time.Hour),
+			To:       day.Add(48 * time.Hour),
+			RootID:   "",
+			Tag:      "",
+			Round:    0,
+			Location: nil,
+			Now:      day.Add(35*time.Hour + 30*time.Minute),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out.Rows, 2)
+		require.Equal(t, ids["acme"], out.Rows[0].TaskID)
+		require.Equal(t, 30*time.Minute, out.Rows[0].Duration)
+		require.Equal(t, ids["home"], out.Rows[1].TaskID)
+	})
+
+	t.Run("subtree", func(t *testing.T) {
+		t.Parallel()
+
+		service, ids := setup(t)
+
+		out, err := service.Export(t.Context(), &timesheet.ExportInput{
+			Username: username,
+			From:     time.Time{},
+			To:       time.Time{},
+			RootID:   ids["acme"],
+			Tag:      "",
+			Round:    15 * time.Minute,
+			Location: nil,
+			Now:      day.Add(36 * time.Hour),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out.Rows, 2)
+		require.Equal(t, ids["api"], out.Rows[0].TaskID)
+		require.Equal(t, 15*time.Minute, out.Rows[0].Duration)
+		require.Equal(t, ids["acme"], out.Rows[1].TaskID)
+	})
+
+	t.Run("tag", func(t *testing.T) {
+		t.Parallel()
+
+		service, ids := setup(t)
+
+		out, err := service.Export(t.Context(), &timesheet.ExportInput{
+			Username: username,
+			From:     time.Time{},
+			To:       time.Time{},
+			RootID:   "",
+			Tag:      "+acme",
+			Round:    6 * time.Minute,
+			Location: nil,
+			Now:      day.Add(36 * time.Hour),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out.Rows, 1)
+		require.Equal(t, ids["acme"], out.Rows[0].TaskID)
+		require.Equal(t, time.Hour, out.Rows[0].Duration)
+	})
+
+	t.Run("unknown root", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := setup(t)
+
+		_, err := service.Export(t.Context(), &timesheet.ExportInput{
+			Username: username,
+			From:     time.Time{},
+			To:       time.Time{},
+			RootID:   "unknown",
+			Tag:      "",
+			Round:    0,
+			Location: nil,
+			Now:      day,
+		})
+
+		require.ErrorIs(t, err, timesheet.ErrTaskNotFound)
+	})
+}
@@ -0,0 +1,26 @@
+package timesheet
+
+import "time"
+
+type ExportInput struct {
+	Username string
+	From     time.Time      // zero이면 하한을 두지 않는다
+	To       time.Time      // 이 시각 이전까지 포함한다. zero이면 상한을 두지 않는다
+	RootID   string         // 비어있지 않으면 해당 task와 그 하위 task만 포함한다
+	Tag      string         // 비어있지 않으면 제목에 해당 단어가 있는 task만 포함한다
+	Round    time.Duration  // 0이 아니면 각 행의 시간을 해당 단위로 반올림한다
+	Location *time.Location // 날짜를 나누는 기준 시간대. nil이면 UTC
+	Now      time.Time      // 진행 중인 task는 이 시각까지의 시간을 포함한다
+}
+
+type Row struct {
+	Date     string // YYYY-MM-DD
+	TaskID   string
+	Path     []string
+	Duration time.Duration
+	Status   string
+}
+
+type ExportOutput struct {
+	Rows []*Row
+}
@@ -0,0 +1,9 @@
+package todotxt
+
+import "errors"
+
+var (
+	ErrInvalidLine     = errors.New("invalid todo.txt line")
+	ErrCyclicParent    = errors.New("cyclic parent")
+	ErrInvalidEstimate = errors.New("invalid estimate")
+)
@@ -0,0 +1,138 @@
+package todotxt
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+var priorityPattern = regexp.MustCompile(`^\(([A-Z])\)$`)
+
+type Tag struct {
+	Key   string
+	Value string
+}
+
+// Item은 todo.txt의 한 줄이다. +project, @context, key:value 확장은 Description 안에 원래 순서대로 남는다.
+type Item struct {
+	Completed   bool
+	Priority    string
+	CompletedAt time.Time
+	CreatedAt   time.Time
+	Description string
+}
+
+func ParseItem(line string) (*Item, error) { //nolint:cyclop
+	tokens := strings.Fields(line)
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("%w: empty line", ErrInvalidLine)
+	}
+
+	var item Item
+
+	if tokens[0] == "x" {
+		item.Completed = true
+		tokens = tokens[1:]
+
+		if date, ok := parseDate(tokens); ok {
+			item.CompletedAt = date
+			tokens = tokens[1:]
+		}
+	}
+
+	if len(tokens) > 0 {
+		if matches := priorityPattern.FindStringSubmatch(tokens[0]); matches != nil {
+			item.Priority = matches[1]
+			tokens = tokens[1:]
+		}
+	}
+
+	if date, ok := parseDate(tokens); ok {
+		item.CreatedAt = date
+		tokens = tokens[1:]
+	}
+
+	item.Description = strings.Join(tokens, " ")
+	if item.Description == "" {
+		return nil, fmt.Errorf("%w: description is required: %q", ErrInvalidLine, line)
+	}
+
+	return &item, nil
+}
+
+func (i *Item) Tags() []Tag {
+	var ret []Tag
+
+	for token := range strings.FieldsSeq(i.Description) {
+		if tag, ok := parseTag(token); ok {
+			ret = append(ret, tag)
+		}
+	}
+
+	return ret
+}
+
+func (i *Item) Tag(key string) (string, bool) {
+	for _, tag := range i.Tags() {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+
+	return "", false
+}
+
+func (i *Item) String() string {
+	var parts []string
+
+	if i.Completed {
+		parts = append(parts, "x")
+
+		if !i.CompletedAt.IsZero() {
+			parts = append(parts, i.CompletedAt.Format(dateLayout))
+		}
+	}
+
+	if i.Priority != "" {
+		parts = append(parts, "("+i.Priority+")")
+	}
+
+	// 완료된 항목은 완료일이 있어야 생성일을 쓸 수 있다.
+	if !i.CreatedAt.IsZero() && (!i.Completed || !i.CompletedAt.IsZero()) {
+		parts = append(parts, i.CreatedAt.Format(dateLayout))
+	}
+
+	parts = append(parts, i.Description)
+
+	return strings.Join(parts, " ")
+}
+
+func parseDate(tokens []string) (time.Time, bool) {
+	if len(tokens) == 0 {
+		return time.Time{}, false
+	}
+
+	date, err := time.Parse(dateLayout, tokens[0])
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return date, true
+}
+
+func parseTag(token string) (Tag, bool) {
+	key, value, ok := strings.Cut(token, ":")
+	if !ok || key == "" || value == "" {
+		return Tag{}, false //nolint:exhaustruct
+	}
+
+	if strings.ContainsAny(key[:1], "+@") || strings.HasPrefix(value, "//") {
+		// +project:x, @context:x, URL은 태그가 아니다.
+		return Tag{}, false //nolint:exhaustruct
+	}
+
+	return Tag{Key: key, Value: value}, true
+}
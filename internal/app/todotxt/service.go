@@ -0,0 +1,397 @@
+package todotxt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/extra"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+const (
+	tagID       = "id"
+	tagParent   = "parent"
+	tagEstimate = "est"
+	tagPriority = "pri"
+)
+
+type Service struct {
+	flowService  *flow.Service
+	extraService *extra.Service
+	traceService *trace.Service
+}
+
+func NewService(flowService *flow.Service, extraService *extra.Service, traceService *trace.Service) *Service {
+	return &Service{
+		flowService:  flowService,
+		extraService: extraService,
+		traceService: traceService,
+	}
+}
+
+func (s *Service) Export(ctx context.Context, input *ExportInput) (*ExportOutput, error) {
+	taskOut, err := s.flowService.ListTasks(ctx, &flow.ListTasksInput{
+		Username:  input.Username,
+		ParentID:  "",
+		Recursive: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	tasks := taskOut.Tasks
+
+	var ids []string
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+
+	extraOut, err := s.extraService.ListExtras(ctx, &extra.ListExtrasInput{Username: input.Username, IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list extras: %w", err)
+	}
+
+	statuses := make(map[string]string)
+	for _, item := range extraOut.Extras {
+		statuses[item.ID] = item.Category
+	}
+
+	traceOut, err := s.traceService.ListTraces(ctx, &trace.ListTracesInput{Username: input.Username, IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list traces: %w", err)
+	}
+
+	estimates := make(map[string]time.Duration)
+	for _, item := range traceOut.Traces {
+		estimates[item.ID] = item.Estimated
+	}
+
+	var lines []string
+	for _, task := range tasks {
+		item := &Item{ //nolint:exhaustruct
+			CreatedAt:   task.CreatedAt,
+			Description: task.Title,
+		}
+
+		if statuses[task.ID] == string(domain.TaskStatusDone) {
+			// 완료된 항목은 todo.txt 관례대로 우선순위를 pri: 태그로 남긴다.
+			item.Completed = true
+			item.CompletedAt = input.Now
+		} else {
+			item.Description, item.Priority = splitPriority(task.Title)
+		}
+
+		item.Description += " " + tagID + ":" + task.ID
+		if task.ParentID != "" {
+			item.Description += " " + tagParent + ":" + task.ParentID
+		}
+
+		if estimates[task.ID] > 0 {
+			item.Description += " " + tagEstimate + ":" + estimates[task.ID].String()
+		}
+
+		lines = append(lines, item.String())
+	}
+
+	return &ExportOutput{
+		Lines: lines,
+	}, nil
+}
+
+func (s *Service) Import(ctx context.Context, input *ImportInput) (*ImportOutput, error) {
+	var (
+		pending []*Item
+		fileIDs = make(map[string]struct{})
+	)
+
+	for _, line := range input.Lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		item, err := ParseItem(line)
+		if err != nil {
+			return nil, err
+		}
+
+		if id, ok := item.Tag(tagID); ok {
+			fileIDs[id] = struct{}{}
+		}
+
+		pending = append(pending, item)
+	}
+
+	var (
+		output   ImportOutput
+		resolved = make(map[string]string)
+	)
+
+	for len(pending) > 0 {
+		var next []*Item
+
+		for _, item := range pending {
+			parentID, ok, err := s.resolveParent(ctx, input.Username, item, fileIDs, resolved)
+			if err != nil {
+				return nil, err
+			}
+
+			if !ok {
+				next = append(next, item)
+
+				continue
+			}
+
+			err = s.importItem(ctx, input, item, parentID, resolved, &output)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if len(next) == len(pending) {
+			return nil, ErrCyclicParent
+		}
+
+		pending = next
+	}
+
+	return &output, nil
+}
+
+// resolveParent는 항목의 부모를 찾고, 아직 만들어지지 않았으면 false를 반환한다.
+func (s *Service) resolveParent(
+	ctx context.Context,
+	username string,
+	item *Item,
+	fileIDs map[string]struct{},
+	resolved map[string]string,
+) (string, bool, error) {
+	parentID, ok := item.Tag(tagParent)
+	if !ok {
+		return "", true, nil
+	}
+
+	if id, ok := resolved[parentID]; ok {
+		return id, true, nil
+	}
+
+	_, err := s.flowService.GetTask(ctx, &flow.GetTaskInput{
+		Username: username,
+		TaskID:   parentID,
+	})
+	if err == nil {
+		return parentID, true, nil
+	}
+
+	if !errors.Is(err, flow.ErrTaskNotFound) {
+		return "", false, fmt.Errorf("failed to get parent task: %w", err)
+	}
+
+	if _, ok := fileIDs[parentID]; ok {
+		return "", false, nil
+	}
+
+	// 알 수 없는 부모는 최상위로 가져온다.
+	return "", true, nil
+}
+
+func (s *Service) importItem( //nolint:cyclop,funlen
+	ctx context.Context,
+	input *ImportInput,
+	item *Item,
+	parentID string,
+	resolved map[string]string,
+	output *ImportOutput,
+) error {
+	title := titleOf(item)
+	if title == "" {
+		return fmt.Errorf("%w: title is required: %q", ErrInvalidLine, item.String())
+	}
+
+	fileID, _ := item.Tag(tagID)
+
+	task, err := s.getTask(ctx, input.Username, fileID)
+	if err != nil {
+		return err
+	}
+
+	var id string
+
+	if task == nil {
+		createdAt := item.CreatedAt
+		if createdAt.IsZero() {
+			createdAt = input.Now
+		}
+
+		out, err := s.flowService.CreateTask(ctx, &flow.CreateTaskInput{
+			Username: input.Username,
+			Title:    title,
+			ParentID: parentID,
+			NextID:   "",
+			Now:      createdAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create task: %w", err)
+		}
+
+		id = out.ID
+		output.Created++
+	} else {
+		id = task.ID
+
+		nextID := task.NextID
+		if task.ParentID != parentID {
+			nextID = ""
+		}
+
+		if task.Title != title || task.ParentID != parentID {
+			err := s.flowService.UpdateTask(ctx, &flow.UpdateTaskInput{
+				Username: input.Username,
+				TaskID:   task.ID,
+				ParentID: parentID,
+				NextID:   nextID,
+				Title:    title,
+				Now:      input.Now,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update task: %w", err)
+			}
+		}
+
+		output.Updated++
+	}
+
+	if fileID != "" {
+		resolved[fileID] = id
+	}
+
+	err = s.updateStatus(ctx, input.Username, id, item.Completed, input.Now)
+	if err != nil {
+		return err
+	}
+
+	if value, ok := item.Tag(tagEstimate); ok {
+		estimated, err := time.ParseDuration(value)
+		if err != nil || estimated < 0 {
+			return fmt.Errorf("%w: %q", ErrInvalidEstimate, value)
+		}
+
+		err = s.traceService.SetEstimated(ctx, &trace.SetEstimatedInput{
+			Username:  input.Username,
+			ID:        id,
+			Estimated: estimated,
+			Now:       input.Now,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set estimated: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) getTask(ctx context.Context, username string, id string) (*flow.Task, error) {
+	if id == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	out, err := s.flowService.GetTask(ctx, &flow.GetTaskInput{
+		Username: username,
+		TaskID:   id,
+	})
+	if err != nil {
+		if errors.Is(err, flow.ErrTaskNotFound) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	return &out.Task, nil
+}
+
+func (s *Service) updateStatus(ctx context.Context, username, id string, completed bool, now time.Time) error {
+	out, err := s.extraService.ListExtras(ctx, &extra.ListExtrasInput{Username: username, IDs: []string{id}})
+	if err != nil {
+		return fmt.Errorf("failed to list extras: %w", err)
+	}
+
+	if len(out.Extras) == 0 {
+		return fmt.Errorf("failed to find extra: %w", extra.ErrExtraNotFound)
+	}
+
+	// 사용자 정의 상태는 완료 여부가 바뀔 때만 기본 상태로 바꾼다.
+	current := out.Extras[0]
+	status := current.Status
+	done := current.Category == string(domain.TaskStatusDone)
+
+	switch {
+	case completed && !done:
+		status = string(domain.TaskStatusDone)
+	case !completed && done:
+		status = string(domain.TaskStatusTodo)
+	}
+
+	err = s.extraService.UpdateStatus(ctx, &extra.UpdateStatusInput{
+		Username: username,
+		ID:       id,
+		Status:   status,
+		Now:      now,
+		Force:    false,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return nil
+}
+
+// titleOf는 서버가 관리하는 태그를 뺀 설명이다.
+func titleOf(item *Item) string {
+	var words []string
+
+	hasPriority := false
+
+	for token := range strings.FieldsSeq(item.Description) {
+		tag, ok := parseTag(token)
+		if ok && (tag.Key == tagID || tag.Key == tagParent || tag.Key == tagEstimate) {
+			continue
+		}
+
+		if ok && tag.Key == tagPriority {
+			hasPriority = true
+		}
+
+		words = append(words, token)
+	}
+
+	if item.Priority != "" && !hasPriority {
+		words = append(words, tagPriority+":"+item.Priority)
+	}
+
+	return strings.Join(words, " ")
+}
+
+func splitPriority(title string) (string, string) {
+	var (
+		words    []string
+		priority string
+	)
+
+	for token := range strings.FieldsSeq(title) {
+		tag, ok := parseTag(token)
+		if ok && tag.Key == tagPriority && priority == "" && priorityPattern.MatchString("("+tag.Value+")") {
+			priority = tag.Value
+
+			continue
+		}
+
+		words = append(words, token)
+	}
+
+	return strings.Join(words, " "), priority
+}
@@ -0,0 +1,180 @@
+package todotxt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/apptest"
+	"github.com/neatflowcv/focus/internal/app/flow"
+	"github.com/neatflowcv/focus/internal/app/todotxt"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/repository/memory"
+	"github.com/stretchr/testify/require"
+)
+
+const username = "test"
+
+func newService(t *testing.T, repo apptest.Repository) (*todotxt.Service, *apptest.Services) {
+	t.Helper()
+
+	data := apptest.NewServices(t, repo, username)
+
+	return todotxt.NewService(data.Flow, data.Extra, data.Trace), data
+}
+
+func findTask(t *testing.T, repo *memory.Repository, title string) domain.TaskID {
+	t.Helper()
+
+	for id, task := range repo.Tasks[username] {
+		if task.Title() == title {
+			return id
+		}
+	}
+
+	t.Fatalf("task %q not found", title)
+
+	return ""
+}
+
+func TestServiceExport(t *testing.T) {
+	t.Parallel()
+
+	repo := memory.NewRepository()
+	service, data := newService(t, repo)
+	createdAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	parent, _ := data.Flow.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: username,
+		Title:    "write report +work pri:A",
+		ParentID: "",
+		NextID:   "",
+		Now:      createdAt,
+	})
+	child, _ := data.Flow.CreateTask(t.Context(), &flow.CreateTaskInput{
+		Username: username,
+		Title:    "collect data @desk",
+		ParentID: parent.ID,
+		NextID:   "",
+		Now:      createdAt,
+	})
+	repo.Extras[username][domain.ExtraID(child.ID)] = repo.Extras[username][domain.ExtraID(child.ID)].
+		SetStatus(domain.TaskStatusDone, domain.TaskStatusDone, createdAt)
+	repo.Traces[username][domain.TraceID(child.ID)] = repo.Traces[username][domain.TraceID(child.ID)].
+		SetEstimated(90 * time.Minute)
+
+	out, err := service.Export(t.Context(), &todotxt.ExportInput{
+		Username: username,
+		Now:      time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"(A) 2025-03-01 write report +work id:" + parent.ID,
+		"x 2025-03-02 2025-03-01 collect data @desk id:" + child.ID + " parent:" + parent.ID + " est:1h30m0s",
+	}, out.Lines)
+}
+
+func TestServiceImport(t *testing.T) {
+	t.Parallel()
+
+	repo := memory.NewRepository()
+	service, _ := newService(t, repo)
+
+	out, err := service.Import(t.Context(), &todotxt.ImportInput{
+		Username: username,
+		Lines: []string{
+			"x 2025-03-02 2025-03-01 buy milk @shop id:b parent:a est:15m",
+			"",
+			"(B) 2025-02-28 groceries +home id:a due:2025-03-05",
+		},
+		Now: time.Now(),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 2, out.Created)
+
+	parentID := findTask(t, repo, "groceries +home due:2025-03-05 pri:B")
+	childID := findTask(t, repo, "buy milk @shop")
+	require.Equal(t, parentID, repo.Tasks[username][childID].ParentID())
+	require.Equal(t, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), repo.Tasks[username][parentID].CreatedAt())
+	require.Equal(t, domain.TaskStatusDone, repo.Extras[username][domain.ExtraID(childID)].Status())
+	require.Equal(t, 15*time.Minute, repo.Traces[username][domain.TraceID(childID)].Estimated())
+}
+
+func TestServiceImport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, backend := range apptest.Backends() {
+		t.Run(backend.Name, func(t *testing.T) {
+			t.Parallel()
+
+			service, data := newService(t, backend.New(t))
+			now := time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)
+			_, _ = service.Import(t.Context(), &todotxt.ImportInput{
+				Username: username,
+				Lines: []string{
+					"(A) 2025-03-01 plan trip +travel id:trip",
+					"x 2025-03-02 2025-03-01 book flight id:flight parent:trip est:30m",
+					"2025-03-01 book hotel pri:C id:hotel parent:trip",
+				},
+				Now: now,
+			})
+			exported, _ := service.Export(t.Context(), &todotxt.ExportInput{Username: username, Now: now})
+
+			out, err := service.Import(t.Context(), &todotxt.ImportInput{
+				Username: username,
+				Lines:    exported.Lines,
+				Now:      now,
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, 0, out.Created)
+			require.Equal(t, 3, out.Updated)
+
+			tasks, err := data.Flow.ListTasks(t.Context(), &flow.ListTasksInput{
+				Username:  username,
+				ParentID:  "",
+				Recursive: true,
+			})
+			require.NoError(t, err)
+			require.Len(t, tasks.Tasks, 3)
+
+			again, _ := service.Export(t.Context(), &todotxt.ExportInput{Username: username, Now: now})
+			require.Equal(t, exported.Lines, again.Lines)
+		})
+	}
+}
+
+func TestServiceImport_Error(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cyclic parent", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newService(t, apptest.NewMemory(t))
+
+		_, err := service.Import(t.Context(), &todotxt.ImportInput{
+			Username: username,
+			Lines: []string{
+				"first id:a parent:b",
+				"second id:b parent:a",
+			},
+			Now: time.Now(),
+		})
+
+		require.ErrorIs(t, err, todotxt.ErrCyclicParent)
+	})
+
+	t.Run("invalid estimate", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newService(t, apptest.NewMemory(t))
+
+		_, err := service.Import(t.Context(), &todotxt.ImportInput{
+			Username: username,
+			Lines:    []string{"task est:soon"},
+			Now:      time.Now(),
+		})
+
+		require.ErrorIs(t, err, todotxt.ErrInvalidEstimate)
+	})
+}
@@ -0,0 +1,23 @@
+package todotxt
+
+import "time"
+
+type ExportInput struct {
+	Username string
+	Now      time.Time
+}
+
+type ExportOutput struct {
+	Lines []string
+}
+
+type ImportInput struct {
+	Username string
+	Lines    []string
+	Now      time.Time
+}
+
+type ImportOutput struct {
+	Created int
+	Updated int
+}
@@ -0,0 +1,41 @@
+package trace
+
+import "github.com/neatflowcv/focus/internal/pkg/domain"
+
+// changeSet은 한 번에 저장할 trace 변경을 처음 바뀐 순서대로 모은다.
+type changeSet struct {
+	traces map[domain.TraceID]*domain.Trace
+	before map[domain.TraceID]*domain.Trace // 처음 바꾸기 전의 trace
+	order  []domain.TraceID
+
+	deleted domain.TraceID // 함께 지울 trace이며 자식으로 세지 않는다
+}
+
+func newChangeSet() *changeSet {
+	return &changeSet{
+		traces: make(map[domain.TraceID]*domain.Trace),
+		before: make(map[domain.TraceID]*domain.Trace),
+		order:  nil,
+
+		deleted: "",
+	}
+}
+
+// put은 처음의 before를 유지하며 after를 모은다.
+func (c *changeSet) put(before *domain.Trace, after *domain.Trace) {
+	if _, ok := c.traces[after.ID()]; !ok {
+		c.order = append(c.order, after.ID())
+		c.before[after.ID()] = before
+	}
+
+	c.traces[after.ID()] = after
+}
+
+func (c *changeSet) list() []*domain.Trace {
+	var ret []*domain.Trace
+	for _, id := range c.order {
+		ret = append(ret, c.traces[id])
+	}
+
+	return ret
+}
@@ -5,4 +5,9 @@ import "errors"
 var (
 	ErrTraceNotFound       = errors.New("trace not found")
 	ErrParentTraceNotFound = errors.New("parent trace not found")
+	ErrStopBeforeStart     = errors.New("stop time is before start time")
+	ErrSessionNotFound     = errors.New("session not found")
+	ErrInvalidSession      = errors.New("invalid session")
+	ErrSessionOverlaps     = errors.New("session overlaps another session")
+	ErrOverlapsRunning     = errors.New("session overlaps the running timer")
 )
@@ -0,0 +1,126 @@
+package trace
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+// rebuildPlan은 저장된 trace를 작업 트리에 맞추기 위해 저장할 변경이다.
+type rebuildPlan struct {
+	created []*domain.Trace
+	updated []*domain.Trace
+	deleted []*domain.Trace
+	orphans []*domain.Session // trace가 이미 없는 구간
+	changes []*Change
+}
+
+func newRebuildPlan( //nolint:cyclop,funlen
+	stored []*domain.Trace,
+	sessions []*domain.Session,
+	nodes []*Node,
+) *rebuildPlan {
+	plan := &rebuildPlan{
+		created: nil,
+		updated: nil,
+		deleted: nil,
+		orphans: nil,
+		changes: nil,
+	}
+
+	current := make(map[domain.TraceID]*domain.Trace, len(stored))
+	for _, trace := range stored {
+		current[trace.ID()] = trace
+	}
+
+	work := make(map[domain.TraceID]*domain.Trace, len(nodes))
+	children := make(map[domain.TraceID][]domain.TraceID)
+
+	for _, node := range nodes {
+		id := domain.TraceID(node.ID)
+		parentID := domain.TraceID(node.ParentID)
+		children[parentID] = append(children[parentID], id)
+
+		trace, ok := current[id]
+
+		switch {
+		case !ok:
+			trace = domain.NewTrace(id, parentID, 0, 0, 0, time.Time{}, time.Time{})
+			plan.add(id, ChangeCreate, "", string(parentID))
+
+		case trace.ParentID() != parentID:
+			plan.add(id, ChangeReparent, string(trace.ParentID()), string(parentID))
+			trace = trace.SetParentID(parentID)
+		}
+
+		work[id] = trace
+	}
+
+	orphans := slices.SortedFunc(slices.Values(stored), func(a, b *domain.Trace) int {
+		return strings.Compare(string(a.ID()), string(b.ID()))
+	})
+	for _, trace := range orphans {
+		if _, ok := work[trace.ID()]; ok {
+			continue
+		}
+
+		plan.deleted = append(plan.deleted, trace)
+		plan.add(trace.ID(), ChangeDelete, string(trace.ParentID()), "")
+	}
+
+	// 지울 trace의 구간은 DeleteTrace가 함께 지운다.
+	for _, session := range sessions {
+		if _, ok := current[session.TraceID()]; ok {
+			continue
+		}
+
+		plan.orphans = append(plan.orphans, session)
+		plan.add(session.TraceID(), ChangeDeleteSession, string(session.ID()), "")
+	}
+
+	// 자식의 actual이 먼저 정해져야 하므로 역순으로 계산한다.
+	for i := len(nodes) - 1; i >= 0; i-- {
+		id := domain.TraceID(nodes[i].ID)
+
+		actual := work[id].Self()
+		for _, child := range children[id] {
+			actual += work[child].Actual()
+		}
+
+		if actual != work[id].Actual() {
+			plan.add(id, ChangeActual, work[id].Actual().String(), actual.String())
+			work[id] = work[id].SetActual(actual)
+		}
+	}
+
+	for _, node := range nodes {
+		id := domain.TraceID(node.ID)
+		trace := work[id]
+
+		original, ok := current[id]
+		if !ok {
+			plan.created = append(plan.created, trace)
+
+			continue
+		}
+
+		if trace.ParentID() == original.ParentID() && trace.Actual() == original.Actual() {
+			continue
+		}
+
+		plan.updated = append(plan.updated, trace)
+	}
+
+	return plan
+}
+
+func (p *rebuildPlan) add(id domain.TraceID, kind string, from string, to string) {
+	p.changes = append(p.changes, &Change{
+		ID:   string(id),
+		Kind: kind,
+		From: from,
+		To:   to,
+	})
+}
@@ -5,31 +5,44 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/neatflowcv/focus/internal/pkg/idmaker"
 	"github.com/neatflowcv/focus/internal/pkg/repository"
 )
 
 type Service struct {
-	repo repository.TraceRepository
+	bus     *eventbus.Bus
+	idmaker idmaker.IDMaker
+	repo    repository.TraceRepository
 }
 
-func NewService(repo repository.TraceRepository) *Service {
-	return &Service{repo: repo}
+func NewService(bus *eventbus.Bus, idmaker idmaker.IDMaker, repo repository.TraceRepository) *Service {
+	return &Service{
+		bus:     bus,
+		idmaker: idmaker,
+		repo:    repo,
+	}
+}
+
+// InitialTrace는 task가 만들어질 때 CreateTrace가 저장하는 trace이다.
+func InitialTrace(id string) *Trace {
+	return toTrace(newTrace(domain.TraceID(id), ""))
 }
 
 func (s *Service) CreateTrace(ctx context.Context, input *CreateTraceInput) error {
-	trace := domain.NewTrace(
-		domain.TraceID(input.ID),
-		domain.TraceID(input.ParentID),
-		time.Duration(0),
-		time.Duration(0),
-		time.Time{},
-	)
+	trace := newTrace(domain.TraceID(input.ID), domain.TraceID(input.ParentID))
 
-	err := s.repo.CreateTrace(ctx, trace)
+	err := s.repo.CreateTrace(ctx, input.Username, trace)
 	if err != nil {
+		// 같은 이벤트가 다시 전달될 수 있으므로 이미 있으면 성공으로 본다.
+		if errors.Is(err, repository.ErrTraceAlreadyExists) {
+			return nil
+		}
+
 		return fmt.Errorf("failed to create trace: %w", err)
 	}
 
@@ -37,7 +50,7 @@ func (s *Service) CreateTrace(ctx context.Context, input *CreateTraceInput) erro
 }
 
 func (s *Service) DeleteTrace(ctx context.Context, input *DeleteTraceInput) error {
-	trace, err := s.repo.GetTrace(ctx, domain.TraceID(input.ID))
+	trace, err := s.repo.GetTrace(ctx, input.Username, domain.TraceID(input.ID))
 	if err != nil {
 		if errors.Is(err, repository.ErrTraceNotFound) {
 			return ErrTraceNotFound
@@ -46,72 +59,118 @@ func (s *Service) DeleteTrace(ctx context.Context, input *DeleteTraceInput) erro
 		return fmt.Errorf("failed to get trace: %w", err)
 	}
 
-	var updates []*domain.Trace
+	changes := newChangeSet()
+	changes.deleted = trace.ID()
 
-	parentID := trace.ParentID()
-	for parentID != "" {
-		parent, err := s.repo.GetTrace(ctx, parentID)
-		if err != nil {
-			return fmt.Errorf("failed to get trace: %w", err)
+	err = s.rollUp(ctx, input.Username, changes, trace.ParentID())
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteTrace(ctx, input.Username, trace, changes.list()...)
+	if err != nil {
+		if errors.Is(err, repository.ErrTraceNotFound) {
+			return ErrTraceNotFound
 		}
 
-		update := parent.SetActual(parent.Actual() - trace.Actual())
-		updates = append(updates, update)
+		return fmt.Errorf("failed to delete trace: %w", err)
+	}
 
-		parentID = parent.ParentID()
+	return s.publishTimes(ctx, input.Username, changes, input.Now)
+}
+
+func (s *Service) SetEstimated(ctx context.Context, input *SetEstimatedInput) error {
+	trace, err := s.repo.GetTrace(ctx, input.Username, domain.TraceID(input.ID))
+	if err != nil {
+		if errors.Is(err, repository.ErrTraceNotFound) {
+			return ErrTraceNotFound
+		}
+
+		return fmt.Errorf("failed to get trace: %w", err)
 	}
 
-	err = s.repo.UpdateTraces(ctx, updates...)
+	if trace.Estimated() == input.Estimated {
+		return nil
+	}
+
+	err = s.repo.UpdateTraces(ctx, input.Username, trace.SetEstimated(input.Estimated))
 	if err != nil {
 		return fmt.Errorf("failed to update trace: %w", err)
 	}
 
-	err = s.repo.DeleteTrace(ctx, trace)
+	err = s.bus.TraceEstimateUpdated.Publish(ctx, &eventbus.TraceEstimateUpdatedEvent{
+		Version:      eventbus.TraceEstimateUpdatedVersion,
+		Username:     input.Username,
+		TraceID:      input.ID,
+		OldEstimated: trace.Estimated(),
+		NewEstimated: input.Estimated,
+		Now:          input.Now,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to delete trace: %w", err)
+		return fmt.Errorf("failed to publish estimate updated: %w", err)
 	}
 
 	return nil
 }
 
-func (s *Service) SetActual(ctx context.Context, input *SetActualInput) error {
-	trace, err := s.repo.GetTrace(ctx, domain.TraceID(input.ID))
+func (s *Service) SetDueAt(ctx context.Context, input *SetDueAtInput) error {
+	trace, err := s.repo.GetTrace(ctx, input.Username, domain.TraceID(input.ID))
 	if err != nil {
 		if errors.Is(err, repository.ErrTraceNotFound) {
 			return ErrTraceNotFound
 		}
+
+		return fmt.Errorf("failed to get trace: %w", err)
 	}
 
-	var updates []*domain.Trace
+	if trace.DueAt().Equal(input.DueAt) {
+		return nil
+	}
 
-	update := trace.SetActual(input.Actual)
-	updates = append(updates, update)
+	err = s.repo.UpdateTraces(ctx, input.Username, trace.SetDueAt(input.DueAt))
+	if err != nil {
+		return fmt.Errorf("failed to update trace: %w", err)
+	}
 
-	diff := input.Actual - trace.Actual()
+	err = s.bus.TraceDueAtUpdated.Publish(ctx, &eventbus.TraceDueAtUpdatedEvent{
+		Version:  eventbus.TraceDueAtUpdatedVersion,
+		Username: input.Username,
+		TraceID:  input.ID,
+		OldDueAt: trace.DueAt(),
+		NewDueAt: input.DueAt,
+		Now:      input.Now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish due date updated: %w", err)
+	}
 
-	parentID := trace.ParentID()
-	for parentID != "" {
-		parent, err := s.repo.GetTrace(ctx, parentID)
-		if err != nil {
-			return fmt.Errorf("failed to get trace: %w", err)
-		}
+	return nil
+}
 
-		update = parent.SetActual(parent.Actual() + diff)
-		updates = append(updates, update)
+// SetActual은 trace 자신에 기록된 시간을 바꾸고 상위 trace의 actual을 다시 계산한다.
+func (s *Service) SetActual(ctx context.Context, input *SetActualInput) error {
+	trace, err := s.repo.GetTrace(ctx, input.Username, domain.TraceID(input.ID))
+	if err != nil {
+		if errors.Is(err, repository.ErrTraceNotFound) {
+			return ErrTraceNotFound
+		}
 
-		parentID = parent.ParentID()
+		return fmt.Errorf("failed to get trace: %w", err)
 	}
 
-	err = s.repo.UpdateTraces(ctx, updates...)
+	changes := newChangeSet()
+	changes.put(trace, trace.SetSelf(input.Actual))
+
+	err = s.rollUp(ctx, input.Username, changes, trace.ID())
 	if err != nil {
-		return fmt.Errorf("failed to update trace: %w", err)
+		return err
 	}
 
-	return nil
+	return s.save(ctx, input.Username, changes, input.Now)
 }
 
-func (s *Service) UpdateParent(ctx context.Context, input *UpdateParentInput) error { //nolint:cyclop,funlen
-	trace, err := s.repo.GetTrace(ctx, domain.TraceID(input.ID))
+func (s *Service) UpdateParent(ctx context.Context, input *UpdateParentInput) error {
+	trace, err := s.repo.GetTrace(ctx, input.Username, domain.TraceID(input.ID))
 	if err != nil {
 		if errors.Is(err, repository.ErrTraceNotFound) {
 			return ErrTraceNotFound
@@ -121,80 +180,51 @@ func (s *Service) UpdateParent(ctx context.Context, input *UpdateParentInput) er
 	}
 
 	if input.ParentID != "" {
-		_, err := s.repo.GetTrace(ctx, domain.TraceID(input.ParentID))
+		_, err := s.repo.GetTrace(ctx, input.Username, domain.TraceID(input.ParentID))
 		if err != nil {
 			if errors.Is(err, repository.ErrTraceNotFound) {
 				return ErrParentTraceNotFound
 			}
-		}
-	}
-
-	score := s.taskScore(ctx, trace)
-
-	var updates []*domain.Trace
-
-	update := trace.SetParentID(domain.TraceID(input.ParentID))
-	updates = append(updates, update)
-
-	if score > 0 {
-		oldParents, err := s.findAncestors(ctx, trace.ParentID())
-		if err != nil {
-			return err
-		}
-
-		newParents, err := s.findAncestors(ctx, update.ParentID())
-		if err != nil {
-			return err
-		}
-
-		pivot := 0
-		for pivot < len(oldParents) && pivot < len(newParents) {
-			if oldParents[pivot].ID() != newParents[pivot].ID() {
-				break
-			}
 
-			pivot++
+			return fmt.Errorf("failed to get parent trace: %w", err)
 		}
+	}
 
-		idx := pivot
-
-		for idx < len(oldParents) {
-			oldUpdate := oldParents[idx].SetActual(oldParents[idx].Actual() - score)
-			updates = append(updates, oldUpdate)
-			idx++
-		}
+	changes := newChangeSet()
+	changes.put(trace, trace.SetParentID(domain.TraceID(input.ParentID)))
 
-		idx = pivot
-		for idx < len(newParents) {
-			newUpdate := newParents[idx].SetActual(newParents[idx].Actual() + score)
-			updates = append(updates, newUpdate)
-			idx++
-		}
+	err = s.rollUp(ctx, input.Username, changes, trace.ParentID())
+	if err != nil {
+		return err
 	}
 
-	err = s.repo.UpdateTraces(ctx, updates...)
+	err = s.rollUp(ctx, input.Username, changes, domain.TraceID(input.ParentID))
 	if err != nil {
-		return fmt.Errorf("failed to update trace: %w", err)
+		return err
 	}
 
-	return nil
+	return s.save(ctx, input.Username, changes, input.Now)
 }
 
 func (s *Service) UpdateStatus(ctx context.Context, input *UpdateStatusInput) error {
-	trace, err := s.repo.GetTrace(ctx, domain.TraceID(input.ID))
+	trace, err := s.repo.GetTrace(ctx, input.Username, domain.TraceID(input.ID))
 	if err != nil {
+		if errors.Is(err, repository.ErrTraceNotFound) {
+			return ErrTraceNotFound
+		}
+
 		return fmt.Errorf("failed to get trace: %w", err)
 	}
 
 	switch domain.TaskStatus(input.Status) {
 	case domain.TaskStatusDoing:
-		err := s.startTrace(ctx, trace, input.Now)
+		err := s.startTrace(ctx, input.Username, trace, input.Now)
 		if err != nil {
 			return err
 		}
 
 	case domain.TaskStatusDone, domain.TaskStatusTodo:
-		err := s.stopTrace(ctx, trace, input.Now)
+		err := s.stopTrace(ctx, input.Username, trace, input.Now, false)
 		if err != nil {
 			return err
 		}
@@ -203,24 +233,38 @@ func (s *Service) UpdateStatus(ctx context.Context, input *UpdateStatusInput) er
 	return nil
 }
 
+// StopTrace는 실행 중인 trace를 At 시점에 멈춘다. 이미 멈춘 trace는 무시한다.
+func (s *Service) StopTrace(ctx context.Context, input *StopTraceInput) error {
+	trace, err := s.repo.GetTrace(ctx, input.Username, domain.TraceID(input.ID))
+	if err != nil {
+		if errors.Is(err, repository.ErrTraceNotFound) {
+			return ErrTraceNotFound
+		}
+
+		return fmt.Errorf("failed to get trace: %w", err)
+	}
+
+	if !trace.StartedAt().IsZero() && input.At.Before(trace.StartedAt()) {
+		return ErrStopBeforeStart
+	}
+
+	return s.stopTrace(ctx, input.Username, trace, input.At, input.Auto)
+}
+
 func (s *Service) ListTraces(ctx context.Context, input *ListTracesInput) (*ListTracesOutput, error) {
 	var ids []domain.TraceID
 	for _, id := range input.IDs {
 		ids = append(ids, domain.TraceID(id))
 	}
 
-	traces, err := s.repo.ListTraces(ctx, ids)
+	traces, err := s.repo.ListTraces(ctx, input.Username, ids)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list traces: %w", err)
 	}
 
 	var items []*Trace
 	for _, trace := range traces {
-		items = append(items, &Trace{
-			Estimated: trace.Estimated(),
-			Actual:    trace.Actual(),
-			StartedAt: trace.StartedAt(),
-		})
+		items = append(items, toTrace(trace))
 	}
 
 	return &ListTracesOutput{
@@ -228,7 +272,373 @@ func (s *Service) ListTraces(ctx context.Context, input *ListTracesInput) (*List
 	}, nil
 }
 
-func (s *Service) startTrace(ctx context.Context, trace *domain.Trace, now time.Time) error {
+func (s *Service) ListSessions(ctx context.Context, input *ListSessionsInput) (*ListSessionsOutput, error) {
+	var ids []domain.TraceID
+	for _, id := range input.IDs {
+		ids = append(ids, domain.TraceID(id))
+	}
+
+	sessions, err := s.repo.ListSessions(ctx, input.Username, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	var items []*Session
+	for _, session := range sessions {
+		items = append(items, toSession(session))
+	}
+
+	return &ListSessionsOutput{
+		Sessions: items,
+	}, nil
+}
+
+// Reconcile은 사용자의 모든 trace의 actual을 self와 하위 trace의 self 합으로 다시 계산해 저장된 값과 비교한다.
+func (s *Service) Reconcile(ctx context.Context, input *ReconcileInput) (*ReconcileOutput, error) {
+	traces, err := s.repo.ListAllTraces(ctx, input.Username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list all traces: %w", err)
+	}
+
+	children := make(map[domain.TraceID][]*domain.Trace)
+	for _, trace := range traces {
+		children[trace.ParentID()] = append(children[trace.ParentID()], trace)
+	}
+
+	expected := make(map[domain.TraceID]time.Duration)
+
+	var sum func(trace *domain.Trace) time.Duration
+
+	sum = func(trace *domain.Trace) time.Duration {
+		if value, ok := expected[trace.ID()]; ok {
+			return value
+		}
+
+		value := trace.Self()
+		for _, child := range children[trace.ID()] {
+			value += sum(child)
+		}
+
+		expected[trace.ID()] = value
+
+		return value
+	}
+
+	var (
+		mismatches []*Mismatch
+		updates    []*domain.Trace
+	)
+
+	for _, trace := range traces {
+		value := sum(trace)
+		if value == trace.Actual() {
+			continue
+		}
+
+		mismatches = append(mismatches, &Mismatch{
+			ID:       string(trace.ID()),
+			Stored:   trace.Actual(),
+			Expected: value,
+		})
+		updates = append(updates, trace.SetActual(value))
+	}
+
+	slices.SortFunc(mismatches, func(a, b *Mismatch) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	if input.Fix && len(updates) > 0 {
+		err := s.repo.UpdateTraces(ctx, input.Username, updates...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update traces: %w", err)
+		}
+	}
+
+	return &ReconcileOutput{
+		Mismatches: mismatches,
+	}, nil
+}
+
+// Rebuild는 작업 트리로부터 모든 trace의 parent와 actual을 다시 계산하고 트리에 없는 trace는 지운다.
+func (s *Service) Rebuild(ctx context.Context, input *RebuildInput) (*RebuildOutput, error) {
+	stored, err := s.repo.ListAllTraces(ctx, input.Username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list all traces: %w", err)
+	}
+
+	sessions, err := s.repo.ListAllSessions(ctx, input.Username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list all sessions: %w", err)
+	}
+
+	plan := newRebuildPlan(stored, sessions, input.Nodes)
+
+	if !input.DryRun {
+		err := s.applyPlan(ctx, input.Username, plan)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &RebuildOutput{
+		Created: len(plan.created),
+		Updated: len(plan.updated),
+		Deleted: len(plan.deleted) + len(plan.orphans),
+		Changes: plan.changes,
+	}, nil
+}
+
+func (s *Service) applyPlan(ctx context.Context, username string, plan *rebuildPlan) error {
+	for _, trace := range plan.created {
+		err := s.repo.CreateTrace(ctx, username, trace)
+		if err != nil {
+			return fmt.Errorf("failed to create trace: %w", err)
+		}
+	}
+
+	if len(plan.updated) > 0 {
+		err := s.repo.UpdateTraces(ctx, username, plan.updated...)
+		if err != nil {
+			return fmt.Errorf("failed to update traces: %w", err)
+		}
+	}
+
+	for _, trace := range plan.deleted {
+		err := s.repo.DeleteTrace(ctx, username, trace)
+		if err != nil {
+			return fmt.Errorf("failed to delete trace: %w", err)
+		}
+	}
+
+	for _, session := range plan.orphans {
+		err := s.repo.DeleteSession(ctx, username, session)
+		if err != nil {
+			return fmt.Errorf("failed to delete session: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// AddSession은 사용자가 직접 입력한 구간을 기록하고 그만큼 trace 자신의 시간을 늘린다.
+func (s *Service) AddSession(ctx context.Context, input *AddSessionInput) (*AddSessionOutput, error) {
+	trace, err := s.repo.GetTrace(ctx, input.Username, domain.TraceID(input.TraceID))
+	if err != nil {
+		if errors.Is(err, repository.ErrTraceNotFound) {
+			return nil, ErrTraceNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get trace: %w", err)
+	}
+
+	err = checkSession(trace, input.StartedAt, input.EndedAt, input.Now)
+	if err != nil {
+		return nil, err
+	}
+
+	session := domain.NewSession(
+		domain.SessionID(s.idmaker.MakeID()),
+		trace.ID(),
+		input.StartedAt,
+		input.EndedAt,
+		false,
+		input.Note,
+	)
+
+	changes, err := s.addSelf(ctx, input.Username, trace, session.Duration())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.commitSession(ctx, input.Username, changes, &repository.SessionChange{
+		Create: []*domain.Session{session},
+		Update: nil,
+		Delete: nil,
+		Traces: nil,
+	}, input.Now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddSessionOutput{
+		Session: toSession(session),
+	}, nil
+}
+
+func (s *Service) GetSession(ctx context.Context, input *GetSessionInput) (*GetSessionOutput, error) {
+	session, err := s.repo.GetSession(ctx, input.Username, domain.SessionID(input.ID))
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil, ErrSessionNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	return &GetSessionOutput{
+		Session: toSession(session),
+	}, nil
+}
+
+// UpdateSession은 기록된 구간을 고치고 달라진 만큼 trace 자신의 시간을 바꾼다.
+func (s *Service) UpdateSession(ctx context.Context, input *UpdateSessionInput) (*UpdateSessionOutput, error) {
+	session, err := s.repo.GetSession(ctx, input.Username, domain.SessionID(input.ID))
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil, ErrSessionNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	trace, err := s.getSessionTrace(ctx, input.Username, session)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkSession(trace, input.StartedAt, input.EndedAt, input.Now)
+	if err != nil {
+		return nil, err
+	}
+
+	update := session
+	if !session.StartedAt().Equal(input.StartedAt) || !session.EndedAt().Equal(input.EndedAt) {
+		update = update.SetRange(input.StartedAt, input.EndedAt)
+	}
+
+	update = update.SetNote(input.Note)
+
+	changes, err := s.addSelf(ctx, input.Username, trace, update.Duration()-session.Duration())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.commitSession(ctx, input.Username, changes, &repository.SessionChange{
+		Create: nil,
+		Update: []*domain.Session{update},
+		Delete: nil,
+		Traces: nil,
+	}, input.Now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateSessionOutput{
+		Session: toSession(update),
+	}, nil
+}
+
+// DeleteSession은 기록된 구간을 지우고 그만큼 trace 자신의 시간을 줄인다.
+func (s *Service) DeleteSession(ctx context.Context, input *DeleteSessionInput) error {
+	session, err := s.repo.GetSession(ctx, input.Username, domain.SessionID(input.ID))
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return ErrSessionNotFound
+		}
+
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+
+	trace, err := s.getSessionTrace(ctx, input.Username, session)
+	if err != nil {
+		return err
+	}
+
+	changes, err := s.addSelf(ctx, input.Username, trace, -session.Duration())
+	if err != nil {
+		return err
+	}
+
+	return s.commitSession(ctx, input.Username, changes, &repository.SessionChange{
+		Create: nil,
+		Update: nil,
+		Delete: []*domain.Session{session},
+		Traces: nil,
+	}, input.Now)
+}
+
+// getSessionTrace는 구간의 trace를 찾는다. trace가 지워졌으면 구간도 없는 것으로 본다.
+func (s *Service) getSessionTrace(
+	ctx context.Context,
+	username string,
+	session *domain.Session,
+) (*domain.Trace, error) {
+	trace, err := s.repo.GetTrace(ctx, username, session.TraceID())
+	if err != nil {
+		if errors.Is(err, repository.ErrTraceNotFound) {
+			return nil, ErrSessionNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get trace: %w", err)
+	}
+
+	return trace, nil
+}
+
+// checkSession은 구간이 올바르고 실행 중인 타이머와 겹치지 않는지 확인한다.
+// 같은 trace의 다른 구간과 겹치는지는 CommitSession이 저장하면서 확인한다.
+func checkSession(trace *domain.Trace, startedAt time.Time, endedAt time.Time, now time.Time) error {
+	if !startedAt.Before(endedAt) || endedAt.After(now) {
+		return ErrInvalidSession
+	}
+
+	if !trace.StartedAt().IsZero() && trace.StartedAt().Before(endedAt) {
+		return ErrOverlapsRunning
+	}
+
+	return nil
+}
+
+// addSelf는 trace 자신의 시간을 diff만큼 바꾸고 상위 trace의 actual을 다시 계산한 변경을 돌려준다.
+func (s *Service) addSelf(
+	ctx context.Context,
+	username string,
+	trace *domain.Trace,
+	diff time.Duration,
+) (*changeSet, error) {
+	changes := newChangeSet()
+	if diff == 0 {
+		return changes, nil
+	}
+
+	changes.put(trace, trace.SetSelf(max(trace.Self()+diff, 0)))
+
+	err := s.rollUp(ctx, username, changes, trace.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// commitSession은 구간의 변경과 그로 인한 trace의 변경을 함께 저장하고 시간이 바뀐 trace마다 알린다.
+func (s *Service) commitSession(
+	ctx context.Context,
+	username string,
+	changes *changeSet,
+	change *repository.SessionChange,
+	now time.Time,
+) error {
+	change.Traces = changes.list()
+
+	err := s.repo.CommitSession(ctx, username, change)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrSessionOverlaps):
+			return ErrSessionOverlaps
+		case errors.Is(err, repository.ErrSessionNotFound):
+			return ErrSessionNotFound
+		case errors.Is(err, repository.ErrTraceNotFound):
+			return ErrTraceNotFound
+		}
+
+		return fmt.Errorf("failed to commit session: %w", err)
+	}
+
+	return s.publishTimes(ctx, username, changes, now)
+}
+
+func (s *Service) startTrace(ctx context.Context, username string, trace *domain.Trace, now time.Time) error {
 	if !trace.StartedAt().IsZero() {
 		// already started
 		return nil
@@ -236,15 +646,31 @@ func (s *Service) startTrace(ctx context.Context, trace *domain.Trace, now time.
 
 	update := trace.SetStartedAt(now)
 
-	err := s.repo.UpdateTraces(ctx, update)
+	err := s.repo.UpdateTraces(ctx, username, update)
 	if err != nil {
 		return fmt.Errorf("failed to update trace: %w", err)
 	}
 
+	err = s.bus.TraceTimerStarted.Publish(ctx, &eventbus.TraceTimerStartedEvent{
+		Version:   eventbus.TraceTimerStartedVersion,
+		Username:  username,
+		TraceID:   string(trace.ID()),
+		StartedAt: now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish timer started: %w", err)
+	}
+
 	return nil
 }
 
-func (s *Service) stopTrace(ctx context.Context, trace *domain.Trace, now time.Time) error {
+func (s *Service) stopTrace(
+	ctx context.Context,
+	username string,
+	trace *domain.Trace,
+	now time.Time,
+	auto bool,
+) error {
 	if trace.StartedAt().IsZero() {
 		// already stopped
 		return nil
@@ -252,64 +678,202 @@ func (s *Service) stopTrace(ctx context.Context, trace *domain.Trace, now time.T
 
 	diff := now.Sub(trace.StartedAt())
 
-	ancestors, err := s.findAncestors(ctx, trace.ParentID())
+	changes := newChangeSet()
+	changes.put(trace, trace.
+		SetStartedAt(time.Time{}).
+		SetSelf(trace.Self()+diff))
+
+	err := s.rollUp(ctx, username, changes, trace.ID())
 	if err != nil {
 		return err
 	}
 
-	ancestors = append(ancestors, trace)
-
-	var updates []*domain.Trace
+	session := domain.NewSession(
+		domain.SessionID(s.idmaker.MakeID()),
+		trace.ID(),
+		trace.StartedAt(),
+		now,
+		auto,
+		"",
+	)
 
-	for _, ancestor := range ancestors {
-		update := ancestor.
-			SetStartedAt(time.Time{}).
-			SetActual(ancestor.Actual() + diff)
-		updates = append(updates, update)
+	err = s.commitSession(ctx, username, changes, &repository.SessionChange{
+		Create: []*domain.Session{session},
+		Update: nil,
+		Delete: nil,
+		Traces: nil,
+	}, now)
+	if err != nil {
+		return err
 	}
 
-	err = s.repo.UpdateTraces(ctx, updates...)
+	err = s.bus.TraceTimerStopped.Publish(ctx, &eventbus.TraceTimerStoppedEvent{
+		Version:   eventbus.TraceTimerStoppedVersion,
+		Username:  username,
+		TraceID:   string(trace.ID()),
+		SessionID: string(session.ID()),
+		StartedAt: trace.StartedAt(),
+		StoppedAt: now,
+		Auto:      auto,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to update trace: %w", err)
+		return fmt.Errorf("failed to publish timer stopped: %w", err)
 	}
 
 	return nil
 }
 
-func (s *Service) taskScore(ctx context.Context, trace *domain.Trace) time.Duration {
-	traces, err := s.repo.ListChildTraces(ctx, trace.ID())
-	if err != nil {
-		return 0
+// rollUp은 id부터 최상위 trace까지 actual을 self와 자식 actual의 합으로 다시 계산한다.
+func (s *Service) rollUp(ctx context.Context, username string, changes *changeSet, id domain.TraceID) error {
+	for id != "" {
+		trace, err := s.getTrace(ctx, username, changes, id)
+		if err != nil {
+			// 부모부터 삭제되는 중이면 더 올라갈 곳이 없다.
+			if errors.Is(err, ErrParentTraceNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		children, err := s.listChildTraces(ctx, username, changes, id)
+		if err != nil {
+			return err
+		}
+
+		actual := trace.Self()
+		for _, child := range children {
+			actual += child.Actual()
+		}
+
+		changes.put(trace, trace.SetActual(actual))
+
+		id = trace.ParentID()
 	}
 
-	sum := time.Duration(0)
-	for _, trace := range traces {
-		sum += trace.Actual()
+	return nil
+}
+
+func (s *Service) getTrace(
+	ctx context.Context,
+	username string,
+	changes *changeSet,
+	id domain.TraceID,
+) (*domain.Trace, error) {
+	if trace, ok := changes.traces[id]; ok {
+		return trace, nil
 	}
 
-	return trace.Actual() - sum
+	trace, err := s.repo.GetTrace(ctx, username, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrTraceNotFound) {
+			return nil, ErrParentTraceNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get trace: %w", err)
+	}
+
+	return trace, nil
 }
 
-func (s *Service) findAncestors(ctx context.Context, id domain.TraceID) ([]*domain.Trace, error) {
-	var parents []*domain.Trace
+// listChildTraces는 아직 저장하지 않은 변경을 반영한 자식 trace를 반환한다.
+func (s *Service) listChildTraces(
+	ctx context.Context,
+	username string,
+	changes *changeSet,
+	parentID domain.TraceID,
+) ([]*domain.Trace, error) {
+	stored, err := s.repo.ListChildTraces(ctx, username, parentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list child traces: %w", err)
+	}
 
-	searchID := id
-	for searchID != "" {
-		parent, err := s.repo.GetTrace(ctx, searchID)
-		if err != nil {
-			if errors.Is(err, repository.ErrTraceNotFound) {
-				return nil, ErrParentTraceNotFound
-			}
+	var ret []*domain.Trace
 
-			return nil, fmt.Errorf("failed to get trace: %w", err)
+	for _, child := range stored {
+		if _, ok := changes.traces[child.ID()]; ok || child.ID() == changes.deleted {
+			continue
 		}
 
-		parents = append(parents, parent)
+		ret = append(ret, child)
+	}
+
+	for _, id := range changes.order {
+		if changes.traces[id].ParentID() == parentID {
+			ret = append(ret, changes.traces[id])
+		}
+	}
+
+	return ret, nil
+}
+
+// save는 모은 변경을 저장하고 시간이 바뀐 trace마다 알린다.
+func (s *Service) save(ctx context.Context, username string, changes *changeSet, now time.Time) error {
+	err := s.repo.UpdateTraces(ctx, username, changes.list()...)
+	if err != nil {
+		return fmt.Errorf("failed to update trace: %w", err)
+	}
+
+	return s.publishTimes(ctx, username, changes, now)
+}
+
+func (s *Service) publishTimes(ctx context.Context, username string, changes *changeSet, now time.Time) error {
+	for _, id := range changes.order {
+		before := changes.before[id]
+		after := changes.traces[id]
+
+		if before.Self() == after.Self() && before.Actual() == after.Actual() {
+			continue
+		}
 
-		searchID = parent.ParentID()
+		err := s.bus.TraceTimeUpdated.Publish(ctx, &eventbus.TraceTimeUpdatedEvent{
+			Version:   eventbus.TraceTimeUpdatedVersion,
+			Username:  username,
+			TraceID:   string(id),
+			OldSelf:   before.Self(),
+			NewSelf:   after.Self(),
+			OldActual: before.Actual(),
+			NewActual: after.Actual(),
+			Derived:   before.Self() == after.Self(),
+			Now:       now,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to publish time updated: %w", err)
+		}
 	}
 
-	slices.Reverse(parents)
+	return nil
+}
 
-	return parents, nil
+func newTrace(id domain.TraceID, parentID domain.TraceID) *domain.Trace {
+	return domain.NewTrace(
+		id,
+		parentID,
+		time.Duration(0),
+		time.Duration(0),
+		time.Duration(0),
+		time.Time{},
+		time.Time{},
+	)
+}
+
+func toTrace(trace *domain.Trace) *Trace {
+	return &Trace{
+		ID:        string(trace.ID()),
+		Estimated: trace.Estimated(),
+		Actual:    trace.Actual(),
+		StartedAt: trace.StartedAt(),
+		DueAt:     trace.DueAt(),
+	}
+}
+
+func toSession(session *domain.Session) *Session {
+	return &Session{
+		ID:        string(session.ID()),
+		TraceID:   string(session.TraceID()),
+		StartedAt: session.StartedAt(),
+		EndedAt:   session.EndedAt(),
+		Auto:      session.Auto(),
+		Note:      session.Note(),
+	}
 }
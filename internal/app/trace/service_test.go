@@ -1,35 +1,66 @@
 package trace_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/neatflowcv/focus/internal/app/trace"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/neatflowcv/focus/internal/pkg/idmaker/ulid"
 	"github.com/neatflowcv/focus/internal/pkg/repository/memory"
 	"github.com/stretchr/testify/require"
 )
 
+const username = "test"
+
 type ServiceData struct {
+	bus  *eventbus.Bus
 	repo *memory.Repository
 }
 
 func newService(t *testing.T) (*trace.Service, *ServiceData) {
 	t.Helper()
 
+	bus := eventbus.NewBus()
 	repo := memory.NewRepository()
-	service := trace.NewService(repo)
+	service := trace.NewService(bus, ulid.NewIDMaker(), repo)
 
 	return service, &ServiceData{
+		bus:  bus,
 		repo: repo,
 	}
 }
 
+// record는 broker에 발행된 이벤트를 events에 순서대로 모은다.
+func record[T any](broker *eventbus.Broker[T], events *[]any) {
+	broker.Subscribe(func(_ context.Context, event T) error {
+		*events = append(*events, event)
+
+		return nil
+	})
+}
+
+func recordAll(bus *eventbus.Bus) *[]any {
+	var events []any
+
+	record(bus.TraceEstimateUpdated, &events)
+	record(bus.TraceDueAtUpdated, &events)
+	record(bus.TraceTimeUpdated, &events)
+	record(bus.TraceTimerStarted, &events)
+	record(bus.TraceTimerStopped, &events)
+
+	return &events
+}
+
 func TestServiceCreateTrace(t *testing.T) {
 	t.Parallel()
 
 	service, _ := newService(t)
 
 	err := service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
 		ID:       "1",
 		ParentID: "",
 	})
@@ -42,34 +73,92 @@ func TestServiceDeleteTrace(t *testing.T) {
 
 	service, data := newService(t)
 	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
 		ID:       "3",
 		ParentID: "",
 	})
 	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
 		ID:       "2",
 		ParentID: "3",
 	})
 	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
 		ID:       "1",
 		ParentID: "2",
 	})
 	_ = service.SetActual(t.Context(), &trace.SetActualInput{
-		ID:     "2",
-		Actual: 5 * time.Second,
+		Username: username,
+		ID:       "2",
+		Actual:   5 * time.Second,
 	})
 	_ = service.SetActual(t.Context(), &trace.SetActualInput{
-		ID:     "1",
-		Actual: 10 * time.Second,
+		Username: username,
+		ID:       "1",
+		Actual:   10 * time.Second,
 	})
 
 	err := service.DeleteTrace(t.Context(), &trace.DeleteTraceInput{
-		ID: "1",
+		Username: username,
+		ID:       "1",
 	})
 
 	require.NoError(t, err)
-	require.Len(t, data.repo.Traces, 2)
-	require.Equal(t, 5*time.Second, data.repo.Traces["2"].Actual())
-	require.Equal(t, 5*time.Second, data.repo.Traces["3"].Actual())
+	require.Len(t, data.repo.Traces[username], 2)
+	require.Equal(t, 5*time.Second, data.repo.Traces[username]["2"].Actual())
+	require.Equal(t, 5*time.Second, data.repo.Traces[username]["3"].Actual())
+}
+
+func TestServiceDeleteTrace_Sessions(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "1", ParentID: ""})
+	now := time.Date(2025, 8, 1, 18, 0, 0, 0, time.UTC)
+	out, _ := service.AddSession(t.Context(), &trace.AddSessionInput{
+		Username:  username,
+		TraceID:   "1",
+		StartedAt: now.Add(-time.Hour),
+		EndedAt:   now,
+		Note:      "",
+		Now:       now,
+	})
+
+	err := service.DeleteTrace(t.Context(), &trace.DeleteTraceInput{Username: username, ID: "1", Now: now})
+
+	require.NoError(t, err)
+	require.Empty(t, data.repo.Sessions[username])
+
+	// 지우기 전부터 trace 없이 남아 있던 구간이다.
+	_ = data.repo.CreateSession(t.Context(), username, domain.NewSession(
+		domain.SessionID(out.Session.ID), "1", now.Add(-time.Hour), now, false, "",
+	))
+
+	err = service.DeleteSession(t.Context(), &trace.DeleteSessionInput{Username: username, ID: out.Session.ID, Now: now})
+
+	require.ErrorIs(t, err, trace.ErrSessionNotFound)
+}
+
+func TestServiceDeleteTrace_ParentFirst(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "3", ParentID: ""})
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "2", ParentID: "3"})
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "1", ParentID: "2"})
+	_ = service.SetActual(t.Context(), &trace.SetActualInput{Username: username, ID: "3", Actual: time.Second})
+	_ = service.SetActual(t.Context(), &trace.SetActualInput{Username: username, ID: "2", Actual: 5 * time.Second})
+	_ = service.SetActual(t.Context(), &trace.SetActualInput{Username: username, ID: "1", Actual: 10 * time.Second})
+
+	// 하위 트리를 지우면 flow는 부모부터 TaskDeleted를 보낸다.
+	for _, id := range []string{"2", "1"} {
+		err := service.DeleteTrace(t.Context(), &trace.DeleteTraceInput{Username: username, ID: id})
+
+		require.NoError(t, err)
+	}
+
+	require.Len(t, data.repo.Traces[username], 1)
+	require.Equal(t, time.Second, data.repo.Traces[username]["3"].Actual())
 }
 
 func TestServiceDeleteTrace_Error(t *testing.T) {
@@ -78,38 +167,260 @@ func TestServiceDeleteTrace_Error(t *testing.T) {
 	service, _ := newService(t)
 
 	err := service.DeleteTrace(t.Context(), &trace.DeleteTraceInput{
-		ID: "1",
+		Username: username,
+		ID:       "1",
 	})
 
 	require.ErrorIs(t, err, trace.ErrTraceNotFound)
 }
 
+func TestServiceSetEstimated(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
+		ID:       "1",
+		ParentID: "",
+	})
+
+	err := service.SetEstimated(t.Context(), &trace.SetEstimatedInput{
+		Username:  username,
+		ID:        "1",
+		Estimated: 30 * time.Minute,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Minute, data.repo.Traces[username]["1"].Estimated())
+}
+
+func TestServiceSetEstimated_Error(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newService(t)
+
+		err := service.SetEstimated(t.Context(), &trace.SetEstimatedInput{
+			Username:  username,
+			ID:        "1",
+			Estimated: 30 * time.Minute,
+		})
+
+		require.ErrorIs(t, err, trace.ErrTraceNotFound)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		t.Parallel()
+
+		service, data := newService(t)
+		_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+			Username: "other",
+			ID:       "1",
+			ParentID: "",
+		})
+
+		err := service.SetEstimated(t.Context(), &trace.SetEstimatedInput{
+			Username:  username,
+			ID:        "1",
+			Estimated: 30 * time.Minute,
+		})
+
+		require.ErrorIs(t, err, trace.ErrTraceNotFound)
+		require.Zero(t, data.repo.Traces["other"]["1"].Estimated())
+	})
+}
+
+func TestServiceSetDueAt(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
+		ID:       "1",
+		ParentID: "",
+	})
+	dueAt := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+
+	err := service.SetDueAt(t.Context(), &trace.SetDueAtInput{
+		Username: username,
+		ID:       "1",
+		DueAt:    dueAt,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, dueAt, data.repo.Traces[username]["1"].DueAt())
+}
+
+func TestServiceUpdateStatus_Session(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
+		ID:       "2",
+		ParentID: "",
+	})
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
+		ID:       "1",
+		ParentID: "2",
+	})
+	now := time.Now()
+	_ = service.UpdateStatus(t.Context(), &trace.UpdateStatusInput{
+		Username: username,
+		ID:       "1",
+		Status:   "doing",
+		Now:      now,
+	})
+
+	err := service.UpdateStatus(t.Context(), &trace.UpdateStatusInput{
+		Username: username,
+		ID:       "1",
+		Status:   "done",
+		Now:      now.Add(time.Hour),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, data.repo.Traces[username]["2"].Actual())
+
+	out, _ := service.ListSessions(t.Context(), &trace.ListSessionsInput{Username: username, IDs: []string{"1", "2"}})
+	require.Len(t, out.Sessions, 1)
+	require.Equal(t, "1", out.Sessions[0].TraceID)
+	require.Equal(t, now, out.Sessions[0].StartedAt)
+	require.Equal(t, now.Add(time.Hour), out.Sessions[0].EndedAt)
+	require.False(t, out.Sessions[0].Auto)
+}
+
+func TestServiceStopTrace(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
+		ID:       "2",
+		ParentID: "",
+	})
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
+		ID:       "1",
+		ParentID: "2",
+	})
+	now := time.Now()
+	_ = service.UpdateStatus(t.Context(), &trace.UpdateStatusInput{
+		Username: username,
+		ID:       "1",
+		Status:   "doing",
+		Now:      now,
+	})
+
+	err := service.StopTrace(t.Context(), &trace.StopTraceInput{
+		Username: username,
+		ID:       "1",
+		At:       now.Add(4 * time.Hour),
+		Auto:     true,
+	})
+
+	require.NoError(t, err)
+	require.True(t, data.repo.Traces[username]["1"].StartedAt().IsZero())
+	require.Equal(t, 4*time.Hour, data.repo.Traces[username]["2"].Actual())
+
+	out, _ := service.ListSessions(t.Context(), &trace.ListSessionsInput{Username: username, IDs: []string{"1"}})
+	require.Len(t, out.Sessions, 1)
+	require.True(t, out.Sessions[0].Auto)
+}
+
+func TestServiceStopTrace_Error(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newService(t)
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
+		ID:       "1",
+		ParentID: "",
+	})
+	now := time.Now()
+	_ = service.UpdateStatus(t.Context(), &trace.UpdateStatusInput{
+		Username: username,
+		ID:       "1",
+		Status:   "doing",
+		Now:      now,
+	})
+
+	err := service.StopTrace(t.Context(), &trace.StopTraceInput{
+		Username: username,
+		ID:       "1",
+		At:       now.Add(-time.Minute),
+		Auto:     true,
+	})
+
+	require.ErrorIs(t, err, trace.ErrStopBeforeStart)
+}
+
+func TestServiceStopTrace_Overlap(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
+		ID:       "1",
+		ParentID: "",
+	})
+	now := time.Now()
+	_ = service.UpdateStatus(t.Context(), &trace.UpdateStatusInput{
+		Username: username,
+		ID:       "1",
+		Status:   "doing",
+		Now:      now,
+	})
+	_ = data.repo.CreateSession(t.Context(), username, domain.NewSession(
+		"other", "1", now.Add(time.Minute), now.Add(2*time.Minute), false, "",
+	))
+
+	err := service.StopTrace(t.Context(), &trace.StopTraceInput{
+		Username: username,
+		ID:       "1",
+		At:       now.Add(time.Hour),
+		Auto:     false,
+	})
+
+	require.ErrorIs(t, err, trace.ErrSessionOverlaps)
+	require.Equal(t, now, data.repo.Traces[username]["1"].StartedAt())
+	require.Equal(t, time.Duration(0), data.repo.Traces[username]["1"].Self())
+	require.Len(t, data.repo.Sessions[username], 1)
+}
+
 func TestServiceSetActual(t *testing.T) {
 	t.Parallel()
 
 	service, data := newService(t)
 	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
 		ID:       "3",
 		ParentID: "",
 	})
 	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
 		ID:       "2",
 		ParentID: "3",
 	})
 	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
 		ID:       "1",
 		ParentID: "2",
 	})
 
 	err := service.SetActual(t.Context(), &trace.SetActualInput{
-		ID:     "1",
-		Actual: 10 * time.Second,
+		Username: username,
+		ID:       "1",
+		Actual:   10 * time.Second,
 	})
 
 	require.NoError(t, err)
-	require.Equal(t, 10*time.Second, data.repo.Traces["1"].Actual())
-	require.Equal(t, 10*time.Second, data.repo.Traces["2"].Actual())
-	require.Equal(t, 10*time.Second, data.repo.Traces["3"].Actual())
+	require.Equal(t, 10*time.Second, data.repo.Traces[username]["1"].Actual())
+	require.Equal(t, 10*time.Second, data.repo.Traces[username]["2"].Actual())
+	require.Equal(t, 10*time.Second, data.repo.Traces[username]["3"].Actual())
 }
 
 func TestServiceSetActual_Error(t *testing.T) {
@@ -118,8 +429,9 @@ func TestServiceSetActual_Error(t *testing.T) {
 	service, _ := newService(t)
 
 	err := service.SetActual(t.Context(), &trace.SetActualInput{
-		ID:     "1",
-		Actual: 10 * time.Second,
+		Username: username,
+		ID:       "1",
+		Actual:   10 * time.Second,
 	})
 
 	require.ErrorIs(t, err, trace.ErrTraceNotFound)
@@ -130,30 +442,35 @@ func TestService_Actual(t *testing.T) {
 
 	service, data := newService(t)
 	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
 		ID:       "3",
 		ParentID: "",
 	})
 	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
 		ID:       "2",
 		ParentID: "3",
 	})
 	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
 		ID:       "1",
 		ParentID: "2",
 	})
 	_ = service.SetActual(t.Context(), &trace.SetActualInput{
-		ID:     "2",
-		Actual: 5 * time.Second,
+		Username: username,
+		ID:       "2",
+		Actual:   5 * time.Second,
 	})
 	_ = service.SetActual(t.Context(), &trace.SetActualInput{
-		ID:     "1",
-		Actual: 10 * time.Second,
+		Username: username,
+		ID:       "1",
+		Actual:   10 * time.Second,
 	})
 
-	require.Len(t, data.repo.Traces, 3)
-	require.Equal(t, 10*time.Second, data.repo.Traces["1"].Actual())
-	require.Equal(t, 15*time.Second, data.repo.Traces["2"].Actual())
-	require.Equal(t, 15*time.Second, data.repo.Traces["3"].Actual())
+	require.Len(t, data.repo.Traces[username], 3)
+	require.Equal(t, 10*time.Second, data.repo.Traces[username]["1"].Actual())
+	require.Equal(t, 15*time.Second, data.repo.Traces[username]["2"].Actual())
+	require.Equal(t, 15*time.Second, data.repo.Traces[username]["3"].Actual())
 }
 
 func TestServiceListTraces(t *testing.T) {
@@ -165,7 +482,8 @@ func TestServiceListTraces(t *testing.T) {
 		service, _ := newService(t)
 
 		out, err := service.ListTraces(t.Context(), &trace.ListTracesInput{
-			IDs: nil,
+			Username: username,
+			IDs:      nil,
 		})
 
 		require.NoError(t, err)
@@ -177,12 +495,14 @@ func TestServiceListTraces(t *testing.T) {
 
 		service, _ := newService(t)
 		_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+			Username: username,
 			ID:       "1",
 			ParentID: "",
 		})
 
 		out, err := service.ListTraces(t.Context(), &trace.ListTracesInput{
-			IDs: []string{"1"},
+			Username: username,
+			IDs:      []string{"1"},
 		})
 
 		require.NoError(t, err)
@@ -194,16 +514,19 @@ func TestServiceListTraces(t *testing.T) {
 
 		service, _ := newService(t)
 		_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+			Username: username,
 			ID:       "1",
 			ParentID: "",
 		})
 		_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+			Username: username,
 			ID:       "2",
 			ParentID: "",
 		})
 
 		out, err := service.ListTraces(t.Context(), &trace.ListTracesInput{
-			IDs: []string{"1", "2"},
+			Username: username,
+			IDs:      []string{"1", "2"},
 		})
 
 		require.NoError(t, err)
@@ -217,15 +540,18 @@ func TestServiceUpdateParent(t *testing.T) {
 	service, _ := newService(t)
 
 	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
 		ID:       "1",
 		ParentID: "",
 	})
 	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+		Username: username,
 		ID:       "2",
 		ParentID: "",
 	})
 
 	err := service.UpdateParent(t.Context(), &trace.UpdateParentInput{
+		Username: username,
 		ID:       "1",
 		ParentID: "2",
 	})
@@ -233,6 +559,30 @@ func TestServiceUpdateParent(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestServiceUpdateParent_Actual(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "old", ParentID: ""})
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "new", ParentID: ""})
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "1", ParentID: "old"})
+	_ = service.SetActual(t.Context(), &trace.SetActualInput{Username: username, ID: "old", Actual: 5 * time.Second})
+	_ = service.SetActual(t.Context(), &trace.SetActualInput{Username: username, ID: "1", Actual: 10 * time.Second})
+	// 어긋난 합계가 다음 변경에 전파되지 않아야 한다.
+	data.repo.Traces[username]["old"] = data.repo.Traces[username]["old"].SetActual(time.Second)
+
+	err := service.UpdateParent(t.Context(), &trace.UpdateParentInput{
+		Username: username,
+		ID:       "1",
+		ParentID: "new",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, data.repo.Traces[username]["old"].Actual())
+	require.Equal(t, 10*time.Second, data.repo.Traces[username]["new"].Actual())
+	require.Equal(t, 10*time.Second, data.repo.Traces[username]["1"].Self())
+}
+
 func TestServiceUpdateParent_Error(t *testing.T) {
 	t.Parallel()
 
@@ -242,6 +592,7 @@ func TestServiceUpdateParent_Error(t *testing.T) {
 		service, _ := newService(t)
 
 		err := service.UpdateParent(t.Context(), &trace.UpdateParentInput{
+			Username: username,
 			ID:       "1",
 			ParentID: "0",
 		})
@@ -254,11 +605,13 @@ func TestServiceUpdateParent_Error(t *testing.T) {
 
 		service, _ := newService(t)
 		_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{
+			Username: username,
 			ID:       "1",
 			ParentID: "",
 		})
 
 		err := service.UpdateParent(t.Context(), &trace.UpdateParentInput{
+			Username: username,
 			ID:       "1",
 			ParentID: "2",
 		})
@@ -266,3 +619,355 @@ func TestServiceUpdateParent_Error(t *testing.T) {
 		require.ErrorIs(t, err, trace.ErrParentTraceNotFound)
 	})
 }
+
+func TestServiceReconcile(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "root", ParentID: ""})
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "parent", ParentID: "root"})
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "a", ParentID: "parent"})
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "b", ParentID: "parent"})
+	_ = service.SetActual(t.Context(), &trace.SetActualInput{Username: username, ID: "a", Actual: 1500 * time.Millisecond})
+	_ = service.SetActual(t.Context(), &trace.SetActualInput{Username: username, ID: "b", Actual: 2500 * time.Millisecond})
+	data.repo.Traces[username]["parent"] = data.repo.Traces[username]["parent"].SetActual(3 * time.Second)
+
+	out, err := service.Reconcile(t.Context(), &trace.ReconcileInput{Username: username, Fix: false})
+
+	require.NoError(t, err)
+	require.Len(t, out.Mismatches, 1)
+	require.Equal(t, "parent", out.Mismatches[0].ID)
+	require.Equal(t, 3*time.Second, out.Mismatches[0].Stored)
+	require.Equal(t, 4*time.Second, out.Mismatches[0].Expected)
+	require.Equal(t, 3*time.Second, data.repo.Traces[username]["parent"].Actual())
+
+	out, err = service.Reconcile(t.Context(), &trace.ReconcileInput{Username: username, Fix: true})
+
+	require.NoError(t, err)
+	require.Len(t, out.Mismatches, 1)
+	require.Equal(t, 4*time.Second, data.repo.Traces[username]["parent"].Actual())
+	require.Equal(t, 4*time.Second, data.repo.Traces[username]["root"].Actual())
+
+	out, _ = service.Reconcile(t.Context(), &trace.ReconcileInput{Username: username, Fix: false})
+	require.Empty(t, out.Mismatches)
+}
+
+func TestServiceRebuild(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "root", ParentID: ""})
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "a", ParentID: "root"})
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "b", ParentID: "a"})
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "orphan", ParentID: "root"})
+	_ = service.SetActual(t.Context(), &trace.SetActualInput{Username: username, ID: "b", Actual: 2 * time.Second})
+	now := time.Date(2025, 8, 1, 18, 0, 0, 0, time.UTC)
+	_ = data.repo.CreateSession(t.Context(), username, domain.NewSession("kept", "orphan", now, now, false, ""))
+	_ = data.repo.CreateSession(t.Context(), username, domain.NewSession("lost", "gone", now, now, false, ""))
+	nodes := []*trace.Node{
+		{ID: "root", ParentID: ""},
+		{ID: "a", ParentID: "root"},
+		{ID: "b", ParentID: "root"},
+		{ID: "c", ParentID: "root"},
+	}
+
+	out, err := service.Rebuild(t.Context(), &trace.RebuildInput{Username: username, Nodes: nodes, DryRun: true})
+
+	require.NoError(t, err)
+	require.Equal(t, []*trace.Change{
+		{ID: "b", Kind: trace.ChangeReparent, From: "a", To: "root"},
+		{ID: "c", Kind: trace.ChangeCreate, From: "", To: "root"},
+		{ID: "orphan", Kind: trace.ChangeDelete, From: "root", To: ""},
+		{ID: "gone", Kind: trace.ChangeDeleteSession, From: "lost", To: ""},
+		{ID: "a", Kind: trace.ChangeActual, From: "2s", To: "0s"},
+	}, out.Changes)
+	require.Equal(t, 2*time.Second, data.repo.Traces[username]["a"].Actual())
+	require.NotContains(t, data.repo.Traces[username], domain.TraceID("c"))
+
+	out, err = service.Rebuild(t.Context(), &trace.RebuildInput{Username: username, Nodes: nodes, DryRun: false})
+
+	require.NoError(t, err)
+	require.Equal(t, 1, out.Created)
+	require.Equal(t, 2, out.Updated)
+	require.Equal(t, 2, out.Deleted)
+	require.Empty(t, data.repo.Sessions[username])
+	require.Equal(t, domain.TraceID("root"), data.repo.Traces[username]["b"].ParentID())
+	require.Equal(t, time.Duration(0), data.repo.Traces[username]["a"].Actual())
+	require.Equal(t, 2*time.Second, data.repo.Traces[username]["root"].Actual())
+	require.NotContains(t, data.repo.Traces[username], domain.TraceID("orphan"))
+
+	out, _ = service.Rebuild(t.Context(), &trace.RebuildInput{Username: username, Nodes: nodes, DryRun: false})
+	require.Empty(t, out.Changes)
+}
+
+func TestServiceAddSession(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "2", ParentID: ""})
+	_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "1", ParentID: "2"})
+	now := time.Date(2025, 8, 1, 18, 0, 0, 0, time.UTC)
+
+	out, err := service.AddSession(t.Context(), &trace.AddSessionInput{
+		Username:  username,
+		TraceID:   "1",
+		StartedAt: now.Add(-3 * time.Hour),
+		EndedAt:   now.Add(-time.Hour),
+		Note:      "site visit",
+		Now:       now,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "site visit", out.Session.Note)
+	require.Equal(t, 2*time.Hour, data.repo.Traces[username]["1"].Self())
+	require.Equal(t, 2*time.Hour, data.repo.Traces[username]["2"].Actual())
+
+	updated, err := service.UpdateSession(t.Context(), &trace.UpdateSessionInput{
+		Username:  username,
+		ID:        out.Session.ID,
+		StartedAt: now.Add(-3 * time.Hour),
+		EndedAt:   now.Add(-150 * time.Minute),
+		Note:      "short visit",
+		Now:       now,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "short visit", updated.Session.Note)
+	require.Equal(t, 30*time.Minute, data.repo.Traces[username]["2"].Actual())
+
+	err = service.DeleteSession(t.Context(), &trace.DeleteSessionInput{Username: username, ID: out.Session.ID})
+
+	require.NoError(t, err)
+	require.Empty(t, data.repo.Sessions[username])
+	require.Equal(t, time.Duration(0), data.repo.Traces[username]["2"].Actual())
+}
+
+func TestServiceAddSession_Error(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	now := time.Date(2025, 8, 1, 18, 0, 0, 0, time.UTC)
+	setup := func(t *testing.T) *trace.Service {
+		t.Helper()
+
+		service, _ := newService(t)
+		_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "1", ParentID: ""})
+		_, _ = service.AddSession(t.Context(), &trace.AddSessionInput{
+			Username:  username,
+			TraceID:   "1",
+			StartedAt: now.Add(-4 * time.Hour),
+			EndedAt:   now.Add(-3 * time.Hour),
+			Note:      "",
+			Now:       now,
+		})
+		_ = service.UpdateStatus(t.Context(), &trace.UpdateStatusInput{
+			Username: username,
+			ID:       "1",
+			Status:   "doing",
+			Now:      now.Add(-time.Hour),
+		})
+
+		return service
+	}
+
+	add := func(t *testing.T, service *trace.Service, startedAt time.Time, endedAt time.Time) error {
+		t.Helper()
+
+		_, err := service.AddSession(t.Context(), &trace.AddSessionInput{
+			Username:  username,
+			TraceID:   "1",
+			StartedAt: startedAt,
+			EndedAt:   endedAt,
+			Note:      "",
+			Now:       now,
+		})
+
+		return err
+	}
+
+	t.Run("ends before start", func(t *testing.T) {
+		t.Parallel()
+
+		service := setup(t)
+
+		err := add(t, service, now.Add(-2*time.Hour), now.Add(-150*time.Minute))
+
+		require.ErrorIs(t, err, trace.ErrInvalidSession)
+	})
+
+	t.Run("ends in future", func(t *testing.T) {
+		t.Parallel()
+
+		service := setup(t)
+
+		err := add(t, service, now.Add(-10*time.Minute), now.Add(time.Hour))
+
+		require.ErrorIs(t, err, trace.ErrInvalidSession)
+	})
+
+	t.Run("overlaps session", func(t *testing.T) {
+		t.Parallel()
+
+		service := setup(t)
+
+		err := add(t, service, now.Add(-210*time.Minute), now.Add(-2*time.Hour))
+
+		require.ErrorIs(t, err, trace.ErrSessionOverlaps)
+	})
+
+	t.Run("overlaps running", func(t *testing.T) {
+		t.Parallel()
+
+		service := setup(t)
+
+		err := add(t, service, now.Add(-2*time.Hour), now.Add(-30*time.Minute))
+
+		require.ErrorIs(t, err, trace.ErrOverlapsRunning)
+	})
+}
+
+func TestServiceEvents(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	newTraces := func(t *testing.T) (*trace.Service, *[]any) {
+		t.Helper()
+
+		service, data := newService(t)
+		_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "2", ParentID: ""})
+		_ = service.CreateTrace(t.Context(), &trace.CreateTraceInput{Username: username, ID: "1", ParentID: "2"})
+
+		return service, recordAll(data.bus)
+	}
+
+	t.Run("estimate", func(t *testing.T) {
+		t.Parallel()
+
+		service, events := newTraces(t)
+
+		err := service.SetEstimated(t.Context(), &trace.SetEstimatedInput{
+			Username:  username,
+			ID:        "1",
+			Estimated: time.Hour,
+			Now:       now,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			&eventbus.TraceEstimateUpdatedEvent{
+				Version:      eventbus.TraceEstimateUpdatedVersion,
+				Username:     username,
+				TraceID:      "1",
+				OldEstimated: 0,
+				NewEstimated: time.Hour,
+				Now:          now,
+			},
+		}, *events)
+	})
+
+	t.Run("due date", func(t *testing.T) {
+		t.Parallel()
+
+		service, events := newTraces(t)
+		dueAt := now.Add(24 * time.Hour)
+
+		err := service.SetDueAt(t.Context(), &trace.SetDueAtInput{
+			Username: username,
+			ID:       "1",
+			DueAt:    dueAt,
+			Now:      now,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			&eventbus.TraceDueAtUpdatedEvent{
+				Version:  eventbus.TraceDueAtUpdatedVersion,
+				Username: username,
+				TraceID:  "1",
+				OldDueAt: time.Time{},
+				NewDueAt: dueAt,
+				Now:      now,
+			},
+		}, *events)
+	})
+
+	t.Run("timer", func(t *testing.T) {
+		t.Parallel()
+
+		service, events := newTraces(t)
+
+		_ = service.UpdateStatus(t.Context(), &trace.UpdateStatusInput{
+			Username: username,
+			ID:       "1",
+			Status:   "doing",
+			Now:      now,
+		})
+		err := service.StopTrace(t.Context(), &trace.StopTraceInput{
+			Username: username,
+			ID:       "1",
+			At:       now.Add(time.Hour),
+			Auto:     true,
+		})
+
+		require.NoError(t, err)
+
+		out, _ := service.ListSessions(t.Context(), &trace.ListSessionsInput{Username: username, IDs: []string{"1"}})
+		require.Len(t, out.Sessions, 1)
+		require.Equal(t, []any{
+			&eventbus.TraceTimerStartedEvent{
+				Version:   eventbus.TraceTimerStartedVersion,
+				Username:  username,
+				TraceID:   "1",
+				StartedAt: now,
+			},
+			&eventbus.TraceTimeUpdatedEvent{
+				Version:   eventbus.TraceTimeUpdatedVersion,
+				Username:  username,
+				TraceID:   "1",
+				OldSelf:   0,
+				NewSelf:   time.Hour,
+				OldActual: 0,
+				NewActual: time.Hour,
+				Derived:   false,
+				Now:       now.Add(time.Hour),
+			},
+			&eventbus.TraceTimeUpdatedEvent{
+				Version:   eventbus.TraceTimeUpdatedVersion,
+				Username:  username,
+				TraceID:   "2",
+				OldSelf:   0,
+				NewSelf:   0,
+				OldActual: 0,
+				NewActual: time.Hour,
+				Derived:   true,
+				Now:       now.Add(time.Hour),
+			},
+			&eventbus.TraceTimerStoppedEvent{
+				Version:   eventbus.TraceTimerStoppedVersion,
+				Username:  username,
+				TraceID:   "1",
+				SessionID: out.Sessions[0].ID,
+				StartedAt: now,
+				StoppedAt: now.Add(time.Hour),
+				Auto:      true,
+			},
+		}, *events)
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		service, events := newTraces(t)
+
+		_ = service.SetEstimated(t.Context(), &trace.SetEstimatedInput{Username: username, ID: "1", Estimated: 0, Now: now})
+		_ = service.SetActual(t.Context(), &trace.SetActualInput{Username: username, ID: "1", Actual: 0, Now: now})
+		_ = service.UpdateStatus(t.Context(), &trace.UpdateStatusInput{
+			Username: username,
+			ID:       "1",
+			Status:   "done",
+			Now:      now,
+		})
+
+		require.Empty(t, *events)
+	})
+}
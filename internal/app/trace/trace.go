@@ -5,32 +5,56 @@ import (
 )
 
 type CreateTraceInput struct {
+	Username string
 	ID       string
 	ParentID string
 }
 
 type DeleteTraceInput struct {
-	ID string
+	Username string
+	ID       string
+	Now      time.Time
+}
+
+type SetEstimatedInput struct {
+	Username  string
+	ID        string
+	Estimated time.Duration
+	Now       time.Time
+}
+
+type SetDueAtInput struct {
+	Username string
+	ID       string
+	DueAt    time.Time // zero이면 마감일을 지운다
+	Now      time.Time
 }
 
 type SetActualInput struct {
-	ID     string
-	Actual time.Duration
+	Username string
+	ID       string
+	Actual   time.Duration
+	Now      time.Time
 }
 
 type UpdateParentInput struct {
+	Username string
 	ID       string
 	ParentID string
+	Now      time.Time
 }
 
 type ListTracesInput struct {
-	IDs []string
+	Username string
+	IDs      []string
 }
 
 type Trace struct {
+	ID        string
 	Estimated time.Duration
 	Actual    time.Duration
 	StartedAt time.Time
+	DueAt     time.Time
 }
 
 type ListTracesOutput struct {
@@ -38,7 +62,124 @@ type ListTracesOutput struct {
 }
 
 type UpdateStatusInput struct {
-	ID     string
-	Status string
-	Now    time.Time
+	Username string
+	ID       string
+	Status   string
+	Now      time.Time
+}
+
+type StopTraceInput struct {
+	Username string
+	ID       string
+	At       time.Time
+	Auto     bool // true이면 사용자가 아니라 auto-stop 작업이 멈춘 것으로 기록한다
+}
+
+type ListSessionsInput struct {
+	Username string
+	IDs      []string
+}
+
+type Session struct {
+	ID        string
+	TraceID   string
+	StartedAt time.Time
+	EndedAt   time.Time
+	Auto      bool
+	Note      string
+}
+
+type ListSessionsOutput struct {
+	Sessions []*Session
+}
+
+type ReconcileInput struct {
+	Username string
+	Fix      bool // true이면 잘못된 actual을 다시 계산한 값으로 고친다
+}
+
+type Mismatch struct {
+	ID       string
+	Stored   time.Duration
+	Expected time.Duration
+}
+
+type ReconcileOutput struct {
+	Mismatches []*Mismatch
+}
+
+type Node struct {
+	ID       string
+	ParentID string
+}
+
+type RebuildInput struct {
+	Username string
+	Nodes    []*Node // 부모가 자식보다 먼저 나오는 작업 트리
+	DryRun   bool    // true이면 바꿀 내용만 계산하고 저장하지 않는다
+}
+
+type RebuildOutput struct {
+	Created int // 작업은 있는데 trace가 없어서 만든 수
+	Updated int // parent 또는 actual을 고친 수
+	Deleted int // 작업이 없는데 남아 있어서 지운 trace와 구간의 수
+	Changes []*Change
+}
+
+const (
+	ChangeCreate   = "create"
+	ChangeReparent = "reparent"
+	ChangeDelete   = "delete"
+	ChangeActual   = "actual"
+
+	ChangeDeleteSession = "delete_session" // From에 지운 구간을 담는다
+)
+
+// Change는 Rebuild가 고치는 값 하나이다. create는 To에 부모를, delete는 From에 부모를 담는다.
+type Change struct {
+	ID   string
+	Kind string
+	From string
+	To   string
+}
+
+type AddSessionInput struct {
+	Username  string
+	TraceID   string
+	StartedAt time.Time
+	EndedAt   time.Time
+	Note      string
+	Now       time.Time // 이 시각 이후에 끝나는 구간은 기록할 수 없다
+}
+
+type AddSessionOutput struct {
+	Session *Session
+}
+
+type GetSessionInput struct {
+	Username string
+	ID       string
+}
+
+type GetSessionOutput struct {
+	Session *Session
+}
+
+type UpdateSessionInput struct {
+	Username  string
+	ID        string
+	StartedAt time.Time
+	EndedAt   time.Time
+	Note      string
+	Now       time.Time
+}
+
+type UpdateSessionOutput struct {
+	Session *Session
+}
+
+type DeleteSessionInput struct {
+	Username string
+	ID       string
+	Now      time.Time
 }
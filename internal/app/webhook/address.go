@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// Resolver는 webhook 주소의 host를 IP로 바꾼다. net.DefaultResolver가 이를 따른다.
+type Resolver interface {
+	LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+}
+
+// NewClient는 공인 주소가 아니면 연결하지 않는 client를 만든다.
+// 만들 때 확인한 host가 나중에 내부 주소로 바뀌어도 보내지 않는다.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{ //nolint:exhaustruct
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("failed to parse address: %w", err)
+			}
+
+			return checkAddr(addrPort.Addr())
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+	transport.Proxy = nil                                        // proxy를 거치면 Control이 proxy의 주소만 본다
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{ //nolint:exhaustruct
+		Transport: transport,
+		Timeout:   timeout,
+	}
+}
+
+// checkHost는 host가 가리키는 모든 주소가 공인 주소인지 확인한다.
+func (s *Service) checkHost(ctx context.Context, host string) error {
+	addrs, err := s.resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidURL, err)
+	}
+
+	for _, addr := range addrs {
+		err := checkAddr(addr)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidURL, err)
+		}
+	}
+
+	return nil
+}
+
+// checkAddr는 사설, 루프백, 링크 로컬처럼 내부를 가리키는 주소를 막는다.
+func checkAddr(addr netip.Addr) error {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+	}
+
+	return nil
+}
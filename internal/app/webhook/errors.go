@@ -0,0 +1,12 @@
+package webhook
+
+import "errors"
+
+var (
+	ErrInvalidURL       = errors.New("invalid webhook url")
+	ErrInvalidEvent     = errors.New("invalid webhook event")
+	ErrForbiddenAddress = errors.New("forbidden webhook address")
+	ErrWebhookNotFound  = errors.New("webhook not found")
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+	errUnexpectedStatus = errors.New("unexpected status code")
+)
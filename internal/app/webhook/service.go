@@ -0,0 +1,452 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/clock"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/idmaker"
+	"github.com/neatflowcv/focus/internal/pkg/repository"
+)
+
+const (
+	SignatureHeader = "X-Focus-Signature-256" // sha256=<본문을 secret으로 서명한 HMAC의 hex>
+	EventHeader     = "X-Focus-Event"
+	DeliveryHeader  = "X-Focus-Delivery"
+)
+
+const (
+	batchSize = 100
+
+	// 30초부터 두 배씩 늘려 최대 1시간 간격으로 maxAttempts번까지 보낸다.
+	maxAttempts = 8
+	baseBackoff = 30 * time.Second
+	maxBackoff  = time.Hour
+
+	maxResponseBody = 64 << 10
+	maxConcurrency  = 4 // webhook 하나에 동시에 보내는 수
+)
+
+type Service struct {
+	clock    clock.Clock
+	idmaker  idmaker.IDMaker
+	repo     repository.WebhookRepository
+	client   *http.Client
+	resolver Resolver
+
+	mu sync.Mutex // Dispatch가 동시에 돌면 같은 기록을 두 번 보낸다
+}
+
+func NewService(
+	clock clock.Clock,
+	idmaker idmaker.IDMaker,
+	repo repository.WebhookRepository,
+	client *http.Client,
+	resolver Resolver,
+) *Service {
+	return &Service{
+		clock:    clock,
+		idmaker:  idmaker,
+		repo:     repo,
+		client:   client,
+		resolver: resolver,
+		mu:       sync.Mutex{},
+	}
+}
+
+// Sign은 받는 쪽이 SignatureHeader와 비교할 값을 만든다.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Service) CreateWebhook(ctx context.Context, input *CreateWebhookInput) (*CreateWebhookOutput, error) {
+	parsed, err := url.Parse(input.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, ErrInvalidURL
+	}
+
+	var events []domain.WebhookEvent
+
+	for _, name := range input.Events {
+		event := domain.WebhookEvent(name)
+		if !event.IsValid() {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidEvent, name)
+		}
+
+		if !slices.Contains(events, event) {
+			events = append(events, event)
+		}
+	}
+
+	if len(events) == 0 {
+		return nil, ErrInvalidEvent
+	}
+
+	err = s.checkHost(ctx, parsed.Hostname())
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := domain.NewWebhook(
+		domain.WebhookID(s.idmaker.MakeID()),
+		input.Username,
+		input.URL,
+		rand.Text(),
+		events,
+		input.Now,
+	)
+
+	err = s.repo.CreateWebhook(ctx, webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	item := makeWebhook(webhook)
+	item.Secret = webhook.Secret()
+
+	return &CreateWebhookOutput{
+		Webhook: item,
+	}, nil
+}
+
+func (s *Service) ListWebhooks(ctx context.Context, input *ListWebhooksInput) (*ListWebhooksOutput, error) {
+	webhooks, err := s.repo.ListWebhooks(ctx, input.Username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	var items []*Webhook
+	for _, webhook := range webhooks {
+		items = append(items, makeWebhook(webhook))
+	}
+
+	return &ListWebhooksOutput{
+		Webhooks: items,
+	}, nil
+}
+
+func (s *Service) DeleteWebhook(ctx context.Context, input *DeleteWebhookInput) error {
+	webhook, err := s.getWebhook(ctx, input.Username, domain.WebhookID(input.ID))
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteWebhook(ctx, webhook)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return nil
+}
+
+// Publish는 이벤트를 고른 webhook마다 보낼 기록을 남긴다. 실제로 보내는 것은 Dispatch이다.
+func (s *Service) Publish(ctx context.Context, input *PublishInput) error {
+	event := domain.WebhookEvent(input.Event)
+
+	webhooks, err := s.repo.ListWebhooks(ctx, input.Username)
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	payload, err := json.Marshal(&Payload{
+		Event:       input.Event,
+		TaskID:      input.TaskID,
+		ParentID:    input.ParentID,
+		OldParentID: input.OldParentID,
+		Status:      input.Status,
+		OccurredAt:  input.At,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	now := s.clock.Now()
+
+	var deliveries []*domain.WebhookDelivery
+
+	for _, webhook := range webhooks {
+		if !webhook.Subscribes(event) {
+			continue
+		}
+
+		deliveries = append(deliveries, domain.NewPendingWebhookDelivery(
+			domain.WebhookDeliveryID(s.idmaker.MakeID()),
+			webhook,
+			event,
+			payload,
+			now,
+		))
+	}
+
+	err = s.repo.CreateWebhookDeliveries(ctx, deliveries...)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+// Dispatch는 보낼 때가 된 기록을 모두 보내고 성공한 수를 돌려준다.
+func (s *Service) Dispatch(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delivered := 0
+
+	for {
+		deliveries, err := s.repo.ListDueWebhookDeliveries(ctx, s.clock.Now(), batchSize)
+		if err != nil {
+			return delivered, fmt.Errorf("failed to list due webhook deliveries: %w", err)
+		}
+
+		count, err := s.deliverBatch(ctx, deliveries)
+		delivered += count
+
+		if err != nil {
+			return delivered, err
+		}
+
+		if len(deliveries) < batchSize {
+			return delivered, nil
+		}
+	}
+}
+
+// deliverBatch는 webhook마다 maxConcurrency개까지 동시에 보낸다. 느린 webhook이 다른 webhook을 막지 않는다.
+func (s *Service) deliverBatch(ctx context.Context, deliveries []*domain.WebhookDelivery) (int, error) {
+	queues := make(map[domain.WebhookID][]*domain.WebhookDelivery)
+	for _, delivery := range deliveries {
+		queues[delivery.WebhookID()] = append(queues[delivery.WebhookID()], delivery)
+	}
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex // 보내기만 동시에 하고 저장소에는 하나씩 쓴다
+		delivered int
+		errs      []error
+	)
+
+	record := func(result *domain.WebhookDelivery, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if err == nil {
+			err = s.repo.UpdateWebhookDelivery(ctx, result)
+			if err != nil {
+				err = fmt.Errorf("failed to update webhook delivery: %w", err)
+			}
+		}
+
+		switch {
+		case err != nil:
+			errs = append(errs, err)
+		case result.Status() == domain.WebhookDeliveryDelivered:
+			delivered++
+		}
+	}
+
+	for _, queue := range queues {
+		mu.Lock()
+		webhook, err := s.repo.GetWebhook(ctx, queue[0].Username(), queue[0].WebhookID())
+		mu.Unlock()
+
+		if errors.Is(err, repository.ErrWebhookNotFound) {
+			for _, delivery := range queue {
+				record(delivery.Fail(0, "webhook deleted", time.Time{}), nil)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			record(nil, fmt.Errorf("failed to get webhook: %w", err))
+
+			continue
+		}
+
+		next := make(chan *domain.WebhookDelivery, len(queue))
+		for _, delivery := range queue {
+			next <- delivery
+		}
+
+		close(next)
+
+		for range min(len(queue), maxConcurrency) {
+			wg.Go(func() {
+				for delivery := range next {
+					record(s.send(ctx, webhook, delivery), nil)
+				}
+			})
+		}
+	}
+
+	wg.Wait()
+
+	return delivered, errors.Join(errs...)
+}
+
+func (s *Service) ListDeliveries(ctx context.Context, input *ListDeliveriesInput) (*ListDeliveriesOutput, error) {
+	webhook, err := s.getWebhook(ctx, input.Username, domain.WebhookID(input.WebhookID))
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.repo.ListWebhookDeliveries(ctx, input.Username, webhook.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	var items []*Delivery
+	for _, delivery := range deliveries {
+		items = append(items, makeDelivery(delivery))
+	}
+
+	return &ListDeliveriesOutput{
+		Deliveries: items,
+	}, nil
+}
+
+// Redeliver는 기록의 내용을 새 기록으로 다시 보낸다. 성공한 기록도 다시 보낼 수 있다.
+func (s *Service) Redeliver(ctx context.Context, input *RedeliverInput) (*RedeliverOutput, error) {
+	delivery, err := s.repo.GetWebhookDelivery(ctx, input.Username, domain.WebhookDeliveryID(input.DeliveryID))
+	if err != nil {
+		if errors.Is(err, repository.ErrWebhookDeliveryNotFound) {
+			return nil, ErrDeliveryNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	if delivery.WebhookID() != domain.WebhookID(input.WebhookID) {
+		return nil, ErrDeliveryNotFound
+	}
+
+	redelivery := delivery.Redeliver(domain.WebhookDeliveryID(s.idmaker.MakeID()), input.Now)
+
+	err = s.repo.CreateWebhookDeliveries(ctx, redelivery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+
+	return &RedeliverOutput{
+		Delivery: makeDelivery(redelivery),
+	}, nil
+}
+
+// send는 기록을 보내고 그 결과를 돌려준다.
+func (s *Service) send(
+	ctx context.Context,
+	webhook *domain.Webhook,
+	delivery *domain.WebhookDelivery,
+) *domain.WebhookDelivery {
+	statusCode, err := s.post(ctx, webhook, delivery)
+	if err != nil {
+		return delivery.Fail(statusCode, err.Error(), s.nextAttemptAt(delivery))
+	}
+
+	return delivery.Succeed(statusCode, s.clock.Now())
+}
+
+// post는 서명한 본문을 보내고 응답 코드를 돌려준다.
+func (s *Service) post(ctx context.Context, webhook *domain.Webhook, delivery *domain.WebhookDelivery) (int, error) {
+	body := delivery.Payload()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL(), bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "focus-webhook")
+	req.Header.Set(EventHeader, string(delivery.Event()))
+	req.Header.Set(DeliveryHeader, string(delivery.ID()))
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret(), body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	// 연결을 다시 쓸 수 있도록 본문을 읽어서 버린다.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("%w: %d", errUnexpectedStatus, resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// nextAttemptAt은 실패한 뒤의 다음 시도 시각이며, 더 보내지 않으면 zero이다.
+func (s *Service) nextAttemptAt(delivery *domain.WebhookDelivery) time.Time {
+	attempts := delivery.Attempts() + 1
+	if attempts >= maxAttempts {
+		return time.Time{}
+	}
+
+	backoff := min(baseBackoff<<(attempts-1), maxBackoff)
+
+	return s.clock.Now().Add(backoff)
+}
+
+func (s *Service) getWebhook(ctx context.Context, username string, id domain.WebhookID) (*domain.Webhook, error) {
+	webhook, err := s.repo.GetWebhook(ctx, username, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrWebhookNotFound) {
+			return nil, ErrWebhookNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+func makeWebhook(webhook *domain.Webhook) *Webhook {
+	var events []string
+	for _, event := range webhook.Events() {
+		events = append(events, string(event))
+	}
+
+	return &Webhook{
+		ID:        string(webhook.ID()),
+		URL:       webhook.URL(),
+		Events:    events,
+		Secret:    "",
+		CreatedAt: webhook.CreatedAt(),
+	}
+}
+
+func makeDelivery(delivery *domain.WebhookDelivery) *Delivery {
+	return &Delivery{
+		ID:             string(delivery.ID()),
+		WebhookID:      string(delivery.WebhookID()),
+		Event:          string(delivery.Event()),
+		Payload:        string(delivery.Payload()),
+		Status:         string(delivery.Status()),
+		Attempts:       delivery.Attempts(),
+		NextAttemptAt:  delivery.NextAttemptAt(),
+		LastStatusCode: delivery.LastStatusCode(),
+		LastError:      delivery.LastError(),
+		CreatedAt:      delivery.CreatedAt(),
+		DeliveredAt:    delivery.DeliveredAt(),
+	}
+}
@@ -0,0 +1,441 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/app/webhook"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/idmaker/ulid"
+	"github.com/neatflowcv/focus/internal/pkg/repository/memory"
+	"github.com/stretchr/testify/require"
+)
+
+const username = "test"
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+// fakeResolver는 host와 상관없이 addrs를 돌려준다.
+type fakeResolver struct {
+	addrs []netip.Addr
+}
+
+func (r *fakeResolver) LookupNetIP(context.Context, string, string) ([]netip.Addr, error) {
+	return r.addrs, nil
+}
+
+type request struct {
+	header http.Header
+	body   []byte
+}
+
+// receiver는 받은 요청을 기록하고 delay만큼 기다렸다가 status에 정한 코드로 응답한다.
+type receiver struct {
+	mu          sync.Mutex
+	status      int
+	delay       time.Duration
+	requests    []*request
+	inFlight    int
+	maxInFlight int // 동시에 처리한 요청의 최대 수
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	r.inFlight++
+	r.maxInFlight = max(r.maxInFlight, r.inFlight)
+	delay := r.delay
+	r.mu.Unlock()
+
+	time.Sleep(delay)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.inFlight--
+	r.requests = append(r.requests, &request{header: req.Header, body: body})
+	w.WriteHeader(r.status)
+}
+
+func (r *receiver) setStatus(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.status = status
+}
+
+func (r *receiver) received() []*request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*request(nil), r.requests...)
+}
+
+type ServiceData struct {
+	repo     *memory.Repository
+	clock    *fakeClock
+	receiver *receiver
+	url      string
+}
+
+func newService(t *testing.T) (*webhook.Service, *ServiceData) {
+	t.Helper()
+
+	repo := memory.NewRepository()
+	clock := &fakeClock{now: time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)}
+	receiver := &receiver{
+		mu:          sync.Mutex{},
+		status:      http.StatusOK,
+		delay:       0,
+		requests:    nil,
+		inFlight:    0,
+		maxInFlight: 0,
+	}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	// 시험 서버는 루프백에 있으므로 공인 주소로 풀리는 것처럼 한다.
+	resolver := &fakeResolver{addrs: []netip.Addr{netip.MustParseAddr("203.0.113.10")}}
+
+	return webhook.NewService(clock, ulid.NewIDMaker(), repo, server.Client(), resolver), &ServiceData{
+		repo:     repo,
+		clock:    clock,
+		receiver: receiver,
+		url:      server.URL,
+	}
+}
+
+func createWebhook(t *testing.T, service *webhook.Service, data *ServiceData, events ...string) *webhook.Webhook {
+	t.Helper()
+
+	out, err := service.CreateWebhook(t.Context(), &webhook.CreateWebhookInput{
+		Username: username,
+		URL:      data.url,
+		Events:   events,
+		Now:      data.clock.now,
+	})
+	require.NoError(t, err)
+
+	return out.Webhook
+}
+
+func publish(t *testing.T, service *webhook.Service, data *ServiceData, event string) {
+	t.Helper()
+
+	err := service.Publish(t.Context(), &webhook.PublishInput{
+		Username:    username,
+		Event:       event,
+		TaskID:      "task",
+		ParentID:    "parent",
+		OldParentID: "",
+		Status:      "",
+		At:          data.clock.now,
+	})
+	require.NoError(t, err)
+}
+
+func listDeliveries(t *testing.T, service *webhook.Service, hook *webhook.Webhook) []*webhook.Delivery {
+	t.Helper()
+
+	out, err := service.ListDeliveries(t.Context(), &webhook.ListDeliveriesInput{
+		Username:  username,
+		WebhookID: hook.ID,
+	})
+	require.NoError(t, err)
+
+	return out.Deliveries
+}
+
+func TestServiceListWebhooks_HidesSecret(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	hook := createWebhook(t, service, data, "task.created")
+
+	out, err := service.ListWebhooks(t.Context(), &webhook.ListWebhooksInput{Username: username})
+
+	require.NoError(t, err)
+	require.NotEmpty(t, hook.Secret)
+	require.Len(t, out.Webhooks, 1)
+	require.Equal(t, hook.ID, out.Webhooks[0].ID)
+	require.Empty(t, out.Webhooks[0].Secret)
+}
+
+func TestServiceCreateWebhook_Error(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		url    string
+		events []string
+		err    error
+	}{
+		{name: "relative url", url: "/hook", events: []string{"task.created"}, err: webhook.ErrInvalidURL},
+		{name: "unsupported scheme", url: "ftp://example.com", events: []string{"task.created"}, err: webhook.ErrInvalidURL},
+		{name: "unknown event", url: "https://example.com", events: []string{"task.renamed"}, err: webhook.ErrInvalidEvent},
+		{name: "no event", url: "https://example.com", events: nil, err: webhook.ErrInvalidEvent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, _ := newService(t)
+
+			_, err := service.CreateWebhook(t.Context(), &webhook.CreateWebhookInput{
+				Username: username,
+				URL:      tt.url,
+				Events:   tt.events,
+				Now:      time.Now(),
+			})
+
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestServiceCreateWebhook_ForbiddenAddress(t *testing.T) {
+	t.Parallel()
+
+	for _, addr := range []string{"127.0.0.1", "10.0.0.1", "169.254.169.254", "::1", "fd00::1", "::ffff:192.168.0.1"} {
+		t.Run(addr, func(t *testing.T) {
+			t.Parallel()
+
+			resolver := &fakeResolver{addrs: []netip.Addr{netip.MustParseAddr("203.0.113.10"), netip.MustParseAddr(addr)}}
+			service := webhook.NewService(&fakeClock{now: time.Now()}, ulid.NewIDMaker(), memory.NewRepository(),
+				http.DefaultClient, resolver)
+
+			_, err := service.CreateWebhook(t.Context(), &webhook.CreateWebhookInput{
+				Username: username,
+				URL:      "https://hooks.example.com",
+				Events:   []string{"task.created"},
+				Now:      time.Now(),
+			})
+
+			require.ErrorIs(t, err, webhook.ErrInvalidURL)
+			require.ErrorIs(t, err, webhook.ErrForbiddenAddress)
+		})
+	}
+}
+
+func TestNewClient_ForbiddenAddress(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(server.Close)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := webhook.NewClient(time.Second).Do(req)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+
+	require.ErrorIs(t, err, webhook.ErrForbiddenAddress)
+}
+
+func TestServiceDispatch(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	hook := createWebhook(t, service, data, "task.created", "task.completed")
+	other := createWebhook(t, service, data, "task.deleted")
+	publish(t, service, data, "task.created")
+
+	delivered, err := service.Dispatch(t.Context())
+
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered)
+	requests := data.receiver.received()
+	require.Len(t, requests, 1)
+	require.Equal(t, webhook.Sign(hook.Secret, requests[0].body), requests[0].header.Get(webhook.SignatureHeader))
+	require.NotEqual(t, webhook.Sign(other.Secret, requests[0].body), requests[0].header.Get(webhook.SignatureHeader))
+	require.Equal(t, "task.created", requests[0].header.Get(webhook.EventHeader))
+
+	var payload webhook.Payload
+
+	require.NoError(t, json.Unmarshal(requests[0].body, &payload))
+	require.Equal(t, "task", payload.TaskID)
+	require.Equal(t, "parent", payload.ParentID)
+
+	deliveries := listDeliveries(t, service, hook)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, string(domain.WebhookDeliveryDelivered), deliveries[0].Status)
+	require.Equal(t, http.StatusOK, deliveries[0].LastStatusCode)
+	require.Empty(t, listDeliveries(t, service, other))
+}
+
+func TestServiceDispatch_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	data.receiver.delay = 50 * time.Millisecond
+	createWebhook(t, service, data, "task.created")
+
+	for range 10 {
+		publish(t, service, data, "task.created")
+	}
+
+	delivered, err := service.Dispatch(t.Context())
+
+	require.NoError(t, err)
+	require.Equal(t, 10, delivered)
+	require.Greater(t, data.receiver.maxInFlight, 1)
+	require.LessOrEqual(t, data.receiver.maxInFlight, 4)
+}
+
+func TestServiceDispatch_Retry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("backs off", func(t *testing.T) {
+		t.Parallel()
+
+		service, data := newService(t)
+		hook := createWebhook(t, service, data, "task.created")
+		publish(t, service, data, "task.created")
+		data.receiver.setStatus(http.StatusServiceUnavailable)
+
+		delivered, err := service.Dispatch(t.Context())
+
+		require.NoError(t, err)
+		require.Zero(t, delivered)
+		delivery := listDeliveries(t, service, hook)[0]
+		require.Equal(t, string(domain.WebhookDeliveryPending), delivery.Status)
+		require.Equal(t, 1, delivery.Attempts)
+		require.Equal(t, http.StatusServiceUnavailable, delivery.LastStatusCode)
+		require.Equal(t, data.clock.now.Add(30*time.Second), delivery.NextAttemptAt)
+
+		data.receiver.setStatus(http.StatusOK)
+		delivered, _ = service.Dispatch(t.Context())
+
+		require.Zero(t, delivered)
+
+		data.clock.now = data.clock.now.Add(30 * time.Second)
+		delivered, _ = service.Dispatch(t.Context())
+
+		require.Equal(t, 1, delivered)
+		require.Len(t, data.receiver.received(), 2)
+		require.Equal(t, 2, listDeliveries(t, service, hook)[0].Attempts)
+	})
+
+	t.Run("gives up", func(t *testing.T) {
+		t.Parallel()
+
+		service, data := newService(t)
+		hook := createWebhook(t, service, data, "task.created")
+		publish(t, service, data, "task.created")
+		data.receiver.setStatus(http.StatusInternalServerError)
+
+		for range 10 {
+			_, err := service.Dispatch(t.Context())
+			require.NoError(t, err)
+
+			data.clock.now = data.clock.now.Add(time.Hour)
+		}
+
+		delivery := listDeliveries(t, service, hook)[0]
+		require.Equal(t, string(domain.WebhookDeliveryFailed), delivery.Status)
+		require.Equal(t, 8, delivery.Attempts)
+		require.Len(t, data.receiver.received(), 8)
+	})
+}
+
+func TestServiceRedeliver(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	hook := createWebhook(t, service, data, "task.created")
+	publish(t, service, data, "task.created")
+	_, _ = service.Dispatch(t.Context())
+	original := listDeliveries(t, service, hook)[0]
+
+	out, err := service.Redeliver(t.Context(), &webhook.RedeliverInput{
+		Username:   username,
+		WebhookID:  hook.ID,
+		DeliveryID: original.ID,
+		Now:        data.clock.now,
+	})
+
+	require.NoError(t, err)
+	require.NotEqual(t, original.ID, out.Delivery.ID)
+	require.Equal(t, string(domain.WebhookDeliveryPending), out.Delivery.Status)
+
+	delivered, _ := service.Dispatch(t.Context())
+
+	require.Equal(t, 1, delivered)
+	requests := data.receiver.received()
+	require.Len(t, requests, 2)
+	require.Equal(t, requests[0].body, requests[1].body)
+	require.NotEqual(t, requests[0].header.Get(webhook.DeliveryHeader), requests[1].header.Get(webhook.DeliveryHeader))
+	require.Len(t, listDeliveries(t, service, hook), 2)
+}
+
+func TestServiceRedeliver_Error(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	hook := createWebhook(t, service, data, "task.created")
+	other := createWebhook(t, service, data, "task.created")
+	publish(t, service, data, "task.created")
+	delivery := listDeliveries(t, service, hook)[0]
+
+	tests := []struct {
+		name       string
+		username   string
+		webhookID  string
+		deliveryID string
+	}{
+		{name: "unknown delivery", username: username, webhookID: hook.ID, deliveryID: "unknown"},
+		{name: "other webhook", username: username, webhookID: other.ID, deliveryID: delivery.ID},
+		{name: "other user", username: "other", webhookID: hook.ID, deliveryID: delivery.ID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Redeliver(t.Context(), &webhook.RedeliverInput{
+				Username:   tt.username,
+				WebhookID:  tt.webhookID,
+				DeliveryID: tt.deliveryID,
+				Now:        data.clock.now,
+			})
+
+			require.ErrorIs(t, err, webhook.ErrDeliveryNotFound)
+		})
+	}
+}
+
+func TestServiceDeleteWebhook(t *testing.T) {
+	t.Parallel()
+
+	service, data := newService(t)
+	hook := createWebhook(t, service, data, "task.created")
+	publish(t, service, data, "task.created")
+
+	err := service.DeleteWebhook(t.Context(), &webhook.DeleteWebhookInput{Username: username, ID: hook.ID})
+
+	require.NoError(t, err)
+	require.Empty(t, data.repo.Deliveries)
+
+	_, err = service.ListDeliveries(t.Context(), &webhook.ListDeliveriesInput{Username: username, WebhookID: hook.ID})
+
+	require.ErrorIs(t, err, webhook.ErrWebhookNotFound)
+
+	err = service.DeleteWebhook(t.Context(), &webhook.DeleteWebhookInput{Username: username, ID: hook.ID})
+
+	require.ErrorIs(t, err, webhook.ErrWebhookNotFound)
+}
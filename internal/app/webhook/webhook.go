@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"time"
+)
+
+type CreateWebhookInput struct {
+	Username string
+	URL      string
+	Events   []string
+	Now      time.Time
+}
+
+type CreateWebhookOutput struct {
+	Webhook *Webhook
+}
+
+type Webhook struct {
+	ID        string
+	URL       string
+	Events    []string
+	Secret    string // 받는 쪽이 서명을 확인할 때 쓴다. 만들 때만 채운다
+	CreatedAt time.Time
+}
+
+type ListWebhooksInput struct {
+	Username string
+}
+
+type ListWebhooksOutput struct {
+	Webhooks []*Webhook
+}
+
+type DeleteWebhookInput struct {
+	Username string
+	ID       string
+}
+
+type PublishInput struct {
+	Username    string
+	Event       string
+	TaskID      string
+	ParentID    string
+	OldParentID string
+	Status      string
+	At          time.Time
+}
+
+// Payload는 webhook으로 보내는 JSON 본문이다.
+type Payload struct {
+	Event       string    `json:"event"`
+	TaskID      string    `json:"task_id"`
+	ParentID    string    `json:"parent_id,omitempty"`
+	OldParentID string    `json:"old_parent_id,omitempty"`
+	Status      string    `json:"status,omitempty"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+type ListDeliveriesInput struct {
+	Username  string
+	WebhookID string
+}
+
+type Delivery struct {
+	ID             string
+	WebhookID      string
+	Event          string
+	Payload        string
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	CreatedAt      time.Time
+	DeliveredAt    time.Time
+}
+
+type ListDeliveriesOutput struct {
+	Deliveries []*Delivery
+}
+
+type RedeliverInput struct {
+	Username   string
+	WebhookID  string
+	DeliveryID string
+	Now        time.Time
+}
+
+type RedeliverOutput struct {
+	Delivery *Delivery
+}
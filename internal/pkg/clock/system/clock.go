@@ -0,0 +1,19 @@
+package system
+
+import (
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/clock"
+)
+
+var _ clock.Clock = &Clock{}
+
+type Clock struct{}
+
+func NewClock() *Clock {
+	return &Clock{}
+}
+
+func (c *Clock) Now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,94 @@
+package domain
+
+import "time"
+
+// AutoStopPolicy는 사용자가 멈추는 것을 잊은 trace를 언제 자동으로 멈출지 정한다.
+type AutoStopPolicy struct {
+	username  string
+	threshold time.Duration  // 0이 아니면 이 시간보다 오래 실행된 trace를 멈춘다
+	cap       time.Duration  // 0이 아니면 멈출 때 기록되는 시간을 이 시간으로 제한한다
+	midnight  bool           // true이면 시작한 날의 자정을 넘긴 trace를 멈춘다
+	location  *time.Location // 자정을 계산하는 기준 시간대
+}
+
+func NewAutoStopPolicy(
+	username string,
+	threshold time.Duration,
+	stopCap time.Duration,
+	midnight bool,
+	location *time.Location,
+) *AutoStopPolicy {
+	ret := &AutoStopPolicy{
+		username:  username,
+		threshold: threshold,
+		cap:       stopCap,
+		midnight:  midnight,
+		location:  location,
+	}
+	ret.validate()
+
+	return ret
+}
+
+func (p *AutoStopPolicy) Username() string {
+	return p.username
+}
+
+func (p *AutoStopPolicy) Threshold() time.Duration {
+	return p.threshold
+}
+
+func (p *AutoStopPolicy) Cap() time.Duration {
+	return p.cap
+}
+
+func (p *AutoStopPolicy) Midnight() bool {
+	return p.midnight
+}
+
+func (p *AutoStopPolicy) Location() *time.Location {
+	return p.location
+}
+
+// StopAt은 startedAt에 시작한 trace를 now에 멈춰야 하는지와 멈춘 것으로 기록할 시점을 반환한다.
+func (p *AutoStopPolicy) StopAt(startedAt time.Time, now time.Time) (time.Time, bool) {
+	var deadline time.Time
+
+	if p.threshold > 0 {
+		deadline = startedAt.Add(p.threshold)
+	}
+
+	if p.midnight {
+		local := startedAt.In(p.location)
+		year, month, day := local.Date()
+
+		midnight := time.Date(year, month, day+1, 0, 0, 0, 0, p.location)
+		if deadline.IsZero() || midnight.Before(deadline) {
+			deadline = midnight
+		}
+	}
+
+	if deadline.IsZero() || now.Before(deadline) {
+		return time.Time{}, false
+	}
+
+	if p.cap > 0 && startedAt.Add(p.cap).Before(deadline) {
+		return startedAt.Add(p.cap), true
+	}
+
+	return deadline, true
+}
+
+func (p *AutoStopPolicy) validate() {
+	if p.username == "" {
+		panic("username is required")
+	}
+
+	if p.threshold < 0 || p.cap < 0 {
+		panic("negative duration")
+	}
+
+	if p.location == nil {
+		panic("location is required")
+	}
+}
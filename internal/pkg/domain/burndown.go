@@ -0,0 +1,141 @@
+package domain
+
+import "time"
+
+// TrackedProject는 매일 burndown snapshot을 남길 subtree이다.
+type TrackedProject struct {
+	username string
+	rootID   TaskID
+	location *time.Location // 하루의 경계를 계산하는 기준 시간대
+}
+
+func NewTrackedProject(username string, rootID TaskID, location *time.Location) *TrackedProject {
+	ret := &TrackedProject{
+		username: username,
+		rootID:   rootID,
+		location: location,
+	}
+	ret.validate()
+
+	return ret
+}
+
+func (p *TrackedProject) Username() string {
+	return p.username
+}
+
+func (p *TrackedProject) RootID() TaskID {
+	return p.rootID
+}
+
+func (p *TrackedProject) Location() *time.Location {
+	return p.location
+}
+
+// Date는 now가 프로젝트 시간대에서 속한 날짜를 UTC 자정으로 반환한다.
+func (p *TrackedProject) Date(now time.Time) time.Time {
+	year, month, day := now.In(p.location).Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func (p *TrackedProject) validate() {
+	if p.username == "" {
+		panic("username is required")
+	}
+
+	if p.rootID == "" {
+		panic("root id is required")
+	}
+
+	if p.location == nil {
+		panic("location is required")
+	}
+}
+
+// BurndownSnapshot은 하루가 끝날 때의 subtree 진행 상황이다.
+type BurndownSnapshot struct {
+	username  string
+	rootID    TaskID
+	date      time.Time     // UTC 자정
+	remaining time.Duration // 완료되지 않은 leaf의 예상 시간 합
+	completed time.Duration // 완료된 leaf의 예상 시간 합
+	actual    time.Duration // subtree의 실제 시간
+	open      int           // 완료되지 않은 leaf 수
+	done      int           // 완료된 leaf 수
+}
+
+func NewBurndownSnapshot(
+	username string,
+	rootID TaskID,
+	date time.Time,
+	remaining time.Duration,
+	completed time.Duration,
+	actual time.Duration,
+	open int,
+	done int,
+) *BurndownSnapshot {
+	ret := &BurndownSnapshot{
+		username:  username,
+		rootID:    rootID,
+		date:      date,
+		remaining: remaining,
+		completed: completed,
+		actual:    actual,
+		open:      open,
+		done:      done,
+	}
+	ret.validate()
+
+	return ret
+}
+
+func (s *BurndownSnapshot) Username() string {
+	return s.username
+}
+
+func (s *BurndownSnapshot) RootID() TaskID {
+	return s.rootID
+}
+
+func (s *BurndownSnapshot) Date() time.Time {
+	return s.date
+}
+
+func (s *BurndownSnapshot) Remaining() time.Duration {
+	return s.remaining
+}
+
+func (s *BurndownSnapshot) Completed() time.Duration {
+	return s.completed
+}
+
+func (s *BurndownSnapshot) Actual() time.Duration {
+	return s.actual
+}
+
+func (s *BurndownSnapshot) Open() int {
+	return s.open
+}
+
+func (s *BurndownSnapshot) Done() int {
+	return s.done
+}
+
+func (s *BurndownSnapshot) validate() {
+	if s.username == "" {
+		panic("username is required")
+	}
+
+	if s.rootID == "" {
+		panic("root id is required")
+	}
+
+	if s.date.Location() != time.UTC || !s.date.Equal(s.date.Truncate(24*time.Hour)) {
+		panic("date must be midnight in UTC")
+	}
+
+	if s.remaining < 0 || s.completed < 0 || s.actual < 0 || s.open < 0 || s.done < 0 {
+		panic("negative value")
+	}
+}
@@ -1,12 +1,20 @@
 package domain
 
+import "time"
+
 type ExtraID string
 
 type Extra struct {
 	id       ExtraID
 	parentID ExtraID
 	leaf     bool
-	status   TaskStatus
+	status   TaskStatus // 사용자가 정의한 상태일 수 있다
+	category TaskStatus // status가 속한 기본 상태
+	rollup   bool       // true이면 자식이 있을 때 상태를 자식으로부터 계산한다
+
+	firstStartedAt time.Time // 처음 doing이 된 시각, 시작한 적이 없으면 zero
+	completedAt    time.Time // 마지막으로 done이 된 시각, done이 아니면 zero
+	reopens        int       // done에서 다른 상태로 돌아간 횟수
 }
 
 func NewExtra(
@@ -14,12 +22,22 @@ func NewExtra(
 	parentID ExtraID,
 	leaf bool,
 	status TaskStatus,
+	category TaskStatus,
+	rollup bool,
+	firstStartedAt time.Time,
+	completedAt time.Time,
+	reopens int,
 ) *Extra {
 	return &Extra{
-		id:       id,
-		parentID: parentID,
-		leaf:     leaf,
-		status:   status,
+		id:             id,
+		parentID:       parentID,
+		leaf:           leaf,
+		status:         status,
+		category:       category,
+		rollup:         rollup,
+		firstStartedAt: firstStartedAt,
+		completedAt:    completedAt,
+		reopens:        reopens,
 	}
 }
 
@@ -39,8 +57,33 @@ func (e *Extra) Status() TaskStatus {
 	return e.status
 }
 
+func (e *Extra) Category() TaskStatus {
+	return e.category
+}
+
+func (e *Extra) Rollup() bool {
+	return e.rollup
+}
+
+func (e *Extra) FirstStartedAt() time.Time {
+	return e.firstStartedAt
+}
+
+func (e *Extra) CompletedAt() time.Time {
+	return e.completedAt
+}
+
+func (e *Extra) Reopens() int {
+	return e.reopens
+}
+
+// IsDerived는 상태가 자식으로부터 계산되어 사용자가 직접 바꿀 수 없는지 확인한다.
+func (e *Extra) IsDerived() bool {
+	return e.rollup && !e.leaf
+}
+
 func (e *Extra) IsCompleted() bool {
-	return e.status == TaskStatusDone
+	return e.category == TaskStatusDone
 }
 
 func (e *Extra) SetLeaf(leaf bool) *Extra {
@@ -59,18 +102,48 @@ func (e *Extra) SetParentID(parentID ExtraID) *Extra {
 	return ret
 }
 
-func (e *Extra) SetStatus(status TaskStatus) *Extra {
+// SetStatus는 상태를 바꾸고 분류의 변화에 따라 시작, 완료, 재개 기록을 남긴다.
+func (e *Extra) SetStatus(status TaskStatus, category TaskStatus, now time.Time) *Extra {
 	ret := e.clone()
 	ret.status = status
+	ret.category = category
+
+	if category == TaskStatusDoing && ret.firstStartedAt.IsZero() {
+		ret.firstStartedAt = now
+	}
+
+	switch {
+	case category == TaskStatusDone && e.category != TaskStatusDone:
+		ret.completedAt = now
+	case category != TaskStatusDone && e.category == TaskStatusDone:
+		ret.completedAt = time.Time{}
+		ret.reopens++
+	}
+
+	ret.validate()
+
+	return ret
+}
+
+func (e *Extra) SetRollup(rollup bool) *Extra {
+	ret := e.clone()
+	ret.rollup = rollup
 	ret.validate()
 
 	return ret
 }
 
 func (e *Extra) clone() *Extra {
-	return NewExtra(e.id, e.parentID, e.leaf, e.status)
+	return NewExtra(
+		e.id, e.parentID, e.leaf, e.status, e.category, e.rollup, e.firstStartedAt, e.completedAt, e.reopens,
+	)
 }
 
 func (e *Extra) validate() {
-	e.status.validate()
+	e.status.validateName()
+	e.category.validate()
+
+	if e.reopens < 0 {
+		panic("reopens must not be negative")
+	}
 }
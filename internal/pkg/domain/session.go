@@ -0,0 +1,106 @@
+package domain
+
+import "time"
+
+type SessionID string
+
+// Session은 trace가 시작되고 멈춘 한 구간이다.
+type Session struct {
+	id        SessionID
+	traceID   TraceID
+	startedAt time.Time
+	endedAt   time.Time
+	auto      bool // 사용자가 아니라 auto-stop 작업이 멈춘 구간이다
+	note      string
+}
+
+func NewSession(
+	id SessionID,
+	traceID TraceID,
+	startedAt time.Time,
+	endedAt time.Time,
+	auto bool,
+	note string,
+) *Session {
+	ret := &Session{
+		id:        id,
+		traceID:   traceID,
+		startedAt: startedAt,
+		endedAt:   endedAt,
+		auto:      auto,
+		note:      note,
+	}
+	ret.validate()
+
+	return ret
+}
+
+func (s *Session) ID() SessionID {
+	return s.id
+}
+
+func (s *Session) TraceID() TraceID {
+	return s.traceID
+}
+
+func (s *Session) StartedAt() time.Time {
+	return s.startedAt
+}
+
+func (s *Session) EndedAt() time.Time {
+	return s.endedAt
+}
+
+func (s *Session) Auto() bool {
+	return s.auto
+}
+
+func (s *Session) Note() string {
+	return s.note
+}
+
+// SetRange는 구간을 바꾼다. 사용자가 고친 구간이므로 auto 표시를 지운다.
+func (s *Session) SetRange(startedAt time.Time, endedAt time.Time) *Session {
+	ret := s.clone()
+	ret.startedAt = startedAt
+	ret.endedAt = endedAt
+	ret.auto = false
+	ret.validate()
+
+	return ret
+}
+
+func (s *Session) SetNote(note string) *Session {
+	ret := s.clone()
+	ret.note = note
+	ret.validate()
+
+	return ret
+}
+
+// Overlaps는 두 구간이 겹치는지 반환한다. 끝과 시작이 맞닿은 것은 겹치지 않는다.
+func (s *Session) Overlaps(startedAt time.Time, endedAt time.Time) bool {
+	return s.startedAt.Before(endedAt) && startedAt.Before(s.endedAt)
+}
+
+func (s *Session) Duration() time.Duration {
+	return s.endedAt.Sub(s.startedAt)
+}
+
+func (s *Session) validate() {
+	if s.id == "" {
+		panic("id is required")
+	}
+
+	if s.traceID == "" {
+		panic("trace id is required")
+	}
+
+	if s.endedAt.Before(s.startedAt) {
+		panic("session ends before it starts")
+	}
+}
+
+func (s *Session) clone() *Session {
+	return NewSession(s.id, s.traceID, s.startedAt, s.endedAt, s.auto, s.note)
+}
@@ -1,18 +1,62 @@
 package domain
 
+import "regexp"
+
 type TaskStatus string
 
+// 기본 상태이며, 사용자가 정의한 상태는 이 중 하나에 속한다.
 const (
 	TaskStatusTodo  TaskStatus = "todo"
 	TaskStatusDoing TaskStatus = "doing"
 	TaskStatusDone  TaskStatus = "done"
 )
 
-func (s TaskStatus) validate() {
+var statusNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`) //nolint:gochecknoglobals
+
+// IsValid는 기본 상태인지 확인한다.
+func (s TaskStatus) IsValid() bool {
 	switch s {
 	case TaskStatusTodo, TaskStatusDoing, TaskStatusDone:
-		return
+		return true
 	default:
+		return false
+	}
+}
+
+// IsValidName은 사용자가 정의한 상태의 이름으로 쓸 수 있는지 확인한다.
+func (s TaskStatus) IsValidName() bool {
+	return statusNamePattern.MatchString(string(s))
+}
+
+// RollupStatus는 자식의 기본 상태로부터 부모의 기본 상태를 계산한다.
+func RollupStatus(categories []TaskStatus) TaskStatus {
+	done := true
+
+	for _, category := range categories {
+		if category == TaskStatusDoing {
+			return TaskStatusDoing
+		}
+
+		if category != TaskStatusDone {
+			done = false
+		}
+	}
+
+	if done {
+		return TaskStatusDone
+	}
+
+	return TaskStatusTodo
+}
+
+func (s TaskStatus) validate() {
+	if !s.IsValid() {
 		panic("invalid task status: " + string(s))
 	}
 }
+
+func (s TaskStatus) validateName() {
+	if !s.IsValidName() {
+		panic("invalid task status name: " + string(s))
+	}
+}
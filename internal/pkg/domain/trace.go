@@ -8,23 +8,29 @@ type Trace struct {
 	id        TraceID
 	parentID  TraceID
 	estimated time.Duration
-	actual    time.Duration
+	self      time.Duration // trace 자신에 기록된 시간
+	actual    time.Duration // self와 모든 하위 trace의 self를 합한 시간. self로부터 다시 계산할 수 있다
 	startedAt time.Time
+	dueAt     time.Time
 }
 
 func NewTrace(
 	id TraceID,
 	parentID TraceID,
 	estimated time.Duration,
+	self time.Duration,
 	actual time.Duration,
 	startedAt time.Time,
+	dueAt time.Time,
 ) *Trace {
 	ret := &Trace{
 		id:        id,
 		parentID:  parentID,
 		estimated: estimated,
+		self:      self,
 		actual:    actual,
 		startedAt: startedAt,
+		dueAt:     dueAt,
 	}
 	ret.validate()
 
@@ -39,6 +45,10 @@ func (t *Trace) Estimated() time.Duration {
 	return t.estimated
 }
 
+func (t *Trace) Self() time.Duration {
+	return t.self
+}
+
 func (t *Trace) Actual() time.Duration {
 	return t.actual
 }
@@ -51,6 +61,10 @@ func (t *Trace) StartedAt() time.Time {
 	return t.startedAt
 }
 
+func (t *Trace) DueAt() time.Time {
+	return t.dueAt
+}
+
 func (t *Trace) SetEstimated(estimated time.Duration) *Trace {
 	ret := t.clone()
 	ret.estimated = estimated
@@ -59,6 +73,14 @@ func (t *Trace) SetEstimated(estimated time.Duration) *Trace {
 	return ret
 }
 
+func (t *Trace) SetSelf(self time.Duration) *Trace {
+	ret := t.clone()
+	ret.self = self
+	ret.validate()
+
+	return ret
+}
+
 func (t *Trace) SetActual(actual time.Duration) *Trace {
 	ret := t.clone()
 	ret.actual = actual
@@ -83,6 +105,14 @@ func (t *Trace) SetStartedAt(startedAt time.Time) *Trace {
 	return ret
 }
 
+func (t *Trace) SetDueAt(dueAt time.Time) *Trace {
+	ret := t.clone()
+	ret.dueAt = dueAt
+	ret.validate()
+
+	return ret
+}
+
 func (t *Trace) validate() {
 	if t.estimated < 0 {
 		panic("estimated is required")
@@ -90,5 +120,5 @@ func (t *Trace) validate() {
 }
 
 func (t *Trace) clone() *Trace {
-	return NewTrace(t.id, t.parentID, t.estimated, t.actual, t.startedAt)
+	return NewTrace(t.id, t.parentID, t.estimated, t.self, t.actual, t.startedAt, t.dueAt)
 }
@@ -0,0 +1,306 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+type WebhookID string
+
+type WebhookEvent string
+
+const (
+	WebhookEventTaskCreated   WebhookEvent = "task.created"
+	WebhookEventTaskCompleted WebhookEvent = "task.completed"
+	WebhookEventTaskMoved     WebhookEvent = "task.moved"
+	WebhookEventTaskDeleted   WebhookEvent = "task.deleted"
+	WebhookEventTimerStarted  WebhookEvent = "timer.started"
+	WebhookEventTimerStopped  WebhookEvent = "timer.stopped"
+)
+
+func (e WebhookEvent) IsValid() bool {
+	switch e {
+	case WebhookEventTaskCreated,
+		WebhookEventTaskCompleted,
+		WebhookEventTaskMoved,
+		WebhookEventTaskDeleted,
+		WebhookEventTimerStarted,
+		WebhookEventTimerStopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// Webhook은 사용자가 고른 이벤트를 받을 URL이다. 보내는 내용은 secret으로 서명한다.
+type Webhook struct {
+	id        WebhookID
+	username  string
+	url       string
+	secret    string
+	events    []WebhookEvent
+	createdAt time.Time
+}
+
+func NewWebhook(
+	id WebhookID,
+	username string,
+	url string,
+	secret string,
+	events []WebhookEvent,
+	createdAt time.Time,
+) *Webhook {
+	ret := &Webhook{
+		id:        id,
+		username:  username,
+		url:       url,
+		secret:    secret,
+		events:    events,
+		createdAt: createdAt,
+	}
+	ret.validate()
+
+	return ret
+}
+
+func (w *Webhook) ID() WebhookID {
+	return w.id
+}
+
+func (w *Webhook) Username() string {
+	return w.username
+}
+
+func (w *Webhook) URL() string {
+	return w.url
+}
+
+func (w *Webhook) Secret() string {
+	return w.secret
+}
+
+func (w *Webhook) Events() []WebhookEvent {
+	return slices.Clone(w.events)
+}
+
+func (w *Webhook) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+func (w *Webhook) Subscribes(event WebhookEvent) bool {
+	return slices.Contains(w.events, event)
+}
+
+func (w *Webhook) validate() {
+	if w.id == "" {
+		panic("id is required")
+	}
+
+	if w.url == "" {
+		panic("url is required")
+	}
+
+	if w.secret == "" {
+		panic("secret is required")
+	}
+
+	if len(w.events) == 0 {
+		panic("events are required")
+	}
+
+	for _, event := range w.events {
+		if !event.IsValid() {
+			panic("invalid webhook event: " + string(event))
+		}
+	}
+}
+
+type WebhookDeliveryID string
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed" // 재시도를 모두 실패했다
+)
+
+// WebhookDelivery는 이벤트 하나를 webhook 하나로 보낸 기록이다.
+type WebhookDelivery struct {
+	id             WebhookDeliveryID
+	webhookID      WebhookID
+	username       string
+	event          WebhookEvent
+	payload        []byte
+	status         WebhookDeliveryStatus
+	attempts       int
+	nextAttemptAt  time.Time // pending일 때 다음에 보낼 시각
+	lastStatusCode int       // 마지막 시도의 응답 코드이며 응답을 받지 못했으면 0이다
+	lastError      string
+	createdAt      time.Time
+	deliveredAt    time.Time
+}
+
+func NewWebhookDelivery( //nolint:funlen
+	id WebhookDeliveryID,
+	webhookID WebhookID,
+	username string,
+	event WebhookEvent,
+	payload []byte,
+	status WebhookDeliveryStatus,
+	attempts int,
+	nextAttemptAt time.Time,
+	lastStatusCode int,
+	lastError string,
+	createdAt time.Time,
+	deliveredAt time.Time,
+) *WebhookDelivery {
+	ret := &WebhookDelivery{
+		id:             id,
+		webhookID:      webhookID,
+		username:       username,
+		event:          event,
+		payload:        payload,
+		status:         status,
+		attempts:       attempts,
+		nextAttemptAt:  nextAttemptAt,
+		lastStatusCode: lastStatusCode,
+		lastError:      lastError,
+		createdAt:      createdAt,
+		deliveredAt:    deliveredAt,
+	}
+	ret.validate()
+
+	return ret
+}
+
+// NewPendingWebhookDelivery는 now에 바로 보낼 기록을 만든다.
+func NewPendingWebhookDelivery(
+	id WebhookDeliveryID,
+	webhook *Webhook,
+	event WebhookEvent,
+	payload []byte,
+	now time.Time,
+) *WebhookDelivery {
+	return NewWebhookDelivery(
+		id, webhook.ID(), webhook.Username(), event, payload,
+		WebhookDeliveryPending, 0, now, 0, "", now, time.Time{},
+	)
+}
+
+func (d *WebhookDelivery) ID() WebhookDeliveryID {
+	return d.id
+}
+
+func (d *WebhookDelivery) WebhookID() WebhookID {
+	return d.webhookID
+}
+
+func (d *WebhookDelivery) Username() string {
+	return d.username
+}
+
+func (d *WebhookDelivery) Event() WebhookEvent {
+	return d.event
+}
+
+func (d *WebhookDelivery) Payload() []byte {
+	return slices.Clone(d.payload)
+}
+
+func (d *WebhookDelivery) Status() WebhookDeliveryStatus {
+	return d.status
+}
+
+func (d *WebhookDelivery) Attempts() int {
+	return d.attempts
+}
+
+func (d *WebhookDelivery) NextAttemptAt() time.Time {
+	return d.nextAttemptAt
+}
+
+func (d *WebhookDelivery) LastStatusCode() int {
+	return d.lastStatusCode
+}
+
+func (d *WebhookDelivery) LastError() string {
+	return d.lastError
+}
+
+func (d *WebhookDelivery) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *WebhookDelivery) DeliveredAt() time.Time {
+	return d.deliveredAt
+}
+
+func (d *WebhookDelivery) Succeed(statusCode int, at time.Time) *WebhookDelivery {
+	ret := d.clone()
+	ret.status = WebhookDeliveryDelivered
+	ret.attempts++
+	ret.nextAttemptAt = time.Time{}
+	ret.lastStatusCode = statusCode
+	ret.lastError = ""
+	ret.deliveredAt = at
+	ret.validate()
+
+	return ret
+}
+
+// Fail은 실패한 시도를 기록한다. nextAttemptAt이 zero이면 더 이상 보내지 않는다.
+func (d *WebhookDelivery) Fail(statusCode int, message string, nextAttemptAt time.Time) *WebhookDelivery {
+	ret := d.clone()
+	ret.attempts++
+	ret.nextAttemptAt = nextAttemptAt
+	ret.lastStatusCode = statusCode
+	ret.lastError = message
+
+	if nextAttemptAt.IsZero() {
+		ret.status = WebhookDeliveryFailed
+	}
+
+	ret.validate()
+
+	return ret
+}
+
+// Redeliver는 같은 내용을 now에 다시 보낼 새 기록을 만든다. 원래 기록은 그대로 남는다.
+func (d *WebhookDelivery) Redeliver(id WebhookDeliveryID, now time.Time) *WebhookDelivery {
+	return NewWebhookDelivery(
+		id, d.webhookID, d.username, d.event, d.payload,
+		WebhookDeliveryPending, 0, now, 0, "", now, time.Time{},
+	)
+}
+
+func (d *WebhookDelivery) validate() {
+	if d.id == "" {
+		panic("id is required")
+	}
+
+	if d.webhookID == "" {
+		panic("webhook id is required")
+	}
+
+	if !d.event.IsValid() {
+		panic("invalid webhook event: " + string(d.event))
+	}
+
+	switch d.status {
+	case WebhookDeliveryPending:
+		if d.nextAttemptAt.IsZero() {
+			panic("pending delivery needs next attempt time")
+		}
+	case WebhookDeliveryDelivered, WebhookDeliveryFailed:
+	default:
+		panic("invalid webhook delivery status: " + string(d.status))
+	}
+}
+
+func (d *WebhookDelivery) clone() *WebhookDelivery {
+	return NewWebhookDelivery(
+		d.id, d.webhookID, d.username, d.event, d.payload,
+		d.status, d.attempts, d.nextAttemptAt, d.lastStatusCode, d.lastError, d.createdAt, d.deliveredAt,
+	)
+}
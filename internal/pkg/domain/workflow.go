@@ -0,0 +1,152 @@
+package domain
+
+import "slices"
+
+// WorkflowStatus는 사용자가 정의한 상태와 그 상태가 속한 기본 상태이다.
+type WorkflowStatus struct {
+	name     TaskStatus
+	category TaskStatus
+}
+
+func NewWorkflowStatus(name TaskStatus, category TaskStatus) *WorkflowStatus {
+	ret := &WorkflowStatus{
+		name:     name,
+		category: category,
+	}
+	ret.validate()
+
+	return ret
+}
+
+func (s *WorkflowStatus) Name() TaskStatus {
+	return s.name
+}
+
+func (s *WorkflowStatus) Category() TaskStatus {
+	return s.category
+}
+
+func (s *WorkflowStatus) validate() {
+	s.name.validateName()
+	s.category.validate()
+
+	if s.name.IsValid() && s.name != s.category {
+		panic("core status must belong to itself: " + string(s.name))
+	}
+}
+
+type Transition struct {
+	from TaskStatus
+	to   TaskStatus
+}
+
+func NewTransition(from TaskStatus, to TaskStatus) *Transition {
+	return &Transition{
+		from: from,
+		to:   to,
+	}
+}
+
+func (t *Transition) From() TaskStatus {
+	return t.from
+}
+
+func (t *Transition) To() TaskStatus {
+	return t.to
+}
+
+// Workflow는 사용자가 쓸 수 있는 상태와 허용된 상태 전이이다.
+type Workflow struct {
+	username    string
+	statuses    []*WorkflowStatus // 기본 상태를 항상 포함한다
+	transitions []*Transition     // 비어 있으면 모든 전이를 허용한다
+}
+
+func NewWorkflow(username string, statuses []*WorkflowStatus, transitions []*Transition) *Workflow {
+	ret := &Workflow{
+		username:    username,
+		statuses:    statuses,
+		transitions: transitions,
+	}
+	ret.validate()
+
+	return ret
+}
+
+// NewDefaultWorkflow는 기본 상태만 있고 모든 전이를 허용하는 workflow를 만든다.
+func NewDefaultWorkflow(username string) *Workflow {
+	return NewWorkflow(
+		username,
+		[]*WorkflowStatus{
+			NewWorkflowStatus(TaskStatusTodo, TaskStatusTodo),
+			NewWorkflowStatus(TaskStatusDoing, TaskStatusDoing),
+			NewWorkflowStatus(TaskStatusDone, TaskStatusDone),
+		},
+		nil,
+	)
+}
+
+func (w *Workflow) Username() string {
+	return w.username
+}
+
+func (w *Workflow) Statuses() []*WorkflowStatus {
+	return slices.Clone(w.statuses)
+}
+
+func (w *Workflow) Transitions() []*Transition {
+	return slices.Clone(w.transitions)
+}
+
+// Category는 status가 속한 기본 상태를 반환한다. workflow에 없는 상태이면 false를 반환한다.
+func (w *Workflow) Category(status TaskStatus) (TaskStatus, bool) {
+	for _, item := range w.statuses {
+		if item.name == status {
+			return item.category, true
+		}
+	}
+
+	return "", false
+}
+
+// CanTransition은 from에서 to로 바꿀 수 있는지 확인한다. workflow에 없는 from은 어디로든 옮길 수 있다.
+func (w *Workflow) CanTransition(from TaskStatus, to TaskStatus) bool {
+	if len(w.transitions) == 0 {
+		return true
+	}
+
+	if _, ok := w.Category(from); !ok {
+		return true
+	}
+
+	return slices.ContainsFunc(w.transitions, func(t *Transition) bool {
+		return t.from == from && t.to == to
+	})
+}
+
+func (w *Workflow) validate() {
+	if w.username == "" {
+		panic("username is required")
+	}
+
+	names := make(map[TaskStatus]bool)
+	for _, status := range w.statuses {
+		if names[status.name] {
+			panic("duplicate status: " + string(status.name))
+		}
+
+		names[status.name] = true
+	}
+
+	for _, core := range []TaskStatus{TaskStatusTodo, TaskStatusDoing, TaskStatusDone} {
+		if !names[core] {
+			panic("missing core status: " + string(core))
+		}
+	}
+
+	for _, transition := range w.transitions {
+		if !names[transition.from] || !names[transition.to] {
+			panic("transition with unknown status")
+		}
+	}
+}
@@ -1,25 +1,384 @@
 package eventbus
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sync"
+	"time"
+)
 
-type Subscriber[T any] func(ctx context.Context, message T)
+// Subscriber는 이벤트를 처리한다. 에러를 돌려주면 RetryPolicy에 따라 다시 호출된다.
+type Subscriber[T any] func(ctx context.Context, message T) error
+
+// Keyed를 구현한 이벤트는 같은 key끼리 같은 worker에서 순서대로 처리된다.
+type Keyed interface {
+	Key() string
+}
+
+type envelope[T any] struct {
+	ctx     context.Context //nolint:containedctx // 발행한 요청의 값을 subscriber까지 넘긴다
+	message T
+}
 
 type Broker[T any] struct {
-	subscribers []Subscriber[T]
+	topic   string
+	options Options
+
+	subscribersMu sync.RWMutex
+	subscribers   []Subscriber[T]
+	interceptors  []Interceptor
+
+	queuesMu sync.RWMutex
+	closed   bool
+	queues   []chan *envelope[T]
+	workers  sync.WaitGroup
 }
 
-func NewBroker[T any]() *Broker[T] {
-	return &Broker[T]{
-		subscribers: nil,
+func NewBroker[T any](topic string, options Options) *Broker[T] {
+	broker := &Broker[T]{ //nolint:exhaustruct
+		topic:        topic,
+		options:      options,
+		subscribers:  nil,
+		interceptors: options.Interceptors,
 	}
+
+	if options.Async {
+		for range max(options.Workers, 1) {
+			queue := make(chan *envelope[T], options.QueueSize)
+			broker.queues = append(broker.queues, queue)
+
+			broker.workers.Add(1)
+
+			go broker.work(queue)
+		}
+	}
+
+	return broker
 }
 
 func (b *Broker[T]) Subscribe(subscriber Subscriber[T]) {
+	b.subscribersMu.Lock()
+	defer b.subscribersMu.Unlock()
+
 	b.subscribers = append(b.subscribers, subscriber)
 }
 
-func (b *Broker[T]) Publish(ctx context.Context, message T) {
-	for _, subscriber := range b.subscribers {
-		subscriber(ctx, message)
+// Use는 이후의 publish와 handle을 감쌀 interceptor를 등록한다. Options.Interceptors 뒤에 붙는다.
+func (b *Broker[T]) Use(interceptors ...Interceptor) {
+	b.subscribersMu.Lock()
+	defer b.subscribersMu.Unlock()
+
+	b.interceptors = append(slices.Clone(b.interceptors), interceptors...)
+}
+
+// Publish는 동기 모드에서는 subscriber를 호출하고, 비동기 모드에서는 queue에 넣는다.
+func (b *Broker[T]) Publish(ctx context.Context, message T) error {
+	return b.intercept(ctx, OperationPublish, message, 0, func(ctx context.Context) error {
+		if !b.options.Async {
+			_, err := b.dispatch(ctx, message, nil)
+
+			return err
+		}
+
+		return b.enqueue(ctx, message)
+	})
+}
+
+func (b *Broker[T]) enqueue(ctx context.Context, message T) error {
+	b.queuesMu.RLock()
+	defer b.queuesMu.RUnlock()
+
+	if b.closed {
+		return fmt.Errorf("failed to publish %s: %w", b.topic, ErrClosed)
+	}
+
+	item := &envelope[T]{
+		ctx:     context.WithoutCancel(ctx), // 요청이 끝나도 처리는 계속되어야 한다
+		message: message,
+	}
+
+	select {
+	case b.queues[b.partition(message)] <- item:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to publish %s: %w", b.topic, ctx.Err())
+	}
+}
+
+// Deliver는 queue를 거치지 않고 done에 없는 subscriber를 재시도 없이 한 번씩 호출한다.
+// 처리한 순번을 더한 done과 실패한 subscriber마다의 SubscriberError를 돌려준다.
+func (b *Broker[T]) Deliver(ctx context.Context, message T, done []int, attempt int) ([]int, error) {
+	b.subscribersMu.RLock()
+	subscribers := b.subscribers
+	b.subscribersMu.RUnlock()
+
+	err := b.intercept(ctx, OperationPublish, message, 0, func(ctx context.Context) error {
+		var errs []error
+
+		for idx, subscriber := range subscribers {
+			if slices.Contains(done, idx) {
+				continue
+			}
+
+			err := b.intercept(ctx, OperationHandle, message, attempt, func(ctx context.Context) error {
+				return subscriber(ctx, message)
+			})
+			if err != nil {
+				errs = append(errs, &SubscriberError{Topic: b.topic, Subscriber: idx, Err: err})
+
+				continue
+			}
+
+			done = append(done, idx)
+		}
+
+		return errors.Join(errs...)
+	})
+
+	return done, err
+}
+
+// Bury는 Deliver를 attempts번 실패한 subscriber의 dead letter를 남긴다. Options.DeadLetters가 없으면 버린다.
+func (b *Broker[T]) Bury(ctx context.Context, message T, subscriber int, attempts int, cause error) error {
+	if b.options.DeadLetters == nil {
+		return nil
+	}
+
+	err := b.options.DeadLetters.Save(ctx, &DeadLetter{
+		Topic:      b.topic,
+		Subscriber: subscriber,
+		Message:    message,
+		Error:      cause.Error(),
+		Attempts:   attempts,
+		FailedAt:   time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save dead letter: %w", err)
+	}
+
+	return nil
+}
+
+// Replay는 dead letter로 남은 이벤트를 실패한 subscriber에게만 다시 전달한다.
+func (b *Broker[T]) Replay(ctx context.Context, message T, subscriber int) error {
+	b.subscribersMu.RLock()
+	subscribers := b.subscribers
+	b.subscribersMu.RUnlock()
+
+	if subscriber < 0 || subscriber >= len(subscribers) {
+		return fmt.Errorf("%w: %s %d", ErrUnknownSubscriber, b.topic, subscriber)
+	}
+
+	return b.intercept(ctx, OperationPublish, message, 0, func(ctx context.Context) error {
+		attempts, err := b.attempt(ctx, subscribers[subscriber], message)
+		if err != nil {
+			return fmt.Errorf("failed to replay %s after %d attempts: %w", b.topic, attempts, err)
+		}
+
+		return nil
+	})
+}
+
+// Close는 더 이상 이벤트를 받지 않고, queue에 남은 이벤트를 모두 처리하거나 ctx가 끝날 때까지 기다린다.
+func (b *Broker[T]) Close(ctx context.Context) error {
+	b.queuesMu.Lock()
+	if !b.closed {
+		b.closed = true
+		for _, queue := range b.queues {
+			close(queue)
+		}
+	}
+	b.queuesMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		b.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to drain %s: %w", b.topic, ctx.Err())
+	}
+}
+
+func (b *Broker[T]) work(queue chan *envelope[T]) {
+	defer b.workers.Done()
+
+	for item := range queue {
+		// 실패는 dead letter로 남으므로 여기서는 버린다.
+		_, _ = b.dispatch(item.ctx, item.message, nil)
+	}
+}
+
+func (b *Broker[T]) partition(message T) int {
+	keyed, ok := any(message).(Keyed)
+	if !ok {
+		return 0
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(keyed.Key()))
+
+	return int(hash.Sum32() % uint32(len(b.queues))) //nolint:gosec
+}
+
+// intercept는 등록된 interceptor로 감싸서 run을 부른다.
+func (b *Broker[T]) intercept(
+	ctx context.Context,
+	operation Operation,
+	message T,
+	attempt int,
+	run func(ctx context.Context) error,
+) error {
+	b.subscribersMu.RLock()
+	interceptors := b.interceptors
+	b.subscribersMu.RUnlock()
+
+	if len(interceptors) == 0 {
+		return run(ctx)
 	}
+
+	handler := chain(interceptors, func(ctx context.Context, _ *Call) error {
+		return run(ctx)
+	})
+
+	return handler(ctx, &Call{
+		Operation: operation,
+		Topic:     b.topic,
+		Message:   message,
+		Attempt:   attempt,
+	})
+}
+
+func (b *Broker[T]) dispatch(ctx context.Context, message T, done []int) ([]int, error) {
+	b.subscribersMu.RLock()
+	subscribers := b.subscribers
+	b.subscribersMu.RUnlock()
+
+	var errs []error
+
+	for idx, subscriber := range subscribers {
+		if slices.Contains(done, idx) {
+			continue
+		}
+
+		err := b.deliver(ctx, idx, subscriber, message)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		done = append(done, idx)
+	}
+
+	return done, errors.Join(errs...)
+}
+
+// deliver는 subscriber를 호출하고, 끝내 실패하면 dead letter로 남긴다.
+func (b *Broker[T]) deliver(ctx context.Context, idx int, subscriber Subscriber[T], message T) error {
+	attempts, err := b.attempt(ctx, subscriber, message)
+	if err != nil {
+		return b.bury(ctx, idx, message, attempts, err)
+	}
+
+	return nil
+}
+
+// attempt는 subscriber가 성공하거나 시도 횟수를 다 쓸 때까지 호출하고 시도한 횟수를 돌려준다.
+func (b *Broker[T]) attempt(ctx context.Context, subscriber Subscriber[T], message T) (int, error) {
+	var (
+		err      error
+		attempts int
+	)
+
+	for attempts < max(b.options.Retry.Attempts, 1) {
+		if attempts > 0 {
+			timer := time.NewTimer(b.options.Retry.delay(attempts))
+
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+
+				return attempts, errors.Join(err, ctx.Err())
+			}
+		}
+
+		attempts++
+
+		err = b.intercept(ctx, OperationHandle, message, attempts, func(ctx context.Context) error {
+			return subscriber(ctx, message)
+		})
+		if err == nil {
+			return attempts, nil
+		}
+	}
+
+	return attempts, err
+}
+
+func (b *Broker[T]) bury(ctx context.Context, idx int, message T, attempts int, cause error) error {
+	err := fmt.Errorf("failed to deliver %s after %d attempts: %w", b.topic, attempts, cause)
+
+	saveErr := b.Bury(ctx, message, idx, attempts, cause)
+	if saveErr != nil {
+		return errors.Join(err, saveErr)
+	}
+
+	return err
+}
+
+// decode는 JSON으로 저장된 이벤트를 풀고 최신 버전으로 올린다.
+func (b *Broker[T]) decode(payload []byte) (T, error) {
+	var message T
+
+	err := json.Unmarshal(payload, &message)
+	if err != nil {
+		return message, fmt.Errorf("failed to decode %s: %w", b.topic, err)
+	}
+
+	if old, ok := any(message).(upgrader); ok {
+		old.upgrade()
+	}
+
+	return message, nil
+}
+
+func (b *Broker[T]) deliverPayload(ctx context.Context, payload []byte, done []int, attempt int) ([]int, error) {
+	message, err := b.decode(payload)
+	if err != nil {
+		return done, err
+	}
+
+	return b.Deliver(ctx, message, done, attempt)
+}
+
+func (b *Broker[T]) buryPayload(ctx context.Context, payload []byte, subscriber int, attempts int, cause error) error {
+	message, err := b.decode(payload)
+	if err != nil {
+		return err
+	}
+
+	return b.Bury(ctx, message, subscriber, attempts, cause)
+}
+
+func (b *Broker[T]) replayPayload(ctx context.Context, payload []byte, subscriber int) error {
+	message, err := b.decode(payload)
+	if err != nil {
+		return err
+	}
+
+	return b.Replay(ctx, message, subscriber)
+}
+
+func (b *Broker[T]) name() string {
+	return b.topic
 }
@@ -0,0 +1,272 @@
+package eventbus_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/stretchr/testify/require"
+)
+
+var errSubscriber = errors.New("subscriber failed")
+
+func newEvent(taskID string, title string) *eventbus.TaskCreatedEvent {
+	return &eventbus.TaskCreatedEvent{
+		Version:  eventbus.TaskCreatedVersion,
+		Username: "test",
+		TaskID:   taskID,
+		ParentID: "",
+		NextID:   "",
+		Title:    title,
+		Now:      time.Date(2025, 7, 4, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestBrokerPublish_Retry(t *testing.T) {
+	t.Parallel()
+
+	deadLetters := eventbus.NewMemoryDeadLetterStore()
+	options := eventbus.SyncOptions()
+	options.Retry = eventbus.RetryPolicy{
+		Attempts:   3,
+		Backoff:    20 * time.Millisecond,
+		MaxBackoff: 30 * time.Millisecond,
+	}
+	options.DeadLetters = deadLetters
+	broker := eventbus.NewBroker[*eventbus.TaskCreatedEvent](eventbus.TopicTaskCreated, options)
+
+	var calls []time.Time
+
+	broker.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		calls = append(calls, time.Now())
+		if len(calls) < 3 {
+			return errSubscriber
+		}
+
+		return nil
+	})
+
+	err := broker.Publish(t.Context(), newEvent("1", ""))
+
+	require.NoError(t, err)
+	require.Len(t, calls, 3)
+	require.GreaterOrEqual(t, calls[1].Sub(calls[0]), 20*time.Millisecond)
+	require.GreaterOrEqual(t, calls[2].Sub(calls[1]), 30*time.Millisecond) // 40ms가 MaxBackoff로 줄어든다
+	require.Empty(t, deadLetters.List())
+}
+
+func TestBrokerPublish_DeadLetter(t *testing.T) {
+	t.Parallel()
+
+	deadLetters := eventbus.NewMemoryDeadLetterStore()
+	options := eventbus.SyncOptions()
+	options.Retry.Attempts = 2
+	options.DeadLetters = deadLetters
+	broker := eventbus.NewBroker[*eventbus.TaskCreatedEvent](eventbus.TopicTaskCreated, options)
+
+	var delivered []string
+
+	broker.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		delivered = append(delivered, event.TaskID)
+
+		return nil
+	})
+	broker.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		return errSubscriber
+	})
+
+	err := broker.Publish(t.Context(), newEvent("1", ""))
+
+	require.ErrorIs(t, err, errSubscriber)
+	require.Equal(t, []string{"1"}, delivered)
+	require.Len(t, deadLetters.List(), 1)
+
+	letter := deadLetters.List()[0]
+	require.Equal(t, eventbus.TopicTaskCreated, letter.Topic)
+	require.Equal(t, 1, letter.Subscriber)
+	require.Equal(t, 2, letter.Attempts)
+	require.Equal(t, "1", letter.Message.(*eventbus.TaskCreatedEvent).TaskID) //nolint:forcetypeassert
+	require.Contains(t, letter.Error, errSubscriber.Error())
+}
+
+func TestBrokerDeliver(t *testing.T) {
+	t.Parallel()
+
+	deadLetters := eventbus.NewMemoryDeadLetterStore()
+	options := eventbus.SyncOptions()
+	options.Retry.Attempts = 5
+	options.DeadLetters = deadLetters
+	broker := eventbus.NewBroker[*eventbus.TaskCreatedEvent](eventbus.TopicTaskCreated, options)
+
+	var calls []int
+
+	for idx := range 3 {
+		broker.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+			calls = append(calls, idx)
+			if idx == 2 {
+				return errSubscriber
+			}
+
+			return nil
+		})
+	}
+
+	done, err := broker.Deliver(t.Context(), newEvent("1", ""), []int{0}, 3)
+
+	require.ErrorIs(t, err, errSubscriber)
+	require.Equal(t, []int{0, 1}, done)
+	require.Equal(t, []int{1, 2}, calls) // 재시도하지 않는다
+	require.Empty(t, deadLetters.List())
+
+	failures := eventbus.SubscriberErrors(err)
+	require.Len(t, failures, 1)
+	require.Equal(t, 2, failures[0].Subscriber)
+
+	err = broker.Bury(t.Context(), newEvent("1", ""), failures[0].Subscriber, 3, failures[0].Err)
+
+	require.NoError(t, err)
+	require.Len(t, deadLetters.List(), 1)
+	require.Equal(t, 2, deadLetters.List()[0].Subscriber)
+	require.Equal(t, 3, deadLetters.List()[0].Attempts)
+}
+
+func TestBrokerPublish_AsyncOrder(t *testing.T) {
+	t.Parallel()
+
+	options := eventbus.AsyncOptions(eventbus.NewMemoryDeadLetterStore())
+	options.Workers = 4
+	broker := eventbus.NewBroker[*eventbus.TaskCreatedEvent](eventbus.TopicTaskCreated, options)
+
+	var (
+		mu       sync.Mutex // worker들이 동시에 호출한다
+		received = make(map[string][]string)
+	)
+
+	broker.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		received[event.TaskID] = append(received[event.TaskID], event.Title)
+
+		return nil
+	})
+
+	var want []string
+
+	for i := range 50 {
+		want = append(want, strconv.Itoa(i))
+
+		for _, id := range []string{"a", "b", "c", "d"} {
+			err := broker.Publish(t.Context(), newEvent(id, strconv.Itoa(i)))
+			require.NoError(t, err)
+		}
+	}
+
+	require.NoError(t, broker.Close(t.Context()))
+
+	for _, id := range []string{"a", "b", "c", "d"} {
+		require.Equal(t, want, received[id], id)
+	}
+}
+
+func TestBrokerClose(t *testing.T) {
+	t.Parallel()
+
+	t.Run("drains", func(t *testing.T) {
+		t.Parallel()
+
+		broker := eventbus.NewBroker[*eventbus.TaskCreatedEvent](
+			eventbus.TopicTaskCreated,
+			eventbus.AsyncOptions(eventbus.NewMemoryDeadLetterStore()),
+		)
+
+		var (
+			mu    sync.Mutex
+			count int
+		)
+
+		broker.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			count++
+
+			return nil
+		})
+
+		for i := range 20 {
+			err := broker.Publish(t.Context(), newEvent(strconv.Itoa(i), ""))
+			require.NoError(t, err)
+		}
+
+		err := broker.Close(t.Context())
+
+		require.NoError(t, err)
+		require.Equal(t, 20, count)
+		require.ErrorIs(t, broker.Publish(t.Context(), newEvent("late", "")), eventbus.ErrClosed)
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		t.Parallel()
+
+		broker := eventbus.NewBroker[*eventbus.TaskCreatedEvent](
+			eventbus.TopicTaskCreated,
+			eventbus.AsyncOptions(eventbus.NewMemoryDeadLetterStore()),
+		)
+		release := make(chan struct{})
+
+		broker.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+			<-release
+
+			return nil
+		})
+
+		err := broker.Publish(t.Context(), newEvent("1", ""))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+
+		err = broker.Close(ctx)
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		close(release)
+		require.NoError(t, broker.Close(t.Context()))
+	})
+}
+
+func TestBusReplay(t *testing.T) {
+	t.Parallel()
+
+	bus := eventbus.NewBus()
+
+	var calls []int
+
+	for idx := range 2 {
+		bus.TaskCreated.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+			calls = append(calls, idx)
+
+			return nil
+		})
+	}
+
+	err := bus.Replay(t.Context(), eventbus.TopicTaskCreated, []byte(`{"Username":"test","TaskID":"1"}`), 1)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, calls)
+
+	err = bus.Replay(t.Context(), eventbus.TopicTaskCreated, []byte(`{}`), 2)
+
+	require.ErrorIs(t, err, eventbus.ErrUnknownSubscriber)
+
+	err = bus.Replay(t.Context(), "unknown", []byte(`{}`), 0)
+
+	require.ErrorIs(t, err, eventbus.ErrUnknownTopic)
+}
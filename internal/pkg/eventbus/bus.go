@@ -1,17 +1,143 @@
 package eventbus
 
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+const (
+	TopicTaskCreated          = "TaskCreated"
+	TopicTaskDeleted          = "TaskDeleted"
+	TopicTaskRelationUpdated  = "TaskRelationUpdated"
+	TopicTaskTitleUpdated     = "TaskTitleUpdated"
+	TopicExtraStatusUpdated   = "ExtraStatusUpdated"
+	TopicExtraRollupUpdated   = "ExtraRollupUpdated"
+	TopicTraceEstimateUpdated = "TraceEstimateUpdated"
+	TopicTraceDueAtUpdated    = "TraceDueAtUpdated"
+	TopicTraceTimeUpdated     = "TraceTimeUpdated"
+	TopicTraceTimerStarted    = "TraceTimerStarted"
+	TopicTraceTimerStopped    = "TraceTimerStopped"
+)
+
 type Bus struct {
-	TaskCreated         *Broker[*TaskCreatedEvent]
-	TaskDeleted         *Broker[*TaskDeletedEvent]
-	TaskRelationUpdated *Broker[*TaskRelationUpdatedEvent]
-	ExtraStatusUpdated  *Broker[*ExtraStatusUpdatedEvent]
+	TaskCreated          *Broker[*TaskCreatedEvent]
+	TaskDeleted          *Broker[*TaskDeletedEvent]
+	TaskRelationUpdated  *Broker[*TaskRelationUpdatedEvent]
+	TaskTitleUpdated     *Broker[*TaskTitleUpdatedEvent]
+	ExtraStatusUpdated   *Broker[*ExtraStatusUpdatedEvent]
+	ExtraRollupUpdated   *Broker[*ExtraRollupUpdatedEvent]
+	TraceEstimateUpdated *Broker[*TraceEstimateUpdatedEvent]
+	TraceDueAtUpdated    *Broker[*TraceDueAtUpdatedEvent]
+	TraceTimeUpdated     *Broker[*TraceTimeUpdatedEvent]
+	TraceTimerStarted    *Broker[*TraceTimerStartedEvent]
+	TraceTimerStopped    *Broker[*TraceTimerStoppedEvent]
 }
 
+// NewBus는 동기 모드의 Bus를 만든다.
 func NewBus() *Bus {
+	return NewBusWithOptions(SyncOptions())
+}
+
+func NewBusWithOptions(options Options) *Bus {
 	return &Bus{
-		TaskCreated:         NewBroker[*TaskCreatedEvent](),
-		TaskDeleted:         NewBroker[*TaskDeletedEvent](),
-		TaskRelationUpdated: NewBroker[*TaskRelationUpdatedEvent](),
-		ExtraStatusUpdated:  NewBroker[*ExtraStatusUpdatedEvent](),
+		TaskCreated:          NewBroker[*TaskCreatedEvent](TopicTaskCreated, options),
+		TaskDeleted:          NewBroker[*TaskDeletedEvent](TopicTaskDeleted, options),
+		TaskRelationUpdated:  NewBroker[*TaskRelationUpdatedEvent](TopicTaskRelationUpdated, options),
+		TaskTitleUpdated:     NewBroker[*TaskTitleUpdatedEvent](TopicTaskTitleUpdated, options),
+		ExtraStatusUpdated:   NewBroker[*ExtraStatusUpdatedEvent](TopicExtraStatusUpdated, options),
+		ExtraRollupUpdated:   NewBroker[*ExtraRollupUpdatedEvent](TopicExtraRollupUpdated, options),
+		TraceEstimateUpdated: NewBroker[*TraceEstimateUpdatedEvent](TopicTraceEstimateUpdated, options),
+		TraceDueAtUpdated:    NewBroker[*TraceDueAtUpdatedEvent](TopicTraceDueAtUpdated, options),
+		TraceTimeUpdated:     NewBroker[*TraceTimeUpdatedEvent](TopicTraceTimeUpdated, options),
+		TraceTimerStarted:    NewBroker[*TraceTimerStartedEvent](TopicTraceTimerStarted, options),
+		TraceTimerStopped:    NewBroker[*TraceTimerStoppedEvent](TopicTraceTimerStopped, options),
+	}
+}
+
+// Deliver는 JSON으로 저장된 이벤트를 topic에 맞게 풀어 Broker.Deliver로 전달한다.
+func (b *Bus) Deliver(ctx context.Context, topic string, payload []byte, done []int, attempt int) ([]int, error) {
+	broker, err := b.broker(topic)
+	if err != nil {
+		return done, err
+	}
+
+	return broker.deliverPayload(ctx, payload, done, attempt)
+}
+
+// Bury는 JSON으로 저장된 이벤트를 topic에 맞게 풀어 Broker.Bury로 dead letter를 남긴다.
+func (b *Bus) Bury(ctx context.Context, topic string, payload []byte, subscriber int, attempts int, cause error) error {
+	broker, err := b.broker(topic)
+	if err != nil {
+		return err
+	}
+
+	return broker.buryPayload(ctx, payload, subscriber, attempts, cause)
+}
+
+// Replay는 JSON으로 저장된 dead letter를 topic에 맞게 풀어 실패한 subscriber에게만 다시 전달한다.
+func (b *Bus) Replay(ctx context.Context, topic string, payload []byte, subscriber int) error {
+	broker, err := b.broker(topic)
+	if err != nil {
+		return err
+	}
+
+	return broker.replayPayload(ctx, payload, subscriber)
+}
+
+// upgrader는 이전 버전으로 저장되었을 수 있는 이벤트이다.
+type upgrader interface {
+	upgrade()
+}
+
+// Use는 모든 topic에 interceptor를 등록한다.
+func (b *Bus) Use(interceptors ...Interceptor) {
+	for _, broker := range b.brokers() {
+		broker.Use(interceptors...)
+	}
+}
+
+// Close는 모든 topic의 queue를 비운다. 동기 모드에서는 아무것도 하지 않는다.
+func (b *Bus) Close(ctx context.Context) error {
+	var errs []error
+	for _, broker := range b.brokers() {
+		errs = append(errs, broker.Close(ctx))
+	}
+
+	return errors.Join(errs...)
+}
+
+type topicBroker interface {
+	Use(interceptors ...Interceptor)
+	Close(ctx context.Context) error
+	deliverPayload(ctx context.Context, payload []byte, done []int, attempt int) ([]int, error)
+	buryPayload(ctx context.Context, payload []byte, subscriber int, attempts int, cause error) error
+	replayPayload(ctx context.Context, payload []byte, subscriber int) error
+	name() string
+}
+
+func (b *Bus) broker(topic string) (topicBroker, error) {
+	for _, broker := range b.brokers() {
+		if broker.name() == topic {
+			return broker, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownTopic, topic)
+}
+
+func (b *Bus) brokers() []topicBroker {
+	return []topicBroker{
+		b.TaskCreated,
+		b.TaskDeleted,
+		b.TaskRelationUpdated,
+		b.TaskTitleUpdated,
+		b.ExtraStatusUpdated,
+		b.ExtraRollupUpdated,
+		b.TraceEstimateUpdated,
+		b.TraceDueAtUpdated,
+		b.TraceTimeUpdated,
+		b.TraceTimerStarted,
+		b.TraceTimerStopped,
 	}
 }
@@ -0,0 +1,65 @@
+package eventbus
+
+import (
+	"context"
+	"crypto/rand"
+)
+
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota
+	traceIDKey
+)
+
+// Metadata는 이벤트를 따라 subscriber까지 이어지는 요청의 정보이다.
+type Metadata struct {
+	RequestID string // 이벤트를 처음 일으킨 요청
+	TraceID   string // 이벤트와 그로 인해 발행된 이벤트를 묶는다
+}
+
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+
+	return id
+}
+
+func WithTraceID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, traceIDKey, id)
+}
+
+func TraceID(ctx context.Context) string {
+	id, _ := ctx.Value(traceIDKey).(string)
+
+	return id
+}
+
+// MetadataFrom은 ctx에 담긴 요청의 정보를 꺼낸다. outbox처럼 ctx 밖에 저장해야 할 때 쓴다.
+func MetadataFrom(ctx context.Context) Metadata {
+	return Metadata{
+		RequestID: RequestID(ctx),
+		TraceID:   TraceID(ctx),
+	}
+}
+
+// WithMetadata는 저장해 둔 요청의 정보로 ctx의 정보를 바꾼다. 빈 값은 정보가 없는 것으로 바꾼다.
+func WithMetadata(ctx context.Context, metadata Metadata) context.Context {
+	return WithTraceID(WithRequestID(ctx, metadata.RequestID), metadata.TraceID)
+}
+
+// Tracing은 trace ID가 없는 발행에 새 trace ID를 붙인다.
+func Tracing() Interceptor {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			if call.Operation == OperationPublish && TraceID(ctx) == "" {
+				ctx = WithTraceID(ctx, rand.Text())
+			}
+
+			return next(ctx, call)
+		}
+	}
+}
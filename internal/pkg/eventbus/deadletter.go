@@ -0,0 +1,52 @@
+package eventbus
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DeadLetter는 재시도를 모두 실패한 이벤트이다.
+type DeadLetter struct {
+	Topic      string
+	Subscriber int // 실패한 subscriber의 등록 순번이며 Replay에 넘긴다
+	Message    any
+	Error      string
+	Attempts   int
+	FailedAt   time.Time
+}
+
+type DeadLetterStore interface {
+	Save(ctx context.Context, letter *DeadLetter) error
+}
+
+var _ DeadLetterStore = (*MemoryDeadLetterStore)(nil)
+
+type MemoryDeadLetterStore struct {
+	mu      sync.Mutex
+	letters []*DeadLetter
+}
+
+func NewMemoryDeadLetterStore() *MemoryDeadLetterStore {
+	return &MemoryDeadLetterStore{
+		mu:      sync.Mutex{},
+		letters: nil,
+	}
+}
+
+func (s *MemoryDeadLetterStore) Save(ctx context.Context, letter *DeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.letters = append(s.letters, letter)
+
+	return nil
+}
+
+func (s *MemoryDeadLetterStore) List() []*DeadLetter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.letters)
+}
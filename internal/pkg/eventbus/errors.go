@@ -0,0 +1,47 @@
+package eventbus
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrClosed            = errors.New("event bus is closed")
+	ErrUnknownTopic      = errors.New("unknown topic")
+	ErrUnknownSubscriber = errors.New("unknown subscriber")
+	ErrPanicked          = errors.New("subscriber panicked")
+)
+
+// SubscriberError는 Deliver에서 한 subscriber가 실패한 것이다.
+type SubscriberError struct {
+	Topic      string
+	Subscriber int // 등록 순번이며 Bury와 Replay에 넘긴다
+	Err        error
+}
+
+func (e *SubscriberError) Error() string {
+	return fmt.Sprintf("failed to deliver %s to subscriber %d: %v", e.Topic, e.Subscriber, e.Err)
+}
+
+func (e *SubscriberError) Unwrap() error {
+	return e.Err
+}
+
+// SubscriberErrors는 err에 들어 있는 SubscriberError를 모두 찾는다.
+func SubscriberErrors(err error) []*SubscriberError {
+	switch wrapped := err.(type) { //nolint:errorlint
+	case *SubscriberError:
+		return []*SubscriberError{wrapped}
+	case interface{ Unwrap() []error }:
+		var ret []*SubscriberError
+		for _, item := range wrapped.Unwrap() {
+			ret = append(ret, SubscriberErrors(item)...)
+		}
+
+		return ret
+	case interface{ Unwrap() error }:
+		return SubscriberErrors(wrapped.Unwrap())
+	}
+
+	return nil
+}
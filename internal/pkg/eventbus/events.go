@@ -2,25 +2,187 @@ package eventbus
 
 import "time"
 
+// 이벤트의 필드가 바뀌면 버전을 올린다.
+const (
+	TaskCreatedVersion          = 2 // 2부터 NextID와 Title이 있다
+	TaskDeletedVersion          = 2 // 2부터 ParentID와 Title이 있다
+	TaskRelationUpdatedVersion  = 1
+	TaskTitleUpdatedVersion     = 1
+	ExtraStatusUpdatedVersion   = 2 // 2부터 OldStatus와 OldCategory가 있다
+	ExtraRollupUpdatedVersion   = 1
+	TraceEstimateUpdatedVersion = 1
+	TraceDueAtUpdatedVersion    = 1
+	TraceTimeUpdatedVersion     = 1
+	TraceTimerStartedVersion    = 1
+	TraceTimerStoppedVersion    = 1
+)
+
 type TaskCreatedEvent struct {
+	Version  int
+	Username string
 	TaskID   string
 	ParentID string
+	NextID   string
+	Title    string
+	Now      time.Time
 }
 
 type TaskDeletedEvent struct {
-	TaskID string
+	Version  int
+	Username string
+	TaskID   string
+	ParentID string
+	Title    string
+	Now      time.Time
 }
 
 type TaskRelationUpdatedEvent struct {
+	Version     int
+	Username    string
 	TaskID      string
 	OldParentID string
 	NewParentID string
 	OldNextID   string
 	NewNextID   string
+	Now         time.Time
+}
+
+type TaskTitleUpdatedEvent struct {
+	Version  int
+	Username string
+	TaskID   string
+	OldTitle string
+	NewTitle string
+	Now      time.Time
 }
 
 type ExtraStatusUpdatedEvent struct {
-	ExtraID string
-	Status  string
-	Now     time.Time
+	Version     int
+	Username    string
+	ExtraID     string
+	OldStatus   string
+	OldCategory string
+	Status      string
+	Category    string // Status가 속한 기본 상태
+	Derived     bool   // true이면 사용자가 아니라 자식의 변화로 다시 계산된 상태이다
+	Now         time.Time
+}
+
+type ExtraRollupUpdatedEvent struct {
+	Version   int
+	Username  string
+	ExtraID   string
+	OldRollup bool
+	NewRollup bool
+	Now       time.Time
+}
+
+type TraceEstimateUpdatedEvent struct {
+	Version      int
+	Username     string
+	TraceID      string
+	OldEstimated time.Duration
+	NewEstimated time.Duration
+	Now          time.Time
+}
+
+type TraceDueAtUpdatedEvent struct {
+	Version  int
+	Username string
+	TraceID  string
+	OldDueAt time.Time // zero이면 마감일이 없었다
+	NewDueAt time.Time // zero이면 마감일을 지웠다
+	Now      time.Time
+}
+
+// TraceTimeUpdatedEvent는 trace 자신의 시간이나 하위 trace를 합친 actual이 바뀐 것을 알린다.
+type TraceTimeUpdatedEvent struct {
+	Version   int
+	Username  string
+	TraceID   string
+	OldSelf   time.Duration
+	NewSelf   time.Duration
+	OldActual time.Duration
+	NewActual time.Duration
+	Derived   bool // true이면 자신의 시간은 그대로이고 하위 trace의 변화로 actual만 바뀌었다
+	Now       time.Time
+}
+
+type TraceTimerStartedEvent struct {
+	Version   int
+	Username  string
+	TraceID   string
+	StartedAt time.Time
+}
+
+type TraceTimerStoppedEvent struct {
+	Version   int
+	Username  string
+	TraceID   string
+	SessionID string // 멈추면서 기록한 구간
+	StartedAt time.Time
+	StoppedAt time.Time
+	Auto      bool // true이면 사용자가 아니라 auto-stop 작업이 멈췄다
+}
+
+// Key는 같은 task의 이벤트를 같은 worker로 보낸다.
+func (e *TaskCreatedEvent) Key() string {
+	return e.Username + "/" + e.TaskID
+}
+
+func (e *TaskDeletedEvent) Key() string {
+	return e.Username + "/" + e.TaskID
+}
+
+func (e *TaskRelationUpdatedEvent) Key() string {
+	return e.Username + "/" + e.TaskID
+}
+
+func (e *TaskTitleUpdatedEvent) Key() string {
+	return e.Username + "/" + e.TaskID
+}
+
+func (e *ExtraStatusUpdatedEvent) Key() string {
+	return e.Username + "/" + e.ExtraID
+}
+
+func (e *ExtraRollupUpdatedEvent) Key() string {
+	return e.Username + "/" + e.ExtraID
+}
+
+func (e *TraceEstimateUpdatedEvent) Key() string {
+	return e.Username + "/" + e.TraceID
+}
+
+func (e *TraceDueAtUpdatedEvent) Key() string {
+	return e.Username + "/" + e.TraceID
+}
+
+func (e *TraceTimeUpdatedEvent) Key() string {
+	return e.Username + "/" + e.TraceID
+}
+
+func (e *TraceTimerStartedEvent) Key() string {
+	return e.Username + "/" + e.TraceID
+}
+
+func (e *TraceTimerStoppedEvent) Key() string {
+	return e.Username + "/" + e.TraceID
+}
+
+// upgrade는 버전을 붙이기 전에 outbox에 저장된 이벤트를 1로 본다.
+func (e *TaskCreatedEvent) upgrade() {
+	e.Version = max(e.Version, 1)
+}
+
+func (e *TaskDeletedEvent) upgrade() {
+	e.Version = max(e.Version, 1)
+}
+
+func (e *TaskRelationUpdatedEvent) upgrade() {
+	e.Version = max(e.Version, 1)
+}
+
+func (e *ExtraStatusUpdatedEvent) upgrade() {
+	e.Version = max(e.Version, 1)
 }
@@ -0,0 +1,35 @@
+package eventbus
+
+import "context"
+
+// Operation은 interceptor가 감싸는 작업의 종류이다.
+type Operation string
+
+const (
+	// OperationPublish는 Publish와 Deliver 한 번이다. 비동기 Publish는 queue에 넣는 데까지이다.
+	OperationPublish Operation = "publish"
+	// OperationHandle은 subscriber를 한 번 호출하는 것이다. 재시도하면 시도마다 따로 감싼다.
+	OperationHandle Operation = "handle"
+)
+
+// Call은 interceptor에 넘기는 작업 하나이다.
+type Call struct {
+	Operation Operation
+	Topic     string
+	Message   any
+	Attempt   int // handle에서 몇 번째 시도인지이며 1부터 센다. publish에서는 0이다
+}
+
+// Handler는 Call을 처리한다. interceptor는 next를 부르기 전후에 일을 하거나 ctx를 바꿔 넘긴다.
+type Handler func(ctx context.Context, call *Call) error
+
+// Interceptor는 publish와 handle을 감싼다. 먼저 등록한 것이 바깥에서 감싼다.
+type Interceptor func(next Handler) Handler
+
+func chain(interceptors []Interceptor, handler Handler) Handler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		handler = interceptors[i](handler)
+	}
+
+	return handler
+}
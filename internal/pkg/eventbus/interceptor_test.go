@@ -0,0 +1,114 @@
+package eventbus_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"expvar"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/eventbus"
+	"github.com/stretchr/testify/require"
+)
+
+func newInterceptedBroker(interceptor eventbus.Interceptor) *eventbus.Broker[*eventbus.TaskCreatedEvent] {
+	options := eventbus.SyncOptions()
+	options.Retry = eventbus.RetryPolicy{Attempts: 2, Backoff: 0, MaxBackoff: 0}
+	options.DeadLetters = eventbus.NewMemoryDeadLetterStore()
+	options.Interceptors = []eventbus.Interceptor{interceptor}
+
+	return eventbus.NewBroker[*eventbus.TaskCreatedEvent](eventbus.TopicTaskCreated, options)
+}
+
+func TestLogging(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})) //nolint:exhaustruct
+	broker := newInterceptedBroker(eventbus.Logging(logger))
+	calls := 0
+
+	broker.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		calls++
+		if calls == 1 {
+			return errSubscriber
+		}
+
+		return nil
+	})
+
+	ctx := eventbus.WithTraceID(eventbus.WithRequestID(t.Context(), "request"), "trace")
+	err := broker.Publish(ctx, newEvent("1", ""))
+
+	require.NoError(t, err)
+
+	var records []map[string]any
+
+	for line := range strings.Lines(buf.String()) {
+		var record map[string]any
+
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+
+	require.Len(t, records, 3)
+
+	for _, record := range records {
+		require.Equal(t, eventbus.TopicTaskCreated, record["topic"])
+		require.Equal(t, "request", record["request_id"])
+		require.Equal(t, "trace", record["trace_id"])
+		require.Equal(t, "test/1", record["key"])
+		require.Contains(t, record, "duration")
+	}
+
+	require.Equal(t, "WARN", records[0]["level"])
+	require.Equal(t, "event failed", records[0]["msg"])
+	require.Equal(t, "handle", records[0]["operation"])
+	require.InDelta(t, 1, records[0]["attempt"], 0)
+	require.Equal(t, errSubscriber.Error(), records[0]["error"])
+
+	require.Equal(t, "DEBUG", records[1]["level"])
+	require.Equal(t, "handle", records[1]["operation"])
+	require.InDelta(t, 2, records[1]["attempt"], 0)
+	require.NotContains(t, records[1], "error")
+
+	require.Equal(t, "DEBUG", records[2]["level"])
+	require.Equal(t, "publish", records[2]["operation"])
+	require.InDelta(t, 0, records[2]["attempt"], 0)
+}
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	metrics := eventbus.NewMetrics()
+	broker := newInterceptedBroker(metrics.Interceptor())
+
+	broker.Subscribe(func(ctx context.Context, event *eventbus.TaskCreatedEvent) error {
+		time.Sleep(time.Millisecond)
+
+		return errSubscriber
+	})
+
+	err := broker.Publish(t.Context(), newEvent("1", ""))
+
+	require.ErrorIs(t, err, errSubscriber)
+
+	stats := metrics.Snapshot()[eventbus.TopicTaskCreated]
+	require.Equal(t, int64(1), stats[eventbus.OperationPublish].Count)
+	require.Equal(t, int64(1), stats[eventbus.OperationPublish].Errors)
+	require.Equal(t, int64(2), stats[eventbus.OperationHandle].Count)
+	require.Equal(t, int64(2), stats[eventbus.OperationHandle].Errors)
+	require.GreaterOrEqual(t, stats[eventbus.OperationHandle].Total, 2*time.Millisecond)
+	require.GreaterOrEqual(t, stats[eventbus.OperationHandle].Max, time.Millisecond)
+
+	var exported expvar.Var = metrics
+
+	var got map[string]map[eventbus.Operation]eventbus.Stat
+
+	require.NoError(t, json.Unmarshal([]byte(exported.String()), &got))
+	require.Equal(t, metrics.Snapshot(), got)
+}
@@ -0,0 +1,41 @@
+package eventbus
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Logging은 publish와 handle마다 topic, 걸린 시간, 요청의 정보와 에러를 남긴다.
+func Logging(logger *slog.Logger) Interceptor {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			start := time.Now()
+			err := next(ctx, call)
+
+			attrs := []slog.Attr{
+				slog.String("operation", string(call.Operation)),
+				slog.String("topic", call.Topic),
+				slog.Int("attempt", call.Attempt),
+				slog.Duration("duration", time.Since(start)),
+				slog.String("request_id", RequestID(ctx)),
+				slog.String("trace_id", TraceID(ctx)),
+			}
+
+			if keyed, ok := call.Message.(Keyed); ok {
+				attrs = append(attrs, slog.String("key", keyed.Key()))
+			}
+
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+				logger.LogAttrs(ctx, slog.LevelWarn, "event failed", attrs...)
+
+				return err
+			}
+
+			logger.LogAttrs(ctx, slog.LevelDebug, "event", attrs...)
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Stat은 topic의 작업 하나에 대해 모은 값이다.
+type Stat struct {
+	Count  int64         `json:"count"`
+	Errors int64         `json:"errors"`
+	Total  time.Duration `json:"total_ns"`
+	Max    time.Duration `json:"max_ns"`
+}
+
+type metricKey struct {
+	topic     string
+	operation Operation
+}
+
+// Metrics는 topic과 작업 별로 횟수, 실패 수와 걸린 시간을 모으는 expvar.Var이다.
+type Metrics struct {
+	mu    sync.Mutex
+	stats map[metricKey]*Stat
+}
+
+func NewMetrics() *Metrics {
+	return &Metrics{
+		mu:    sync.Mutex{},
+		stats: make(map[metricKey]*Stat),
+	}
+}
+
+func (m *Metrics) Interceptor() Interceptor {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			start := time.Now()
+			err := next(ctx, call)
+			m.observe(call, time.Since(start), err)
+
+			return err
+		}
+	}
+}
+
+// Snapshot은 topic 별, 작업 별로 지금까지 모은 값을 복사해서 돌려준다.
+func (m *Metrics) Snapshot() map[string]map[Operation]Stat {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ret := make(map[string]map[Operation]Stat)
+	for key, stat := range m.stats {
+		if ret[key.topic] == nil {
+			ret[key.topic] = make(map[Operation]Stat)
+		}
+
+		ret[key.topic][key.operation] = *stat
+	}
+
+	return ret
+}
+
+// String은 Snapshot을 JSON으로 돌려준다.
+func (m *Metrics) String() string {
+	data, err := json.Marshal(m.Snapshot())
+	if err != nil {
+		return "{}"
+	}
+
+	return string(data)
+}
+
+func (m *Metrics) observe(call *Call, duration time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := metricKey{topic: call.Topic, operation: call.Operation}
+
+	stat, ok := m.stats[key]
+	if !ok {
+		stat = &Stat{Count: 0, Errors: 0, Total: 0, Max: 0}
+		m.stats[key] = stat
+	}
+
+	stat.Count++
+	stat.Total += duration
+	stat.Max = max(stat.Max, duration)
+
+	if err != nil {
+		stat.Errors++
+	}
+}
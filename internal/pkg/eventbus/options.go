@@ -0,0 +1,73 @@
+package eventbus
+
+import "time"
+
+const (
+	defaultQueueSize  = 1024
+	defaultWorkers    = 4
+	defaultAttempts   = 5
+	defaultBackoff    = 100 * time.Millisecond
+	defaultMaxBackoff = 5 * time.Second
+)
+
+// RetryPolicy는 실패한 subscriber를 다시 호출하는 방식이다.
+type RetryPolicy struct {
+	Attempts   int           // 처음 호출을 포함한 최대 시도 횟수
+	Backoff    time.Duration // 첫 재시도 전 대기 시간이며 재시도마다 두 배가 된다
+	MaxBackoff time.Duration // 0이면 제한하지 않는다
+}
+
+// delay는 attempts번 실패한 뒤 기다릴 시간이다.
+func (p RetryPolicy) delay(attempts int) time.Duration {
+	delay := p.Backoff
+	for range attempts - 1 {
+		delay *= 2
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+
+	return delay
+}
+
+type Options struct {
+	Async       bool
+	QueueSize   int // topic의 worker 하나가 가진 queue의 크기
+	Workers     int // topic 별 worker 수
+	Retry       RetryPolicy
+	DeadLetters DeadLetterStore // nil이면 끝내 실패한 이벤트를 남기지 않는다
+
+	Interceptors []Interceptor // 모든 topic의 publish와 handle을 감싼다
+}
+
+// SyncOptions는 발행한 goroutine에서 한 번씩만 호출하는 설정이다. 테스트에서 쓴다.
+func SyncOptions() Options {
+	return Options{
+		Async:     false,
+		QueueSize: 0,
+		Workers:   0,
+		Retry: RetryPolicy{
+			Attempts:   1,
+			Backoff:    0,
+			MaxBackoff: 0,
+		},
+		DeadLetters:  nil,
+		Interceptors: nil,
+	}
+}
+
+// AsyncOptions는 topic 별 worker pool에서 backoff와 함께 재시도하는 설정이다.
+func AsyncOptions(deadLetters DeadLetterStore) Options {
+	return Options{
+		Async:     true,
+		QueueSize: defaultQueueSize,
+		Workers:   defaultWorkers,
+		Retry: RetryPolicy{
+			Attempts:   defaultAttempts,
+			Backoff:    defaultBackoff,
+			MaxBackoff: defaultMaxBackoff,
+		},
+		DeadLetters:  deadLetters,
+		Interceptors: nil,
+	}
+}
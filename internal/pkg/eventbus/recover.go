@@ -0,0 +1,22 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+)
+
+// Recover는 subscriber의 panic을 에러로 바꾼다. 다른 interceptor가 에러를 보도록 마지막에 등록한다.
+func Recover() Interceptor {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (err error) {
+			defer func() {
+				recovered := recover()
+				if recovered != nil {
+					err = fmt.Errorf("%w in %s: %v", ErrPanicked, call.Topic, recovered)
+				}
+			}()
+
+			return next(ctx, call)
+		}
+	}
+}
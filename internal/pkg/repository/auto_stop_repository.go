@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+type AutoStopRepository interface {
+	// SaveAutoStopPolicy는 사용자의 기존 정책을 교체한다.
+	SaveAutoStopPolicy(ctx context.Context, policy *domain.AutoStopPolicy) error
+	GetAutoStopPolicy(ctx context.Context, username string) (*domain.AutoStopPolicy, error)
+	ListAutoStopPolicies(ctx context.Context) ([]*domain.AutoStopPolicy, error)
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+type UserData struct {
+	Username string
+	Tasks    []*domain.Task
+	Extras   []*domain.Extra
+	Traces   []*domain.Trace
+	Sessions []*domain.Session
+
+	Workflow  *domain.Workflow       // nil이면 기본 workflow
+	Policy    *domain.AutoStopPolicy // nil이면 정책이 없다
+	Projects  []*domain.TrackedProject
+	Snapshots []*domain.BurndownSnapshot
+	Webhooks  []*domain.Webhook
+}
+
+type BackupRepository interface {
+	ListUsernames(ctx context.Context) ([]string, error)
+	ListAllTasks(ctx context.Context, username string) ([]*domain.Task, error)
+	// ReplaceUserData는 각 사용자의 기존 데이터를 모두 지우고 주어진 데이터로 교체한다.
+	ReplaceUserData(ctx context.Context, data ...*UserData) error
+}
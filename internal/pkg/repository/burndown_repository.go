@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+type BurndownRepository interface {
+	// SaveTrackedProject는 같은 subtree의 기존 설정을 교체한다.
+	SaveTrackedProject(ctx context.Context, project *domain.TrackedProject) error
+	GetTrackedProject(ctx context.Context, username string, rootID domain.TaskID) (*domain.TrackedProject, error)
+	ListTrackedProjects(ctx context.Context) ([]*domain.TrackedProject, error)
+	// DeleteTrackedProject는 프로젝트의 snapshot도 함께 삭제한다.
+	DeleteTrackedProject(ctx context.Context, project *domain.TrackedProject) error
+
+	// SaveBurndownSnapshot은 같은 날짜의 기존 snapshot을 교체한다.
+	SaveBurndownSnapshot(ctx context.Context, snapshot *domain.BurndownSnapshot) error
+	// ListBurndownSnapshots는 날짜 순으로 정렬된 snapshot을 반환한다.
+	ListBurndownSnapshots(
+		ctx context.Context,
+		username string,
+		rootID domain.TaskID,
+	) ([]*domain.BurndownSnapshot, error)
+}
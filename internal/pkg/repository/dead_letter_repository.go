@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// DeadLetter는 재시도를 모두 실패해 다시 전달할 때까지 남겨 둔 이벤트이다.
+type DeadLetter struct {
+	ID         uint64 // 저장할 때 정해진다
+	Topic      string
+	Subscriber int // 실패한 subscriber의 등록 순번
+	Payload    []byte
+	Error      string
+	Attempts   int
+	FailedAt   time.Time
+}
+
+type DeadLetterRepository interface {
+	CreateDeadLetter(ctx context.Context, letter *DeadLetter) error
+	GetDeadLetter(ctx context.Context, id uint64) (*DeadLetter, error)
+	// ListDeadLetters는 남은 dead letter를 저장된 순서대로 돌려준다.
+	ListDeadLetters(ctx context.Context) ([]*DeadLetter, error)
+	DeleteDeadLetter(ctx context.Context, id uint64) error
+}
@@ -17,3 +17,24 @@ var (
 	ErrTraceNotFound      = errors.New("trace not found")
 	ErrTraceAlreadyExists = errors.New("trace already exists")
 )
+
+var (
+	ErrSessionNotFound      = errors.New("session not found")
+	ErrSessionAlreadyExists = errors.New("session already exists")
+	ErrSessionOverlaps      = errors.New("session overlaps another session")
+)
+
+var ErrAutoStopPolicyNotFound = errors.New("auto stop policy not found")
+
+var ErrTrackedProjectNotFound = errors.New("tracked project not found")
+
+var ErrWorkflowNotFound = errors.New("workflow not found")
+
+var ErrOutboxEventNotFound = errors.New("outbox event not found")
+
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+var (
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+)
@@ -6,10 +6,13 @@ import (
 	"github.com/neatflowcv/focus/internal/pkg/domain"
 )
 
+// ExtraRepository는 extra를 사용자별로 격리한다.
 type ExtraRepository interface {
-	CreateExtra(ctx context.Context, extra *domain.Extra) error
-	DeleteExtra(ctx context.Context, extra *domain.Extra) error
-	UpdateExtra(ctx context.Context, extra *domain.Extra) error
-	GetExtra(ctx context.Context, id domain.ExtraID) (*domain.Extra, error)
-	ListExtras(ctx context.Context, ids []domain.ExtraID) ([]*domain.Extra, error)
+	CreateExtra(ctx context.Context, username string, extra *domain.Extra) error
+	DeleteExtra(ctx context.Context, username string, extra *domain.Extra) error
+	UpdateExtra(ctx context.Context, username string, extra *domain.Extra) error
+	GetExtra(ctx context.Context, username string, id domain.ExtraID) (*domain.Extra, error)
+	ListExtras(ctx context.Context, username string, ids []domain.ExtraID) ([]*domain.Extra, error)
+	ListChildExtras(ctx context.Context, username string, parentID domain.ExtraID) ([]*domain.Extra, error)
+	ListAllExtras(ctx context.Context, username string) ([]*domain.Extra, error)
 }
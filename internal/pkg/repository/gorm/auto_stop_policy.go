@@ -0,0 +1,40 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+type AutoStopPolicy struct {
+	Username  string `gorm:"primaryKey"`
+	Threshold time.Duration
+	Cap       time.Duration
+	Midnight  bool
+	Timezone  string
+}
+
+func FromDomainAutoStopPolicy(policy *domain.AutoStopPolicy) *AutoStopPolicy {
+	return &AutoStopPolicy{
+		Username:  policy.Username(),
+		Threshold: policy.Threshold(),
+		Cap:       policy.Cap(),
+		Midnight:  policy.Midnight(),
+		Timezone:  policy.Location().String(),
+	}
+}
+
+func (p *AutoStopPolicy) ToDomain() *domain.AutoStopPolicy {
+	location, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	return domain.NewAutoStopPolicy(
+		p.Username,
+		p.Threshold,
+		p.Cap,
+		p.Midnight,
+		location,
+	)
+}
@@ -0,0 +1,180 @@
+package gorm
+
+import (
+	"database/sql"
+	"time"
+)
+
+// 아래 모델은 1번 마이그레이션의 스키마이며 고치지 않는다.
+
+type baselineTask struct {
+	Username  string `gorm:"primaryKey"`
+	ID        string `gorm:"primaryKey"`
+	ParentID  sql.NullString
+	NextID    sql.NullString
+	Title     string
+	CreatedAt time.Time
+	Version   uint64
+}
+
+type baselineExtra struct {
+	Username       string `gorm:"index"`
+	ID             string
+	ParentID       sql.NullString
+	Leaf           sql.NullBool
+	Status         string
+	Category       string
+	Rollup         sql.NullBool
+	FirstStartedAt sql.NullTime
+	CompletedAt    sql.NullTime
+	Reopens        int
+}
+
+type baselineTrace struct {
+	Username  string `gorm:"index"`
+	ID        string
+	ParentID  sql.NullString
+	Estimated sql.NullInt64 `gorm:"column:estimated_ns"`
+	Self      sql.NullInt64 `gorm:"column:self_ns"`
+	Actual    sql.NullInt64 `gorm:"column:actual_ns"`
+	StartedAt sql.NullTime
+	DueAt     sql.NullTime
+}
+
+type baselineSession struct {
+	Username  string `gorm:"index"`
+	ID        string
+	TraceID   string `gorm:"index"`
+	StartedAt time.Time
+	EndedAt   time.Time
+	Auto      bool
+	Note      string
+}
+
+type baselineAutoStopPolicy struct {
+	Username  string `gorm:"primaryKey"`
+	Threshold time.Duration
+	Cap       time.Duration
+	Midnight  bool
+	Timezone  string
+}
+
+type baselineTrackedProject struct {
+	Username string `gorm:"primaryKey"`
+	RootID   string `gorm:"primaryKey"`
+	Timezone string
+}
+
+type baselineBurndownSnapshot struct {
+	Username  string    `gorm:"primaryKey"`
+	RootID    string    `gorm:"primaryKey"`
+	Date      time.Time `gorm:"primaryKey"`
+	Remaining int64     `gorm:"column:remaining_ns"`
+	Completed int64     `gorm:"column:completed_ns"`
+	Actual    int64     `gorm:"column:actual_ns"`
+	Open      int
+	Done      int
+}
+
+type baselineWorkflow struct {
+	Username    string               `gorm:"primaryKey"`
+	Statuses    []WorkflowStatus     `gorm:"serializer:json"`
+	Transitions []WorkflowTransition `gorm:"serializer:json"`
+}
+
+type baselineOutboxEvent struct {
+	ID          uint64 `gorm:"primaryKey;autoIncrement"`
+	Username    string `gorm:"index"`
+	Topic       string
+	Payload     []byte
+	CreatedAt   time.Time
+	Attempts    int
+	DeliveredAt sql.NullTime `gorm:"index"`
+	RequestID   string
+	TraceID     string
+}
+
+type baselineWebhook struct {
+	ID        string `gorm:"primaryKey"`
+	Username  string `gorm:"index"`
+	URL       string
+	Secret    string
+	Events    []string `gorm:"serializer:json"`
+	CreatedAt time.Time
+}
+
+type baselineWebhookDelivery struct {
+	ID             string `gorm:"primaryKey"`
+	WebhookID      string `gorm:"index"`
+	Username       string `gorm:"index"`
+	Event          string
+	Payload        []byte
+	Status         string `gorm:"index"`
+	Attempts       int
+	NextAttemptAt  sql.NullTime `gorm:"index"`
+	LastStatusCode int
+	LastError      string
+	CreatedAt      time.Time
+	DeliveredAt    sql.NullTime
+}
+
+func (*baselineTask) TableName() string {
+	return "tasks"
+}
+
+func (*baselineExtra) TableName() string {
+	return "extras"
+}
+
+func (*baselineTrace) TableName() string {
+	return "traces"
+}
+
+func (*baselineSession) TableName() string {
+	return "sessions"
+}
+
+func (*baselineAutoStopPolicy) TableName() string {
+	return "auto_stop_policies"
+}
+
+func (*baselineTrackedProject) TableName() string {
+	return "tracked_projects"
+}
+
+func (*baselineBurndownSnapshot) TableName() string {
+	return "burndown_snapshots"
+}
+
+func (*baselineWorkflow) TableName() string {
+	return "workflows"
+}
+
+func (*baselineOutboxEvent) TableName() string {
+	return "outbox_events"
+}
+
+func (*baselineWebhook) TableName() string {
+	return "webhooks"
+}
+
+func (*baselineWebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// baselineModels는 1번 마이그레이션이 만드는 테이블이다.
+func baselineModels() []any {
+	return []any{
+		&baselineTask{},             //nolint:exhaustruct
+		&baselineExtra{},            //nolint:exhaustruct
+		&baselineTrace{},            //nolint:exhaustruct
+		&baselineSession{},          //nolint:exhaustruct
+		&baselineAutoStopPolicy{},   //nolint:exhaustruct
+		&baselineTrackedProject{},   //nolint:exhaustruct
+		&baselineBurndownSnapshot{}, //nolint:exhaustruct
+		&baselineWorkflow{},         //nolint:exhaustruct
+		&baselineOutboxEvent{},      //nolint:exhaustruct
+		&baselineWebhook{},          //nolint:exhaustruct
+		&baselineWebhookDelivery{},  //nolint:exhaustruct
+	}
+}
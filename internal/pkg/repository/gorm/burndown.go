@@ -0,0 +1,67 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+type TrackedProject struct {
+	Username string `gorm:"primaryKey"`
+	RootID   string `gorm:"primaryKey"`
+	Timezone string
+}
+
+func FromDomainTrackedProject(project *domain.TrackedProject) *TrackedProject {
+	return &TrackedProject{
+		Username: project.Username(),
+		RootID:   string(project.RootID()),
+		Timezone: project.Location().String(),
+	}
+}
+
+func (p *TrackedProject) ToDomain() *domain.TrackedProject {
+	location, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	return domain.NewTrackedProject(p.Username, domain.TaskID(p.RootID), location)
+}
+
+type BurndownSnapshot struct {
+	Username  string    `gorm:"primaryKey"`
+	RootID    string    `gorm:"primaryKey"`
+	Date      time.Time `gorm:"primaryKey"`
+	Remaining int64     `gorm:"column:remaining_ns"` // nanoseconds
+	Completed int64     `gorm:"column:completed_ns"` // nanoseconds
+	Actual    int64     `gorm:"column:actual_ns"`    // nanoseconds
+	Open      int
+	Done      int
+}
+
+func FromDomainBurndownSnapshot(snapshot *domain.BurndownSnapshot) *BurndownSnapshot {
+	return &BurndownSnapshot{
+		Username:  snapshot.Username(),
+		RootID:    string(snapshot.RootID()),
+		Date:      snapshot.Date(),
+		Remaining: int64(snapshot.Remaining()),
+		Completed: int64(snapshot.Completed()),
+		Actual:    int64(snapshot.Actual()),
+		Open:      snapshot.Open(),
+		Done:      snapshot.Done(),
+	}
+}
+
+func (s *BurndownSnapshot) ToDomain() *domain.BurndownSnapshot {
+	return domain.NewBurndownSnapshot(
+		s.Username,
+		domain.TaskID(s.RootID),
+		s.Date.UTC(),
+		time.Duration(s.Remaining),
+		time.Duration(s.Completed),
+		time.Duration(s.Actual),
+		s.Open,
+		s.Done,
+	)
+}
@@ -0,0 +1,41 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/repository"
+)
+
+type DeadLetter struct {
+	ID         uint64 `gorm:"primaryKey;autoIncrement"`
+	Topic      string
+	Subscriber int
+	Payload    []byte
+	Error      string
+	Attempts   int
+	FailedAt   time.Time
+}
+
+func FromDeadLetter(letter *repository.DeadLetter) *DeadLetter {
+	return &DeadLetter{
+		ID:         letter.ID,
+		Topic:      letter.Topic,
+		Subscriber: letter.Subscriber,
+		Payload:    letter.Payload,
+		Error:      letter.Error,
+		Attempts:   letter.Attempts,
+		FailedAt:   letter.FailedAt,
+	}
+}
+
+func (l *DeadLetter) ToDeadLetter() *repository.DeadLetter {
+	return &repository.DeadLetter{
+		ID:         l.ID,
+		Topic:      l.Topic,
+		Subscriber: l.Subscriber,
+		Payload:    l.Payload,
+		Error:      l.Error,
+		Attempts:   l.Attempts,
+		FailedAt:   l.FailedAt,
+	}
+}
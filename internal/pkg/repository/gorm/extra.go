@@ -7,27 +7,53 @@ import (
 )
 
 type Extra struct {
-	ID       string
+	Username string `gorm:"primaryKey;index"`
+
+	ID       string `gorm:"primaryKey"`
 	ParentID sql.NullString
 	Leaf     sql.NullBool
 	Status   string
+	Category string
+	Rollup   sql.NullBool
+
+	FirstStartedAt sql.NullTime
+	CompletedAt    sql.NullTime
+	Reopens        int
 }
 
-func FromDomainExtra(extra *domain.Extra) *Extra {
+func FromDomainExtra(extra *domain.Extra, username string) *Extra {
 	return &Extra{
+		Username: username,
 		ID:       string(extra.ID()),
 		ParentID: sql.NullString{String: string(extra.ParentID()), Valid: true},
 		Leaf:     sql.NullBool{Bool: extra.Leaf(), Valid: true},
 		Status:   string(extra.Status()),
+		Category: string(extra.Category()),
+		Rollup:   sql.NullBool{Bool: extra.Rollup(), Valid: true},
+
+		FirstStartedAt: toNullTime(extra.FirstStartedAt()),
+		CompletedAt:    toNullTime(extra.CompletedAt()),
+		Reopens:        extra.Reopens(),
 	}
 }
 
 func (e *Extra) ToDomain() *domain.Extra {
+	// 사용자 정의 상태가 생기기 전의 행은 기본 상태만 가지므로 상태가 곧 분류이다.
+	category := e.Category
+	if category == "" {
+		category = e.Status
+	}
+
 	return domain.NewExtra(
 		domain.ExtraID(e.ID),
 		domain.ExtraID(getString(e.ParentID)),
 		getBool(e.Leaf),
 		domain.TaskStatus(e.Status),
+		domain.TaskStatus(category),
+		getBool(e.Rollup),
+		getTime(e.FirstStartedAt),
+		getTime(e.CompletedAt),
+		e.Reopens,
 	)
 }
 
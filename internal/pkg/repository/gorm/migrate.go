@@ -0,0 +1,466 @@
+package gorm
+
+import (
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// upBaseline은 AutoMigrate로 만들어 온 데이터베이스도 1번 스키마로 맞춘다. 옛 데이터는 9번부터 채운다.
+func upBaseline(tx *gorm.DB) error {
+	err := tx.AutoMigrate(baselineModels()...)
+	if err != nil {
+		return fmt.Errorf("failed to auto migrate: %w", err)
+	}
+
+	return nil
+}
+
+func downBaseline(tx *gorm.DB) error {
+	models := baselineModels()
+	slices.Reverse(models)
+
+	err := tx.Migrator().DropTable(models...)
+	if err != nil {
+		return fmt.Errorf("failed to drop tables: %w", err)
+	}
+
+	return nil
+}
+
+// downTaskPrimaryKey는 PostgreSQL에서 되돌리지 않는다. 사용자끼리 겹치는 id가 생겼으면 id만으로 키를 만들 수 없다.
+func downTaskPrimaryKey(tx *gorm.DB) error {
+	if tx.Name() != "postgres" {
+		return nil
+	}
+
+	return fmt.Errorf("%w: tasks cannot go back to an id-only primary key", ErrIrreversibleMigration)
+}
+
+// upTaskParentIndex는 부모의 자식을 찾는 조회를 위해 (username, parent_id)에 인덱스를 만든다.
+func upTaskParentIndex(tx *gorm.DB) error {
+	return createIndex(tx, "idx_tasks_username_parent_id", "tasks", "username, parent_id")
+}
+
+func downTaskParentIndex(tx *gorm.DB) error {
+	return dropIndex(tx, "idx_tasks_username_parent_id")
+}
+
+// upParentIndexes는 하위 extra와 trace를 찾는 조회를 위해 parent_id에 인덱스를 만든다.
+func upParentIndexes(tx *gorm.DB) error {
+	err := createIndex(tx, "idx_extras_parent_id", "extras", "parent_id")
+	if err != nil {
+		return err
+	}
+
+	return createIndex(tx, "idx_traces_parent_id", "traces", "parent_id")
+}
+
+func downParentIndexes(tx *gorm.DB) error {
+	err := dropIndex(tx, "idx_traces_parent_id")
+	if err != nil {
+		return err
+	}
+
+	return dropIndex(tx, "idx_extras_parent_id")
+}
+
+// outboxDelivered는 5번 마이그레이션이 더하는 컬럼이다.
+type outboxDelivered struct {
+	Delivered []int `gorm:"serializer:json"`
+}
+
+func (*outboxDelivered) TableName() string {
+	return "outbox_events"
+}
+
+// upOutboxDelivered는 이벤트를 처리한 subscriber를 기록할 컬럼을 더한다.
+func upOutboxDelivered(tx *gorm.DB) error {
+	err := tx.Migrator().AddColumn(&outboxDelivered{}, "Delivered") //nolint:exhaustruct
+	if err != nil {
+		return fmt.Errorf("failed to add delivered: %w", err)
+	}
+
+	return nil
+}
+
+func downOutboxDelivered(tx *gorm.DB) error {
+	err := tx.Migrator().DropColumn(&outboxDelivered{}, "Delivered") //nolint:exhaustruct
+	if err != nil {
+		return fmt.Errorf("failed to drop delivered: %w", err)
+	}
+
+	return nil
+}
+
+// deadLetterTable은 6번 마이그레이션이 만드는 테이블이다.
+type deadLetterTable struct {
+	ID         uint64 `gorm:"primaryKey;autoIncrement"`
+	Topic      string
+	Subscriber int
+	Payload    []byte
+	Error      string
+	Attempts   int
+	FailedAt   time.Time
+}
+
+func (*deadLetterTable) TableName() string {
+	return "dead_letters"
+}
+
+// upDeadLetters는 재시도를 모두 실패한 이벤트를 남길 테이블을 만든다.
+func upDeadLetters(tx *gorm.DB) error {
+	err := tx.Migrator().CreateTable(&deadLetterTable{}) //nolint:exhaustruct
+	if err != nil {
+		return fmt.Errorf("failed to create dead letters: %w", err)
+	}
+
+	return nil
+}
+
+func downDeadLetters(tx *gorm.DB) error {
+	err := tx.Migrator().DropTable(&deadLetterTable{}) //nolint:exhaustruct
+	if err != nil {
+		return fmt.Errorf("failed to drop dead letters: %w", err)
+	}
+
+	return nil
+}
+
+// keyedExtra와 keyedTrace는 7번 마이그레이션이 (username, id)를 키로 만든 테이블이다.
+type keyedExtra struct {
+	Username       string `gorm:"primaryKey;index"`
+	ID             string `gorm:"primaryKey"`
+	ParentID       sql.NullString
+	Leaf           sql.NullBool
+	Status         string
+	Category       string
+	Rollup         sql.NullBool
+	FirstStartedAt sql.NullTime
+	CompletedAt    sql.NullTime
+	Reopens        int
+}
+
+type keyedTrace struct {
+	Username  string `gorm:"primaryKey;index"`
+	ID        string `gorm:"primaryKey"`
+	ParentID  sql.NullString
+	Estimated sql.NullInt64 `gorm:"column:estimated_ns"`
+	Self      sql.NullInt64 `gorm:"column:self_ns"`
+	Actual    sql.NullInt64 `gorm:"column:actual_ns"`
+	StartedAt sql.NullTime
+	DueAt     sql.NullTime
+}
+
+func (*keyedExtra) TableName() string {
+	return "extras"
+}
+
+func (*keyedTrace) TableName() string {
+	return "traces"
+}
+
+// upExtraTracePrimaryKeys는 id만 키로 쓰던 extras와 traces의 키를 tasks처럼 (username, id)로 바꾼다.
+func upExtraTracePrimaryKeys(tx *gorm.DB) error {
+	for _, model := range []any{&keyedExtra{}, &keyedTrace{}} { //nolint:exhaustruct
+		err := setPrimaryKey(tx, model, "username, id")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// downExtraTracePrimaryKeys는 키를 id로 되돌린다.
+func downExtraTracePrimaryKeys(tx *gorm.DB) error {
+	for _, model := range []any{&baselineExtra{}, &baselineTrace{}} { //nolint:exhaustruct
+		err := setPrimaryKey(tx, model, "id")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// outboxNextAttempt는 8번 마이그레이션이 더하는 컬럼이다.
+type outboxNextAttempt struct {
+	NextAttemptAt sql.NullTime
+}
+
+func (*outboxNextAttempt) TableName() string {
+	return "outbox_events"
+}
+
+// upOutboxNextAttempt는 실패한 이벤트를 다시 전달할 때를 기록할 컬럼을 더한다.
+func upOutboxNextAttempt(tx *gorm.DB) error {
+	err := tx.Migrator().AddColumn(&outboxNextAttempt{}, "NextAttemptAt") //nolint:exhaustruct
+	if err != nil {
+		return fmt.Errorf("failed to add next attempt at: %w", err)
+	}
+
+	return nil
+}
+
+func downOutboxNextAttempt(tx *gorm.DB) error {
+	err := tx.Migrator().DropColumn(&outboxNextAttempt{}, "NextAttemptAt") //nolint:exhaustruct
+	if err != nil {
+		return fmt.Errorf("failed to drop next attempt at: %w", err)
+	}
+
+	return nil
+}
+
+// setPrimaryKey는 model 테이블의 키를 columns로 바꾼다.
+func setPrimaryKey(tx *gorm.DB, model any, columns string) error { //nolint:cyclop,funlen
+	stmt := &gorm.Statement{DB: tx} //nolint:exhaustruct
+
+	err := stmt.Parse(model)
+	if err != nil {
+		return fmt.Errorf("failed to parse model: %w", err)
+	}
+
+	table := stmt.Schema.Table
+
+	// username이 NULL인 행은 키에 넣을 수 없다.
+	err = tx.Exec(fmt.Sprintf("UPDATE %s SET username = '' WHERE username IS NULL", table)).Error
+	if err != nil {
+		return fmt.Errorf("failed to fill username of %s: %w", table, err)
+	}
+
+	if tx.Name() == "postgres" {
+		err = tx.Exec(fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s_pkey", table, table)).Error
+		if err != nil {
+			return fmt.Errorf("failed to drop primary key of %s: %w", table, err)
+		}
+
+		err = tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (%s)", table, columns)).Error
+		if err != nil {
+			return fmt.Errorf("failed to add primary key to %s: %w", table, err)
+		}
+
+		return nil
+	}
+
+	old := table + "_old"
+
+	err = tx.Migrator().RenameTable(table, old)
+	if err != nil {
+		return fmt.Errorf("failed to rename %s: %w", table, err)
+	}
+
+	// 새 테이블이 같은 인덱스 이름을 쓴다.
+	for _, index := range []string{"idx_" + table + "_username", "idx_" + table + "_parent_id"} {
+		err = dropIndex(tx, index)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Migrator().CreateTable(model)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", table, err)
+	}
+
+	names := strings.Join(stmt.Schema.DBNames, ", ")
+
+	err = tx.Exec(fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s", table, names, names, old)).Error
+	if err != nil {
+		return fmt.Errorf("failed to copy %s: %w", table, err)
+	}
+
+	err = tx.Migrator().DropTable(old)
+	if err != nil {
+		return fmt.Errorf("failed to drop %s: %w", old, err)
+	}
+
+	return createIndex(tx, "idx_"+table+"_parent_id", table, "parent_id")
+}
+
+// isPrimaryKey는 model의 테이블에서 column이 키에 들어 있는지 확인한다.
+func isPrimaryKey(db *gorm.DB, model any, column string) (bool, error) {
+	columns, err := db.Migrator().ColumnTypes(model)
+	if err != nil {
+		return false, fmt.Errorf("failed to get column types: %w", err)
+	}
+
+	for _, item := range columns {
+		if item.Name() != column {
+			continue
+		}
+
+		primary, ok := item.PrimaryKey()
+
+		return ok && primary, nil
+	}
+
+	return false, nil
+}
+
+// createIndex와 dropIndex는 PostgreSQL과 SQLite가 함께 받는 문장만 쓴다.
+func createIndex(tx *gorm.DB, name string, table string, columns string) error {
+	err := tx.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (%s)", name, table, columns)).Error
+	if err != nil {
+		return fmt.Errorf("failed to create index %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func dropIndex(tx *gorm.DB, name string) error {
+	err := tx.Exec("DROP INDEX IF EXISTS " + name).Error
+	if err != nil {
+		return fmt.Errorf("failed to drop index %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// migrateTraceDurations는 초 단위의 estimated, actual 컬럼을 나노초 단위 컬럼으로 옮긴다.
+func migrateTraceDurations(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasColumn(&baselineTrace{}, "estimated") && //nolint:exhaustruct
+		!migrator.HasColumn(&baselineTrace{}, "actual") { //nolint:exhaustruct
+		return nil
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for _, column := range []string{"estimated", "actual"} {
+			if !tx.Migrator().HasColumn(&baselineTrace{}, column) { //nolint:exhaustruct
+				continue
+			}
+
+			err := tx.Exec(
+				fmt.Sprintf("UPDATE traces SET %s_ns = %s * ? WHERE %s IS NOT NULL", column, column, column),
+				int64(time.Second),
+			).Error
+			if err != nil {
+				return fmt.Errorf("failed to copy %s: %w", column, err)
+			}
+
+			err = tx.Migrator().DropColumn(&baselineTrace{}, column) //nolint:exhaustruct
+			if err != nil {
+				return fmt.Errorf("failed to drop %s: %w", column, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to migrate: %w", err)
+	}
+
+	return nil
+}
+
+// backfillTraceSelf는 self_ns가 비어 있는 trace의 self를 actual에서 자식의 actual을 빼서 채운다.
+func backfillTraceSelf(db *gorm.DB) error {
+	var traces []*keyedTrace
+
+	err := db.Find(&traces).Error
+	if err != nil {
+		return fmt.Errorf("failed to list traces: %w", err)
+	}
+
+	type key struct {
+		username string
+		id       string
+	}
+
+	childActual := make(map[key]int64)
+	for _, trace := range traces {
+		childActual[key{username: trace.Username, id: getString(trace.ParentID)}] += getInt64(trace.Actual)
+	}
+
+	for _, trace := range traces {
+		if trace.Self.Valid {
+			continue
+		}
+
+		self := max(getInt64(trace.Actual)-childActual[key{username: trace.Username, id: trace.ID}], 0)
+
+		model := &keyedTrace{} //nolint:exhaustruct
+
+		err := db.Model(model).Where("username = ? AND id = ?", trace.Username, trace.ID).Update("self_ns", self).Error
+		if err != nil {
+			return fmt.Errorf("failed to update trace: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// migrateTaskPrimaryKey는 id만 키로 쓰던 tasks의 키를 (username, id)로 바꾼다.
+func migrateTaskPrimaryKey(db *gorm.DB) error {
+	// id만 키로 쓰던 때에는 PostgreSQL만 지원했으므로 다른 데이터베이스는 처음부터 (username, id)가 키이다.
+	if db.Name() != "postgres" {
+		return nil
+	}
+
+	primary, err := isPrimaryKey(db, &baselineTask{}, "username") //nolint:exhaustruct
+	if err != nil {
+		return err
+	}
+
+	if primary {
+		return nil
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("ALTER TABLE tasks DROP CONSTRAINT tasks_pkey").Error
+		if err != nil {
+			return fmt.Errorf("failed to drop primary key: %w", err)
+		}
+
+		err = tx.Exec("ALTER TABLE tasks ADD PRIMARY KEY (username, id)").Error
+		if err != nil {
+			return fmt.Errorf("failed to add primary key: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to migrate: %w", err)
+	}
+
+	return nil
+}
+
+// backfillOwners는 username이 비어 있는 extra, trace와 session에 task의 사용자를 채운다.
+func backfillOwners(db *gorm.DB) error {
+	statements := []string{
+		"UPDATE extras SET username = (SELECT tasks.username FROM tasks WHERE tasks.id = extras.id LIMIT 1) " +
+			"WHERE (username IS NULL OR username = '') AND EXISTS (SELECT 1 FROM tasks WHERE tasks.id = extras.id)",
+		"UPDATE traces SET username = (SELECT tasks.username FROM tasks WHERE tasks.id = traces.id LIMIT 1) " +
+			"WHERE (username IS NULL OR username = '') AND EXISTS (SELECT 1 FROM tasks WHERE tasks.id = traces.id)",
+		"UPDATE sessions SET username = (SELECT traces.username FROM traces WHERE traces.id = sessions.trace_id) " +
+			"WHERE (username IS NULL OR username = '') AND EXISTS " +
+			"(SELECT 1 FROM traces WHERE traces.id = sessions.trace_id AND traces.username <> '')",
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for _, statement := range statements {
+			err := tx.Exec(statement).Error
+			if err != nil {
+				return fmt.Errorf("failed to backfill: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to backfill owners: %w", err)
+	}
+
+	return nil
+}
+
+// downNothing은 데이터만 채운 마이그레이션을 되돌린다. 채운 값은 그대로 두어도 맞다.
+func downNothing(*gorm.DB) error {
+	return nil
+}
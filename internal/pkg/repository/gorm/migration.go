@@ -0,0 +1,209 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrUnknownMigration은 이 바이너리가 모르는 버전이 적용되어 있다는 뜻이다.
+var ErrUnknownMigration = errors.New("unknown migration")
+
+// ErrIrreversibleMigration은 되돌릴 수 없는 마이그레이션을 되돌리려 했다는 뜻이다.
+var ErrIrreversibleMigration = errors.New("irreversible migration")
+
+// SchemaMigration은 적용한 마이그레이션의 기록이다.
+type SchemaMigration struct {
+	Version   int `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+// MigrationStatus는 마이그레이션 하나와 그것을 적용한 때이다.
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	AppliedAt time.Time // zero이면 아직 적용하지 않았다
+}
+
+// migration은 스키마를 바꾸는 한 단계이다.
+type migration struct {
+	version int
+	name    string
+	up      func(tx *gorm.DB) error
+	down    func(tx *gorm.DB) error
+}
+
+// migrations는 적용할 순서대로 놓인 마이그레이션이다.
+func migrations() []*migration {
+	return []*migration{
+		{version: 1, name: "baseline", up: upBaseline, down: downBaseline},
+		{version: 2, name: "tasks_username_id_primary_key", up: migrateTaskPrimaryKey, down: downTaskPrimaryKey},
+		{version: 3, name: "tasks_username_parent_id_index", up: upTaskParentIndex, down: downTaskParentIndex},
+		{version: 4, name: "extras_traces_parent_id_index", up: upParentIndexes, down: downParentIndexes},
+		{version: 5, name: "outbox_events_delivered", up: upOutboxDelivered, down: downOutboxDelivered},
+		{version: 6, name: "dead_letters", up: upDeadLetters, down: downDeadLetters},
+		{
+			version: 7,
+			name:    "extras_traces_username_id_primary_key",
+			up:      upExtraTracePrimaryKeys,
+			down:    downExtraTracePrimaryKeys,
+		},
+		{version: 8, name: "outbox_events_next_attempt_at", up: upOutboxNextAttempt, down: downOutboxNextAttempt},
+		{version: 9, name: "traces_duration_ns", up: migrateTraceDurations, down: downNothing},
+		{version: 10, name: "extras_traces_sessions_owner", up: backfillOwners, down: downNothing},
+		{version: 11, name: "traces_self_ns", up: backfillTraceSelf, down: downNothing},
+	}
+}
+
+// MigrateUp은 적용하지 않은 마이그레이션을 버전 순으로 steps개 적용한다. steps가 0이면 모두 적용한다.
+func (r *Repository) MigrateUp(ctx context.Context, steps int) ([]*MigrationStatus, error) {
+	applied, err := r.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []*MigrationStatus
+
+	for _, m := range migrations() {
+		if steps > 0 && len(done) == steps {
+			break
+		}
+
+		if _, ok := applied[m.version]; ok {
+			continue
+		}
+
+		record := &SchemaMigration{
+			Version:   m.version,
+			Name:      m.name,
+			AppliedAt: time.Now(),
+		}
+
+		err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			err := m.up(tx)
+			if err != nil {
+				return err
+			}
+
+			return tx.Create(record).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("failed to apply migration %d %s: %w", m.version, m.name, err)
+		}
+
+		done = append(done, toMigrationStatus(record))
+	}
+
+	return done, nil
+}
+
+// MigrateDown은 적용한 마이그레이션을 최근 것부터 steps개 되돌린다. steps가 0이면 모두 되돌린다.
+func (r *Repository) MigrateDown(ctx context.Context, steps int) ([]*MigrationStatus, error) {
+	applied, err := r.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []*MigrationStatus
+
+	for _, m := range slices.Backward(migrations()) {
+		if steps > 0 && len(done) == steps {
+			break
+		}
+
+		record, ok := applied[m.version]
+		if !ok {
+			continue
+		}
+
+		err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			err := m.down(tx)
+			if err != nil {
+				return err
+			}
+
+			return tx.Delete(record).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("failed to revert migration %d %s: %w", m.version, m.name, err)
+		}
+
+		done = append(done, toMigrationStatus(record))
+	}
+
+	return done, nil
+}
+
+// MigrationStatuses는 모든 마이그레이션을 버전 순으로 적용한 때와 함께 돌려준다.
+func (r *Repository) MigrationStatuses(ctx context.Context) ([]*MigrationStatus, error) {
+	applied, err := r.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []*MigrationStatus
+
+	for _, m := range migrations() {
+		status := &MigrationStatus{
+			Version:   m.version,
+			Name:      m.name,
+			AppliedAt: time.Time{},
+		}
+		if record, ok := applied[m.version]; ok {
+			status.AppliedAt = record.AppliedAt
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// appliedMigrations는 기록 테이블이 없으면 만들고, 적용한 마이그레이션을 버전으로 찾을 수 있게 돌려준다.
+func (r *Repository) appliedMigrations(ctx context.Context) (map[int]*SchemaMigration, error) {
+	db := r.db.WithContext(ctx)
+
+	if !db.Migrator().HasTable(&SchemaMigration{}) { //nolint:exhaustruct
+		err := db.Migrator().CreateTable(&SchemaMigration{}) //nolint:exhaustruct
+		if err != nil {
+			return nil, fmt.Errorf("failed to create migration table: %w", err)
+		}
+	}
+
+	var records []*SchemaMigration
+
+	err := db.Order("version").Find(&records).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	known := make(map[int]bool)
+	for _, m := range migrations() {
+		known[m.version] = true
+	}
+
+	applied := make(map[int]*SchemaMigration)
+
+	for _, record := range records {
+		if !known[record.Version] {
+			return nil, fmt.Errorf("%w: %d %s", ErrUnknownMigration, record.Version, record.Name)
+		}
+
+		applied[record.Version] = record
+	}
+
+	return applied, nil
+}
+
+func toMigrationStatus(record *SchemaMigration) *MigrationStatus {
+	return &MigrationStatus{
+		Version:   record.Version,
+		Name:      record.Name,
+		AppliedAt: record.AppliedAt,
+	}
+}
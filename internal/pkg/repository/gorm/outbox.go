@@ -0,0 +1,54 @@
+package gorm
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/repository"
+)
+
+type OutboxEvent struct {
+	ID            uint64 `gorm:"primaryKey;autoIncrement"`
+	Username      string `gorm:"index"`
+	Topic         string
+	Payload       []byte
+	CreatedAt     time.Time
+	Attempts      int
+	NextAttemptAt sql.NullTime
+	DeliveredAt   sql.NullTime `gorm:"index"`
+	Delivered     []int        `gorm:"serializer:json"`
+	RequestID     string
+	TraceID       string
+}
+
+func FromOutboxEvent(event *repository.OutboxEvent, username string) *OutboxEvent {
+	return &OutboxEvent{
+		ID:            event.ID,
+		Username:      username,
+		Topic:         event.Topic,
+		Payload:       event.Payload,
+		CreatedAt:     event.CreatedAt,
+		Attempts:      event.Attempts,
+		NextAttemptAt: toNullTime(event.NextAttemptAt),
+		DeliveredAt:   toNullTime(event.DeliveredAt),
+		Delivered:     event.Delivered,
+		RequestID:     event.RequestID,
+		TraceID:       event.TraceID,
+	}
+}
+
+func (e *OutboxEvent) ToOutboxEvent() *repository.OutboxEvent {
+	return &repository.OutboxEvent{
+		ID:            e.ID,
+		Username:      e.Username,
+		Topic:         e.Topic,
+		Payload:       e.Payload,
+		CreatedAt:     e.CreatedAt,
+		Attempts:      e.Attempts,
+		NextAttemptAt: getTime(e.NextAttemptAt),
+		DeliveredAt:   getTime(e.DeliveredAt),
+		Delivered:     e.Delivered,
+		RequestID:     e.RequestID,
+		TraceID:       e.TraceID,
+	}
+}
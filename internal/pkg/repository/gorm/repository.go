@@ -5,62 +5,112 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/neatflowcv/focus/internal/pkg/domain"
 	"github.com/neatflowcv/focus/internal/pkg/repository"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
-	_ repository.Repository      = (*Repository)(nil)
-	_ repository.ExtraRepository = (*Repository)(nil)
-	_ repository.TraceRepository = (*Repository)(nil)
+	_ repository.Repository           = (*Repository)(nil)
+	_ repository.ExtraRepository      = (*Repository)(nil)
+	_ repository.TraceRepository      = (*Repository)(nil)
+	_ repository.BackupRepository     = (*Repository)(nil)
+	_ repository.AutoStopRepository   = (*Repository)(nil)
+	_ repository.BurndownRepository   = (*Repository)(nil)
+	_ repository.WorkflowRepository   = (*Repository)(nil)
+	_ repository.OutboxRepository     = (*Repository)(nil)
+	_ repository.WebhookRepository    = (*Repository)(nil)
+	_ repository.DeadLetterRepository = (*Repository)(nil)
 )
 
 type Repository struct {
 	db *gorm.DB
 }
 
-func NewRepository() (*Repository, error) {
-	dsn :=
-		"host=127.0.0.1 user=focus password=password dbname=focus port=5432 sslmode=disable TimeZone=Asia/Seoul"
+// DefaultDSN은 scripts/deploy_postgresql.sh로 띄운 PostgreSQL에 접속한다.
+const DefaultDSN = "host=127.0.0.1 user=focus password=password dbname=focus port=5432 " +
+	"sslmode=disable TimeZone=Asia/Seoul"
 
-	db, err := gorm.Open(
-		postgres.New(
-			postgres.Config{ //nolint:exhaustruct
-				DSN:                  dsn,
-				PreferSimpleProtocol: true,
-			},
-		),
-		&gorm.Config{}) //nolint:exhaustruct
+// Pool은 데이터베이스 연결의 수와 수명이다. 0인 값은 database/sql의 기본값을 그대로 둔다.
+type Pool struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+}
+
+func (p Pool) apply(db *sql.DB) {
+	if p.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(p.MaxOpenConns)
+	}
+
+	if p.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(p.MaxIdleConns)
+	}
+
+	if p.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(p.ConnMaxLifetime)
+	}
+
+	if p.ConnMaxIdleTime > 0 {
+		db.SetConnMaxIdleTime(p.ConnMaxIdleTime)
+	}
+}
+
+// NewRepository는 dsn의 PostgreSQL을 열고 스키마를 최신 버전으로 올린다.
+func NewRepository(dsn string, pool Pool) (*Repository, error) {
+	repo, err := OpenPostgres(dsn, pool)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open database: %w", err)
+		return nil, err
 	}
 
-	err = db.AutoMigrate(&Task{}, &Extra{}, &Trace{}) //nolint:exhaustruct
+	_, err = repo.MigrateUp(context.Background(), 0)
 	if err != nil {
-		return nil, fmt.Errorf("failed to auto migrate: %w", err)
+		return nil, err
 	}
 
-	return &Repository{db: db}, nil
+	return repo, nil
 }
 
-func (r *Repository) CreateTasks(ctx context.Context, username string, dTasks ...*domain.Task) error {
-	var tasks []*Task
-	for _, task := range dTasks {
-		tasks = append(tasks, FromDomainTask(task, username))
+// OpenPostgres는 dsn의 PostgreSQL에 연결만 하고 스키마는 건드리지 않는다.
+func OpenPostgres(dsn string, pool Pool) (*Repository, error) {
+	if dsn == "" {
+		dsn = DefaultDSN
 	}
 
-	err := r.db.Transaction(func(tx *gorm.DB) error {
-		for _, task := range tasks {
-			err := gorm.G[Task](tx).Create(ctx, task)
-			if err != nil {
-				return fmt.Errorf("failed to create task: %w", err)
-			}
-		}
+	return Open(postgres.New(
+		postgres.Config{ //nolint:exhaustruct
+			DSN:                  dsn,
+			PreferSimpleProtocol: true,
+		},
+	), pool)
+}
 
-		return nil
+// Open은 dialector로 데이터베이스에 연결한다. 스키마는 MigrateUp으로 맞춘다.
+func Open(dialector gorm.Dialector, pool Pool) (*Repository, error) {
+	db, err := gorm.Open(dialector, &gorm.Config{TranslateError: true}) //nolint:exhaustruct
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection pool: %w", err)
+	}
+
+	pool.apply(sqlDB)
+
+	return &Repository{db: db}, nil
+}
+
+func (r *Repository) CreateTasks(ctx context.Context, username string, tasks ...*domain.Task) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		return createTasks(ctx, tx, username, tasks)
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create tasks: %w", err)
@@ -69,6 +119,21 @@ func (r *Repository) CreateTasks(ctx context.Context, username string, dTasks ..
 	return nil
 }
 
+func createTasks(ctx context.Context, tx *gorm.DB, username string, tasks []*domain.Task) error {
+	for _, task := range tasks {
+		err := gorm.G[Task](tx).Create(ctx, FromDomainTask(task, username))
+		if err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return repository.ErrTaskAlreadyExists
+			}
+
+			return fmt.Errorf("failed to create task: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (r *Repository) GetTask(ctx context.Context, username string, id domain.TaskID) (*domain.Task, error) {
 	task, err := gorm.G[Task](r.db).
 		Where(&Task{ID: string(id), Username: username}). //nolint:exhaustruct
@@ -86,26 +151,7 @@ func (r *Repository) GetTask(ctx context.Context, username string, id domain.Tas
 
 func (r *Repository) DeleteTasks(ctx context.Context, username string, tasks ...*domain.Task) error {
 	err := r.db.Transaction(func(tx *gorm.DB) error {
-		for _, task := range tasks {
-			affected, err := gorm.G[Task](tx).
-				Where(
-					&Task{ //nolint:exhaustruct
-						ID:       string(task.ID()),
-						Username: username,
-						Version:  task.Version(),
-					},
-				).
-				Delete(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to delete task: %w", err)
-			}
-
-			if affected == 0 {
-				return repository.ErrTaskNotFound
-			}
-		}
-
-		return nil
+		return deleteTasks(ctx, tx, username, tasks)
 	})
 	if err != nil {
 		return fmt.Errorf("failed to delete tasks: %w", err)
@@ -114,6 +160,29 @@ func (r *Repository) DeleteTasks(ctx context.Context, username string, tasks ...
 	return nil
 }
 
+func deleteTasks(ctx context.Context, tx *gorm.DB, username string, tasks []*domain.Task) error {
+	for _, task := range tasks {
+		affected, err := gorm.G[Task](tx).
+			Where(
+				&Task{ //nolint:exhaustruct
+					ID:       string(task.ID()),
+					Username: username,
+					Version:  task.Version(),
+				},
+			).
+			Delete(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete task: %w", err)
+		}
+
+		if affected == 0 {
+			return repository.ErrTaskNotFound
+		}
+	}
+
+	return nil
+}
+
 func (r *Repository) ListTasks(ctx context.Context, username string, parentID domain.TaskID) ([]*domain.Task, error) {
 	var tasks []Task
 
@@ -132,60 +201,95 @@ func (r *Repository) ListTasks(ctx context.Context, username string, parentID do
 	return ToDomainTasks(tasks), nil
 }
 
-func (r *Repository) UpdateTasks(ctx context.Context, username string, dTasks ...*domain.Task) error {
-	var tasks []*Task
-	for _, task := range dTasks {
-		tasks = append(tasks, FromDomainTask(task, username))
+func (r *Repository) UpdateTasks(ctx context.Context, username string, tasks ...*domain.Task) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		return updateTasks(ctx, tx, username, tasks)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update tasks: %w", err)
+	}
+
+	return nil
+}
+
+func updateTasks(ctx context.Context, tx *gorm.DB, username string, dTasks []*domain.Task) error {
+	for _, dTask := range dTasks {
+		task := FromDomainTask(dTask, username)
+		oldVersion := task.Version
+		task.Version++
+
+		affected, err := gorm.G[Task](tx).
+			Where(
+				&Task{ //nolint:exhaustruct
+					ID:       task.ID,
+					Username: username,
+					Version:  oldVersion,
+				},
+			).
+			Updates(ctx, *task)
+		if err != nil {
+			return fmt.Errorf("failed to update task: %w", err)
+		}
+
+		if affected == 0 {
+			return repository.ErrTaskNotFound
+		}
 	}
 
+	return nil
+}
+
+func (r *Repository) CommitTasks(ctx context.Context, username string, change *repository.TaskChange) error {
 	err := r.db.Transaction(func(tx *gorm.DB) error {
-		for _, task := range tasks {
-			oldVersion := task.Version
-			task.Version++
-
-			affected, err := gorm.G[Task](tx).
-				Where(
-					&Task{ //nolint:exhaustruct
-						ID:       task.ID,
-						Username: username,
-						Version:  oldVersion,
-					},
-				).
-				Updates(ctx, *task)
-			if err != nil {
-				return fmt.Errorf("failed to update task: %w", err)
-			}
+		err := createTasks(ctx, tx, username, change.Create)
+		if err != nil {
+			return err
+		}
 
-			if affected == 0 {
-				return repository.ErrTaskNotFound
+		err = updateTasks(ctx, tx, username, change.Update)
+		if err != nil {
+			return err
+		}
+
+		err = deleteTasks(ctx, tx, username, change.Delete)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range change.Events {
+			err := gorm.G[OutboxEvent](tx).Create(ctx, FromOutboxEvent(event, username))
+			if err != nil {
+				return fmt.Errorf("failed to create outbox event: %w", err)
 			}
 		}
 
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("failed to update tasks: %w", err)
+		return fmt.Errorf("failed to commit tasks: %w", err)
 	}
 
 	return nil
 }
 
-func (r *Repository) CreateExtra(ctx context.Context, dExtra *domain.Extra) error {
-	extra := FromDomainExtra(dExtra)
+func (r *Repository) CreateExtra(ctx context.Context, username string, dExtra *domain.Extra) error {
+	extra := FromDomainExtra(dExtra, username)
 
 	err := gorm.G[Extra](r.db).Create(ctx, extra)
 	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return repository.ErrExtraAlreadyExists
+		}
+
 		return fmt.Errorf("failed to create extra: %w", err)
 	}
 
 	return nil
 }
 
-func (r *Repository) DeleteExtra(ctx context.Context, dExtra *domain.Extra) error {
-	extra := FromDomainExtra(dExtra)
-
+func (r *Repository) DeleteExtra(ctx context.Context, username string, extra *domain.Extra) error {
 	affected, err := gorm.G[Extra](r.db).
-		Where(extra).
+		Where(&Extra{Username: username, ID: string(extra.ID())}). //nolint:exhaustruct
 		Delete(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to delete extra: %w", err)
@@ -198,25 +302,33 @@ func (r *Repository) DeleteExtra(ctx context.Context, dExtra *domain.Extra) erro
 	return nil
 }
 
-func (r *Repository) GetExtra(ctx context.Context, id domain.ExtraID) (*domain.Extra, error) {
+func (r *Repository) GetExtra(ctx context.Context, username string, id domain.ExtraID) (*domain.Extra, error) {
 	extra, err := gorm.G[Extra](r.db).
-		Where(&Extra{ID: string(id)}). //nolint:exhaustruct
+		Where(&Extra{Username: username, ID: string(id)}). //nolint:exhaustruct
 		Take(ctx)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrExtraNotFound
+		}
+
 		return nil, fmt.Errorf("failed to get extra: %w", err)
 	}
 
 	return extra.ToDomain(), nil
 }
 
-func (r *Repository) ListExtras(ctx context.Context, ids []domain.ExtraID) ([]*domain.Extra, error) {
+func (r *Repository) ListExtras(ctx context.Context, username string, ids []domain.ExtraID) ([]*domain.Extra, error) {
 	var ret []*domain.Extra
 
 	for _, id := range ids {
 		extra, err := gorm.G[Extra](r.db).
-			Where(&Extra{ID: string(id)}). //nolint:exhaustruct
+			Where(&Extra{Username: username, ID: string(id)}). //nolint:exhaustruct
 			Take(ctx)
 		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+
 			return nil, fmt.Errorf("failed to list extras: %w", err)
 		}
 
@@ -226,10 +338,35 @@ func (r *Repository) ListExtras(ctx context.Context, ids []domain.ExtraID) ([]*d
 	return ret, nil
 }
 
-func (r *Repository) UpdateExtra(ctx context.Context, extra *domain.Extra) error {
+func (r *Repository) ListChildExtras(
+	ctx context.Context,
+	username string,
+	parentID domain.ExtraID,
+) ([]*domain.Extra, error) {
+	parent := sql.NullString{String: string(parentID), Valid: true}
+
+	extras, err := gorm.G[Extra](r.db).
+		Where(&Extra{Username: username, ParentID: parent}). //nolint:exhaustruct
+		Order("id").
+		Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list child extras: %w", err)
+	}
+
+	var ret []*domain.Extra
+	for _, extra := range extras {
+		ret = append(ret, extra.ToDomain())
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) UpdateExtra(ctx context.Context, username string, extra *domain.Extra) error {
+	// 재개되면 completed_at이 비워져야 하므로 모든 컬럼을 쓴다.
 	affected, err := gorm.G[Extra](r.db).
-		Where(&Extra{ID: string(extra.ID())}). //nolint:exhaustruct
-		Updates(ctx, *FromDomainExtra(extra))
+		Where(&Extra{Username: username, ID: string(extra.ID())}). //nolint:exhaustruct
+		Select("*").
+		Updates(ctx, *FromDomainExtra(extra, username))
 	if err != nil {
 		return fmt.Errorf("failed to update extra: %w", err)
 	}
@@ -241,72 +378,105 @@ func (r *Repository) UpdateExtra(ctx context.Context, extra *domain.Extra) error
 	return nil
 }
 
-func (r *Repository) CreateTrace(ctx context.Context, dTrace *domain.Trace) error {
-	trace := FromDomainTrace(dTrace)
+func (r *Repository) CreateTrace(ctx context.Context, username string, dTrace *domain.Trace) error {
+	trace := FromDomainTrace(dTrace, username)
 
 	err := gorm.G[Trace](r.db).Create(ctx, trace)
 	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return repository.ErrTraceAlreadyExists
+		}
+
 		return fmt.Errorf("failed to create trace: %w", err)
 	}
 
 	return nil
 }
 
-func (r *Repository) DeleteTrace(ctx context.Context, trace *domain.Trace) error {
-	affected, err := gorm.G[Trace](r.db).
-		Where(&Trace{ID: string(trace.ID())}). //nolint:exhaustruct
-		Delete(ctx)
+func (r *Repository) DeleteTrace(
+	ctx context.Context,
+	username string,
+	trace *domain.Trace,
+	updates ...*domain.Trace,
+) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		affected, err := gorm.G[Trace](tx).
+			Where(&Trace{Username: username, ID: string(trace.ID())}). //nolint:exhaustruct
+			Delete(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete trace: %w", err)
+		}
+
+		if affected == 0 {
+			return repository.ErrTraceNotFound
+		}
+
+		_, err = gorm.G[Session](tx).
+			Where(&Session{Username: username, TraceID: string(trace.ID())}). //nolint:exhaustruct
+			Delete(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete sessions: %w", err)
+		}
+
+		return updateTraces(ctx, tx, username, updates)
+	})
 	if err != nil {
 		return fmt.Errorf("failed to delete trace: %w", err)
 	}
 
-	if affected == 0 {
-		return repository.ErrTraceNotFound
-	}
-
 	return nil
 }
 
-func (r *Repository) GetTrace(ctx context.Context, id domain.TraceID) (*domain.Trace, error) {
+func (r *Repository) GetTrace(ctx context.Context, username string, id domain.TraceID) (*domain.Trace, error) {
 	trace, err := gorm.G[Trace](r.db).
-		Where(&Trace{ID: string(id)}). //nolint:exhaustruct
+		Where(&Trace{Username: username, ID: string(id)}). //nolint:exhaustruct
 		Take(ctx)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrTraceNotFound
+		}
+
 		return nil, fmt.Errorf("failed to get trace: %w", err)
 	}
 
 	return trace.ToDomain(), nil
 }
 
-func (r *Repository) UpdateTraces(ctx context.Context, dTraces ...*domain.Trace) error {
+func (r *Repository) UpdateTraces(ctx context.Context, username string, dTraces ...*domain.Trace) error {
 	err := r.db.Transaction(func(tx *gorm.DB) error {
-		for _, dTrace := range dTraces {
-			trace := FromDomainTrace(dTrace)
+		return updateTraces(ctx, tx, username, dTraces)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update traces: %w", err)
+	}
 
-			affected, err := gorm.G[Trace](r.db).
-				Where(&Trace{ID: trace.ID}). //nolint:exhaustruct
-				Updates(ctx, *trace)
-			if err != nil {
-				return fmt.Errorf("failed to update traces: %w", err)
-			}
+	return nil
+}
 
-			if affected == 0 {
-				return repository.ErrTraceNotFound
-			}
+func updateTraces(ctx context.Context, tx *gorm.DB, username string, dTraces []*domain.Trace) error {
+	for _, dTrace := range dTraces {
+		trace := FromDomainTrace(dTrace, username)
+
+		// 멈추거나 마감일을 지우면 started_at, due_at이 비워져야 하므로 모든 컬럼을 쓴다.
+		affected, err := gorm.G[Trace](tx).
+			Where(&Trace{Username: username, ID: trace.ID}). //nolint:exhaustruct
+			Select("*").
+			Updates(ctx, *trace)
+		if err != nil {
+			return fmt.Errorf("failed to update traces: %w", err)
 		}
 
-		return nil
-	})
-	if err != nil {
-		return fmt.Errorf("failed to update traces: %w", err)
+		if affected == 0 {
+			return repository.ErrTraceNotFound
+		}
 	}
 
 	return nil
 }
 
-func (r *Repository) ListTraces(ctx context.Context, ids []domain.TraceID) ([]*domain.Trace, error) {
+func (r *Repository) ListTraces(ctx context.Context, username string, ids []domain.TraceID) ([]*domain.Trace, error) {
 	traces, err := gorm.G[Trace](r.db).
-		Where("id IN ?", ids).
+		Where("username = ? AND id IN ?", username, ids).
 		Find(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list traces: %w", err)
@@ -320,9 +490,15 @@ func (r *Repository) ListTraces(ctx context.Context, ids []domain.TraceID) ([]*d
 	return ret, nil
 }
 
-func (r *Repository) ListChildTraces(ctx context.Context, parentID domain.TraceID) ([]*domain.Trace, error) {
+func (r *Repository) ListChildTraces(
+	ctx context.Context,
+	username string,
+	parentID domain.TraceID,
+) ([]*domain.Trace, error) {
+	parent := sql.NullString{String: string(parentID), Valid: true}
+
 	traces, err := gorm.G[Trace](r.db).
-		Where(&Trace{ParentID: sql.NullString{String: string(parentID), Valid: true}}). //nolint:exhaustruct
+		Where(&Trace{Username: username, ParentID: parent}). //nolint:exhaustruct
 		Find(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list child traces: %w", err)
@@ -335,3 +511,828 @@ func (r *Repository) ListChildTraces(ctx context.Context, parentID domain.TraceI
 
 	return ret, nil
 }
+
+func (r *Repository) ListAllExtras(ctx context.Context, username string) ([]*domain.Extra, error) {
+	extras, err := gorm.G[Extra](r.db).
+		Where(&Extra{Username: username}). //nolint:exhaustruct
+		Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list all extras: %w", err)
+	}
+
+	var ret []*domain.Extra
+	for _, extra := range extras {
+		ret = append(ret, extra.ToDomain())
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) ListAllTraces(ctx context.Context, username string) ([]*domain.Trace, error) {
+	traces, err := gorm.G[Trace](r.db).
+		Where(&Trace{Username: username}). //nolint:exhaustruct
+		Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list all traces: %w", err)
+	}
+
+	var ret []*domain.Trace
+	for _, trace := range traces {
+		ret = append(ret, trace.ToDomain())
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) CreateSession(ctx context.Context, username string, session *domain.Session) error {
+	err := gorm.G[Session](r.db).Create(ctx, FromDomainSession(session, username))
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CommitSession(ctx context.Context, username string, change *repository.SessionChange) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := lockSessionTraces(ctx, tx, username, change)
+		if err != nil {
+			return err
+		}
+
+		for _, session := range change.Delete {
+			affected, err := gorm.G[Session](tx).
+				Where(&Session{Username: username, ID: string(session.ID())}). //nolint:exhaustruct
+				Delete(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to delete session: %w", err)
+			}
+
+			if affected == 0 {
+				return repository.ErrSessionNotFound
+			}
+		}
+
+		for _, session := range change.Update {
+			affected, err := gorm.G[Session](tx).
+				Where(&Session{Username: username, ID: string(session.ID())}). //nolint:exhaustruct
+				Select("*").
+				Updates(ctx, *FromDomainSession(session, username))
+			if err != nil {
+				return fmt.Errorf("failed to update session: %w", err)
+			}
+
+			if affected == 0 {
+				return repository.ErrSessionNotFound
+			}
+		}
+
+		for _, session := range change.Create {
+			err := gorm.G[Session](tx).Create(ctx, FromDomainSession(session, username))
+			if err != nil {
+				return fmt.Errorf("failed to create session: %w", err)
+			}
+		}
+
+		for _, session := range slices.Concat(change.Create, change.Update) {
+			err := checkSessionOverlap(ctx, tx, username, session)
+			if err != nil {
+				return err
+			}
+		}
+
+		return updateTraces(ctx, tx, username, change.Traces)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to commit session: %w", err)
+	}
+
+	return nil
+}
+
+// lockSessionTraces는 구간을 고치는 trace를 잠가서 같은 trace의 구간을 동시에 고치지 못하게 한다.
+func lockSessionTraces(ctx context.Context, tx *gorm.DB, username string, change *repository.SessionChange) error {
+	var ids []string
+
+	for _, session := range slices.Concat(change.Create, change.Update, change.Delete) {
+		if !slices.Contains(ids, string(session.TraceID())) {
+			ids = append(ids, string(session.TraceID()))
+		}
+	}
+
+	locking := clause.Locking{Strength: clause.LockingStrengthUpdate} //nolint:exhaustruct
+
+	for _, id := range ids {
+		_, err := gorm.G[Trace](tx, locking).
+			Where(&Trace{Username: username, ID: id}). //nolint:exhaustruct
+			Take(ctx)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repository.ErrTraceNotFound
+			}
+
+			return fmt.Errorf("failed to lock trace: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func checkSessionOverlap(ctx context.Context, tx *gorm.DB, username string, session *domain.Session) error {
+	count, err := gorm.G[Session](tx).
+		Where("username = ? AND trace_id = ? AND id <> ? AND started_at < ? AND ended_at > ?",
+			username, string(session.TraceID()), string(session.ID()), session.EndedAt(), session.StartedAt()).
+		Count(ctx, "*")
+	if err != nil {
+		return fmt.Errorf("failed to count overlapping sessions: %w", err)
+	}
+
+	if count > 0 {
+		return repository.ErrSessionOverlaps
+	}
+
+	return nil
+}
+
+func (r *Repository) GetSession(ctx context.Context, username string, id domain.SessionID) (*domain.Session, error) {
+	session, err := gorm.G[Session](r.db).
+		Where(&Session{Username: username, ID: string(id)}). //nolint:exhaustruct
+		Take(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrSessionNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	return session.ToDomain(), nil
+}
+
+func (r *Repository) UpdateSession(ctx context.Context, username string, session *domain.Session) error {
+	// auto, note는 zero 값으로도 바뀌어야 하므로 모든 컬럼을 쓴다.
+	affected, err := gorm.G[Session](r.db).
+		Where(&Session{Username: username, ID: string(session.ID())}). //nolint:exhaustruct
+		Select("*").
+		Updates(ctx, *FromDomainSession(session, username))
+	if err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+
+	if affected == 0 {
+		return repository.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteSession(ctx context.Context, username string, session *domain.Session) error {
+	affected, err := gorm.G[Session](r.db).
+		Where(&Session{Username: username, ID: string(session.ID())}). //nolint:exhaustruct
+		Delete(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	if affected == 0 {
+		return repository.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) ListSessions(
+	ctx context.Context,
+	username string,
+	traceIDs []domain.TraceID,
+) ([]*domain.Session, error) {
+	sessions, err := gorm.G[Session](r.db).
+		Where("username = ? AND trace_id IN ?", username, traceIDs).
+		Order("started_at").
+		Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	var ret []*domain.Session
+	for _, session := range sessions {
+		ret = append(ret, session.ToDomain())
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) ListAllSessions(ctx context.Context, username string) ([]*domain.Session, error) {
+	sessions, err := gorm.G[Session](r.db).
+		Where(&Session{Username: username}). //nolint:exhaustruct
+		Order("started_at").
+		Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list all sessions: %w", err)
+	}
+
+	var ret []*domain.Session
+	for _, session := range sessions {
+		ret = append(ret, session.ToDomain())
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) ListUsernames(ctx context.Context) ([]string, error) {
+	var usernames []string
+
+	err := r.db.WithContext(ctx).
+		Model(&Task{}). //nolint:exhaustruct
+		Distinct().
+		Order("username").
+		Pluck("username", &usernames).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list usernames: %w", err)
+	}
+
+	return usernames, nil
+}
+
+func (r *Repository) ListAllTasks(ctx context.Context, username string) ([]*domain.Task, error) {
+	tasks, err := gorm.G[Task](r.db).
+		Where(&Task{Username: username}). //nolint:exhaustruct
+		Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list all tasks: %w", err)
+	}
+
+	return ToDomainTasks(tasks), nil
+}
+
+func (r *Repository) ReplaceUserData(ctx context.Context, data ...*repository.UserData) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, item := range data {
+			err := replaceUserData(ctx, tx, item)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to replace user data: %w", err)
+	}
+
+	return nil
+}
+
+func replaceUserData(ctx context.Context, tx *gorm.DB, data *repository.UserData) error {
+	_, err := gorm.G[Extra](tx).
+		Where(&Extra{Username: data.Username}). //nolint:exhaustruct
+		Delete(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete extras: %w", err)
+	}
+
+	_, err = gorm.G[Trace](tx).
+		Where(&Trace{Username: data.Username}). //nolint:exhaustruct
+		Delete(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete traces: %w", err)
+	}
+
+	_, err = gorm.G[Session](tx).
+		Where(&Session{Username: data.Username}). //nolint:exhaustruct
+		Delete(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete sessions: %w", err)
+	}
+
+	_, err = gorm.G[Task](tx).
+		Where(&Task{Username: data.Username}). //nolint:exhaustruct
+		Delete(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete tasks: %w", err)
+	}
+
+	for _, task := range data.Tasks {
+		err := gorm.G[Task](tx).Create(ctx, FromDomainTask(task, data.Username))
+		if err != nil {
+			return fmt.Errorf("failed to create task: %w", err)
+		}
+	}
+
+	for _, extra := range data.Extras {
+		err := gorm.G[Extra](tx).Create(ctx, FromDomainExtra(extra, data.Username))
+		if err != nil {
+			return fmt.Errorf("failed to create extra: %w", err)
+		}
+	}
+
+	for _, trace := range data.Traces {
+		err := gorm.G[Trace](tx).Create(ctx, FromDomainTrace(trace, data.Username))
+		if err != nil {
+			return fmt.Errorf("failed to create trace: %w", err)
+		}
+	}
+
+	for _, session := range data.Sessions {
+		err := gorm.G[Session](tx).Create(ctx, FromDomainSession(session, data.Username))
+		if err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+	}
+
+	return replaceUserSettings(ctx, tx, data)
+}
+
+// replaceUserSettings는 사용자의 workflow, 정책, 프로젝트와 webhook을 교체한다.
+func replaceUserSettings(ctx context.Context, tx *gorm.DB, data *repository.UserData) error { //nolint:cyclop,funlen
+	for _, model := range []any{
+		&Workflow{Username: data.Username},         //nolint:exhaustruct
+		&AutoStopPolicy{Username: data.Username},   //nolint:exhaustruct
+		&BurndownSnapshot{Username: data.Username}, //nolint:exhaustruct
+		&TrackedProject{Username: data.Username},   //nolint:exhaustruct
+		&WebhookDelivery{Username: data.Username},  //nolint:exhaustruct
+		&Webhook{Username: data.Username},          //nolint:exhaustruct
+	} {
+		err := tx.WithContext(ctx).Where(model).Delete(model).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete %T: %w", model, err)
+		}
+	}
+
+	if data.Workflow != nil {
+		err := gorm.G[Workflow](tx).Create(ctx, FromDomainWorkflow(data.Workflow))
+		if err != nil {
+			return fmt.Errorf("failed to create workflow: %w", err)
+		}
+	}
+
+	if data.Policy != nil {
+		err := gorm.G[AutoStopPolicy](tx).Create(ctx, FromDomainAutoStopPolicy(data.Policy))
+		if err != nil {
+			return fmt.Errorf("failed to create auto stop policy: %w", err)
+		}
+	}
+
+	for _, project := range data.Projects {
+		err := gorm.G[TrackedProject](tx).Create(ctx, FromDomainTrackedProject(project))
+		if err != nil {
+			return fmt.Errorf("failed to create tracked project: %w", err)
+		}
+	}
+
+	for _, snapshot := range data.Snapshots {
+		err := gorm.G[BurndownSnapshot](tx).Create(ctx, FromDomainBurndownSnapshot(snapshot))
+		if err != nil {
+			return fmt.Errorf("failed to create burndown snapshot: %w", err)
+		}
+	}
+
+	for _, webhook := range data.Webhooks {
+		err := gorm.G[Webhook](tx).Create(ctx, FromDomainWebhook(webhook))
+		if err != nil {
+			return fmt.Errorf("failed to create webhook: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) SaveAutoStopPolicy(ctx context.Context, policy *domain.AutoStopPolicy) error {
+	upsert := clause.OnConflict{UpdateAll: true} //nolint:exhaustruct
+
+	err := gorm.G[AutoStopPolicy](r.db, upsert).Create(ctx, FromDomainAutoStopPolicy(policy))
+	if err != nil {
+		return fmt.Errorf("failed to save auto stop policy: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetAutoStopPolicy(ctx context.Context, username string) (*domain.AutoStopPolicy, error) {
+	policy, err := gorm.G[AutoStopPolicy](r.db).
+		Where(&AutoStopPolicy{Username: username}). //nolint:exhaustruct
+		Take(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrAutoStopPolicyNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get auto stop policy: %w", err)
+	}
+
+	return policy.ToDomain(), nil
+}
+
+func (r *Repository) ListAutoStopPolicies(ctx context.Context) ([]*domain.AutoStopPolicy, error) {
+	policies, err := gorm.G[AutoStopPolicy](r.db).
+		Order("username").
+		Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list auto stop policies: %w", err)
+	}
+
+	var ret []*domain.AutoStopPolicy
+	for _, policy := range policies {
+		ret = append(ret, policy.ToDomain())
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) SaveTrackedProject(ctx context.Context, project *domain.TrackedProject) error {
+	upsert := clause.OnConflict{UpdateAll: true} //nolint:exhaustruct
+
+	err := gorm.G[TrackedProject](r.db, upsert).Create(ctx, FromDomainTrackedProject(project))
+	if err != nil {
+		return fmt.Errorf("failed to save tracked project: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetTrackedProject(
+	ctx context.Context,
+	username string,
+	rootID domain.TaskID,
+) (*domain.TrackedProject, error) {
+	project, err := gorm.G[TrackedProject](r.db).
+		Where(&TrackedProject{Username: username, RootID: string(rootID)}). //nolint:exhaustruct
+		Take(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrTrackedProjectNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get tracked project: %w", err)
+	}
+
+	return project.ToDomain(), nil
+}
+
+func (r *Repository) ListTrackedProjects(ctx context.Context) ([]*domain.TrackedProject, error) {
+	projects, err := gorm.G[TrackedProject](r.db).
+		Order("username, root_id").
+		Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracked projects: %w", err)
+	}
+
+	var ret []*domain.TrackedProject
+	for _, project := range projects {
+		ret = append(ret, project.ToDomain())
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) DeleteTrackedProject(ctx context.Context, project *domain.TrackedProject) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		username := project.Username()
+		rootID := string(project.RootID())
+
+		_, err := gorm.G[BurndownSnapshot](tx).
+			Where(&BurndownSnapshot{Username: username, RootID: rootID}). //nolint:exhaustruct
+			Delete(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete burndown snapshots: %w", err)
+		}
+
+		affected, err := gorm.G[TrackedProject](tx).
+			Where(&TrackedProject{Username: username, RootID: rootID}). //nolint:exhaustruct
+			Delete(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete tracked project: %w", err)
+		}
+
+		if affected == 0 {
+			return repository.ErrTrackedProjectNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete tracked project: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) SaveBurndownSnapshot(ctx context.Context, snapshot *domain.BurndownSnapshot) error {
+	upsert := clause.OnConflict{UpdateAll: true} //nolint:exhaustruct
+
+	err := gorm.G[BurndownSnapshot](r.db, upsert).Create(ctx, FromDomainBurndownSnapshot(snapshot))
+	if err != nil {
+		return fmt.Errorf("failed to save burndown snapshot: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListBurndownSnapshots(
+	ctx context.Context,
+	username string,
+	rootID domain.TaskID,
+) ([]*domain.BurndownSnapshot, error) {
+	snapshots, err := gorm.G[BurndownSnapshot](r.db).
+		Where(&BurndownSnapshot{Username: username, RootID: string(rootID)}). //nolint:exhaustruct
+		Order("date").
+		Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list burndown snapshots: %w", err)
+	}
+
+	var ret []*domain.BurndownSnapshot
+	for _, snapshot := range snapshots {
+		ret = append(ret, snapshot.ToDomain())
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) SaveWorkflow(ctx context.Context, workflow *domain.Workflow) error {
+	upsert := clause.OnConflict{UpdateAll: true} //nolint:exhaustruct
+
+	err := gorm.G[Workflow](r.db, upsert).Create(ctx, FromDomainWorkflow(workflow))
+	if err != nil {
+		return fmt.Errorf("failed to save workflow: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetWorkflow(ctx context.Context, username string) (*domain.Workflow, error) {
+	workflow, err := gorm.G[Workflow](r.db).
+		Where(&Workflow{Username: username}). //nolint:exhaustruct
+		Take(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrWorkflowNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get workflow: %w", err)
+	}
+
+	return workflow.ToDomain(), nil
+}
+
+func (r *Repository) ListPendingOutboxEvents(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]*repository.OutboxEvent, error) {
+	events, err := gorm.G[OutboxEvent](r.db).
+		Where("delivered_at IS NULL AND (next_attempt_at IS NULL OR next_attempt_at <= ?)", now).
+		Order("id").
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list outbox events: %w", err)
+	}
+
+	var ret []*repository.OutboxEvent
+	for _, event := range events {
+		ret = append(ret, event.ToOutboxEvent())
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) UpdateOutboxEvent(ctx context.Context, event *repository.OutboxEvent) error {
+	affected, err := gorm.G[OutboxEvent](r.db).
+		Where(&OutboxEvent{ID: event.ID}). //nolint:exhaustruct
+		Select("*").
+		Updates(ctx, *FromOutboxEvent(event, event.Username))
+	if err != nil {
+		return fmt.Errorf("failed to update outbox event: %w", err)
+	}
+
+	if affected == 0 {
+		return repository.ErrOutboxEventNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateDeadLetter(ctx context.Context, letter *repository.DeadLetter) error {
+	saved := FromDeadLetter(letter)
+
+	err := gorm.G[DeadLetter](r.db).Create(ctx, saved)
+	if err != nil {
+		return fmt.Errorf("failed to create dead letter: %w", err)
+	}
+
+	letter.ID = saved.ID
+
+	return nil
+}
+
+func (r *Repository) GetDeadLetter(ctx context.Context, id uint64) (*repository.DeadLetter, error) {
+	letter, err := gorm.G[DeadLetter](r.db).
+		Where(&DeadLetter{ID: id}). //nolint:exhaustruct
+		Take(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrDeadLetterNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get dead letter: %w", err)
+	}
+
+	return letter.ToDeadLetter(), nil
+}
+
+func (r *Repository) ListDeadLetters(ctx context.Context) ([]*repository.DeadLetter, error) {
+	letters, err := gorm.G[DeadLetter](r.db).Order("id").Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dead letters: %w", err)
+	}
+
+	var ret []*repository.DeadLetter
+	for _, letter := range letters {
+		ret = append(ret, letter.ToDeadLetter())
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) DeleteDeadLetter(ctx context.Context, id uint64) error {
+	affected, err := gorm.G[DeadLetter](r.db).
+		Where(&DeadLetter{ID: id}). //nolint:exhaustruct
+		Delete(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete dead letter: %w", err)
+	}
+
+	if affected == 0 {
+		return repository.ErrDeadLetterNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	err := gorm.G[Webhook](r.db).Create(ctx, FromDomainWebhook(webhook))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetWebhook(ctx context.Context, username string, id domain.WebhookID) (*domain.Webhook, error) {
+	webhook, err := gorm.G[Webhook](r.db).
+		Where(&Webhook{Username: username, ID: string(id)}). //nolint:exhaustruct
+		Take(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrWebhookNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	return webhook.ToDomain(), nil
+}
+
+func (r *Repository) ListWebhooks(ctx context.Context, username string) ([]*domain.Webhook, error) {
+	webhooks, err := gorm.G[Webhook](r.db).
+		Where(&Webhook{Username: username}). //nolint:exhaustruct
+		Order("created_at, id").
+		Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	var ret []*domain.Webhook
+	for _, webhook := range webhooks {
+		ret = append(ret, webhook.ToDomain())
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) DeleteWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		affected, err := gorm.G[Webhook](tx).
+			Where(&Webhook{Username: webhook.Username(), ID: string(webhook.ID())}). //nolint:exhaustruct
+			Delete(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete webhook: %w", err)
+		}
+
+		if affected == 0 {
+			return repository.ErrWebhookNotFound
+		}
+
+		_, err = gorm.G[WebhookDelivery](tx).
+			Where(&WebhookDelivery{Username: webhook.Username(), WebhookID: string(webhook.ID())}). //nolint:exhaustruct
+			Delete(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateWebhookDeliveries(ctx context.Context, deliveries ...*domain.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	var models []WebhookDelivery
+	for _, delivery := range deliveries {
+		models = append(models, *FromDomainWebhookDelivery(delivery))
+	}
+
+	err := gorm.G[WebhookDelivery](r.db).CreateInBatches(ctx, &models, len(models))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetWebhookDelivery(
+	ctx context.Context,
+	username string,
+	id domain.WebhookDeliveryID,
+) (*domain.WebhookDelivery, error) {
+	delivery, err := gorm.G[WebhookDelivery](r.db).
+		Where(&WebhookDelivery{Username: username, ID: string(id)}). //nolint:exhaustruct
+		Take(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrWebhookDeliveryNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	return delivery.ToDomain(), nil
+}
+
+func (r *Repository) UpdateWebhookDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	// last_error, next_attempt_at은 zero 값으로도 바뀌어야 하므로 모든 컬럼을 쓴다.
+	affected, err := gorm.G[WebhookDelivery](r.db).
+		Where(&WebhookDelivery{Username: delivery.Username(), ID: string(delivery.ID())}). //nolint:exhaustruct
+		Select("*").
+		Updates(ctx, *FromDomainWebhookDelivery(delivery))
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	if affected == 0 {
+		return repository.ErrWebhookDeliveryNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) ListWebhookDeliveries(
+	ctx context.Context,
+	username string,
+	webhookID domain.WebhookID,
+) ([]*domain.WebhookDelivery, error) {
+	deliveries, err := gorm.G[WebhookDelivery](r.db).
+		Where(&WebhookDelivery{Username: username, WebhookID: string(webhookID)}). //nolint:exhaustruct
+		Order("created_at, id").
+		Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	var ret []*domain.WebhookDelivery
+	for _, delivery := range deliveries {
+		ret = append(ret, delivery.ToDomain())
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) ListDueWebhookDeliveries(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]*domain.WebhookDelivery, error) {
+	deliveries, err := gorm.G[WebhookDelivery](r.db).
+		Where("status = ? AND next_attempt_at <= ?", string(domain.WebhookDeliveryPending), now).
+		Order("next_attempt_at, id").
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due webhook deliveries: %w", err)
+	}
+
+	var ret []*domain.WebhookDelivery
+	for _, delivery := range deliveries {
+		ret = append(ret, delivery.ToDomain())
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,41 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+type Session struct {
+	Username string `gorm:"index"`
+
+	ID        string
+	TraceID   string `gorm:"index"`
+	StartedAt time.Time
+	EndedAt   time.Time
+	Auto      bool
+	Note      string
+}
+
+func FromDomainSession(session *domain.Session, username string) *Session {
+	return &Session{
+		Username:  username,
+		ID:        string(session.ID()),
+		TraceID:   string(session.TraceID()),
+		StartedAt: session.StartedAt(),
+		EndedAt:   session.EndedAt(),
+		Auto:      session.Auto(),
+		Note:      session.Note(),
+	}
+}
+
+func (s *Session) ToDomain() *domain.Session {
+	return domain.NewSession(
+		domain.SessionID(s.ID),
+		domain.TraceID(s.TraceID),
+		s.StartedAt,
+		s.EndedAt,
+		s.Auto,
+		s.Note,
+	)
+}
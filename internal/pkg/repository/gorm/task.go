@@ -8,7 +8,7 @@ import (
 )
 
 type Task struct {
-	Username string
+	Username string `gorm:"primaryKey"` // root dummy의 ID는 모든 사용자가 같으므로 사용자와 함께 키가 된다
 
 	ID       string `gorm:"primaryKey"`
 	ParentID sql.NullString
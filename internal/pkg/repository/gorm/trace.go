@@ -8,20 +8,27 @@ import (
 )
 
 type Trace struct {
-	ID        string
+	Username string `gorm:"primaryKey;index"`
+
+	ID        string `gorm:"primaryKey"`
 	ParentID  sql.NullString
-	Estimated sql.NullInt64 // seconds
-	Actual    sql.NullInt64 // seconds
+	Estimated sql.NullInt64 `gorm:"column:estimated_ns"` // nanoseconds
+	Self      sql.NullInt64 `gorm:"column:self_ns"`      // nanoseconds
+	Actual    sql.NullInt64 `gorm:"column:actual_ns"`    // nanoseconds
 	StartedAt sql.NullTime
+	DueAt     sql.NullTime
 }
 
-func FromDomainTrace(trace *domain.Trace) *Trace {
+func FromDomainTrace(trace *domain.Trace, username string) *Trace {
 	return &Trace{
+		Username:  username,
 		ID:        string(trace.ID()),
 		ParentID:  sql.NullString{String: string(trace.ParentID()), Valid: true},
-		Estimated: sql.NullInt64{Int64: int64(trace.Estimated().Seconds()), Valid: true},
-		Actual:    sql.NullInt64{Int64: int64(trace.Actual().Seconds()), Valid: true},
+		Estimated: sql.NullInt64{Int64: int64(trace.Estimated()), Valid: true},
+		Self:      sql.NullInt64{Int64: int64(trace.Self()), Valid: true},
+		Actual:    sql.NullInt64{Int64: int64(trace.Actual()), Valid: true},
 		StartedAt: toNullTime(trace.StartedAt()),
+		DueAt:     toNullTime(trace.DueAt()),
 	}
 }
 
@@ -43,9 +50,11 @@ func (t *Trace) ToDomain() *domain.Trace {
 	return domain.NewTrace(
 		domain.TraceID(t.ID),
 		domain.TraceID(getString(t.ParentID)),
-		time.Duration(getInt64(t.Estimated))*time.Second,
-		time.Duration(getInt64(t.Actual))*time.Second,
+		time.Duration(getInt64(t.Estimated)),
+		time.Duration(getInt64(t.Self)),
+		time.Duration(getInt64(t.Actual)),
 		getTime(t.StartedAt),
+		getTime(t.DueAt),
 	)
 }
 
@@ -0,0 +1,91 @@
+package gorm
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+type Webhook struct {
+	ID        string `gorm:"primaryKey"`
+	Username  string `gorm:"index"`
+	URL       string
+	Secret    string
+	Events    []string `gorm:"serializer:json"`
+	CreatedAt time.Time
+}
+
+func FromDomainWebhook(webhook *domain.Webhook) *Webhook {
+	var events []string
+	for _, event := range webhook.Events() {
+		events = append(events, string(event))
+	}
+
+	return &Webhook{
+		ID:        string(webhook.ID()),
+		Username:  webhook.Username(),
+		URL:       webhook.URL(),
+		Secret:    webhook.Secret(),
+		Events:    events,
+		CreatedAt: webhook.CreatedAt(),
+	}
+}
+
+func (w *Webhook) ToDomain() *domain.Webhook {
+	var events []domain.WebhookEvent
+	for _, event := range w.Events {
+		events = append(events, domain.WebhookEvent(event))
+	}
+
+	return domain.NewWebhook(domain.WebhookID(w.ID), w.Username, w.URL, w.Secret, events, w.CreatedAt)
+}
+
+type WebhookDelivery struct {
+	ID             string `gorm:"primaryKey"`
+	WebhookID      string `gorm:"index"`
+	Username       string `gorm:"index"`
+	Event          string
+	Payload        []byte
+	Status         string `gorm:"index"`
+	Attempts       int
+	NextAttemptAt  sql.NullTime `gorm:"index"`
+	LastStatusCode int
+	LastError      string
+	CreatedAt      time.Time
+	DeliveredAt    sql.NullTime
+}
+
+func FromDomainWebhookDelivery(delivery *domain.WebhookDelivery) *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:             string(delivery.ID()),
+		WebhookID:      string(delivery.WebhookID()),
+		Username:       delivery.Username(),
+		Event:          string(delivery.Event()),
+		Payload:        delivery.Payload(),
+		Status:         string(delivery.Status()),
+		Attempts:       delivery.Attempts(),
+		NextAttemptAt:  toNullTime(delivery.NextAttemptAt()),
+		LastStatusCode: delivery.LastStatusCode(),
+		LastError:      delivery.LastError(),
+		CreatedAt:      delivery.CreatedAt(),
+		DeliveredAt:    toNullTime(delivery.DeliveredAt()),
+	}
+}
+
+func (d *WebhookDelivery) ToDomain() *domain.WebhookDelivery {
+	return domain.NewWebhookDelivery(
+		domain.WebhookDeliveryID(d.ID),
+		domain.WebhookID(d.WebhookID),
+		d.Username,
+		domain.WebhookEvent(d.Event),
+		d.Payload,
+		domain.WebhookDeliveryStatus(d.Status),
+		d.Attempts,
+		getTime(d.NextAttemptAt),
+		d.LastStatusCode,
+		d.LastError,
+		d.CreatedAt,
+		getTime(d.DeliveredAt),
+	)
+}
@@ -0,0 +1,65 @@
+package gorm
+
+import (
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+type Workflow struct {
+	Username    string               `gorm:"primaryKey"`
+	Statuses    []WorkflowStatus     `gorm:"serializer:json"`
+	Transitions []WorkflowTransition `gorm:"serializer:json"`
+}
+
+type WorkflowStatus struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+}
+
+type WorkflowTransition struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+func FromDomainWorkflow(workflow *domain.Workflow) *Workflow {
+	var statuses []WorkflowStatus
+	for _, status := range workflow.Statuses() {
+		statuses = append(statuses, WorkflowStatus{
+			Name:     string(status.Name()),
+			Category: string(status.Category()),
+		})
+	}
+
+	var transitions []WorkflowTransition
+	for _, transition := range workflow.Transitions() {
+		transitions = append(transitions, WorkflowTransition{
+			From: string(transition.From()),
+			To:   string(transition.To()),
+		})
+	}
+
+	return &Workflow{
+		Username:    workflow.Username(),
+		Statuses:    statuses,
+		Transitions: transitions,
+	}
+}
+
+func (w *Workflow) ToDomain() *domain.Workflow {
+	var statuses []*domain.WorkflowStatus
+	for _, status := range w.Statuses {
+		statuses = append(statuses, domain.NewWorkflowStatus(
+			domain.TaskStatus(status.Name),
+			domain.TaskStatus(status.Category),
+		))
+	}
+
+	var transitions []*domain.Transition
+	for _, transition := range w.Transitions {
+		transitions = append(transitions, domain.NewTransition(
+			domain.TaskStatus(transition.From),
+			domain.TaskStatus(transition.To),
+		))
+	}
+
+	return domain.NewWorkflow(w.Username, statuses, transitions)
+}
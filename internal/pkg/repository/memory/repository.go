@@ -2,41 +2,81 @@ package memory
 
 import (
 	"context"
+	"maps"
+	"slices"
+	"sort"
+	"time"
 
 	"github.com/neatflowcv/focus/internal/pkg/domain"
 	"github.com/neatflowcv/focus/internal/pkg/repository"
 )
 
 var (
-	_ repository.Repository      = (*Repository)(nil)
-	_ repository.ExtraRepository = (*Repository)(nil)
-	_ repository.TraceRepository = (*Repository)(nil)
+	_ repository.Repository           = (*Repository)(nil)
+	_ repository.ExtraRepository      = (*Repository)(nil)
+	_ repository.TraceRepository      = (*Repository)(nil)
+	_ repository.BackupRepository     = (*Repository)(nil)
+	_ repository.AutoStopRepository   = (*Repository)(nil)
+	_ repository.BurndownRepository   = (*Repository)(nil)
+	_ repository.WorkflowRepository   = (*Repository)(nil)
+	_ repository.OutboxRepository     = (*Repository)(nil)
+	_ repository.WebhookRepository    = (*Repository)(nil)
+	_ repository.DeadLetterRepository = (*Repository)(nil)
 )
 
 type Repository struct {
-	Tasks    map[string]map[domain.TaskID]*domain.Task
-	Children map[domain.TaskID][]domain.TaskID
-	Extras   map[domain.ExtraID]*domain.Extra
-	Traces   map[domain.TraceID]*domain.Trace
+	Tasks     map[string]map[domain.TaskID]*domain.Task
+	Children  map[string]map[domain.TaskID][]domain.TaskID
+	Extras    map[string]map[domain.ExtraID]*domain.Extra
+	Traces    map[string]map[domain.TraceID]*domain.Trace
+	Sessions  map[string]map[domain.SessionID]*domain.Session
+	Policies  map[string]*domain.AutoStopPolicy
+	Workflows map[string]*domain.Workflow
+
+	Projects  map[string]map[domain.TaskID]*domain.TrackedProject
+	Snapshots map[string]map[domain.TaskID][]*domain.BurndownSnapshot
+
+	Outbox []*repository.OutboxEvent
+
+	Webhooks   map[string]map[domain.WebhookID]*domain.Webhook
+	Deliveries []*domain.WebhookDelivery
+
+	DeadLetters []*repository.DeadLetter
+	lastLetter  uint64
 }
 
 func NewRepository() *Repository {
 	return &Repository{
-		Tasks:    make(map[string]map[domain.TaskID]*domain.Task),
-		Children: make(map[domain.TaskID][]domain.TaskID),
-		Extras:   make(map[domain.ExtraID]*domain.Extra),
-		Traces:   make(map[domain.TraceID]*domain.Trace),
+		Tasks:     make(map[string]map[domain.TaskID]*domain.Task),
+		Children:  make(map[string]map[domain.TaskID][]domain.TaskID),
+		Extras:    make(map[string]map[domain.ExtraID]*domain.Extra),
+		Traces:    make(map[string]map[domain.TraceID]*domain.Trace),
+		Sessions:  make(map[string]map[domain.SessionID]*domain.Session),
+		Policies:  make(map[string]*domain.AutoStopPolicy),
+		Workflows: make(map[string]*domain.Workflow),
+
+		Projects:  make(map[string]map[domain.TaskID]*domain.TrackedProject),
+		Snapshots: make(map[string]map[domain.TaskID][]*domain.BurndownSnapshot),
+
+		Outbox: nil,
+
+		Webhooks:   make(map[string]map[domain.WebhookID]*domain.Webhook),
+		Deliveries: nil,
+
+		DeadLetters: nil,
+		lastLetter:  0,
 	}
 }
 
 func (r *Repository) CreateTasks(ctx context.Context, username string, tasks ...*domain.Task) error {
 	if _, ok := r.Tasks[username]; !ok {
 		r.Tasks[username] = make(map[domain.TaskID]*domain.Task)
+		r.Children[username] = make(map[domain.TaskID][]domain.TaskID)
 	}
 
 	for _, task := range tasks {
 		r.Tasks[username][task.ID()] = task
-		r.Children[task.ParentID()] = append(r.Children[task.ParentID()], task.ID())
+		r.Children[username][task.ParentID()] = append(r.Children[username][task.ParentID()], task.ID())
 	}
 
 	return nil
@@ -54,7 +94,7 @@ func (r *Repository) GetTask(ctx context.Context, username string, id domain.Tas
 func (r *Repository) ListTasks(ctx context.Context, username string, parentID domain.TaskID) ([]*domain.Task, error) {
 	var ret []*domain.Task
 
-	for _, id := range r.Children[parentID] {
+	for _, id := range r.Children[username][parentID] {
 		task, ok := r.Tasks[username][id]
 		if !ok {
 			panic("task not found")
@@ -78,28 +118,32 @@ func (r *Repository) DeleteTasks(ctx context.Context, username string, tasks ...
 	return nil
 }
 
-func (r *Repository) CreateExtra(ctx context.Context, extra *domain.Extra) error {
-	if _, ok := r.Extras[extra.ID()]; ok {
+func (r *Repository) CreateExtra(ctx context.Context, username string, extra *domain.Extra) error {
+	if _, ok := r.Extras[username][extra.ID()]; ok {
 		return repository.ErrExtraAlreadyExists
 	}
 
-	r.Extras[extra.ID()] = extra
+	if _, ok := r.Extras[username]; !ok {
+		r.Extras[username] = make(map[domain.ExtraID]*domain.Extra)
+	}
+
+	r.Extras[username][extra.ID()] = extra
 
 	return nil
 }
 
-func (r *Repository) DeleteExtra(ctx context.Context, extra *domain.Extra) error {
-	if _, ok := r.Extras[extra.ID()]; !ok {
+func (r *Repository) DeleteExtra(ctx context.Context, username string, extra *domain.Extra) error {
+	if _, ok := r.Extras[username][extra.ID()]; !ok {
 		return repository.ErrExtraNotFound
 	}
 
-	delete(r.Extras, extra.ID())
+	delete(r.Extras[username], extra.ID())
 
 	return nil
 }
 
-func (r *Repository) GetExtra(ctx context.Context, id domain.ExtraID) (*domain.Extra, error) {
-	extra, ok := r.Extras[id]
+func (r *Repository) GetExtra(ctx context.Context, username string, id domain.ExtraID) (*domain.Extra, error) {
+	extra, ok := r.Extras[username][id]
 	if !ok {
 		return nil, repository.ErrExtraNotFound
 	}
@@ -107,11 +151,11 @@ func (r *Repository) GetExtra(ctx context.Context, id domain.ExtraID) (*domain.E
 	return extra, nil
 }
 
-func (r *Repository) ListExtras(ctx context.Context, ids []domain.ExtraID) ([]*domain.Extra, error) {
+func (r *Repository) ListExtras(ctx context.Context, username string, ids []domain.ExtraID) ([]*domain.Extra, error) {
 	var ret []*domain.Extra
 
 	for _, id := range ids {
-		extra, ok := r.Extras[id]
+		extra, ok := r.Extras[username][id]
 		if !ok {
 			continue
 		}
@@ -122,28 +166,52 @@ func (r *Repository) ListExtras(ctx context.Context, ids []domain.ExtraID) ([]*d
 	return ret, nil
 }
 
-func (r *Repository) UpdateExtra(ctx context.Context, extra *domain.Extra) error {
-	if _, ok := r.Extras[extra.ID()]; !ok {
+func (r *Repository) ListChildExtras(
+	ctx context.Context,
+	username string,
+	parentID domain.ExtraID,
+) ([]*domain.Extra, error) {
+	var ret []*domain.Extra
+
+	for _, extra := range r.Extras[username] {
+		if extra.ParentID() == parentID {
+			ret = append(ret, extra)
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID() < ret[j].ID()
+	})
+
+	return ret, nil
+}
+
+func (r *Repository) UpdateExtra(ctx context.Context, username string, extra *domain.Extra) error {
+	if _, ok := r.Extras[username][extra.ID()]; !ok {
 		return repository.ErrExtraNotFound
 	}
 
-	r.Extras[extra.ID()] = extra
+	r.Extras[username][extra.ID()] = extra
 
 	return nil
 }
 
-func (r *Repository) CreateTrace(ctx context.Context, trace *domain.Trace) error {
-	if _, ok := r.Traces[trace.ID()]; ok {
+func (r *Repository) CreateTrace(ctx context.Context, username string, trace *domain.Trace) error {
+	if _, ok := r.Traces[username][trace.ID()]; ok {
 		return repository.ErrTraceAlreadyExists
 	}
 
-	r.Traces[trace.ID()] = trace
+	if _, ok := r.Traces[username]; !ok {
+		r.Traces[username] = make(map[domain.TraceID]*domain.Trace)
+	}
+
+	r.Traces[username][trace.ID()] = trace
 
 	return nil
 }
 
-func (r *Repository) GetTrace(ctx context.Context, id domain.TraceID) (*domain.Trace, error) {
-	trace, ok := r.Traces[id]
+func (r *Repository) GetTrace(ctx context.Context, username string, id domain.TraceID) (*domain.Trace, error) {
+	trace, ok := r.Traces[username][id]
 	if !ok {
 		return nil, repository.ErrTraceNotFound
 	}
@@ -151,28 +219,41 @@ func (r *Repository) GetTrace(ctx context.Context, id domain.TraceID) (*domain.T
 	return trace, nil
 }
 
-func (r *Repository) UpdateTraces(ctx context.Context, traces ...*domain.Trace) error {
+func (r *Repository) UpdateTraces(ctx context.Context, username string, traces ...*domain.Trace) error {
 	for _, trace := range traces {
-		if _, ok := r.Traces[trace.ID()]; !ok {
+		if _, ok := r.Traces[username][trace.ID()]; !ok {
 			return repository.ErrTraceNotFound
 		}
 	}
 
 	for _, trace := range traces {
-		r.Traces[trace.ID()] = trace
+		r.Traces[username][trace.ID()] = trace
 	}
 
 	return nil
 }
 
-func (r *Repository) DeleteTrace(ctx context.Context, trace *domain.Trace) error {
-	if _, ok := r.Traces[trace.ID()]; !ok {
-		return repository.ErrTraceNotFound
+func (r *Repository) DeleteTrace(
+	ctx context.Context,
+	username string,
+	trace *domain.Trace,
+	updates ...*domain.Trace,
+) error {
+	for _, item := range append([]*domain.Trace{trace}, updates...) {
+		if _, ok := r.Traces[username][item.ID()]; !ok {
+			return repository.ErrTraceNotFound
+		}
 	}
 
-	delete(r.Traces, trace.ID())
+	delete(r.Traces[username], trace.ID())
 
-	return nil
+	for id, session := range r.Sessions[username] {
+		if session.TraceID() == trace.ID() {
+			delete(r.Sessions[username], id)
+		}
+	}
+
+	return r.UpdateTraces(ctx, username, updates...)
 }
 
 func (r *Repository) UpdateTasks(ctx context.Context, username string, tasks ...*domain.Task) error {
@@ -183,11 +264,32 @@ func (r *Repository) UpdateTasks(ctx context.Context, username string, tasks ...
 	return nil
 }
 
-func (r *Repository) ListTraces(ctx context.Context, ids []domain.TraceID) ([]*domain.Trace, error) {
+func (r *Repository) CommitTasks(ctx context.Context, username string, change *repository.TaskChange) error {
+	for _, task := range change.Delete {
+		if _, ok := r.Tasks[username][task.ID()]; !ok {
+			return repository.ErrTaskNotFound
+		}
+	}
+
+	_ = r.CreateTasks(ctx, username, change.Create...)
+	_ = r.UpdateTasks(ctx, username, change.Update...)
+	_ = r.DeleteTasks(ctx, username, change.Delete...)
+
+	for _, event := range change.Events {
+		saved := *event
+		saved.ID = uint64(len(r.Outbox) + 1) //nolint:gosec
+		saved.Username = username
+		r.Outbox = append(r.Outbox, &saved)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListTraces(ctx context.Context, username string, ids []domain.TraceID) ([]*domain.Trace, error) {
 	var ret []*domain.Trace
 
 	for _, id := range ids {
-		trace, ok := r.Traces[id]
+		trace, ok := r.Traces[username][id]
 		if !ok {
 			continue
 		}
@@ -198,10 +300,14 @@ func (r *Repository) ListTraces(ctx context.Context, ids []domain.TraceID) ([]*d
 	return ret, nil
 }
 
-func (r *Repository) ListChildTraces(ctx context.Context, parentID domain.TraceID) ([]*domain.Trace, error) {
+func (r *Repository) ListChildTraces(
+	ctx context.Context,
+	username string,
+	parentID domain.TraceID,
+) ([]*domain.Trace, error) {
 	var ret []*domain.Trace
 
-	for _, trace := range r.Traces {
+	for _, trace := range r.Traces[username] {
 		if trace.ParentID() == parentID {
 			ret = append(ret, trace)
 		}
@@ -209,3 +315,553 @@ func (r *Repository) ListChildTraces(ctx context.Context, parentID domain.TraceI
 
 	return ret, nil
 }
+
+func (r *Repository) ListAllExtras(ctx context.Context, username string) ([]*domain.Extra, error) {
+	var ret []*domain.Extra
+	for _, extra := range r.Extras[username] {
+		ret = append(ret, extra)
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) ListAllTraces(ctx context.Context, username string) ([]*domain.Trace, error) {
+	var ret []*domain.Trace
+	for _, trace := range r.Traces[username] {
+		ret = append(ret, trace)
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) CreateSession(ctx context.Context, username string, session *domain.Session) error {
+	if _, ok := r.Sessions[username][session.ID()]; ok {
+		return repository.ErrSessionAlreadyExists
+	}
+
+	if _, ok := r.Sessions[username]; !ok {
+		r.Sessions[username] = make(map[domain.SessionID]*domain.Session)
+	}
+
+	r.Sessions[username][session.ID()] = session
+
+	return nil
+}
+
+func (r *Repository) CommitSession(ctx context.Context, username string, change *repository.SessionChange) error {
+	for _, session := range slices.Concat(change.Create, change.Update, change.Delete) {
+		if _, ok := r.Traces[username][session.TraceID()]; !ok {
+			return repository.ErrTraceNotFound
+		}
+	}
+
+	for _, session := range change.Create {
+		if _, ok := r.Sessions[username][session.ID()]; ok {
+			return repository.ErrSessionAlreadyExists
+		}
+	}
+
+	for _, session := range slices.Concat(change.Update, change.Delete) {
+		if _, ok := r.Sessions[username][session.ID()]; !ok {
+			return repository.ErrSessionNotFound
+		}
+	}
+
+	for _, trace := range change.Traces {
+		if _, ok := r.Traces[username][trace.ID()]; !ok {
+			return repository.ErrTraceNotFound
+		}
+	}
+
+	sessions := maps.Clone(r.Sessions[username])
+	if sessions == nil {
+		sessions = make(map[domain.SessionID]*domain.Session)
+	}
+
+	for _, session := range change.Delete {
+		delete(sessions, session.ID())
+	}
+
+	for _, session := range slices.Concat(change.Create, change.Update) {
+		sessions[session.ID()] = session
+	}
+
+	for _, session := range slices.Concat(change.Create, change.Update) {
+		for _, other := range sessions {
+			if other.ID() != session.ID() && other.TraceID() == session.TraceID() &&
+				other.Overlaps(session.StartedAt(), session.EndedAt()) {
+				return repository.ErrSessionOverlaps
+			}
+		}
+	}
+
+	r.Sessions[username] = sessions
+
+	return r.UpdateTraces(ctx, username, change.Traces...)
+}
+
+func (r *Repository) GetSession(ctx context.Context, username string, id domain.SessionID) (*domain.Session, error) {
+	session, ok := r.Sessions[username][id]
+	if !ok {
+		return nil, repository.ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+func (r *Repository) UpdateSession(ctx context.Context, username string, session *domain.Session) error {
+	if _, ok := r.Sessions[username][session.ID()]; !ok {
+		return repository.ErrSessionNotFound
+	}
+
+	r.Sessions[username][session.ID()] = session
+
+	return nil
+}
+
+func (r *Repository) DeleteSession(ctx context.Context, username string, session *domain.Session) error {
+	if _, ok := r.Sessions[username][session.ID()]; !ok {
+		return repository.ErrSessionNotFound
+	}
+
+	delete(r.Sessions[username], session.ID())
+
+	return nil
+}
+
+func (r *Repository) ListSessions(
+	ctx context.Context,
+	username string,
+	traceIDs []domain.TraceID,
+) ([]*domain.Session, error) {
+	var ret []*domain.Session
+
+	for _, session := range r.Sessions[username] {
+		if slices.Contains(traceIDs, session.TraceID()) {
+			ret = append(ret, session)
+		}
+	}
+
+	sortSessions(ret)
+
+	return ret, nil
+}
+
+func (r *Repository) ListAllSessions(ctx context.Context, username string) ([]*domain.Session, error) {
+	ret := slices.Collect(maps.Values(r.Sessions[username]))
+
+	sortSessions(ret)
+
+	return ret, nil
+}
+
+func (r *Repository) ListUsernames(ctx context.Context) ([]string, error) {
+	var ret []string
+	for username := range r.Tasks {
+		ret = append(ret, username)
+	}
+
+	sort.Strings(ret)
+
+	return ret, nil
+}
+
+func (r *Repository) ListAllTasks(ctx context.Context, username string) ([]*domain.Task, error) {
+	var ret []*domain.Task
+	for _, task := range r.Tasks[username] {
+		ret = append(ret, task)
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) ReplaceUserData(ctx context.Context, data ...*repository.UserData) error {
+	for _, item := range data {
+		r.Tasks[item.Username] = make(map[domain.TaskID]*domain.Task)
+		r.Children[item.Username] = make(map[domain.TaskID][]domain.TaskID)
+		r.Extras[item.Username] = make(map[domain.ExtraID]*domain.Extra)
+		r.Traces[item.Username] = make(map[domain.TraceID]*domain.Trace)
+		r.Sessions[item.Username] = make(map[domain.SessionID]*domain.Session)
+		r.Projects[item.Username] = make(map[domain.TaskID]*domain.TrackedProject)
+		r.Snapshots[item.Username] = make(map[domain.TaskID][]*domain.BurndownSnapshot)
+		r.Webhooks[item.Username] = make(map[domain.WebhookID]*domain.Webhook)
+		r.Deliveries = slices.DeleteFunc(r.Deliveries, func(delivery *domain.WebhookDelivery) bool {
+			return delivery.Username() == item.Username
+		})
+
+		delete(r.Workflows, item.Username)
+		delete(r.Policies, item.Username)
+
+		if item.Workflow != nil {
+			r.Workflows[item.Username] = item.Workflow
+		}
+
+		if item.Policy != nil {
+			r.Policies[item.Username] = item.Policy
+		}
+
+		for _, task := range item.Tasks {
+			r.Tasks[item.Username][task.ID()] = task
+			r.Children[item.Username][task.ParentID()] = append(r.Children[item.Username][task.ParentID()], task.ID())
+		}
+
+		for _, extra := range item.Extras {
+			r.Extras[item.Username][extra.ID()] = extra
+		}
+
+		for _, trace := range item.Traces {
+			r.Traces[item.Username][trace.ID()] = trace
+		}
+
+		for _, session := range item.Sessions {
+			r.Sessions[item.Username][session.ID()] = session
+		}
+
+		for _, project := range item.Projects {
+			r.Projects[item.Username][project.RootID()] = project
+		}
+
+		for _, snapshot := range item.Snapshots {
+			r.Snapshots[item.Username][snapshot.RootID()] = append(r.Snapshots[item.Username][snapshot.RootID()], snapshot)
+		}
+
+		for _, webhook := range item.Webhooks {
+			r.Webhooks[item.Username][webhook.ID()] = webhook
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) SaveAutoStopPolicy(ctx context.Context, policy *domain.AutoStopPolicy) error {
+	r.Policies[policy.Username()] = policy
+
+	return nil
+}
+
+func (r *Repository) GetAutoStopPolicy(ctx context.Context, username string) (*domain.AutoStopPolicy, error) {
+	policy, ok := r.Policies[username]
+	if !ok {
+		return nil, repository.ErrAutoStopPolicyNotFound
+	}
+
+	return policy, nil
+}
+
+func (r *Repository) ListAutoStopPolicies(ctx context.Context) ([]*domain.AutoStopPolicy, error) {
+	var ret []*domain.AutoStopPolicy
+	for _, policy := range r.Policies {
+		ret = append(ret, policy)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Username() < ret[j].Username()
+	})
+
+	return ret, nil
+}
+
+func (r *Repository) SaveTrackedProject(ctx context.Context, project *domain.TrackedProject) error {
+	if _, ok := r.Projects[project.Username()]; !ok {
+		r.Projects[project.Username()] = make(map[domain.TaskID]*domain.TrackedProject)
+	}
+
+	r.Projects[project.Username()][project.RootID()] = project
+
+	return nil
+}
+
+func (r *Repository) GetTrackedProject(
+	ctx context.Context,
+	username string,
+	rootID domain.TaskID,
+) (*domain.TrackedProject, error) {
+	project, ok := r.Projects[username][rootID]
+	if !ok {
+		return nil, repository.ErrTrackedProjectNotFound
+	}
+
+	return project, nil
+}
+
+func (r *Repository) ListTrackedProjects(ctx context.Context) ([]*domain.TrackedProject, error) {
+	var ret []*domain.TrackedProject
+	for _, projects := range r.Projects {
+		for _, project := range projects {
+			ret = append(ret, project)
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Username() != ret[j].Username() {
+			return ret[i].Username() < ret[j].Username()
+		}
+
+		return ret[i].RootID() < ret[j].RootID()
+	})
+
+	return ret, nil
+}
+
+func (r *Repository) DeleteTrackedProject(ctx context.Context, project *domain.TrackedProject) error {
+	if _, ok := r.Projects[project.Username()][project.RootID()]; !ok {
+		return repository.ErrTrackedProjectNotFound
+	}
+
+	delete(r.Projects[project.Username()], project.RootID())
+	delete(r.Snapshots[project.Username()], project.RootID())
+
+	return nil
+}
+
+func (r *Repository) SaveBurndownSnapshot(ctx context.Context, snapshot *domain.BurndownSnapshot) error {
+	if _, ok := r.Snapshots[snapshot.Username()]; !ok {
+		r.Snapshots[snapshot.Username()] = make(map[domain.TaskID][]*domain.BurndownSnapshot)
+	}
+
+	snapshots := r.Snapshots[snapshot.Username()][snapshot.RootID()]
+	snapshots = slices.DeleteFunc(snapshots, func(s *domain.BurndownSnapshot) bool {
+		return s.Date().Equal(snapshot.Date())
+	})
+	snapshots = append(snapshots, snapshot)
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Date().Before(snapshots[j].Date())
+	})
+
+	r.Snapshots[snapshot.Username()][snapshot.RootID()] = snapshots
+
+	return nil
+}
+
+func (r *Repository) ListBurndownSnapshots(
+	ctx context.Context,
+	username string,
+	rootID domain.TaskID,
+) ([]*domain.BurndownSnapshot, error) {
+	return slices.Clone(r.Snapshots[username][rootID]), nil
+}
+
+func (r *Repository) SaveWorkflow(ctx context.Context, workflow *domain.Workflow) error {
+	r.Workflows[workflow.Username()] = workflow
+
+	return nil
+}
+
+func (r *Repository) GetWorkflow(ctx context.Context, username string) (*domain.Workflow, error) {
+	workflow, ok := r.Workflows[username]
+	if !ok {
+		return nil, repository.ErrWorkflowNotFound
+	}
+
+	return workflow, nil
+}
+
+func sortSessions(sessions []*domain.Session) {
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].StartedAt().Before(sessions[j].StartedAt())
+	})
+}
+
+func (r *Repository) ListPendingOutboxEvents(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]*repository.OutboxEvent, error) {
+	var ret []*repository.OutboxEvent
+
+	for _, event := range r.Outbox {
+		if len(ret) == limit {
+			break
+		}
+
+		if !event.DeliveredAt.IsZero() || event.NextAttemptAt.After(now) {
+			continue
+		}
+
+		saved := *event
+		saved.Delivered = slices.Clone(event.Delivered)
+		ret = append(ret, &saved)
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) UpdateOutboxEvent(ctx context.Context, event *repository.OutboxEvent) error {
+	for idx, saved := range r.Outbox {
+		if saved.ID == event.ID {
+			updated := *event
+			r.Outbox[idx] = &updated
+
+			return nil
+		}
+	}
+
+	return repository.ErrOutboxEventNotFound
+}
+
+func (r *Repository) CreateWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	if _, ok := r.Webhooks[webhook.Username()]; !ok {
+		r.Webhooks[webhook.Username()] = make(map[domain.WebhookID]*domain.Webhook)
+	}
+
+	r.Webhooks[webhook.Username()][webhook.ID()] = webhook
+
+	return nil
+}
+
+func (r *Repository) GetWebhook(ctx context.Context, username string, id domain.WebhookID) (*domain.Webhook, error) {
+	webhook, ok := r.Webhooks[username][id]
+	if !ok {
+		return nil, repository.ErrWebhookNotFound
+	}
+
+	return webhook, nil
+}
+
+func (r *Repository) ListWebhooks(ctx context.Context, username string) ([]*domain.Webhook, error) {
+	var ret []*domain.Webhook
+	for _, webhook := range r.Webhooks[username] {
+		ret = append(ret, webhook)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID() < ret[j].ID()
+	})
+
+	return ret, nil
+}
+
+func (r *Repository) DeleteWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	if _, ok := r.Webhooks[webhook.Username()][webhook.ID()]; !ok {
+		return repository.ErrWebhookNotFound
+	}
+
+	delete(r.Webhooks[webhook.Username()], webhook.ID())
+
+	r.Deliveries = slices.DeleteFunc(r.Deliveries, func(delivery *domain.WebhookDelivery) bool {
+		return delivery.Username() == webhook.Username() && delivery.WebhookID() == webhook.ID()
+	})
+
+	return nil
+}
+
+func (r *Repository) CreateWebhookDeliveries(ctx context.Context, deliveries ...*domain.WebhookDelivery) error {
+	r.Deliveries = append(r.Deliveries, deliveries...)
+
+	return nil
+}
+
+func (r *Repository) GetWebhookDelivery(
+	ctx context.Context,
+	username string,
+	id domain.WebhookDeliveryID,
+) (*domain.WebhookDelivery, error) {
+	for _, delivery := range r.Deliveries {
+		if delivery.Username() == username && delivery.ID() == id {
+			return delivery, nil
+		}
+	}
+
+	return nil, repository.ErrWebhookDeliveryNotFound
+}
+
+func (r *Repository) UpdateWebhookDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	for idx, saved := range r.Deliveries {
+		if saved.Username() == delivery.Username() && saved.ID() == delivery.ID() {
+			r.Deliveries[idx] = delivery
+
+			return nil
+		}
+	}
+
+	return repository.ErrWebhookDeliveryNotFound
+}
+
+func (r *Repository) ListWebhookDeliveries(
+	ctx context.Context,
+	username string,
+	webhookID domain.WebhookID,
+) ([]*domain.WebhookDelivery, error) {
+	var ret []*domain.WebhookDelivery
+
+	for _, delivery := range r.Deliveries {
+		if delivery.Username() == username && delivery.WebhookID() == webhookID {
+			ret = append(ret, delivery)
+		}
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) ListDueWebhookDeliveries(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]*domain.WebhookDelivery, error) {
+	var ret []*domain.WebhookDelivery
+
+	for _, delivery := range r.Deliveries {
+		if delivery.Status() != domain.WebhookDeliveryPending || delivery.NextAttemptAt().After(now) {
+			continue
+		}
+
+		ret = append(ret, delivery)
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].NextAttemptAt().Before(ret[j].NextAttemptAt())
+	})
+
+	if len(ret) > limit {
+		ret = ret[:limit]
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) CreateDeadLetter(ctx context.Context, letter *repository.DeadLetter) error {
+	r.lastLetter++
+
+	saved := *letter
+	saved.ID = r.lastLetter
+	letter.ID = saved.ID
+	r.DeadLetters = append(r.DeadLetters, &saved)
+
+	return nil
+}
+
+func (r *Repository) GetDeadLetter(ctx context.Context, id uint64) (*repository.DeadLetter, error) {
+	for _, letter := range r.DeadLetters {
+		if letter.ID == id {
+			saved := *letter
+
+			return &saved, nil
+		}
+	}
+
+	return nil, repository.ErrDeadLetterNotFound
+}
+
+func (r *Repository) ListDeadLetters(ctx context.Context) ([]*repository.DeadLetter, error) {
+	var ret []*repository.DeadLetter
+
+	for _, letter := range r.DeadLetters {
+		saved := *letter
+		ret = append(ret, &saved)
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) DeleteDeadLetter(ctx context.Context, id uint64) error {
+	for idx, letter := range r.DeadLetters {
+		if letter.ID == id {
+			r.DeadLetters = slices.Delete(r.DeadLetters, idx, idx+1)
+
+			return nil
+		}
+	}
+
+	return repository.ErrDeadLetterNotFound
+}
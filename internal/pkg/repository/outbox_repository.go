@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+// OutboxEvent는 task의 변경과 같은 transaction에 저장되었다가 나중에 발행되는 이벤트이다.
+type OutboxEvent struct {
+	ID            uint64 // 저장할 때 정해지며 발행 순서가 된다
+	Username      string
+	Topic         string
+	Payload       []byte
+	CreatedAt     time.Time
+	Attempts      int
+	NextAttemptAt time.Time // 실패한 이벤트를 다시 전달할 때이며 zero이면 바로 전달한다
+	DeliveredAt   time.Time // zero이면 아직 발행되지 않았다
+	Delivered     []int     // 이미 처리한 subscriber의 순번이며 다시 전달할 때 건너뛴다
+	RequestID     string    // 이벤트를 일으킨 요청이며 전달할 때 ctx에 되돌린다
+	TraceID       string
+}
+
+// TaskChange는 한 transaction으로 저장할 task의 변경과 그로 인한 이벤트이다.
+type TaskChange struct {
+	Create []*domain.Task
+	Update []*domain.Task
+	Delete []*domain.Task
+	Events []*OutboxEvent
+}
+
+type OutboxRepository interface {
+	// ListPendingOutboxEvents는 아직 발행되지 않았고 now까지 다시 전달할 때가 된 이벤트를
+	// 저장된 순서대로 limit개까지 돌려준다.
+	ListPendingOutboxEvents(ctx context.Context, now time.Time, limit int) ([]*OutboxEvent, error)
+	UpdateOutboxEvent(ctx context.Context, event *OutboxEvent) error
+}
@@ -12,4 +12,6 @@ type Repository interface {
 	GetTask(ctx context.Context, username string, id domain.TaskID) (*domain.Task, error)
 	ListTasks(ctx context.Context, username string, parentID domain.TaskID) ([]*domain.Task, error)
 	UpdateTasks(ctx context.Context, username string, tasks ...*domain.Task) error
+	// CommitTasks는 task의 생성, 수정, 삭제와 outbox 이벤트를 한 transaction으로 저장한다.
+	CommitTasks(ctx context.Context, username string, change *TaskChange) error
 }
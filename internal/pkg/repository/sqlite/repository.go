@@ -0,0 +1,65 @@
+// Package sqlite는 PostgreSQL 없이 파일 하나에 저장하는 repository를 만든다.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+
+	"github.com/glebarez/sqlite"
+	"github.com/neatflowcv/focus/internal/pkg/repository/gorm"
+)
+
+// busyTimeout은 다른 프로세스가 쓰는 중일 때 기다리는 밀리초이다.
+const busyTimeout = 5000
+
+// NewRepository는 path의 SQLite 데이터베이스를 열고 스키마를 최신 버전으로 올린다.
+func NewRepository(path string) (*gorm.Repository, error) {
+	repo, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = repo.MigrateUp(context.Background(), 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate: %w", err)
+	}
+
+	return repo, nil
+}
+
+// Open은 path의 SQLite 데이터베이스에 연결만 하고 스키마는 건드리지 않는다.
+func Open(path string) (*gorm.Repository, error) {
+	dsn := path + "?" + pragmas()
+
+	db, err := sql.Open(sqlite.DriverName, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite: %w", err)
+	}
+
+	// 잠금 에러를 막고 메모리 데이터베이스를 함께 보도록 연결을 하나로 제한한다.
+	repo, err := gorm.Open(&sqlite.Dialector{
+		DriverName: sqlite.DriverName,
+		DSN:        dsn,
+		Conn:       db,
+	}, gorm.Pool{
+		MaxOpenConns:    1,
+		MaxIdleConns:    1,
+		ConnMaxLifetime: 0,
+		ConnMaxIdleTime: 0,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	return repo, nil
+}
+
+func pragmas() string {
+	query := url.Values{}
+	query.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeout))
+	query.Add("_pragma", "foreign_keys(1)")
+
+	return query.Encode()
+}
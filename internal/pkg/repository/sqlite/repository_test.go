@@ -0,0 +1,289 @@
+package sqlite_test
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	glebarez "github.com/glebarez/sqlite"
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+	"github.com/neatflowcv/focus/internal/pkg/repository"
+	"github.com/neatflowcv/focus/internal/pkg/repository/sqlite"
+	"github.com/stretchr/testify/require"
+)
+
+const username = "test"
+
+func TestRepositoryUpdateTraces_Clear(t *testing.T) {
+	t.Parallel()
+
+	t.Run("started at", func(t *testing.T) {
+		t.Parallel()
+
+		repo, err := sqlite.NewRepository(filepath.Join(t.TempDir(), "focus.db"))
+		require.NoError(t, err)
+
+		now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+		trace := domain.NewTrace("trace", "", 0, 0, 0, now, time.Time{})
+		err = repo.CreateTrace(t.Context(), username, trace)
+		require.NoError(t, err)
+
+		err = repo.UpdateTraces(t.Context(), username, trace.SetStartedAt(time.Time{}).SetSelf(time.Hour))
+
+		require.NoError(t, err)
+
+		got, err := repo.GetTrace(t.Context(), username, "trace")
+		require.NoError(t, err)
+		require.True(t, got.StartedAt().IsZero())
+		require.Equal(t, time.Hour, got.Self())
+	})
+
+	t.Run("due at", func(t *testing.T) {
+		t.Parallel()
+
+		repo, err := sqlite.NewRepository(filepath.Join(t.TempDir(), "focus.db"))
+		require.NoError(t, err)
+
+		now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+		trace := domain.NewTrace("trace", "", 0, 0, 0, time.Time{}, now)
+		err = repo.CreateTrace(t.Context(), username, trace)
+		require.NoError(t, err)
+
+		err = repo.UpdateTraces(t.Context(), username, trace.SetDueAt(time.Time{}))
+
+		require.NoError(t, err)
+
+		got, err := repo.GetTrace(t.Context(), username, "trace")
+		require.NoError(t, err)
+		require.True(t, got.DueAt().IsZero())
+	})
+}
+
+func TestRepositoryCommitSession_Error(t *testing.T) {
+	t.Parallel()
+
+	repo, err := sqlite.NewRepository(filepath.Join(t.TempDir(), "focus.db"))
+	require.NoError(t, err)
+
+	now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	trace := domain.NewTrace("trace", "", 0, 0, 0, now, time.Time{})
+	err = repo.CreateTrace(t.Context(), username, trace)
+	require.NoError(t, err)
+
+	session := domain.NewSession("session", "trace", now, now.Add(time.Hour), false, "")
+	missing := domain.NewTrace("missing", "", 0, 0, 0, time.Time{}, time.Time{})
+
+	err = repo.CommitSession(t.Context(), username, &repository.SessionChange{
+		Create: []*domain.Session{session},
+		Update: nil,
+		Delete: nil,
+		Traces: []*domain.Trace{trace.SetStartedAt(time.Time{}).SetSelf(time.Hour), missing},
+	})
+
+	require.ErrorIs(t, err, repository.ErrTraceNotFound)
+
+	got, err := repo.GetTrace(t.Context(), username, "trace")
+	require.NoError(t, err)
+	require.Equal(t, now, got.StartedAt())
+	require.Equal(t, time.Duration(0), got.Self())
+
+	sessions, err := repo.ListSessions(t.Context(), username, []domain.TraceID{"trace"})
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+func TestRepositoryCommitSession_Overlaps(t *testing.T) {
+	t.Parallel()
+
+	repo, err := sqlite.NewRepository(filepath.Join(t.TempDir(), "focus.db"))
+	require.NoError(t, err)
+
+	now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	trace := domain.NewTrace("trace", "", 0, 0, 0, time.Time{}, time.Time{})
+	err = repo.CreateTrace(t.Context(), username, trace)
+	require.NoError(t, err)
+
+	first := domain.NewSession("first", "trace", now, now.Add(time.Hour), false, "")
+	err = repo.CommitSession(t.Context(), username, &repository.SessionChange{
+		Create: []*domain.Session{first},
+		Update: nil,
+		Delete: nil,
+		Traces: []*domain.Trace{trace.SetSelf(time.Hour).SetActual(time.Hour)},
+	})
+	require.NoError(t, err)
+
+	second := domain.NewSession("second", "trace", now.Add(30*time.Minute), now.Add(2*time.Hour), false, "")
+	err = repo.CommitSession(t.Context(), username, &repository.SessionChange{
+		Create: []*domain.Session{second},
+		Update: nil,
+		Delete: nil,
+		Traces: []*domain.Trace{trace.SetSelf(3 * time.Hour).SetActual(3 * time.Hour)},
+	})
+
+	require.ErrorIs(t, err, repository.ErrSessionOverlaps)
+
+	got, err := repo.GetTrace(t.Context(), username, "trace")
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, got.Self())
+
+	sessions, err := repo.ListSessions(t.Context(), username, []domain.TraceID{"trace"})
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+
+	// 자신과는 겹치지 않는다.
+	err = repo.CommitSession(t.Context(), username, &repository.SessionChange{
+		Create: nil,
+		Update: []*domain.Session{first.SetNote("note")},
+		Delete: nil,
+		Traces: nil,
+	})
+	require.NoError(t, err)
+}
+
+func TestRepositoryDeleteTrace_Sessions(t *testing.T) {
+	t.Parallel()
+
+	repo, err := sqlite.NewRepository(filepath.Join(t.TempDir(), "focus.db"))
+	require.NoError(t, err)
+
+	now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	trace := domain.NewTrace("trace", "", 0, 0, 0, time.Time{}, time.Time{})
+
+	for _, user := range []string{username, "other"} {
+		err = repo.CreateTrace(t.Context(), user, trace)
+		require.NoError(t, err)
+		err = repo.CreateSession(t.Context(), user, domain.NewSession(
+			domain.SessionID(user), "trace", now, now.Add(time.Hour), false, "",
+		))
+		require.NoError(t, err)
+	}
+
+	err = repo.DeleteTrace(t.Context(), username, trace)
+	require.NoError(t, err)
+
+	sessions, err := repo.ListAllSessions(t.Context(), username)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+
+	sessions, err = repo.ListAllSessions(t.Context(), "other")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+}
+
+func TestRepositoryReplaceUserData_OtherUser(t *testing.T) {
+	t.Parallel()
+
+	repo, err := sqlite.NewRepository(filepath.Join(t.TempDir(), "focus.db"))
+	require.NoError(t, err)
+
+	now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	trace := domain.NewTrace("task", "", 0, time.Hour, 0, time.Time{}, time.Time{})
+	session := domain.NewSession("session", "task", now, now.Add(time.Hour), false, "")
+	err = repo.CreateTrace(t.Context(), "other", trace)
+	require.NoError(t, err)
+	err = repo.CreateSession(t.Context(), "other", session)
+	require.NoError(t, err)
+
+	err = repo.ReplaceUserData(t.Context(), &repository.UserData{
+		Username: username,
+		Tasks:    []*domain.Task{domain.NewTask("task", "", "", "title", now, 1)},
+		Extras:   nil,
+		Traces:   nil,
+		Sessions: nil,
+	})
+
+	require.NoError(t, err)
+
+	got, err := repo.GetTrace(t.Context(), "other", "task")
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, got.Self())
+
+	sessions, err := repo.ListSessions(t.Context(), "other", []domain.TraceID{"task"})
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+}
+
+func TestRepositoryCreateTrace_OtherUser(t *testing.T) {
+	t.Parallel()
+
+	repo, err := sqlite.NewRepository(filepath.Join(t.TempDir(), "focus.db"))
+	require.NoError(t, err)
+
+	for _, user := range []string{username, "other"} {
+		err = repo.CreateTrace(t.Context(), user, domain.NewTrace("task", "", 0, 0, 0, time.Time{}, time.Time{}))
+		require.NoError(t, err)
+		err = repo.CreateExtra(t.Context(), user, domain.NewExtra(
+			"task", "", true, domain.TaskStatusTodo, domain.TaskStatusTodo, false, time.Time{}, time.Time{}, 0,
+		))
+		require.NoError(t, err)
+	}
+
+	trace := domain.NewTrace("task", "", 0, time.Hour, time.Hour, time.Time{}, time.Time{})
+	err = repo.UpdateTraces(t.Context(), "other", trace)
+
+	require.NoError(t, err)
+
+	got, err := repo.GetTrace(t.Context(), username, "task")
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), got.Self())
+}
+
+func TestRepositoryMigrateUp_BackfillSelf(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "focus.db")
+	repo, err := sqlite.NewRepository(path)
+	require.NoError(t, err)
+
+	for user, actual := range map[string]time.Duration{username: time.Hour, "other": 2 * time.Hour} {
+		err = repo.CreateTrace(t.Context(), user, domain.NewTrace("parent", "", 0, 0, 3*time.Hour, time.Time{}, time.Time{}))
+		require.NoError(t, err)
+		err = repo.CreateTrace(t.Context(), user, domain.NewTrace("child", "parent", 0, 0, actual, time.Time{}, time.Time{}))
+		require.NoError(t, err)
+	}
+
+	_, err = repo.MigrateDown(t.Context(), 1)
+	require.NoError(t, err)
+
+	// self_ns 컬럼이 없던 데이터베이스처럼 만든다.
+	db, err := sql.Open(glebarez.DriverName, path)
+	require.NoError(t, err)
+	_, err = db.ExecContext(t.Context(), "UPDATE traces SET self_ns = NULL")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	_, err = repo.MigrateUp(t.Context(), 0)
+	require.NoError(t, err)
+
+	for user, self := range map[string]time.Duration{username: 2 * time.Hour, "other": time.Hour} {
+		parent, err := repo.GetTrace(t.Context(), user, "parent")
+		require.NoError(t, err)
+		require.Equal(t, self, parent.Self())
+
+		child, err := repo.GetTrace(t.Context(), user, "child")
+		require.NoError(t, err)
+		require.Equal(t, child.Actual(), child.Self())
+	}
+}
+
+func TestRepositoryMigrateDown_SharedID(t *testing.T) {
+	t.Parallel()
+
+	repo, err := sqlite.NewRepository(filepath.Join(t.TempDir(), "focus.db"))
+	require.NoError(t, err)
+
+	for _, user := range []string{username, "other"} {
+		err = repo.CreateTrace(t.Context(), user, domain.NewTrace("task", "", 0, 0, 0, time.Time{}, time.Time{}))
+		require.NoError(t, err)
+	}
+
+	_, err = repo.MigrateDown(t.Context(), 0)
+
+	require.ErrorContains(t, err, "extras_traces_username_id_primary_key")
+
+	for _, user := range []string{username, "other"} {
+		_, err = repo.GetTrace(t.Context(), user, "task")
+		require.NoError(t, err)
+	}
+}
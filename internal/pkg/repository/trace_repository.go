@@ -6,11 +6,32 @@ import (
 	"github.com/neatflowcv/focus/internal/pkg/domain"
 )
 
+// SessionChange는 한 transaction으로 저장할 구간의 변경과 그로 인해 바뀐 trace이다.
+type SessionChange struct {
+	Create []*domain.Session
+	Update []*domain.Session
+	Delete []*domain.Session
+	Traces []*domain.Trace
+}
+
+// TraceRepository는 trace와 session을 사용자별로 격리한다.
 type TraceRepository interface {
-	CreateTrace(ctx context.Context, trace *domain.Trace) error
-	DeleteTrace(ctx context.Context, trace *domain.Trace) error
-	GetTrace(ctx context.Context, id domain.TraceID) (*domain.Trace, error)
-	UpdateTraces(ctx context.Context, traces ...*domain.Trace) error
-	ListTraces(ctx context.Context, ids []domain.TraceID) ([]*domain.Trace, error)
-	ListChildTraces(ctx context.Context, parentID domain.TraceID) ([]*domain.Trace, error)
+	CreateTrace(ctx context.Context, username string, trace *domain.Trace) error
+	// DeleteTrace는 trace와 그 구간을 지우고 updates를 고치는 일을 한 transaction으로 한다.
+	DeleteTrace(ctx context.Context, username string, trace *domain.Trace, updates ...*domain.Trace) error
+	GetTrace(ctx context.Context, username string, id domain.TraceID) (*domain.Trace, error)
+	UpdateTraces(ctx context.Context, username string, traces ...*domain.Trace) error
+	ListTraces(ctx context.Context, username string, ids []domain.TraceID) ([]*domain.Trace, error)
+	ListChildTraces(ctx context.Context, username string, parentID domain.TraceID) ([]*domain.Trace, error)
+	ListAllTraces(ctx context.Context, username string) ([]*domain.Trace, error)
+
+	CreateSession(ctx context.Context, username string, session *domain.Session) error
+	GetSession(ctx context.Context, username string, id domain.SessionID) (*domain.Session, error)
+	UpdateSession(ctx context.Context, username string, session *domain.Session) error
+	DeleteSession(ctx context.Context, username string, session *domain.Session) error
+	ListSessions(ctx context.Context, username string, traceIDs []domain.TraceID) ([]*domain.Session, error)
+	ListAllSessions(ctx context.Context, username string) ([]*domain.Session, error)
+	// CommitSession은 change를 한 transaction으로 저장한다.
+	// 만들거나 고친 구간이 같은 trace의 다른 구간과 겹치면 ErrSessionOverlaps를 돌려준다.
+	CommitSession(ctx context.Context, username string, change *SessionChange) error
 }
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook *domain.Webhook) error
+	GetWebhook(ctx context.Context, username string, id domain.WebhookID) (*domain.Webhook, error)
+	ListWebhooks(ctx context.Context, username string) ([]*domain.Webhook, error)
+	// DeleteWebhook은 webhook과 함께 그 전달 기록을 지운다.
+	DeleteWebhook(ctx context.Context, webhook *domain.Webhook) error
+
+	CreateWebhookDeliveries(ctx context.Context, deliveries ...*domain.WebhookDelivery) error
+	GetWebhookDelivery(ctx context.Context, username string, id domain.WebhookDeliveryID) (*domain.WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error
+	// ListWebhookDeliveries는 webhook의 전달 기록을 만든 순서로 반환한다.
+	ListWebhookDeliveries(
+		ctx context.Context,
+		username string,
+		webhookID domain.WebhookID,
+	) ([]*domain.WebhookDelivery, error)
+	// ListDueWebhookDeliveries는 모든 사용자의 기록 중 now까지 보내야 하는 pending 기록을 오래된 것부터 반환한다.
+	ListDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*domain.WebhookDelivery, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/neatflowcv/focus/internal/pkg/domain"
+)
+
+type WorkflowRepository interface {
+	// SaveWorkflow는 사용자의 기존 workflow를 교체한다.
+	SaveWorkflow(ctx context.Context, workflow *domain.Workflow) error
+	GetWorkflow(ctx context.Context, username string) (*domain.Workflow, error)
+}